@@ -65,6 +65,7 @@ export default function AttemptModal({ isOpen, onClose, node }: AttemptModalProp
   const [answer, setAnswer] = useState("");
   const [startTime, setStartTime] = useState(Date.now());
   const [elapsed, setElapsed] = useState(0);
+  const [reviewId, setReviewId] = useState(() => crypto.randomUUID());
 
   // Grading State
   const [fsrsGrade, setFsrsGrade] = useState<number | null>(null);
@@ -83,6 +84,7 @@ export default function AttemptModal({ isOpen, onClose, node }: AttemptModalProp
     if (isOpen) {
       setStartTime(Date.now());
       setElapsed(0);
+      setReviewId(crypto.randomUUID());
       setStep("answering");
       setAnswer("");
       setFsrsGrade(null);
@@ -126,7 +128,7 @@ export default function AttemptModal({ isOpen, onClose, node }: AttemptModalProp
 
       const duration = Date.now() - startTime;
 
-      await ReviewCard(String(node.id), fsrsGrade, duration, payload);
+      await ReviewCard(String(node.id), fsrsGrade, duration, payload, reviewId);
 
       await queryClient.invalidateQueries({ queryKey: ["attempts", String(node.id)] });
       await queryClient.invalidateQueries({ queryKey: ["cardState", String(node.id)] });
@@ -18,6 +18,7 @@ export default function useStudySession() {
   // Current Card State
   const [isAnswerShown, setIsAnswerShown] = useState(false);
   const [startTime, setStartTime] = useState(Date.now());
+  const [reviewId, setReviewId] = useState(() => crypto.randomUUID());
   const [intervals, setIntervals] = useState<{ [key: number]: string }>({});
 
   // 1. Initialize Queue from URL
@@ -48,6 +49,7 @@ export default function useStudySession() {
     if (currentNodeId) {
       GetSchedulingInfo(currentNodeId).then(setIntervals).catch(console.error);
       setStartTime(Date.now()); // Reset timer
+      setReviewId(crypto.randomUUID()); // One ID per answer, so double-clicks are ignored
       setIsAnswerShown(false);  // Reset view
     }
   }, [currentNodeId]);
@@ -60,7 +62,7 @@ export default function useStudySession() {
 
     try {
       // Optimistic Update: Move to next immediately
-      await ReviewCard(node.id, grade, duration, "");
+      await ReviewCard(node.id, grade, duration, "", reviewId);
 
       // Update stats
       setSessionStats(prev => ({
@@ -81,7 +83,7 @@ export default function useStudySession() {
       console.error(err);
       toast.error("Failed to save review");
     }
-  }, [node, startTime, currentIndex, queue.length, navigate, searchParams, toast]);
+  }, [node, startTime, reviewId, currentIndex, queue.length, navigate, searchParams, toast]);
 
   return {
     node,
@@ -1,16 +1,45 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
+import {service} from '../models';
 import {ent} from '../models';
 import {data} from '../models';
 
+export function AcceptErrorWeights(arg1:service.ErrorWeightConfig,arg2:Array<string>):Promise<Array<ent.ErrorDefinition>>;
+
+export function AnalyzeErrorWeights(arg1:service.ErrorWeightConfig):Promise<service.ErrorWeightAnalysis>;
+
+export function ApplyDesiredRetention(arg1:string,arg2:number):Promise<ent.SchedulerPreset>;
+
+export function ArchiveErrorType(arg1:string,arg2:boolean):Promise<ent.ErrorDefinition>;
+
+export function AssignPreset(arg1:string,arg2:string):Promise<void>;
+
+export function BuryNode(arg1:string,arg2:boolean):Promise<number>;
+
+export function ComputeOptimalRetention(arg1:string,arg2:service.RetentionSearch):Promise<service.OptimalRetentionResult>;
+
 export function CreateAssociation(arg1:string,arg2:string,arg3:string):Promise<void>;
 
+export function CreateErrorType(arg1:service.ErrorTypeInput):Promise<ent.ErrorDefinition>;
+
 export function CreateNode(arg1:string,arg2:string,arg3:string):Promise<ent.Node>;
 
+export function CreatePreset(arg1:service.PresetSettings):Promise<ent.SchedulerPreset>;
+
+export function DeleteAttempt(arg1:string,arg2:boolean):Promise<service.ReplayReport>;
+
 export function DeleteNode(arg1:string):Promise<void>;
 
+export function DeletePreset(arg1:string):Promise<void>;
+
 export function DuplicateNode(arg1:string):Promise<ent.Node>;
 
+export function EditAttempt(arg1:string,arg2:number,arg3:boolean):Promise<service.ReplayReport>;
+
+export function EvaluateWeights(arg1:string,arg2:Array<number>):Promise<service.WeightComparison>;
+
+export function ExplainPrerequisites(arg1:string):Promise<service.PrerequisiteStatus>;
+
 export function GetAllAttempts():Promise<Array<ent.Attempt>>;
 
 export function GetAttemptDetails(arg1:string):Promise<Record<string, any>>;
@@ -27,6 +56,12 @@ export function GetDueCardsFromNode(arg1:string,arg2:number):Promise<Array<strin
 
 export function GetDueCardsQueue(arg1:number):Promise<Array<string>>;
 
+export function GetEffectivePreset(arg1:string):Promise<ent.SchedulerPreset>;
+
+export function GetErrorTypes(arg1:boolean):Promise<Array<ent.ErrorDefinition>>;
+
+export function GetLeeches(arg1:string):Promise<Array<ent.Node>>;
+
 export function GetNode(arg1:string):Promise<ent.Node>;
 
 export function GetNodeAssociations(arg1:string):Promise<Array<ent.NodeAssociation>>;
@@ -35,20 +70,66 @@ export function GetNodeBreadcrumbs(arg1:string):Promise<Array<ent.Node>>;
 
 export function GetNodeMastery(arg1:string):Promise<Record<string, any>>;
 
+export function GetNodeTimeLimit(arg1:string):Promise<service.TimeLimit>;
+
 export function GetNodeWithCard(arg1:string):Promise<Record<string, any>>;
 
+export function GetPresets():Promise<Array<ent.SchedulerPreset>>;
+
 export function GetRelatedNodes(arg1:string,arg2:string,arg3:string):Promise<Array<ent.Node>>;
 
+export function GetRemediation(arg1:string,arg2:number,arg3:Array<string>):Promise<service.RemediationPlan>;
+
 export function GetSchedulingInfo(arg1:string):Promise<Record<number, string>>;
 
+export function GetSettings():Promise<ent.Settings>;
+
 export function GetSubjects():Promise<Array<ent.Node>>;
 
+export function GetSuggestions(arg1:string,arg2:number,arg3:service.SuggestionRatios):Promise<Array<service.Suggestion>>;
+
+export function GetTimeTravel():Promise<Record<string, any>>;
+
+export function GetTopicTimeStats(arg1:string):Promise<Array<service.TopicTimeStats>>;
+
 export function IsFullscreen():Promise<boolean>;
 
-export function ReviewCard(arg1:string,arg2:number,arg3:number,arg4:string):Promise<void>;
+export function MergeErrorTypes(arg1:string,arg2:string):Promise<service.MergeResult>;
+
+export function ReplayLibrary(arg1:boolean):Promise<service.ReplayReport>;
+
+export function ReplayNode(arg1:string,arg2:boolean):Promise<service.ReplayReport>;
+
+export function ReplaySubtree(arg1:string,arg2:boolean):Promise<service.ReplayReport>;
+
+export function ReturnToPresent():Promise<void>;
+
+export function ReviewCard(arg1:string,arg2:number,arg3:number,arg4:string,arg5:string):Promise<void>;
+
+export function ReviewCardWithErrors(arg1:string,arg2:number,arg3:number,arg4:string,arg5:string,arg6:Array<string>,arg7:string):Promise<void>;
+
+export function RunOptimizer(arg1:string):Promise<service.OptimizationResult>;
 
 export function SearchNodes(arg1:string):Promise<Array<ent.Node>>;
 
+export function SetNodeTimeLimit(arg1:string,arg2:number):Promise<ent.Node>;
+
+export function SimulateWorkload(arg1:service.SimulationScenario):Promise<service.SimulationResult>;
+
+export function SuspendNode(arg1:string,arg2:boolean):Promise<number>;
+
+export function TimeTravel(arg1:string):Promise<void>;
+
 export function ToggleFullscreen():Promise<void>;
 
+export function UndoLastReview():Promise<service.UndoResult>;
+
+export function UnsuspendNode(arg1:string,arg2:boolean):Promise<number>;
+
+export function UpdateDayBoundary(arg1:number,arg2:string):Promise<ent.Settings>;
+
+export function UpdateErrorTypeWeight(arg1:string,arg2:number):Promise<ent.ErrorDefinition>;
+
 export function UpdateNode(arg1:string,arg2:string,arg3:string):Promise<ent.Node>;
+
+export function UpdatePreset(arg1:string,arg2:service.PresetSettings):Promise<ent.SchedulerPreset>;
@@ -2,22 +2,78 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AcceptErrorWeights(arg1, arg2) {
+  return window['go']['app']['App']['AcceptErrorWeights'](arg1, arg2);
+}
+
+export function AnalyzeErrorWeights(arg1) {
+  return window['go']['app']['App']['AnalyzeErrorWeights'](arg1);
+}
+
+export function ApplyDesiredRetention(arg1, arg2) {
+  return window['go']['app']['App']['ApplyDesiredRetention'](arg1, arg2);
+}
+
+export function ArchiveErrorType(arg1, arg2) {
+  return window['go']['app']['App']['ArchiveErrorType'](arg1, arg2);
+}
+
+export function AssignPreset(arg1, arg2) {
+  return window['go']['app']['App']['AssignPreset'](arg1, arg2);
+}
+
+export function BuryNode(arg1, arg2) {
+  return window['go']['app']['App']['BuryNode'](arg1, arg2);
+}
+
+export function ComputeOptimalRetention(arg1, arg2) {
+  return window['go']['app']['App']['ComputeOptimalRetention'](arg1, arg2);
+}
+
 export function CreateAssociation(arg1, arg2, arg3) {
   return window['go']['app']['App']['CreateAssociation'](arg1, arg2, arg3);
 }
 
+export function CreateErrorType(arg1) {
+  return window['go']['app']['App']['CreateErrorType'](arg1);
+}
+
 export function CreateNode(arg1, arg2, arg3) {
   return window['go']['app']['App']['CreateNode'](arg1, arg2, arg3);
 }
 
+export function CreatePreset(arg1) {
+  return window['go']['app']['App']['CreatePreset'](arg1);
+}
+
+export function DeleteAttempt(arg1, arg2) {
+  return window['go']['app']['App']['DeleteAttempt'](arg1, arg2);
+}
+
 export function DeleteNode(arg1) {
   return window['go']['app']['App']['DeleteNode'](arg1);
 }
 
+export function DeletePreset(arg1) {
+  return window['go']['app']['App']['DeletePreset'](arg1);
+}
+
 export function DuplicateNode(arg1) {
   return window['go']['app']['App']['DuplicateNode'](arg1);
 }
 
+export function EditAttempt(arg1, arg2, arg3) {
+  return window['go']['app']['App']['EditAttempt'](arg1, arg2, arg3);
+}
+
+export function EvaluateWeights(arg1, arg2) {
+  return window['go']['app']['App']['EvaluateWeights'](arg1, arg2);
+}
+
+export function ExplainPrerequisites(arg1) {
+  return window['go']['app']['App']['ExplainPrerequisites'](arg1);
+}
+
 export function GetAllAttempts() {
   return window['go']['app']['App']['GetAllAttempts']();
 }
@@ -50,6 +106,18 @@ export function GetDueCardsQueue(arg1) {
   return window['go']['app']['App']['GetDueCardsQueue'](arg1);
 }
 
+export function GetEffectivePreset(arg1) {
+  return window['go']['app']['App']['GetEffectivePreset'](arg1);
+}
+
+export function GetErrorTypes(arg1) {
+  return window['go']['app']['App']['GetErrorTypes'](arg1);
+}
+
+export function GetLeeches(arg1) {
+  return window['go']['app']['App']['GetLeeches'](arg1);
+}
+
 export function GetNode(arg1) {
   return window['go']['app']['App']['GetNode'](arg1);
 }
@@ -66,38 +134,130 @@ export function GetNodeMastery(arg1) {
   return window['go']['app']['App']['GetNodeMastery'](arg1);
 }
 
+export function GetNodeTimeLimit(arg1) {
+  return window['go']['app']['App']['GetNodeTimeLimit'](arg1);
+}
+
 export function GetNodeWithCard(arg1) {
   return window['go']['app']['App']['GetNodeWithCard'](arg1);
 }
 
+export function GetPresets() {
+  return window['go']['app']['App']['GetPresets']();
+}
+
 export function GetRelatedNodes(arg1, arg2, arg3) {
   return window['go']['app']['App']['GetRelatedNodes'](arg1, arg2, arg3);
 }
 
+export function GetRemediation(arg1, arg2, arg3) {
+  return window['go']['app']['App']['GetRemediation'](arg1, arg2, arg3);
+}
+
 export function GetSchedulingInfo(arg1) {
   return window['go']['app']['App']['GetSchedulingInfo'](arg1);
 }
 
+export function GetSettings() {
+  return window['go']['app']['App']['GetSettings']();
+}
+
 export function GetSubjects() {
   return window['go']['app']['App']['GetSubjects']();
 }
 
+export function GetSuggestions(arg1, arg2, arg3) {
+  return window['go']['app']['App']['GetSuggestions'](arg1, arg2, arg3);
+}
+
+export function GetTimeTravel() {
+  return window['go']['app']['App']['GetTimeTravel']();
+}
+
+export function GetTopicTimeStats(arg1) {
+  return window['go']['app']['App']['GetTopicTimeStats'](arg1);
+}
+
 export function IsFullscreen() {
   return window['go']['app']['App']['IsFullscreen']();
 }
 
-export function ReviewCard(arg1, arg2, arg3, arg4) {
-  return window['go']['app']['App']['ReviewCard'](arg1, arg2, arg3, arg4);
+export function MergeErrorTypes(arg1, arg2) {
+  return window['go']['app']['App']['MergeErrorTypes'](arg1, arg2);
+}
+
+export function ReplayLibrary(arg1) {
+  return window['go']['app']['App']['ReplayLibrary'](arg1);
+}
+
+export function ReplayNode(arg1, arg2) {
+  return window['go']['app']['App']['ReplayNode'](arg1, arg2);
+}
+
+export function ReplaySubtree(arg1, arg2) {
+  return window['go']['app']['App']['ReplaySubtree'](arg1, arg2);
+}
+
+export function ReturnToPresent() {
+  return window['go']['app']['App']['ReturnToPresent']();
+}
+
+export function ReviewCard(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['app']['App']['ReviewCard'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function ReviewCardWithErrors(arg1, arg2, arg3, arg4, arg5, arg6, arg7) {
+  return window['go']['app']['App']['ReviewCardWithErrors'](arg1, arg2, arg3, arg4, arg5, arg6, arg7);
+}
+
+export function RunOptimizer(arg1) {
+  return window['go']['app']['App']['RunOptimizer'](arg1);
 }
 
 export function SearchNodes(arg1) {
   return window['go']['app']['App']['SearchNodes'](arg1);
 }
 
+export function SetNodeTimeLimit(arg1, arg2) {
+  return window['go']['app']['App']['SetNodeTimeLimit'](arg1, arg2);
+}
+
+export function SimulateWorkload(arg1) {
+  return window['go']['app']['App']['SimulateWorkload'](arg1);
+}
+
+export function SuspendNode(arg1, arg2) {
+  return window['go']['app']['App']['SuspendNode'](arg1, arg2);
+}
+
+export function TimeTravel(arg1) {
+  return window['go']['app']['App']['TimeTravel'](arg1);
+}
+
 export function ToggleFullscreen() {
   return window['go']['app']['App']['ToggleFullscreen']();
 }
 
+export function UndoLastReview() {
+  return window['go']['app']['App']['UndoLastReview']();
+}
+
+export function UnsuspendNode(arg1, arg2) {
+  return window['go']['app']['App']['UnsuspendNode'](arg1, arg2);
+}
+
+export function UpdateDayBoundary(arg1, arg2) {
+  return window['go']['app']['App']['UpdateDayBoundary'](arg1, arg2);
+}
+
+export function UpdateErrorTypeWeight(arg1, arg2) {
+  return window['go']['app']['App']['UpdateErrorTypeWeight'](arg1, arg2);
+}
+
 export function UpdateNode(arg1, arg2, arg3) {
   return window['go']['app']['App']['UpdateNode'](arg1, arg2, arg3);
 }
+
+export function UpdatePreset(arg1, arg2) {
+  return window['go']['app']['App']['UpdatePreset'](arg1, arg2);
+}
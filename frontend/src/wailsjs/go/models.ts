@@ -4,6 +4,8 @@ export namespace data {
 	    total_nodes: number;
 	    total_attempts: number;
 	    due_cards: number;
+	    suspended: number;
+	    buried: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new DashboardStats(source);
@@ -14,6 +16,8 @@ export namespace data {
 	        this.total_nodes = source["total_nodes"];
 	        this.total_attempts = source["total_attempts"];
 	        this.due_cards = source["due_cards"];
+	        this.suspended = source["suspended"];
+	        this.buried = source["buried"];
 	    }
 	}
 
@@ -21,8 +25,77 @@ export namespace data {
 
 export namespace ent {
 	
+	export class AttemptErrorEdges {
+	    attempt?: Attempt;
+	    error_definition?: ErrorDefinition;
+	
+	    static createFrom(source: any = {}) {
+	        return new AttemptErrorEdges(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.attempt = this.convertValues(source["attempt"], Attempt);
+	        this.error_definition = this.convertValues(source["error_definition"], ErrorDefinition);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class AttemptError {
+	    id?: number[];
+	    attempt_id?: number[];
+	    error_type_id?: number[];
+	    edges: AttemptErrorEdges;
+	
+	    static createFrom(source: any = {}) {
+	        return new AttemptError(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.attempt_id = source["attempt_id"];
+	        this.error_type_id = source["error_type_id"];
+	        this.edges = this.convertValues(source["edges"], AttemptErrorEdges);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class ErrorDefinitionEdges {
 	    attempts?: Attempt[];
+	    attempt_errors?: AttemptError[];
 	
 	    static createFrom(source: any = {}) {
 	        return new ErrorDefinitionEdges(source);
@@ -31,6 +104,7 @@ export namespace ent {
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.attempts = this.convertValues(source["attempts"], Attempt);
+	        this.attempt_errors = this.convertValues(source["attempt_errors"], AttemptError);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -53,9 +127,13 @@ export namespace ent {
 	}
 	export class ErrorDefinition {
 	    id?: number[];
+	    code?: string;
 	    label?: string;
+	    category?: string;
+	    description?: string;
 	    base_weight?: number;
 	    is_system?: boolean;
+	    is_archived?: boolean;
 	    edges: ErrorDefinitionEdges;
 	
 	    static createFrom(source: any = {}) {
@@ -65,9 +143,13 @@ export namespace ent {
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.code = source["code"];
 	        this.label = source["label"];
+	        this.category = source["category"];
+	        this.description = source["description"];
 	        this.base_weight = source["base_weight"];
 	        this.is_system = source["is_system"];
+	        this.is_archived = source["is_archived"];
 	        this.edges = this.convertValues(source["edges"], ErrorDefinitionEdges);
 	    }
 	
@@ -89,6 +171,126 @@ export namespace ent {
 		    return a;
 		}
 	}
+	export class SchedulerPresetEdges {
+	    nodes?: Node[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SchedulerPresetEdges(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.nodes = this.convertValues(source["nodes"], Node);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SchedulerPreset {
+	    id?: number[];
+	    name?: string;
+	    scheduler?: string;
+	    leitner_intervals?: number[];
+	    algorithm_version?: string;
+	    weights?: number[];
+	    desired_retention?: number;
+	    max_interval?: number;
+	    enable_fuzz?: boolean;
+	    enable_load_balance?: boolean;
+	    learning_steps?: number[];
+	    relearning_steps?: number[];
+	    graduating_interval?: number;
+	    easy_interval?: number;
+	    leech_threshold?: number;
+	    leech_suspend?: boolean;
+	    bury_translations?: boolean;
+	    implicit_credit?: number;
+	    implicit_lapse_pull?: number;
+	    prerequisite_gate?: string;
+	    prerequisite_min_stability?: number;
+	    error_resolve_after?: number;
+	    new_per_day?: number;
+	    reviews_per_day?: number;
+	    learn_ahead_minutes?: number;
+	    queue_order?: string;
+	    is_default?: boolean;
+	    // Go type: time
+	    created_at?: any;
+	    // Go type: time
+	    updated_at?: any;
+	    edges: SchedulerPresetEdges;
+	
+	    static createFrom(source: any = {}) {
+	        return new SchedulerPreset(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.scheduler = source["scheduler"];
+	        this.leitner_intervals = source["leitner_intervals"];
+	        this.algorithm_version = source["algorithm_version"];
+	        this.weights = source["weights"];
+	        this.desired_retention = source["desired_retention"];
+	        this.max_interval = source["max_interval"];
+	        this.enable_fuzz = source["enable_fuzz"];
+	        this.enable_load_balance = source["enable_load_balance"];
+	        this.learning_steps = source["learning_steps"];
+	        this.relearning_steps = source["relearning_steps"];
+	        this.graduating_interval = source["graduating_interval"];
+	        this.easy_interval = source["easy_interval"];
+	        this.leech_threshold = source["leech_threshold"];
+	        this.leech_suspend = source["leech_suspend"];
+	        this.bury_translations = source["bury_translations"];
+	        this.implicit_credit = source["implicit_credit"];
+	        this.implicit_lapse_pull = source["implicit_lapse_pull"];
+	        this.prerequisite_gate = source["prerequisite_gate"];
+	        this.prerequisite_min_stability = source["prerequisite_min_stability"];
+	        this.error_resolve_after = source["error_resolve_after"];
+	        this.new_per_day = source["new_per_day"];
+	        this.reviews_per_day = source["reviews_per_day"];
+	        this.learn_ahead_minutes = source["learn_ahead_minutes"];
+	        this.queue_order = source["queue_order"];
+	        this.is_default = source["is_default"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	        this.updated_at = this.convertValues(source["updated_at"], null);
+	        this.edges = this.convertValues(source["edges"], SchedulerPresetEdges);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class ErrorResolutionEdges {
 	    node?: Node;
 	
@@ -126,9 +328,12 @@ export namespace ent {
 	    weight_impact?: number;
 	    is_resolved?: boolean;
 	    resolution_notes?: string;
+	    occurrences?: number;
 	    // Go type: time
 	    created_at?: any;
 	    // Go type: time
+	    last_seen_at?: any;
+	    // Go type: time
 	    resolved_at?: any;
 	    edges: ErrorResolutionEdges;
 	
@@ -144,7 +349,9 @@ export namespace ent {
 	        this.weight_impact = source["weight_impact"];
 	        this.is_resolved = source["is_resolved"];
 	        this.resolution_notes = source["resolution_notes"];
+	        this.occurrences = source["occurrences"];
 	        this.created_at = this.convertValues(source["created_at"], null);
+	        this.last_seen_at = this.convertValues(source["last_seen_at"], null);
 	        this.resolved_at = this.convertValues(source["resolved_at"], null);
 	        this.edges = this.convertValues(source["edges"], ErrorResolutionEdges);
 	    }
@@ -316,6 +523,7 @@ export namespace ent {
 	    incoming_associations?: NodeAssociation[];
 	    fsrs_card?: FsrsCard;
 	    error_resolutions?: ErrorResolution[];
+	    preset?: SchedulerPreset;
 	
 	    static createFrom(source: any = {}) {
 	        return new NodeEdges(source);
@@ -331,6 +539,7 @@ export namespace ent {
 	        this.incoming_associations = this.convertValues(source["incoming_associations"], NodeAssociation);
 	        this.fsrs_card = this.convertValues(source["fsrs_card"], FsrsCard);
 	        this.error_resolutions = this.convertValues(source["error_resolutions"], ErrorResolution);
+	        this.preset = this.convertValues(source["preset"], SchedulerPreset);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -360,6 +569,7 @@ export namespace ent {
 	    // Go type: time
 	    created_at?: any;
 	    parent_id?: number[];
+	    preset_id?: number[];
 	    edges: NodeEdges;
 	
 	    static createFrom(source: any = {}) {
@@ -375,6 +585,7 @@ export namespace ent {
 	        this.metadata = source["metadata"];
 	        this.created_at = this.convertValues(source["created_at"], null);
 	        this.parent_id = source["parent_id"];
+	        this.preset_id = source["preset_id"];
 	        this.edges = this.convertValues(source["edges"], NodeEdges);
 	    }
 	
@@ -442,10 +653,14 @@ export namespace ent {
 	    // Go type: time
 	    due?: any;
 	    node_id?: number[];
-	    card_state?: string;
 	    current_step?: number;
+	    ease_factor?: number;
+	    leitner_box?: number;
+	    is_leech?: boolean;
+	    is_suspended?: boolean;
 	    // Go type: time
-	    next_review?: any;
+	    buried_until?: any;
+	    version?: number;
 	    edges: FsrsCardEdges;
 	
 	    static createFrom(source: any = {}) {
@@ -465,9 +680,13 @@ export namespace ent {
 	        this.last_review = this.convertValues(source["last_review"], null);
 	        this.due = this.convertValues(source["due"], null);
 	        this.node_id = source["node_id"];
-	        this.card_state = source["card_state"];
 	        this.current_step = source["current_step"];
-	        this.next_review = this.convertValues(source["next_review"], null);
+	        this.ease_factor = source["ease_factor"];
+	        this.leitner_box = source["leitner_box"];
+	        this.is_leech = source["is_leech"];
+	        this.is_suspended = source["is_suspended"];
+	        this.buried_until = this.convertValues(source["buried_until"], null);
+	        this.version = source["version"];
 	        this.edges = this.convertValues(source["edges"], FsrsCardEdges);
 	    }
 	
@@ -492,6 +711,7 @@ export namespace ent {
 	export class AttemptEdges {
 	    card?: FsrsCard;
 	    error_definition?: ErrorDefinition;
+	    errors?: AttemptError[];
 	
 	    static createFrom(source: any = {}) {
 	        return new AttemptEdges(source);
@@ -501,6 +721,7 @@ export namespace ent {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.card = this.convertValues(source["card"], FsrsCard);
 	        this.error_definition = this.convertValues(source["error_definition"], ErrorDefinition);
+	        this.errors = this.convertValues(source["errors"], AttemptError);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -533,8 +754,14 @@ export namespace ent {
 	    card_id?: number[];
 	    is_correct?: boolean;
 	    error_type_id?: number[];
+	    error_note?: string;
 	    user_answer?: string;
 	    metadata?: Record<string, any>;
+	    review_id?: number[];
+	    scheduler?: string;
+	    kind?: string;
+	    card_before?: schema.CardSnapshot;
+	    effects?: schema.ReviewEffects;
 	    edges: AttemptEdges;
 	
 	    static createFrom(source: any = {}) {
@@ -553,8 +780,14 @@ export namespace ent {
 	        this.card_id = source["card_id"];
 	        this.is_correct = source["is_correct"];
 	        this.error_type_id = source["error_type_id"];
+	        this.error_note = source["error_note"];
 	        this.user_answer = source["user_answer"];
 	        this.metadata = source["metadata"];
+	        this.review_id = source["review_id"];
+	        this.scheduler = source["scheduler"];
+	        this.kind = source["kind"];
+	        this.card_before = this.convertValues(source["card_before"], schema.CardSnapshot);
+	        this.effects = this.convertValues(source["effects"], schema.ReviewEffects);
 	        this.edges = this.convertValues(source["edges"], AttemptEdges);
 	    }
 	
@@ -588,6 +821,1249 @@ export namespace ent {
 	
 	
 	
+	
+	
+	
+	
+	
+	export class Settings {
+	    id?: number;
+	    day_start_hour?: number;
+	    timezone?: string;
+	    // Go type: time
+	    updated_at?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Settings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.day_start_hour = source["day_start_hour"];
+	        this.timezone = source["timezone"];
+	        this.updated_at = this.convertValues(source["updated_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace schema {
+	
+	export class BuriedSnapshot {
+	    id: number[];
+	    // Go type: time
+	    buried_until?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new BuriedSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.buried_until = this.convertValues(source["buried_until"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardSnapshot {
+	    state: string;
+	    current_step: number;
+	    stability: number;
+	    difficulty: number;
+	    elapsed_days: number;
+	    scheduled_days: number;
+	    reps: number;
+	    lapses: number;
+	    ease_factor?: number;
+	    leitner_box: number;
+	    // Go type: time
+	    due: any;
+	    // Go type: time
+	    last_review?: any;
+	    is_leech?: boolean;
+	    is_suspended?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.state = source["state"];
+	        this.current_step = source["current_step"];
+	        this.stability = source["stability"];
+	        this.difficulty = source["difficulty"];
+	        this.elapsed_days = source["elapsed_days"];
+	        this.scheduled_days = source["scheduled_days"];
+	        this.reps = source["reps"];
+	        this.lapses = source["lapses"];
+	        this.ease_factor = source["ease_factor"];
+	        this.leitner_box = source["leitner_box"];
+	        this.due = this.convertValues(source["due"], null);
+	        this.last_review = this.convertValues(source["last_review"], null);
+	        this.is_leech = source["is_leech"];
+	        this.is_suspended = source["is_suspended"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResolutionSnapshot {
+	    id: number[];
+	    weight_impact: number;
+	    occurrences: number;
+	    // Go type: time
+	    last_seen_at?: any;
+	    is_resolved: boolean;
+	    // Go type: time
+	    resolved_at?: any;
+	    resolution_notes?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResolutionSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.weight_impact = source["weight_impact"];
+	        this.occurrences = source["occurrences"];
+	        this.last_seen_at = this.convertValues(source["last_seen_at"], null);
+	        this.is_resolved = source["is_resolved"];
+	        this.resolved_at = this.convertValues(source["resolved_at"], null);
+	        this.resolution_notes = source["resolution_notes"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ReviewEffects {
+	    created_resolutions?: number[][];
+	    changed_resolutions?: ResolutionSnapshot[];
+	    implicit_attempts?: number[][];
+	    buried_cards?: BuriedSnapshot[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReviewEffects(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.created_resolutions = source["created_resolutions"];
+	        this.changed_resolutions = this.convertValues(source["changed_resolutions"], ResolutionSnapshot);
+	        this.implicit_attempts = source["implicit_attempts"];
+	        this.buried_cards = this.convertValues(source["buried_cards"], BuriedSnapshot);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace service {
+	
+	export class CalibrationBin {
+	    low: number;
+	    high: number;
+	    reviews: number;
+	    predicted: number;
+	    actual: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CalibrationBin(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.low = source["low"];
+	        this.high = source["high"];
+	        this.reviews = source["reviews"];
+	        this.predicted = source["predicted"];
+	        this.actual = source["actual"];
+	    }
+	}
+	export class CardSnapshot {
+	    state: string;
+	    stability: number;
+	    difficulty: number;
+	    reps: number;
+	    lapses: number;
+	    current_step: number;
+	    ease_factor: number;
+	    leitner_box: number;
+	    // Go type: time
+	    due: any;
+	    // Go type: time
+	    last_review?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardSnapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.state = source["state"];
+	        this.stability = source["stability"];
+	        this.difficulty = source["difficulty"];
+	        this.reps = source["reps"];
+	        this.lapses = source["lapses"];
+	        this.current_step = source["current_step"];
+	        this.ease_factor = source["ease_factor"];
+	        this.leitner_box = source["leitner_box"];
+	        this.due = this.convertValues(source["due"], null);
+	        this.last_review = this.convertValues(source["last_review"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardDiff {
+	    card_id: number[];
+	    node_id: number[];
+	    attempts: number;
+	    before: CardSnapshot;
+	    after: CardSnapshot;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardDiff(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.card_id = source["card_id"];
+	        this.node_id = source["node_id"];
+	        this.attempts = source["attempts"];
+	        this.before = this.convertValues(source["before"], CardSnapshot);
+	        this.after = this.convertValues(source["after"], CardSnapshot);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class ErrorResolutionConfig {
+	    resolve_after: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorResolutionConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.resolve_after = source["resolve_after"];
+	    }
+	}
+	export class ErrorTypeInput {
+	    code: string;
+	    label: string;
+	    category: string;
+	    description: string;
+	    base_weight: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorTypeInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.label = source["label"];
+	        this.category = source["category"];
+	        this.description = source["description"];
+	        this.base_weight = source["base_weight"];
+	    }
+	}
+	export class ErrorWeightProposal {
+	    error_type_id: number[];
+	    label: string;
+	    current: number;
+	    proposed: number;
+	    lower: number;
+	    upper: number;
+	    events: number;
+	    outcomes: number;
+	    rate: number;
+	    sufficient: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorWeightProposal(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.error_type_id = source["error_type_id"];
+	        this.label = source["label"];
+	        this.current = source["current"];
+	        this.proposed = source["proposed"];
+	        this.lower = source["lower"];
+	        this.upper = source["upper"];
+	        this.events = source["events"];
+	        this.outcomes = source["outcomes"];
+	        this.rate = source["rate"];
+	        this.sufficient = source["sufficient"];
+	    }
+	}
+	export class ErrorWeightConfig {
+	    horizon_days: number;
+	    min_events: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorWeightConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.horizon_days = source["horizon_days"];
+	        this.min_events = source["min_events"];
+	    }
+	}
+	export class ErrorWeightAnalysis {
+	    config: ErrorWeightConfig;
+	    baseline_rate: number;
+	    baseline_reviews: number;
+	    proposals: ErrorWeightProposal[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorWeightAnalysis(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.config = this.convertValues(source["config"], ErrorWeightConfig);
+	        this.baseline_rate = source["baseline_rate"];
+	        this.baseline_reviews = source["baseline_reviews"];
+	        this.proposals = this.convertValues(source["proposals"], ErrorWeightProposal);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class FSRSConfig {
+	    version: string;
+	    desired_retention: number;
+	    max_interval: number;
+	    w: number[];
+	    enable_fuzz: boolean;
+	    enable_load_balance: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new FSRSConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.version = source["version"];
+	        this.desired_retention = source["desired_retention"];
+	        this.max_interval = source["max_interval"];
+	        this.w = source["w"];
+	        this.enable_fuzz = source["enable_fuzz"];
+	        this.enable_load_balance = source["enable_load_balance"];
+	    }
+	}
+	export class ImplicitCreditConfig {
+	    credit: number;
+	    lapse_pull: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImplicitCreditConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.credit = source["credit"];
+	        this.lapse_pull = source["lapse_pull"];
+	    }
+	}
+	export class LearningStepsConfig {
+	    learning_steps: number[];
+	    relearning_steps: number[];
+	    graduating_interval: number;
+	    easy_interval: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LearningStepsConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.learning_steps = source["learning_steps"];
+	        this.relearning_steps = source["relearning_steps"];
+	        this.graduating_interval = source["graduating_interval"];
+	        this.easy_interval = source["easy_interval"];
+	    }
+	}
+	export class LeechConfig {
+	    threshold: number;
+	    suspend: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeechConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.threshold = source["threshold"];
+	        this.suspend = source["suspend"];
+	    }
+	}
+	export class LeitnerConfig {
+	    intervals: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LeitnerConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.intervals = source["intervals"];
+	    }
+	}
+	export class MergeResult {
+	    attempts: number;
+	    links: number;
+	    resolutions: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MergeResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.attempts = source["attempts"];
+	        this.links = source["links"];
+	        this.resolutions = source["resolutions"];
+	    }
+	}
+	export class RetentionPoint {
+	    desired_retention: number;
+	    total_minutes: number;
+	    total_lapses: number;
+	    expected_retained: number;
+	    minutes_per_retained: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RetentionPoint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.desired_retention = source["desired_retention"];
+	        this.total_minutes = source["total_minutes"];
+	        this.total_lapses = source["total_lapses"];
+	        this.expected_retained = source["expected_retained"];
+	        this.minutes_per_retained = source["minutes_per_retained"];
+	    }
+	}
+	export class RetentionSearch {
+	    min_retention: number;
+	    max_retention: number;
+	    step: number;
+	    new_cards_per_day: number;
+	    horizon_days: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RetentionSearch(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.min_retention = source["min_retention"];
+	        this.max_retention = source["max_retention"];
+	        this.step = source["step"];
+	        this.new_cards_per_day = source["new_cards_per_day"];
+	        this.horizon_days = source["horizon_days"];
+	    }
+	}
+	export class OptimalRetentionResult {
+	    optimal_retention: number;
+	    search: RetentionSearch;
+	    curve: RetentionPoint[];
+	    recall_seconds: number;
+	    lapse_seconds: number;
+	    new_seconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new OptimalRetentionResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.optimal_retention = source["optimal_retention"];
+	        this.search = this.convertValues(source["search"], RetentionSearch);
+	        this.curve = this.convertValues(source["curve"], RetentionPoint);
+	        this.recall_seconds = source["recall_seconds"];
+	        this.lapse_seconds = source["lapse_seconds"];
+	        this.new_seconds = source["new_seconds"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WeightEvaluation {
+	    weights: number[];
+	    log_loss: number;
+	    rmse_bins: number;
+	    reviews: number;
+	    calibration: CalibrationBin[];
+	
+	    static createFrom(source: any = {}) {
+	        return new WeightEvaluation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.weights = source["weights"];
+	        this.log_loss = source["log_loss"];
+	        this.rmse_bins = source["rmse_bins"];
+	        this.reviews = source["reviews"];
+	        this.calibration = this.convertValues(source["calibration"], CalibrationBin);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WeightComparison {
+	    current: WeightEvaluation;
+	    candidate: WeightEvaluation;
+	    worse: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new WeightComparison(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.current = this.convertValues(source["current"], WeightEvaluation);
+	        this.candidate = this.convertValues(source["candidate"], WeightEvaluation);
+	        this.worse = source["worse"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class OptimizerIteration {
+	    iteration: number;
+	    train_loss: number;
+	    validation_loss: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new OptimizerIteration(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.iteration = source["iteration"];
+	        this.train_loss = source["train_loss"];
+	        this.validation_loss = source["validation_loss"];
+	    }
+	}
+	export class OptimizerMetrics {
+	    log_loss: number;
+	    rmse: number;
+	    reviews: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new OptimizerMetrics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.log_loss = source["log_loss"];
+	        this.rmse = source["rmse"];
+	        this.reviews = source["reviews"];
+	    }
+	}
+	export class OptimizationResult {
+	    weights: number[];
+	    initial_weights: number[];
+	    train_before: OptimizerMetrics;
+	    train_after: OptimizerMetrics;
+	    validation_before: OptimizerMetrics;
+	    validation_after: OptimizerMetrics;
+	    train_cards: number;
+	    validation_cards: number;
+	    iterations: number;
+	    converged: boolean;
+	    history: OptimizerIteration[];
+	    evaluation?: WeightComparison;
+	    applied: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new OptimizationResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.weights = source["weights"];
+	        this.initial_weights = source["initial_weights"];
+	        this.train_before = this.convertValues(source["train_before"], OptimizerMetrics);
+	        this.train_after = this.convertValues(source["train_after"], OptimizerMetrics);
+	        this.validation_before = this.convertValues(source["validation_before"], OptimizerMetrics);
+	        this.validation_after = this.convertValues(source["validation_after"], OptimizerMetrics);
+	        this.train_cards = source["train_cards"];
+	        this.validation_cards = source["validation_cards"];
+	        this.iterations = source["iterations"];
+	        this.converged = source["converged"];
+	        this.history = this.convertValues(source["history"], OptimizerIteration);
+	        this.evaluation = this.convertValues(source["evaluation"], WeightComparison);
+	        this.applied = source["applied"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class PrerequisiteBlock {
+	    node_id: number[];
+	    title: string;
+	    depth: number;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PrerequisiteBlock(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.node_id = source["node_id"];
+	        this.title = source["title"];
+	        this.depth = source["depth"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class PrerequisiteGate {
+	    mode: string;
+	    min_stability: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PrerequisiteGate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.mode = source["mode"];
+	        this.min_stability = source["min_stability"];
+	    }
+	}
+	export class PrerequisiteStatus {
+	    node_id: number[];
+	    locked: boolean;
+	    gate: PrerequisiteGate;
+	    blocking: PrerequisiteBlock[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PrerequisiteStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.node_id = source["node_id"];
+	        this.locked = source["locked"];
+	        this.gate = this.convertValues(source["gate"], PrerequisiteGate);
+	        this.blocking = this.convertValues(source["blocking"], PrerequisiteBlock);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class QueuePolicy {
+	    new_per_day: number;
+	    reviews_per_day: number;
+	    learn_ahead_minutes: number;
+	    order: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new QueuePolicy(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.new_per_day = source["new_per_day"];
+	        this.reviews_per_day = source["reviews_per_day"];
+	        this.learn_ahead_minutes = source["learn_ahead_minutes"];
+	        this.order = source["order"];
+	    }
+	}
+	export class PresetSettings {
+	    name: string;
+	    scheduler: string;
+	    fsrs: FSRSConfig;
+	    leitner: LeitnerConfig;
+	    learning: LearningStepsConfig;
+	    leech: LeechConfig;
+	    queue: QueuePolicy;
+	    implicit: ImplicitCreditConfig;
+	    gate: PrerequisiteGate;
+	    errors: ErrorResolutionConfig;
+	    bury_translations: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PresetSettings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.scheduler = source["scheduler"];
+	        this.fsrs = this.convertValues(source["fsrs"], FSRSConfig);
+	        this.leitner = this.convertValues(source["leitner"], LeitnerConfig);
+	        this.learning = this.convertValues(source["learning"], LearningStepsConfig);
+	        this.leech = this.convertValues(source["leech"], LeechConfig);
+	        this.queue = this.convertValues(source["queue"], QueuePolicy);
+	        this.implicit = this.convertValues(source["implicit"], ImplicitCreditConfig);
+	        this.gate = this.convertValues(source["gate"], PrerequisiteGate);
+	        this.errors = this.convertValues(source["errors"], ErrorResolutionConfig);
+	        this.bury_translations = source["bury_translations"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class RemediationStep {
+	    node_id: number[];
+	    title: string;
+	    type: string;
+	    relation: string;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemediationStep(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.node_id = source["node_id"];
+	        this.title = source["title"];
+	        this.type = source["type"];
+	        this.relation = source["relation"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class RemediationPlan {
+	    attempt_id: number[];
+	    node_id: number[];
+	    steps: RemediationStep[];
+	    queue?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RemediationPlan(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.attempt_id = source["attempt_id"];
+	        this.node_id = source["node_id"];
+	        this.steps = this.convertValues(source["steps"], RemediationStep);
+	        this.queue = source["queue"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class ReplayReport {
+	    dry_run: boolean;
+	    cards_replayed: number;
+	    cards_changed: number;
+	    diffs: CardDiff[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReplayReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.dry_run = source["dry_run"];
+	        this.cards_replayed = source["cards_replayed"];
+	        this.cards_changed = source["cards_changed"];
+	        this.diffs = this.convertValues(source["diffs"], CardDiff);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class SimulationDay {
+	    day: number;
+	    // Go type: time
+	    date: any;
+	    due_reviews: number;
+	    new_cards: number;
+	    expected_minutes: number;
+	    expected_lapses: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SimulationDay(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.day = source["day"];
+	        this.date = this.convertValues(source["date"], null);
+	        this.due_reviews = source["due_reviews"];
+	        this.new_cards = source["new_cards"];
+	        this.expected_minutes = source["expected_minutes"];
+	        this.expected_lapses = source["expected_lapses"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SimulationScenario {
+	    desired_retention: number;
+	    new_cards_per_day: number;
+	    horizon_days: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SimulationScenario(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.desired_retention = source["desired_retention"];
+	        this.new_cards_per_day = source["new_cards_per_day"];
+	        this.horizon_days = source["horizon_days"];
+	    }
+	}
+	export class SimulationResult {
+	    scenario: SimulationScenario;
+	    days: SimulationDay[];
+	    total_reviews: number;
+	    total_new_cards: number;
+	    total_minutes: number;
+	    total_lapses: number;
+	    recall_seconds: number;
+	    lapse_seconds: number;
+	    new_seconds: number;
+	    remaining_new: number;
+	    expected_retained: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SimulationResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.scenario = this.convertValues(source["scenario"], SimulationScenario);
+	        this.days = this.convertValues(source["days"], SimulationDay);
+	        this.total_reviews = source["total_reviews"];
+	        this.total_new_cards = source["total_new_cards"];
+	        this.total_minutes = source["total_minutes"];
+	        this.total_lapses = source["total_lapses"];
+	        this.recall_seconds = source["recall_seconds"];
+	        this.lapse_seconds = source["lapse_seconds"];
+	        this.new_seconds = source["new_seconds"];
+	        this.remaining_new = source["remaining_new"];
+	        this.expected_retained = source["expected_retained"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class Suggestion {
+	    node_id: number[];
+	    title: string;
+	    type: string;
+	    stream: string;
+	    score: number;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Suggestion(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.node_id = source["node_id"];
+	        this.title = source["title"];
+	        this.type = source["type"];
+	        this.stream = source["stream"];
+	        this.score = source["score"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class SuggestionRatios {
+	    maintenance: number;
+	    remediation: number;
+	    discovery: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SuggestionRatios(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.maintenance = source["maintenance"];
+	        this.remediation = source["remediation"];
+	        this.discovery = source["discovery"];
+	    }
+	}
+	export class TimeLimit {
+	    seconds: number;
+	    source_id: number[];
+	    inherited: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new TimeLimit(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.seconds = source["seconds"];
+	        this.source_id = source["source_id"];
+	        this.inherited = source["inherited"];
+	    }
+	}
+	export class TopicTimeStats {
+	    topic_id: number[];
+	    title: string;
+	    reviews: number;
+	    correct: number;
+	    median_ms: number;
+	    p75_ms: number;
+	    p90_ms: number;
+	    limit?: TimeLimit;
+	    over_limit: number;
+	    suggested_sec: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new TopicTimeStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.topic_id = source["topic_id"];
+	        this.title = source["title"];
+	        this.reviews = source["reviews"];
+	        this.correct = source["correct"];
+	        this.median_ms = source["median_ms"];
+	        this.p75_ms = source["p75_ms"];
+	        this.p90_ms = source["p90_ms"];
+	        this.limit = this.convertValues(source["limit"], TimeLimit);
+	        this.over_limit = source["over_limit"];
+	        this.suggested_sec = source["suggested_sec"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UndoResult {
+	    attempt_id: number[];
+	    node_id: number[];
+	    rating: number;
+	    card_state: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new UndoResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.attempt_id = source["attempt_id"];
+	        this.node_id = source["node_id"];
+	        this.rating = source["rating"];
+	        this.card_state = source["card_state"];
+	    }
+	}
+	
 
 }
 
@@ -4,7 +4,10 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
+
 	"profen/internal/app/service"
 	"profen/internal/data"
 	"profen/internal/data/ent"
@@ -23,10 +26,26 @@ type App struct {
 	learningService   *service.LearningStepsService
 	fsrsService       *service.FSRSService
 	snapshotService   *service.SnapshotService
+	optimizerService  *service.OptimizerService
+	evaluationService *service.EvaluationService
+	presetService     *service.PresetService
+	simulatorService  *service.SimulatorService
+	replayService     *service.ReplayService
+	undoService       *service.UndoService
+	leechService      *service.LeechService
+	suspendService    *service.SuspendService
+	prereqService     *service.PrerequisiteService
+	remedyService     *service.RemediationService
+	taxonomyService   *service.ErrorTaxonomyService
+	errWeightService  *service.ErrorWeightService
+	timeLimitService  *service.TimeLimitService
 	nodeRepo          *data.NodeRepository
 	suggestionRepo    *data.SuggestionRepository
+	suggestionEngine  *service.SuggestionEngine
 	attemptRepo       *data.AttemptRepository
 	statsRepo         *data.StatsRepository
+	settingsRepo      *data.SettingsRepository
+	travelClock       *data.TravelClock // Drives the read-only queue and stats views
 	studyCoordinator  *service.StudyCoordinator
 	isFullscreen      bool // Track fullscreen state
 }
@@ -37,11 +56,16 @@ func NewApp(client *ent.Client) *App {
 	learningConfig := service.DefaultLearningConfig()
 	fsrsConfig := service.DefaultFSRSConfig()
 
+	// Reviews always use the wall clock; queue and stats views follow the
+	// travel clock so they can be inspected as of another date
+	clock := data.SystemClock()
+	travelClock := data.NewTravelClock()
+
 	// Initialize services
-	learningService := service.NewLearningStepsService(client, learningConfig)
-	fsrsService := service.NewFSRSService(client, fsrsConfig)
+	learningService := service.NewLearningStepsService(client, learningConfig, clock)
+	fsrsService := service.NewFSRSService(client, fsrsConfig, clock)
 	coordinator := service.NewReviewCoordinator(learningService, fsrsService, client)
-	studyCoordinator := service.NewStudyCoordinator(client)
+	studyCoordinator := service.NewStudyCoordinator(client, travelClock)
 
 	return &App{
 		client:            client,
@@ -49,10 +73,26 @@ func NewApp(client *ent.Client) *App {
 		learningService:   learningService,
 		fsrsService:       fsrsService,
 		snapshotService:   service.NewSnapshotService(client),
+		optimizerService:  service.NewOptimizerService(client, service.DefaultOptimizerConfig()),
+		evaluationService: service.NewEvaluationService(client),
+		presetService:     service.NewPresetService(client),
+		simulatorService:  service.NewSimulatorService(client, travelClock),
+		replayService:     service.NewReplayService(learningService, fsrsService, client),
+		undoService:       service.NewUndoService(client),
+		leechService:      service.NewLeechService(client),
+		suspendService:    service.NewSuspendService(client, clock),
+		prereqService:     service.NewPrerequisiteService(client),
+		remedyService:     service.NewRemediationService(client),
+		taxonomyService:   service.NewErrorTaxonomyService(client),
+		errWeightService:  service.NewErrorWeightService(client),
+		timeLimitService:  service.NewTimeLimitService(client),
 		nodeRepo:          data.NewNodeRepository(client),
-		suggestionRepo:    data.NewSuggestionRepository(client),
+		suggestionRepo:    data.NewSuggestionRepository(client, travelClock),
+		suggestionEngine:  service.NewSuggestionEngine(client, travelClock),
 		attemptRepo:       data.NewAttemptRepository(client),
-		statsRepo:         data.NewStatsRepository(client),
+		statsRepo:         data.NewStatsRepository(client, travelClock),
+		settingsRepo:      data.NewSettingsRepository(client),
+		travelClock:       travelClock,
 		studyCoordinator:  studyCoordinator,
 	}
 }
@@ -60,6 +100,13 @@ func NewApp(client *ent.Client) *App {
 // startup is called when the app starts.
 func (a *App) Startup(ctx context.Context) {
 	a.ctx = ctx
+
+	// Seed the default scheduler preset from the built-in configs
+	if a.client != nil {
+		if _, err := a.presetService.EnsureDefaultPreset(ctx); err != nil {
+			runtime.LogErrorf(ctx, "failed to seed default preset: %v", err)
+		}
+	}
 }
 
 // -- Fullscreen methods
@@ -111,15 +158,68 @@ func (a *App) GetDueCards(limit int) ([]*ent.Node, error) {
 	return a.suggestionRepo.GetDueCards(a.ctx, limit)
 }
 
-// ReviewCard processes a user answer using the coordinator
-func (a *App) ReviewCard(nodeIDStr string, grade int, durationMs int, userAnswer string) error {
+// GetSuggestions returns a scored, explained queue blending due reviews, open
+// errors and unexplored neighbours. An empty rootIDStr covers the whole library;
+// all-zero ratios use the defaults.
+func (a *App) GetSuggestions(rootIDStr string, limit int, ratios service.SuggestionRatios) ([]service.Suggestion, error) {
+	var rootID *uuid.UUID
+	if rootIDStr != "" {
+		id, err := uuid.Parse(rootIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node UUID: %w", err)
+		}
+		rootID = &id
+	}
+	if ratios == (service.SuggestionRatios{}) {
+		ratios = service.DefaultSuggestionRatios()
+	}
+	return a.suggestionEngine.Suggest(a.ctx, rootID, limit, ratios)
+}
+
+// GetRemediation returns the ordered nodes to revisit after a failed attempt:
+// its theory, their prerequisites, then similar and variant problems. Passing
+// the remaining study queue returns it with those nodes moved to the front.
+func (a *App) GetRemediation(attemptIDStr string, limit int, queue []string) (*service.RemediationPlan, error) {
+	id, err := uuid.Parse(attemptIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attempt UUID: %w", err)
+	}
+	return a.remedyService.ForAttempt(a.ctx, id, limit, queue)
+}
+
+// ReviewCard processes a user answer atomically. reviewIDStr is generated by the
+// client per answer; resubmitting the same ID (e.g. a double-click) is ignored.
+func (a *App) ReviewCard(nodeIDStr string, grade int, durationMs int, userAnswer string, reviewIDStr string) error {
+	return a.ReviewCardWithErrors(nodeIDStr, grade, durationMs, userAnswer, reviewIDStr, nil, "")
+}
+
+// ReviewCardWithErrors is ReviewCard with the error definitions made in the
+// answer and a note. Each error opens or bumps an unresolved error on the node.
+// An empty errorNote falls back to the errorLog in the answer metadata.
+func (a *App) ReviewCardWithErrors(
+	nodeIDStr string,
+	grade int,
+	durationMs int,
+	userAnswer string,
+	reviewIDStr string,
+	errorTypeIDStrs []string,
+	errorNote string,
+) error {
+	if a.travelClock.Travelling() {
+		return fmt.Errorf("time travel is active; return to the present to review")
+	}
+
 	nodeID, err := uuid.Parse(nodeIDStr)
 	if err != nil {
 		return fmt.Errorf("invalid node UUID: %w", err)
 	}
 
-	if grade < 1 || grade > 4 {
-		return fmt.Errorf("grade must be between 1 and 4")
+	var reviewID uuid.UUID
+	if reviewIDStr != "" {
+		reviewID, err = uuid.Parse(reviewIDStr)
+		if err != nil {
+			return fmt.Errorf("invalid review UUID: %w", err)
+		}
 	}
 
 	// Parse userAnswer as JSON to extract metadata
@@ -131,30 +231,49 @@ func (a *App) ReviewCard(nodeIDStr string, grade int, durationMs int, userAnswer
 		}
 	}
 
-	// Get the card BEFORE processing review (to capture pre-review state)
-	card, err := a.reviewCoordinator.GetCard(a.ctx, nodeID)
-	if err != nil {
-		return fmt.Errorf("failed to get card: %w", err)
+	// Extract text from metadata for user_answer field
+	text, _ := metadata["text"].(string)
+
+	errorTypeIDs := make([]uuid.UUID, 0, len(errorTypeIDStrs))
+	for _, s := range errorTypeIDStrs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return fmt.Errorf("invalid error type UUID: %w", err)
+		}
+		errorTypeIDs = append(errorTypeIDs, id)
 	}
+	if errorNote == "" {
+		errorNote, _ = metadata["errorLog"].(string)
+	}
+
+	// Schedule the card and record the attempt in one transaction
+	_, attempt, err := a.reviewCoordinator.SubmitReview(a.ctx, service.ReviewSubmission{
+		ReviewID:   reviewID,
+		NodeID:     nodeID,
+		Grade:      grade,
+		DurationMs: durationMs,
+		UserAnswer: text,
+		Metadata:   metadata,
 
-	// Process review through coordinator (this updates the card)
-	_, err = a.reviewCoordinator.ProcessReview(a.ctx, nodeID, grade)
+		ErrorTypeIDs: errorTypeIDs,
+		ErrorNote:    errorNote,
+	})
+	if errors.Is(err, service.ErrDuplicateReview) {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to process review: %w", err)
 	}
 
-	// Extract text from metadata for user_answer field
-	text, _ := metadata["text"].(string)
+	a.undoService.Push(attempt.ID)
+	return nil
+}
 
-	// Create attempt record (with card snapshot BEFORE the review)
-	return a.attemptRepo.CreateAttempt(
-		a.ctx,
-		card,
-		grade,
-		int64(durationMs),
-		text,
-		metadata,
-	)
+// UndoLastReview reverts the most recent review of this session (or, after a
+// restart, the most recent review overall). The returned node ID should be put
+// back at the front of the study queue.
+func (a *App) UndoLastReview() (*service.UndoResult, error) {
+	return a.undoService.UndoLast(a.ctx)
 }
 
 // GetSchedulingInfo returns the intervals for all 4 grade buttons
@@ -167,14 +286,351 @@ func (a *App) GetSchedulingInfo(nodeIDStr string) (map[int]string, error) {
 	return a.reviewCoordinator.GetSchedulingInfo(a.ctx, nodeID)
 }
 
+// RunOptimizer fits the FSRS weights of a preset to the review history and
+// saves them back to the preset, unless they predict the revlog worse than
+// the current weights. Evaluation reports the comparison either way.
+func (a *App) RunOptimizer(presetIDStr string) (*service.OptimizationResult, error) {
+	id, err := uuid.Parse(presetIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid preset UUID: %w", err)
+	}
+
+	preset, err := a.presetService.GetPreset(a.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fsrsConfig, _ := service.PresetConfigs(preset)
+	result, err := a.optimizerService.RunOptimization(a.ctx, fsrsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison, err := a.evaluationService.ApplyWeights(a.ctx, id, result.Weights)
+	if err != nil && !errors.Is(err, service.ErrWorseWeights) {
+		return nil, fmt.Errorf("failed to save optimized weights: %w", err)
+	}
+	result.Evaluation = comparison
+	result.Applied = err == nil
+	return result, nil
+}
+
+// EvaluateWeights compares candidate weights with a preset's current weights
+// on the review history (log-loss, RMSE(bins) and calibration). Nothing is saved.
+func (a *App) EvaluateWeights(presetIDStr string, weights []float64) (*service.WeightComparison, error) {
+	id, err := uuid.Parse(presetIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid preset UUID: %w", err)
+	}
+
+	preset, err := a.presetService.GetPreset(a.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fsrsConfig, _ := service.PresetConfigs(preset)
+	return a.evaluationService.CompareWeights(a.ctx, fsrsConfig, weights)
+}
+
+// --- SCHEDULER PRESET METHODS ---
+
+// GetPresets returns all scheduler presets
+func (a *App) GetPresets() ([]*ent.SchedulerPreset, error) {
+	return a.presetService.ListPresets(a.ctx)
+}
+
+// CreatePreset creates a new scheduler preset
+func (a *App) CreatePreset(settings service.PresetSettings) (*ent.SchedulerPreset, error) {
+	return a.presetService.CreatePreset(a.ctx, settings)
+}
+
+// UpdatePreset replaces the settings of a scheduler preset
+func (a *App) UpdatePreset(presetIDStr string, settings service.PresetSettings) (*ent.SchedulerPreset, error) {
+	id, err := uuid.Parse(presetIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid preset UUID: %w", err)
+	}
+	return a.presetService.UpdatePreset(a.ctx, id, settings)
+}
+
+// DeletePreset deletes a non-default scheduler preset
+func (a *App) DeletePreset(presetIDStr string) error {
+	id, err := uuid.Parse(presetIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid preset UUID: %w", err)
+	}
+	return a.presetService.DeletePreset(a.ctx, id)
+}
+
+// AssignPreset assigns a preset to a subject/topic.
+// An empty presetIDStr clears the assignment so the node inherits again.
+func (a *App) AssignPreset(nodeIDStr string, presetIDStr string) error {
+	nodeID, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid node UUID: %w", err)
+	}
+
+	if presetIDStr == "" {
+		return a.presetService.AssignPreset(a.ctx, nodeID, nil)
+	}
+
+	presetID, err := uuid.Parse(presetIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid preset UUID: %w", err)
+	}
+	return a.presetService.AssignPreset(a.ctx, nodeID, &presetID)
+}
+
+// GetEffectivePreset returns the preset that schedules a node (own, inherited or default)
+func (a *App) GetEffectivePreset(nodeIDStr string) (*ent.SchedulerPreset, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.presetService.ResolveForNode(a.ctx, id)
+}
+
+// ExplainPrerequisites reports whether a node's new card is held back by
+// comes_before prerequisites, and which ones block it
+func (a *App) ExplainPrerequisites(nodeIDStr string) (*service.PrerequisiteStatus, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.prereqService.Explain(a.ctx, id)
+}
+
+// SetNodeTimeLimit sets the answer time limit of a node, inherited by
+// everything below it. 0 removes it.
+func (a *App) SetNodeTimeLimit(nodeIDStr string, seconds int) (*ent.Node, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.timeLimitService.SetTimeLimit(a.ctx, id, seconds)
+}
+
+// GetNodeTimeLimit returns the effective time limit of a node, or nil when none applies
+func (a *App) GetNodeTimeLimit(nodeIDStr string) (*service.TimeLimit, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.timeLimitService.Resolve(a.ctx, id)
+}
+
+// GetTopicTimeStats reports answer time percentiles per topic. An empty
+// rootIDStr covers every topic.
+func (a *App) GetTopicTimeStats(rootIDStr string) ([]service.TopicTimeStats, error) {
+	var rootID *uuid.UUID
+	if rootIDStr != "" {
+		id, err := uuid.Parse(rootIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node UUID: %w", err)
+		}
+		rootID = &id
+	}
+	return a.timeLimitService.TopicStats(a.ctx, rootID)
+}
+
+// GetErrorTypes lists the error definitions by category, optionally including archived ones
+func (a *App) GetErrorTypes(includeArchived bool) ([]*ent.ErrorDefinition, error) {
+	return a.taxonomyService.List(a.ctx, includeArchived)
+}
+
+// CreateErrorType adds a user-defined error type
+func (a *App) CreateErrorType(input service.ErrorTypeInput) (*ent.ErrorDefinition, error) {
+	return a.taxonomyService.Create(a.ctx, input)
+}
+
+// UpdateErrorTypeWeight changes the base weight used for errors recorded from now on
+func (a *App) UpdateErrorTypeWeight(errorTypeIDStr string, weight float64) (*ent.ErrorDefinition, error) {
+	id, err := uuid.Parse(errorTypeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid error type UUID: %w", err)
+	}
+	return a.taxonomyService.UpdateWeight(a.ctx, id, weight)
+}
+
+// ArchiveErrorType hides a user-defined error type from reviews, or restores it
+func (a *App) ArchiveErrorType(errorTypeIDStr string, archived bool) (*ent.ErrorDefinition, error) {
+	id, err := uuid.Parse(errorTypeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid error type UUID: %w", err)
+	}
+	return a.taxonomyService.Archive(a.ctx, id, archived)
+}
+
+// MergeErrorTypes moves the history of a user-defined error type onto another and deletes it
+func (a *App) MergeErrorTypes(sourceIDStr, targetIDStr string) (*service.MergeResult, error) {
+	sourceID, err := uuid.Parse(sourceIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source UUID: %w", err)
+	}
+	targetID, err := uuid.Parse(targetIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target UUID: %w", err)
+	}
+	return a.taxonomyService.Merge(a.ctx, sourceID, targetID)
+}
+
+// AnalyzeErrorWeights proposes base weights learned from what followed each
+// recorded error, with confidence intervals. A zero config uses the defaults.
+// Nothing is applied until AcceptErrorWeights.
+func (a *App) AnalyzeErrorWeights(config service.ErrorWeightConfig) (*service.ErrorWeightAnalysis, error) {
+	if config == (service.ErrorWeightConfig{}) {
+		config = service.DefaultErrorWeightConfig()
+	}
+	return a.errWeightService.Analyze(a.ctx, config)
+}
+
+// AcceptErrorWeights re-learns the weights of the accepted error types under the
+// config they were analyzed with and applies the sufficient ones. A zero config
+// uses the defaults.
+func (a *App) AcceptErrorWeights(config service.ErrorWeightConfig, errorTypeIDStrs []string) ([]*ent.ErrorDefinition, error) {
+	if config == (service.ErrorWeightConfig{}) {
+		config = service.DefaultErrorWeightConfig()
+	}
+
+	errorTypeIDs := make([]uuid.UUID, 0, len(errorTypeIDStrs))
+	for _, s := range errorTypeIDStrs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid error type UUID: %w", err)
+		}
+		errorTypeIDs = append(errorTypeIDs, id)
+	}
+	return a.errWeightService.Accept(a.ctx, config, errorTypeIDs)
+}
+
+// SimulateWorkload projects daily reviews, minutes, lapses and new cards for a scenario,
+// using each card's preset weights, or the default preset's for cards without one
+func (a *App) SimulateWorkload(scenario service.SimulationScenario) (*service.SimulationResult, error) {
+	preset, err := a.presetService.EnsureDefaultPreset(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fsrsConfig, _ := service.PresetConfigs(preset)
+	return a.simulatorService.Simulate(a.ctx, fsrsConfig, scenario)
+}
+
+// ComputeOptimalRetention simulates a preset's cards over a range of desired
+// retentions and returns the cheapest one with its cost curve. Nothing is saved.
+func (a *App) ComputeOptimalRetention(presetIDStr string, search service.RetentionSearch) (*service.OptimalRetentionResult, error) {
+	id, err := uuid.Parse(presetIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid preset UUID: %w", err)
+	}
+
+	preset, err := a.presetService.GetPreset(a.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.simulatorService.OptimalRetention(a.ctx, preset, search)
+}
+
+// ApplyDesiredRetention sets a preset's desired retention, e.g. to the computed optimum
+func (a *App) ApplyDesiredRetention(presetIDStr string, retention float64) (*ent.SchedulerPreset, error) {
+	id, err := uuid.Parse(presetIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid preset UUID: %w", err)
+	}
+	return a.presetService.UpdateDesiredRetention(a.ctx, id, retention)
+}
+
+// --- REVLOG REPLAY METHODS ---
+
+// ReplayNode rebuilds a node's card from its attempts. With dryRun nothing is saved.
+func (a *App) ReplayNode(nodeIDStr string, dryRun bool) (*service.ReplayReport, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.replayService.ReplayNode(a.ctx, id, dryRun)
+}
+
+// ReplaySubtree rebuilds every card under a node from its attempts
+func (a *App) ReplaySubtree(rootIDStr string, dryRun bool) (*service.ReplayReport, error) {
+	id, err := uuid.Parse(rootIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.replayService.ReplaySubtree(a.ctx, id, dryRun)
+}
+
+// ReplayLibrary rebuilds every card in the library from its attempts
+func (a *App) ReplayLibrary(dryRun bool) (*service.ReplayReport, error) {
+	return a.replayService.ReplayLibrary(a.ctx, dryRun)
+}
+
+// EditAttempt changes the rating of a past attempt and re-derives its card
+func (a *App) EditAttempt(attemptIDStr string, rating int, dryRun bool) (*service.ReplayReport, error) {
+	id, err := uuid.Parse(attemptIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attempt UUID: %w", err)
+	}
+	return a.replayService.EditAttempt(a.ctx, id, rating, dryRun)
+}
+
+// DeleteAttempt removes a past attempt and re-derives its card
+func (a *App) DeleteAttempt(attemptIDStr string, dryRun bool) (*service.ReplayReport, error) {
+	id, err := uuid.Parse(attemptIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attempt UUID: %w", err)
+	}
+	return a.replayService.DeleteAttempt(a.ctx, id, dryRun)
+}
+
+// GetLeeches lists the leech nodes under a subject, with their cards
+func (a *App) GetLeeches(subjectIDStr string) ([]*ent.Node, error) {
+	id, err := uuid.Parse(subjectIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject UUID: %w", err)
+	}
+	return a.leechService.ListLeeches(a.ctx, id)
+}
+
+// SuspendNode takes a node's card, or with subtree every card under it, out of study sessions
+func (a *App) SuspendNode(nodeIDStr string, subtree bool) (int, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.suspendService.Suspend(a.ctx, id, subtree)
+}
+
+// BuryNode hides a node's card, or with subtree every card under it, until tomorrow
+func (a *App) BuryNode(nodeIDStr string, subtree bool) (int, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.suspendService.Bury(a.ctx, id, subtree)
+}
+
+// UnsuspendNode returns suspended or buried cards to study sessions
+func (a *App) UnsuspendNode(nodeIDStr string, subtree bool) (int, error) {
+	id, err := uuid.Parse(nodeIDStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid node UUID: %w", err)
+	}
+	return a.suspendService.Unsuspend(a.ctx, id, subtree)
+}
+
 // UpdateNode updates the node's title and body.
 func (a *App) UpdateNode(idStr string, title string, body string) (*ent.Node, error) {
 	id, err := uuid.Parse(idStr)
 	if err != nil {
 		return nil, err
 	}
-	// Pass empty metadata for now
-	return a.nodeRepo.UpdateNode(a.ctx, id, title, body, map[string]interface{}{})
+	// Keep settings stored in metadata, such as the time limit
+	existing, err := a.nodeRepo.GetNode(a.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return a.nodeRepo.UpdateNode(a.ctx, id, title, body, existing.Metadata)
 }
 
 // CreateNode creates a new node with the specified type, parent, and title.
@@ -275,6 +731,40 @@ func (a *App) GetDashboardStats() (*data.DashboardStats, error) {
 	return a.statsRepo.GetDashboardStats(a.ctx)
 }
 
+// GetSettings returns the app settings (day boundary and timezone)
+func (a *App) GetSettings() (*ent.Settings, error) {
+	return a.settingsRepo.GetSettings(a.ctx)
+}
+
+// UpdateDayBoundary sets when the next study day starts and the user's timezone
+func (a *App) UpdateDayBoundary(startHour int, timezone string) (*ent.Settings, error) {
+	return a.settingsRepo.UpdateDayBoundary(a.ctx, startHour, timezone)
+}
+
+// TimeTravel (developer mode) shows the queue and stats as of an RFC 3339 date.
+// Nothing is written; reviews are refused until ReturnToPresent.
+func (a *App) TimeTravel(at string) error {
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return fmt.Errorf("invalid date: %w", err)
+	}
+	a.travelClock.TravelTo(t)
+	return nil
+}
+
+// ReturnToPresent ends time travel
+func (a *App) ReturnToPresent() {
+	a.travelClock.Return()
+}
+
+// GetTimeTravel returns the instant the app is viewing and whether time travel is active
+func (a *App) GetTimeTravel() map[string]interface{} {
+	return map[string]interface{}{
+		"now":        a.travelClock.Now(),
+		"travelling": a.travelClock.Travelling(),
+	}
+}
+
 // GetNodeAssociations returns all associations for a node
 func (a *App) GetNodeAssociations(nodeIDStr string) ([]*ent.NodeAssociation, error) {
 	id, err := uuid.Parse(nodeIDStr)
@@ -370,6 +860,21 @@ func (a *App) GetAttemptDetails(attemptIDStr string) (map[string]interface{}, er
 		result["submitted_at"] = attempt.Metadata["submittedAt"]
 	}
 
+	// Add recorded errors
+	if attempt.ErrorNote != "" {
+		result["error_log"] = attempt.ErrorNote
+	}
+	errorTypes := make([]map[string]interface{}, 0, len(attempt.Edges.Errors))
+	for _, e := range attempt.Edges.Errors {
+		if def := e.Edges.ErrorDefinition; def != nil {
+			errorTypes = append(errorTypes, map[string]interface{}{
+				"id":    def.ID.String(),
+				"label": def.Label,
+			})
+		}
+	}
+	result["error_types"] = errorTypes
+
 	// Add user answer
 	if attempt.UserAnswer != "" {
 		result["user_answer"] = attempt.UserAnswer
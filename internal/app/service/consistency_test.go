@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// After every kind of review, the study queue, suggestions and dashboard
+// stats must agree with the state and due date the scheduler wrote.
+func TestQueueStatsAndSchedulerAgree(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+
+	learningConfig := service.LearningStepsConfig{
+		LearningSteps:      []int{1, 10},
+		RelearningSteps:    []int{10},
+		GraduatingInterval: 1,
+		EasyInterval:       4,
+	}
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, learningConfig, data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+	study := service.NewStudyCoordinator(client, data.SystemClock())
+	suggestions := data.NewSuggestionRepository(client, data.SystemClock())
+	stats := data.NewStatsRepository(client, data.SystemClock())
+
+	n := client.Node.Create().SetType(node.TypeProblem).SetTitle("Agree").SaveX(ctx)
+
+	makeOverdue := func() {
+		client.FsrsCard.Update().
+			Where(fsrscard.NodeID(n.ID)).
+			SetDue(time.Now().Add(-time.Hour)).
+			ExecX(ctx)
+	}
+
+	steps := []struct {
+		name      string
+		before    func()
+		grade     int
+		wantState fsrscard.State
+		wantDue   bool
+	}{
+		{"new -> learning", nil, 3, fsrscard.StateLearning, true},
+		{"learning -> graduated", nil, 3, fsrscard.StateReview, false},
+		{"review lapse -> relearning", makeOverdue, 1, fsrscard.StateRelearning, true},
+		{"relearning -> review", nil, 3, fsrscard.StateReview, false},
+		{"review -> review", makeOverdue, 3, fsrscard.StateReview, false},
+	}
+
+	for _, step := range steps {
+		if step.before != nil {
+			step.before()
+		}
+
+		result, err := coordinator.ProcessReview(ctx, n.ID, step.grade)
+		require.NoError(t, err, step.name)
+
+		card := client.FsrsCard.Query().Where(fsrscard.NodeID(n.ID)).OnlyX(ctx)
+		assert.Equal(t, step.wantState, card.State, step.name)
+		assert.Equal(t, string(result.CardState), string(card.State), "%s: scheduler result vs stored state", step.name)
+
+		queue, err := study.GetDueCardsQueue(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, step.wantDue, len(queue) == 1, "%s: study queue", step.name)
+
+		suggested, err := suggestions.GetDueCards(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, step.wantDue, len(suggested) == 1, "%s: suggestions", step.name)
+
+		dashboard, err := stats.GetDashboardStats(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, step.wantDue, dashboard.DueCards == 1, "%s: dashboard stats", step.name)
+
+		nodeWithCard, err := study.GetNodeWithCard(ctx, n.ID)
+		require.NoError(t, err)
+		assert.Equal(t, card.State, nodeWithCard["card_state"], step.name)
+	}
+}
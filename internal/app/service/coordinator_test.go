@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
 
+	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,8 +23,8 @@ func TestCoordinator_NewCard_UsesLearningSteps(t *testing.T) {
 	learningConfig := service.DefaultLearningConfig()
 	fsrsConfig := service.DefaultFSRSConfig()
 
-	learningService := service.NewLearningStepsService(client, learningConfig)
-	fsrsService := service.NewFSRSService(client, fsrsConfig)
+	learningService := service.NewLearningStepsService(client, learningConfig, data.SystemClock())
+	fsrsService := service.NewFSRSService(client, fsrsConfig, data.SystemClock())
 	coordinator := service.NewReviewCoordinator(learningService, fsrsService, client)
 
 	testNode, _ := client.Node.Create().
@@ -36,7 +39,7 @@ func TestCoordinator_NewCard_UsesLearningSteps(t *testing.T) {
 
 	// Should be in learning state
 	card, _ := client.FsrsCard.Query().Where( /* match node */ ).First(ctx)
-	assert.Equal(t, "learning", card.CardState)
+	assert.Equal(t, fsrscard.StateLearning, card.State)
 	assert.NotNil(t, result)
 }
 
@@ -55,8 +58,8 @@ func TestCoordinator_CompleteFlow_NewToReview(t *testing.T) {
 	}
 	fsrsConfig := service.DefaultFSRSConfig()
 
-	learningService := service.NewLearningStepsService(client, learningConfig)
-	fsrsService := service.NewFSRSService(client, fsrsConfig)
+	learningService := service.NewLearningStepsService(client, learningConfig, data.SystemClock())
+	fsrsService := service.NewFSRSService(client, fsrsConfig, data.SystemClock())
 	coordinator := service.NewReviewCoordinator(learningService, fsrsService, client)
 
 	testNode, _ := client.Node.Create().
@@ -70,7 +73,7 @@ func TestCoordinator_CompleteFlow_NewToReview(t *testing.T) {
 	require.NoError(t, err)
 
 	card, _ := client.FsrsCard.Query().First(ctx)
-	assert.Equal(t, "learning", card.CardState)
+	assert.Equal(t, fsrscard.StateLearning, card.State)
 	assert.Equal(t, 1, card.CurrentStep) // ✅ Now at step 1
 
 	// Step 2: Learning (step 1) -> Graduate to Review
@@ -78,7 +81,7 @@ func TestCoordinator_CompleteFlow_NewToReview(t *testing.T) {
 	require.NoError(t, err)
 
 	card, _ = client.FsrsCard.Get(ctx, card.ID)
-	assert.Equal(t, "review", card.CardState)
+	assert.Equal(t, fsrscard.StateReview, card.State)
 	assert.True(t, card.Stability > 0)
 }
 
@@ -89,8 +92,8 @@ func TestCoordinator_ReviewCard_UsesFSRS(t *testing.T) {
 	learningConfig := service.DefaultLearningConfig()
 	fsrsConfig := service.DefaultFSRSConfig()
 
-	learningService := service.NewLearningStepsService(client, learningConfig)
-	fsrsService := service.NewFSRSService(client, fsrsConfig)
+	learningService := service.NewLearningStepsService(client, learningConfig, data.SystemClock())
+	fsrsService := service.NewFSRSService(client, fsrsConfig, data.SystemClock())
 	coordinator := service.NewReviewCoordinator(learningService, fsrsService, client)
 
 	testNode, _ := client.Node.Create().
@@ -102,8 +105,8 @@ func TestCoordinator_ReviewCard_UsesFSRS(t *testing.T) {
 	// Create card already in review state
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now().Add(-1 * 24 * time.Hour)).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now().Add(-1 * 24 * time.Hour)).
 		SetStability(3.0).
 		SetDifficulty(5.0).
 		SetReps(2).
@@ -117,7 +120,7 @@ func TestCoordinator_ReviewCard_UsesFSRS(t *testing.T) {
 
 	card, _ = client.FsrsCard.Get(ctx, card.ID)
 	assert.Greater(t, card.Stability, initialStability)
-	assert.Equal(t, "review", card.CardState)
+	assert.Equal(t, fsrscard.StateReview, card.State)
 	assert.NotNil(t, result)
 }
 
@@ -128,8 +131,8 @@ func TestCoordinator_GetSchedulingInfo(t *testing.T) {
 	learningConfig := service.DefaultLearningConfig()
 	fsrsConfig := service.DefaultFSRSConfig()
 
-	learningService := service.NewLearningStepsService(client, learningConfig)
-	fsrsService := service.NewFSRSService(client, fsrsConfig)
+	learningService := service.NewLearningStepsService(client, learningConfig, data.SystemClock())
+	fsrsService := service.NewFSRSService(client, fsrsConfig, data.SystemClock())
 	coordinator := service.NewReviewCoordinator(learningService, fsrsService, client)
 
 	testNode, _ := client.Node.Create().
@@ -141,9 +144,9 @@ func TestCoordinator_GetSchedulingInfo(t *testing.T) {
 	// Create learning card
 	_, _ = client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("learning").
+		SetState(fsrscard.StateLearning).
 		SetCurrentStep(0).
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -158,3 +161,80 @@ func TestCoordinator_GetSchedulingInfo(t *testing.T) {
 	assert.NotEmpty(t, intervals[3]) // Good
 	assert.NotEmpty(t, intervals[4]) // Easy
 }
+
+func TestCoordinator_SubmitReview_IgnoresDuplicateReviewID(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+
+	testNode := client.Node.Create().SetType(node.TypeProblem).SetTitle("Test").SaveX(ctx)
+
+	sub := service.ReviewSubmission{
+		ReviewID:   uuid.New(),
+		NodeID:     testNode.ID,
+		Grade:      3,
+		DurationMs: 1500,
+	}
+
+	result, recorded, err := coordinator.SubmitReview(ctx, sub)
+	require.NoError(t, err)
+	assert.Equal(t, service.StateLearning, result.CardState)
+	require.NotNil(t, recorded.ReviewID)
+	assert.Equal(t, sub.ReviewID, *recorded.ReviewID)
+
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(testNode.ID)).OnlyX(ctx)
+	assert.Equal(t, 1, card.Version)
+	assert.Equal(t, 1, card.CurrentStep)
+
+	// Double-click: same review ID changes nothing
+	_, _, err = coordinator.SubmitReview(ctx, sub)
+	assert.ErrorIs(t, err, service.ErrDuplicateReview)
+
+	card = client.FsrsCard.GetX(ctx, card.ID)
+	assert.Equal(t, 1, card.Version)
+	assert.Equal(t, 1, card.CurrentStep)
+	assert.Equal(t, 1, client.Attempt.Query().CountX(ctx))
+
+	// Other constraint violations are not mistaken for duplicates
+	_, _, err = coordinator.SubmitReview(ctx, service.ReviewSubmission{
+		ReviewID: uuid.New(),
+		NodeID:   uuid.New(), // No such node
+		Grade:    3,
+	})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, service.ErrDuplicateReview)
+
+	// Invalid grades never open a transaction
+	_, _, err = coordinator.SubmitReview(ctx, service.ReviewSubmission{NodeID: testNode.ID, Grade: 5})
+	assert.Error(t, err)
+}
+
+func TestCoordinator_EasyGraduatesWithEasyInterval(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+
+	learningConfig := service.DefaultLearningConfig()
+	learningConfig.EasyInterval = 7
+	fsrsConfig := service.DefaultFSRSConfig()
+	fsrsConfig.EnableFuzz = false
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, learningConfig, data.SystemClock()),
+		service.NewFSRSService(client, fsrsConfig, data.SystemClock()),
+		client,
+	)
+
+	testNode := client.Node.Create().SetType(node.TypeProblem).SetTitle("Easy").SaveX(ctx)
+	result, err := coordinator.ProcessReview(ctx, testNode.ID, 4)
+	require.NoError(t, err)
+	assert.True(t, result.Graduated)
+
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(testNode.ID)).OnlyX(ctx)
+	assert.Equal(t, fsrscard.StateReview, card.State)
+	assert.Equal(t, 7, card.ScheduledDays)
+}
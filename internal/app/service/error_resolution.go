@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/schema"
+
+	"github.com/google/uuid"
+)
+
+// ErrorResolutionConfig controls when an open error closes on its own
+type ErrorResolutionConfig struct {
+	ResolveAfter int `json:"resolve_after"` // Consecutive correct answers that close an error; 0 never closes automatically
+}
+
+// DefaultErrorResolutionConfig closes an error after three correct answers in a row
+func DefaultErrorResolutionConfig() ErrorResolutionConfig {
+	return ErrorResolutionConfig{ResolveAfter: 3}
+}
+
+// Validate checks the config is usable
+func (c ErrorResolutionConfig) Validate() error {
+	if c.ResolveAfter < 0 {
+		return fmt.Errorf("error resolve streak must not be negative")
+	}
+	return nil
+}
+
+// ErrorResolutionService records the errors made in attempts and tracks
+// their ErrorResolution on the node from opening to closing
+type ErrorResolutionService struct {
+	client *ent.Client
+}
+
+// NewErrorResolutionService creates a new ErrorResolutionService
+func NewErrorResolutionService(client *ent.Client) *ErrorResolutionService {
+	return &ErrorResolutionService{client: client}
+}
+
+// Record links each error type to the attempt and opens an ErrorResolution on
+// the node, or bumps the open one by the type's base weight. The first error
+// type is also stored on the attempt itself, along with the note.
+func (s *ErrorResolutionService) Record(
+	ctx context.Context,
+	recorded *ent.Attempt,
+	nodeID uuid.UUID,
+	errorTypeIDs []uuid.UUID,
+	note string,
+) ([]*ent.ErrorResolution, error) {
+	errorTypeIDs = uniqueIDs(errorTypeIDs)
+
+	// Check every type before touching the attempt, so an unknown ID is a
+	// plain error rather than a foreign key violation
+	defs := make([]*ent.ErrorDefinition, 0, len(errorTypeIDs))
+	for _, id := range errorTypeIDs {
+		def, err := s.client.ErrorDefinition.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("loading error definition %s: %w", id, err)
+		}
+		if def.IsArchived {
+			return nil, fmt.Errorf("error type %q is archived", def.Label)
+		}
+		defs = append(defs, def)
+	}
+
+	update := recorded.Update()
+	if note != "" {
+		update = update.SetErrorNote(note)
+	}
+	if len(errorTypeIDs) > 0 {
+		update = update.SetErrorTypeID(errorTypeIDs[0])
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return nil, fmt.Errorf("recording attempt errors: %w", err)
+	}
+
+	resolutions := make([]*ent.ErrorResolution, 0, len(defs))
+	for _, def := range defs {
+		if err := s.client.AttemptError.Create().
+			SetAttemptID(recorded.ID).
+			SetErrorTypeID(def.ID).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("linking error to attempt: %w", err)
+		}
+
+		r, err := s.openOrBump(ctx, nodeID, def, recorded.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resolutions = append(resolutions, r)
+	}
+	return resolutions, nil
+}
+
+// openOrBump adds the definition's base weight to the node's open resolution
+// of that type, creating it if there is none
+func (s *ErrorResolutionService) openOrBump(
+	ctx context.Context,
+	nodeID uuid.UUID,
+	def *ent.ErrorDefinition,
+	at time.Time,
+) (*ent.ErrorResolution, error) {
+	open, err := s.client.ErrorResolution.Query().
+		Where(
+			errorresolution.NodeID(nodeID),
+			errorresolution.ErrorTypeID(def.ID),
+			errorresolution.IsResolved(false),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return s.client.ErrorResolution.Create().
+			SetNodeID(nodeID).
+			SetErrorTypeID(def.ID).
+			SetWeightImpact(def.BaseWeight).
+			SetLastSeenAt(at).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading open error: %w", err)
+	}
+
+	return open.Update().
+		AddWeightImpact(def.BaseWeight).
+		AddOccurrences(1).
+		SetLastSeenAt(at).
+		Save(ctx)
+}
+
+// ResolveStreaks closes the node's open errors whose last ResolveAfter graded
+// attempts since the error was last seen were all correct. Returns how many closed.
+func (s *ErrorResolutionService) ResolveStreaks(
+	ctx context.Context,
+	nodeID uuid.UUID,
+	config ErrorResolutionConfig,
+	now time.Time,
+) (int, error) {
+	if config.ResolveAfter <= 0 {
+		return 0, nil
+	}
+
+	open, err := s.client.ErrorResolution.Query().
+		Where(
+			errorresolution.NodeID(nodeID),
+			errorresolution.IsResolved(false),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("loading open errors: %w", err)
+	}
+
+	closed := 0
+	for _, r := range open {
+		since := r.CreatedAt
+		if r.LastSeenAt != nil {
+			since = *r.LastSeenAt
+		}
+
+		recent, err := s.client.Attempt.Query().
+			Where(
+				attempt.HasCardWith(fsrscard.NodeID(nodeID)),
+				attempt.KindEQ(attempt.KindReview),
+				attempt.CreatedAtGT(since),
+			).
+			Order(ent.Desc(attempt.FieldCreatedAt)).
+			Limit(config.ResolveAfter).
+			All(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("loading attempts since error: %w", err)
+		}
+		if !correctStreak(recent, config.ResolveAfter) {
+			continue
+		}
+
+		err = r.Update().
+			SetIsResolved(true).
+			SetResolvedAt(now).
+			SetResolutionNotes(fmt.Sprintf("Resolved automatically after %d consecutive correct answers", config.ResolveAfter)).
+			Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("resolving error: %w", err)
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// correctStreak reports whether the most recent n attempts are all correct
+func correctStreak(recent []*ent.Attempt, n int) bool {
+	if len(recent) < n {
+		return false
+	}
+	for _, a := range recent[:n] {
+		if !a.IsCorrect {
+			return false
+		}
+	}
+	return true
+}
+
+// createdResolutions returns the IDs of the resolutions in after that were not in before
+func createdResolutions(before, after []*ent.ErrorResolution) []uuid.UUID {
+	existed := make(map[uuid.UUID]bool, len(before))
+	for _, r := range before {
+		existed[r.ID] = true
+	}
+	var created []uuid.UUID
+	for _, r := range after {
+		if !existed[r.ID] {
+			created = append(created, r.ID)
+		}
+	}
+	return created
+}
+
+// changedResolutions snapshots the resolutions in before that differ in after
+func changedResolutions(before, after []*ent.ErrorResolution) []schema.ResolutionSnapshot {
+	now := make(map[uuid.UUID]*ent.ErrorResolution, len(after))
+	for _, r := range after {
+		now[r.ID] = r
+	}
+	var changed []schema.ResolutionSnapshot
+	for _, r := range before {
+		a, ok := now[r.ID]
+		if !ok || resolutionEqual(r, a) {
+			continue
+		}
+		changed = append(changed, schema.ResolutionSnapshot{
+			ID:              r.ID,
+			WeightImpact:    r.WeightImpact,
+			Occurrences:     r.Occurrences,
+			LastSeenAt:      r.LastSeenAt,
+			IsResolved:      r.IsResolved,
+			ResolvedAt:      r.ResolvedAt,
+			ResolutionNotes: r.ResolutionNotes,
+		})
+	}
+	return changed
+}
+
+// resolutionEqual compares the fields a review can change
+func resolutionEqual(a, b *ent.ErrorResolution) bool {
+	return a.WeightImpact == b.WeightImpact &&
+		a.Occurrences == b.Occurrences &&
+		a.IsResolved == b.IsResolved &&
+		timePtrEqual(a.LastSeenAt, b.LastSeenAt) &&
+		timePtrEqual(a.ResolvedAt, b.ResolvedAt)
+}
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// uniqueIDs drops repeats and nil IDs, keeping the first occurrence order
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if id == uuid.Nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempterror"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/node"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewCoordinator_ErrorResolutionLifecycle(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Integrate by parts").SaveX(ctx)
+	concept := client.ErrorDefinition.Create().SetLabel("Concept " + uuid.NewString()).SetBaseWeight(2).SaveX(ctx)
+	sign := client.ErrorDefinition.Create().SetLabel("Sign " + uuid.NewString()).SetBaseWeight(0.5).SaveX(ctx)
+
+	review := func(grade int, errorTypes ...uuid.UUID) *service.ReviewResult {
+		result, _, err := coordinator.SubmitReview(ctx, service.ReviewSubmission{
+			NodeID:       problem.ID,
+			Grade:        grade,
+			ErrorTypeIDs: errorTypes,
+		})
+		require.NoError(t, err)
+		return result
+	}
+	open := func(def uuid.UUID) float64 {
+		r := client.ErrorResolution.Query().
+			Where(
+				errorresolution.NodeID(problem.ID),
+				errorresolution.ErrorTypeID(def),
+				errorresolution.IsResolved(false),
+			).
+			OnlyX(ctx)
+		return r.WeightImpact
+	}
+
+	// An unknown error type fails before anything is written
+	_, recorded, err := coordinator.SubmitReview(ctx, service.ReviewSubmission{
+		NodeID:       problem.ID,
+		Grade:        1,
+		ErrorTypeIDs: []uuid.UUID{uuid.New()},
+	})
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+	assert.Nil(t, recorded)
+	assert.Zero(t, client.Attempt.Query().CountX(ctx))
+
+	// Errors are linked to the attempt and open a resolution each
+	_, recorded, err = coordinator.SubmitReview(ctx, service.ReviewSubmission{
+		NodeID:       problem.ID,
+		Grade:        1,
+		ErrorTypeIDs: []uuid.UUID{concept.ID, sign.ID, concept.ID},
+		ErrorNote:    "confused u and dv",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, recorded.ErrorTypeID)
+	assert.Equal(t, concept.ID, *recorded.ErrorTypeID)
+	assert.Equal(t, "confused u and dv", recorded.ErrorNote)
+	assert.Equal(t, 2, client.AttemptError.Query().Where(attempterror.AttemptID(recorded.ID)).CountX(ctx))
+	assert.Equal(t, 2.0, open(concept.ID))
+	assert.Equal(t, 0.5, open(sign.ID))
+
+	// Repeating an error bumps the open resolution by its base weight
+	review(1, concept.ID)
+	assert.Equal(t, 4.0, open(concept.ID))
+
+	// A wrong answer breaks the streak
+	review(3)
+	review(3)
+	review(1)
+	review(3)
+	review(3)
+	assert.Equal(t, 2, client.ErrorResolution.Query().Where(errorresolution.IsResolved(false)).CountX(ctx))
+
+	// Three correct answers in a row close both errors
+	result := review(3)
+	assert.Equal(t, 2, result.ResolvedErrors)
+
+	resolved := client.ErrorResolution.Query().Where(errorresolution.NodeID(problem.ID)).AllX(ctx)
+	require.Len(t, resolved, 2)
+	for _, r := range resolved {
+		assert.True(t, r.IsResolved)
+		assert.NotNil(t, r.ResolvedAt)
+		assert.NotEmpty(t, r.ResolutionNotes)
+	}
+}
+
+func TestUndoService_RevertsErrorResolution(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+	undo := service.NewUndoService(client)
+
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Chain rule").SaveX(ctx)
+	concept := client.ErrorDefinition.Create().SetLabel("Concept " + uuid.NewString()).SetBaseWeight(2).SaveX(ctx)
+
+	review := func(grade int, errorTypes ...uuid.UUID) {
+		_, recorded, err := coordinator.SubmitReview(ctx, service.ReviewSubmission{
+			NodeID:       problem.ID,
+			Grade:        grade,
+			ErrorTypeIDs: errorTypes,
+		})
+		require.NoError(t, err)
+		undo.Push(recorded.ID)
+	}
+	resolution := func() *ent.ErrorResolution {
+		return client.ErrorResolution.Query().Where(errorresolution.NodeID(problem.ID)).OnlyX(ctx)
+	}
+
+	review(1, concept.ID)
+	opened := resolution()
+
+	// Undoing a repeat takes the bump back
+	review(1, concept.ID)
+	assert.Equal(t, 4.0, resolution().WeightImpact)
+	_, err := undo.UndoLast(ctx)
+	require.NoError(t, err)
+	reverted := resolution()
+	assert.Equal(t, 2.0, reverted.WeightImpact)
+	assert.Equal(t, 1, reverted.Occurrences)
+	require.NotNil(t, reverted.LastSeenAt)
+	assert.WithinDuration(t, *opened.LastSeenAt, *reverted.LastSeenAt, time.Millisecond)
+
+	// Undoing the answer that closed the error reopens it
+	review(3)
+	review(3)
+	review(3)
+	require.True(t, resolution().IsResolved)
+	_, err = undo.UndoLast(ctx)
+	require.NoError(t, err)
+	reopened := resolution()
+	assert.False(t, reopened.IsResolved)
+	assert.Nil(t, reopened.ResolvedAt)
+	assert.Empty(t, reopened.ResolutionNotes)
+
+	// Undoing the first error removes it entirely
+	for i := 0; i < 3; i++ {
+		_, err = undo.UndoLast(ctx)
+		require.NoError(t, err)
+	}
+	assert.Zero(t, client.ErrorResolution.Query().Where(errorresolution.NodeID(problem.ID)).CountX(ctx))
+}
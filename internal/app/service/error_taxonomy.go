@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
+	"profen/internal/data/ent/errordefinition"
+	"profen/internal/data/ent/errorresolution"
+
+	"github.com/google/uuid"
+)
+
+// ErrProtectedDefinition is returned when a system error type would be archived or merged away
+var ErrProtectedDefinition = errors.New("system error types cannot be archived or merged away")
+
+// errorCodePattern is the shape of a stable error code, e.g. ERR_SIGN_SLIP
+var errorCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// ErrorTypeInput describes a user-defined error type
+type ErrorTypeInput struct {
+	Code        string  `json:"code"` // Derived from the label when empty
+	Label       string  `json:"label"`
+	Category    string  `json:"category"`
+	Description string  `json:"description"`
+	BaseWeight  float64 `json:"base_weight"` // 0 uses the default of 1.0
+}
+
+// MergeResult counts the records moved onto the surviving error type
+type MergeResult struct {
+	Attempts    int `json:"attempts"`
+	Links       int `json:"links"`
+	Resolutions int `json:"resolutions"`
+}
+
+// ErrorTaxonomyService manages error definitions: the system codes and user-defined types
+type ErrorTaxonomyService struct {
+	client *ent.Client
+}
+
+// NewErrorTaxonomyService creates a new ErrorTaxonomyService
+func NewErrorTaxonomyService(client *ent.Client) *ErrorTaxonomyService {
+	return &ErrorTaxonomyService{client: client}
+}
+
+// List returns the error types grouped by category, system types first
+func (s *ErrorTaxonomyService) List(ctx context.Context, includeArchived bool) ([]*ent.ErrorDefinition, error) {
+	q := s.client.ErrorDefinition.Query()
+	if !includeArchived {
+		q = q.Where(errordefinition.IsArchived(false))
+	}
+	return q.
+		Order(
+			ent.Asc(errordefinition.FieldCategory),
+			ent.Desc(errordefinition.FieldIsSystem),
+			ent.Asc(errordefinition.FieldLabel),
+		).
+		All(ctx)
+}
+
+// Create adds a user-defined error type
+func (s *ErrorTaxonomyService) Create(ctx context.Context, input ErrorTypeInput) (*ent.ErrorDefinition, error) {
+	input.Label = strings.TrimSpace(input.Label)
+	if input.Label == "" {
+		return nil, fmt.Errorf("error type label is required")
+	}
+	if input.Code == "" {
+		input.Code = errorCodeFor(input.Label)
+		if input.Code == "" {
+			return nil, fmt.Errorf("no code can be derived from %q; give the error type a code", input.Label)
+		}
+	}
+	if !errorCodePattern.MatchString(input.Code) {
+		return nil, fmt.Errorf("error code %q must be upper case letters, digits and underscores", input.Code)
+	}
+	if input.Code == "ERR" {
+		return nil, fmt.Errorf("error code ERR is too generic; name the error after it, e.g. ERR_SIGN_SLIP")
+	}
+	if input.BaseWeight == 0 {
+		input.BaseWeight = 1.0
+	}
+	if input.BaseWeight < 0 {
+		return nil, fmt.Errorf("base weight must be positive")
+	}
+
+	def, err := s.client.ErrorDefinition.Create().
+		SetCode(input.Code).
+		SetLabel(input.Label).
+		SetCategory(strings.TrimSpace(input.Category)).
+		SetDescription(input.Description).
+		SetBaseWeight(input.BaseWeight).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("an error type with code %s or label %q already exists", input.Code, input.Label)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating error type: %w", err)
+	}
+	return def, nil
+}
+
+// UpdateWeight changes the base weight of any error type. Only errors recorded
+// afterwards use the new weight.
+func (s *ErrorTaxonomyService) UpdateWeight(ctx context.Context, id uuid.UUID, weight float64) (*ent.ErrorDefinition, error) {
+	if weight <= 0 {
+		return nil, fmt.Errorf("base weight must be positive, got %v", weight)
+	}
+	return s.client.ErrorDefinition.UpdateOneID(id).
+		SetBaseWeight(weight).
+		Save(ctx)
+}
+
+// Archive hides a user-defined error type from new reviews, or restores it.
+// Its history is kept.
+func (s *ErrorTaxonomyService) Archive(ctx context.Context, id uuid.UUID, archived bool) (*ent.ErrorDefinition, error) {
+	def, err := s.client.ErrorDefinition.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if def.IsSystem && archived {
+		return nil, ErrProtectedDefinition
+	}
+	return def.Update().
+		SetIsArchived(archived).
+		Save(ctx)
+}
+
+// Merge moves every attempt, attempt link and resolution of the source type
+// onto the target and deletes the source. An open source resolution on a node
+// that already has an open target resolution is folded into it.
+func (s *ErrorTaxonomyService) Merge(ctx context.Context, sourceID, targetID uuid.UUID) (*MergeResult, error) {
+	if sourceID == targetID {
+		return nil, fmt.Errorf("cannot merge an error type into itself")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	result, err := merge(ctx, tx.Client(), sourceID, targetID)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("rolling back transaction: %v (original error: %w)", rerr, err)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return result, nil
+}
+
+func merge(ctx context.Context, client *ent.Client, sourceID, targetID uuid.UUID) (*MergeResult, error) {
+	source, err := client.ErrorDefinition.Get(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("loading source error type: %w", err)
+	}
+	if source.IsSystem {
+		return nil, ErrProtectedDefinition
+	}
+	if _, err := client.ErrorDefinition.Get(ctx, targetID); err != nil {
+		return nil, fmt.Errorf("loading target error type: %w", err)
+	}
+
+	result := &MergeResult{}
+
+	result.Attempts, err = client.Attempt.Update().
+		Where(attempt.ErrorTypeID(sourceID)).
+		SetErrorTypeID(targetID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("moving attempts: %w", err)
+	}
+
+	// Attempts that recorded both types keep a single link
+	_, err = client.AttemptError.Delete().
+		Where(
+			attempterror.ErrorTypeID(sourceID),
+			attempterror.HasAttemptWith(attempt.HasErrorsWith(attempterror.ErrorTypeID(targetID))),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("dropping duplicate attempt links: %w", err)
+	}
+	result.Links, err = client.AttemptError.Update().
+		Where(attempterror.ErrorTypeID(sourceID)).
+		SetErrorTypeID(targetID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("moving attempt links: %w", err)
+	}
+
+	// Fold open source errors into the node's open target error, if any
+	open, err := client.ErrorResolution.Query().
+		Where(
+			errorresolution.ErrorTypeID(sourceID),
+			errorresolution.IsResolved(false),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading open errors: %w", err)
+	}
+	for _, r := range open {
+		existing, err := client.ErrorResolution.Query().
+			Where(
+				errorresolution.NodeID(r.NodeID),
+				errorresolution.ErrorTypeID(targetID),
+				errorresolution.IsResolved(false),
+			).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			continue // Re-pointed below
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		update := existing.Update().
+			AddWeightImpact(r.WeightImpact).
+			AddOccurrences(r.Occurrences)
+		if r.LastSeenAt != nil && (existing.LastSeenAt == nil || r.LastSeenAt.After(*existing.LastSeenAt)) {
+			update = update.SetLastSeenAt(*r.LastSeenAt)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("folding open error: %w", err)
+		}
+		if err := client.ErrorResolution.DeleteOne(r).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("folding open error: %w", err)
+		}
+		result.Resolutions++
+	}
+
+	moved, err := client.ErrorResolution.Update().
+		Where(errorresolution.ErrorTypeID(sourceID)).
+		SetErrorTypeID(targetID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("moving resolutions: %w", err)
+	}
+	result.Resolutions += moved
+
+	if err := client.ErrorDefinition.DeleteOne(source).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("deleting merged error type: %w", err)
+	}
+	return result, nil
+}
+
+// errorCodeFor derives a code from a label: "Sign slip" becomes ERR_SIGN_SLIP.
+// Only ASCII letters and digits are kept; a label without any gives "".
+func errorCodeFor(label string) string {
+	var b strings.Builder
+	b.WriteString("ERR")
+	sep := true
+	for _, r := range strings.ToUpper(label) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			if sep {
+				b.WriteByte('_')
+				sep = false
+			}
+			b.WriteRune(r)
+			continue
+		}
+		sep = true
+	}
+	if b.Len() == len("ERR") {
+		return ""
+	}
+	return b.String()
+}
+
+// systemErrorType returns the definition with a canonical code, creating it
+// from the seed table if it is missing
+func systemErrorType(ctx context.Context, client *ent.Client, code string) (*ent.ErrorDefinition, error) {
+	def, err := client.ErrorDefinition.Query().
+		Where(errordefinition.Code(code)).
+		Only(ctx)
+	if !ent.IsNotFound(err) {
+		return def, err
+	}
+
+	for _, d := range data.SystemErrorTypes {
+		if d.Code != code {
+			continue
+		}
+		return client.ErrorDefinition.Create().
+			SetCode(d.Code).
+			SetLabel(d.Label).
+			SetCategory(d.Category).
+			SetDescription(d.Description).
+			SetBaseWeight(d.Weight).
+			SetIsSystem(true).
+			Save(ctx)
+	}
+	return nil, fmt.Errorf("unknown system error code %s", code)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"profen/internal/data"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/errordefinition"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCodeFor(t *testing.T) {
+	assert.Equal(t, "ERR_SIGN_SLIP", errorCodeFor("Sign slip"))
+	assert.Equal(t, "ERR_UNITS_M_S", errorCodeFor("  units (m/s) "))
+	assert.Equal(t, "ERR_2ND_ORDER", errorCodeFor("2nd-order"))
+	assert.Empty(t, errorCodeFor("Ошибка знака"))
+	assert.Empty(t, errorCodeFor("符号错误"))
+}
+
+func TestErrorTaxonomyService_CreateArchiveMerge(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+	require.NoError(t, data.SeedErrorDefinitions(ctx, client))
+
+	svc := NewErrorTaxonomyService(client)
+	suffix := uuid.NewString()[:8]
+
+	slip, err := svc.Create(ctx, ErrorTypeInput{Label: "Sign slip " + suffix, Category: "Execution"})
+	require.NoError(t, err)
+	require.NotNil(t, slip.Code)
+	assert.Equal(t, 1.0, slip.BaseWeight)
+	assert.False(t, slip.IsSystem)
+
+	_, err = svc.Create(ctx, ErrorTypeInput{Code: "err-bad", Label: "Bad code " + suffix})
+	assert.Error(t, err)
+	_, err = svc.Create(ctx, ErrorTypeInput{Code: "ERR", Label: "Generic " + suffix})
+	assert.Error(t, err)
+
+	// Labels without ASCII letters need an explicit code
+	_, err = svc.Create(ctx, ErrorTypeInput{Label: "Ошибка знака"})
+	assert.Error(t, err)
+	sign, err := svc.Create(ctx, ErrorTypeInput{Code: "ERR_SIGN_RU_" + strings.ToUpper(suffix), Label: "Ошибка знака " + suffix})
+	require.NoError(t, err)
+	require.NotNil(t, sign.Code)
+
+	// System types can be reweighted but not archived or merged away
+	execution := client.ErrorDefinition.Query().Where(errordefinition.Code(data.ErrorCodeExecution)).OnlyX(ctx)
+	_, err = svc.Archive(ctx, execution.ID, true)
+	assert.ErrorIs(t, err, ErrProtectedDefinition)
+	_, err = svc.Merge(ctx, execution.ID, slip.ID)
+	assert.ErrorIs(t, err, ErrProtectedDefinition)
+	updated, err := svc.UpdateWeight(ctx, execution.ID, 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, updated.BaseWeight)
+
+	// Archived types drop out of the list and cannot be recorded
+	_, err = svc.Archive(ctx, slip.ID, true)
+	require.NoError(t, err)
+	listed, err := svc.List(ctx, false)
+	require.NoError(t, err)
+	for _, def := range listed {
+		assert.NotEqual(t, slip.ID, def.ID)
+	}
+	_, err = svc.Archive(ctx, slip.ID, false)
+	require.NoError(t, err)
+
+	// Record the user type on one problem and both types on another
+	a := client.Node.Create().SetType(node.TypeProblem).SetTitle("Expand (a-b)^2").SaveX(ctx)
+	b := client.Node.Create().SetType(node.TypeProblem).SetTitle("Expand (a-b)^3").SaveX(ctx)
+	errs := NewErrorResolutionService(client)
+	record := func(nodeID uuid.UUID, types ...uuid.UUID) uuid.UUID {
+		card := client.FsrsCard.Query().Where(fsrscard.NodeID(nodeID)).OnlyX(ctx)
+		at := client.Attempt.Create().
+			SetCardID(card.ID).
+			SetRating(1).
+			SetState(attempt.StateNew).
+			SetStability(0).
+			SetDifficulty(0).
+			SetIsCorrect(false).
+			SaveX(ctx)
+		_, err := errs.Record(ctx, at, nodeID, types, "")
+		require.NoError(t, err)
+		return at.ID
+	}
+	onlySlip := record(a.ID, slip.ID)
+	bothTypes := record(b.ID, slip.ID, execution.ID)
+
+	result, err := svc.Merge(ctx, slip.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Attempts)
+	assert.Equal(t, 1, result.Links) // The duplicate link on bothTypes is dropped
+	assert.Equal(t, 2, result.Resolutions)
+
+	assert.False(t, client.ErrorDefinition.Query().Where(errordefinition.ID(slip.ID)).ExistX(ctx))
+	assert.Equal(t, execution.ID, *client.Attempt.GetX(ctx, onlySlip).ErrorTypeID)
+	assert.Equal(t, 1, client.Attempt.GetX(ctx, bothTypes).QueryErrors().CountX(ctx))
+
+	// b's two open errors fold into one, weighted by both
+	open := client.ErrorResolution.Query().
+		Where(errorresolution.NodeID(b.ID), errorresolution.IsResolved(false)).
+		AllX(ctx)
+	require.Len(t, open, 1)
+	assert.Equal(t, execution.ID, open[0].ErrorTypeID)
+	assert.InDelta(t, 1.0+1.5, open[0].WeightImpact, 1e-9)
+	assert.Equal(t, 2, open[0].Occurrences)
+}
@@ -0,0 +1,374 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/nodeassociation"
+
+	"github.com/google/uuid"
+)
+
+// wilsonZ is the normal quantile of the 95% confidence intervals
+const wilsonZ = 1.96
+
+// minLearnedWeight keeps a learned weight positive so the error still ranks
+const minLearnedWeight = 0.1
+
+// ErrorWeightConfig controls how error weights are learned
+type ErrorWeightConfig struct {
+	HorizonDays int `json:"horizon_days"` // How long after an error a lapse or repeat still counts
+	MinEvents   int `json:"min_events"`   // Occurrences needed before a weight is proposed
+}
+
+// DefaultErrorWeightConfig looks 30 days ahead and needs 10 occurrences per type
+func DefaultErrorWeightConfig() ErrorWeightConfig {
+	return ErrorWeightConfig{
+		HorizonDays: 30,
+		MinEvents:   10,
+	}
+}
+
+// Validate checks the config is usable
+func (c ErrorWeightConfig) Validate() error {
+	if c.HorizonDays < 1 {
+		return fmt.Errorf("horizon must be at least 1 day")
+	}
+	if c.MinEvents < 1 {
+		return fmt.Errorf("minimum events must be at least 1")
+	}
+	return nil
+}
+
+// ErrorWeightProposal is the learned weight of one error type. The weight is
+// how much more often a bad outcome follows this error than any review.
+type ErrorWeightProposal struct {
+	ErrorTypeID uuid.UUID `json:"error_type_id"`
+	Label       string    `json:"label"`
+	Current     float64   `json:"current"`
+	Proposed    float64   `json:"proposed"`
+	Lower       float64   `json:"lower"` // 95% confidence interval of Proposed
+	Upper       float64   `json:"upper"`
+	Events      int       `json:"events"`   // Times the error was recorded
+	Outcomes    int       `json:"outcomes"` // Followed by a lapse or repeat error within the horizon
+	Rate        float64   `json:"rate"`
+	Sufficient  bool      `json:"sufficient"` // Enough events to propose a weight
+}
+
+// ErrorWeightAnalysis is the outcome of one learning run
+type ErrorWeightAnalysis struct {
+	Config          ErrorWeightConfig     `json:"config"`
+	BaselineRate    float64               `json:"baseline_rate"` // Bad-outcome rate after any review
+	BaselineReviews int                   `json:"baseline_reviews"`
+	Proposals       []ErrorWeightProposal `json:"proposals"`
+}
+
+// ErrorWeightService learns error weights from what followed each error
+type ErrorWeightService struct {
+	client *ent.Client
+}
+
+// NewErrorWeightService creates a new ErrorWeightService
+func NewErrorWeightService(client *ent.Client) *ErrorWeightService {
+	return &ErrorWeightService{client: client}
+}
+
+// errorEvent is one recorded occurrence of an error type on a node
+type errorEvent struct {
+	typeID uuid.UUID
+	nodeID uuid.UUID
+	at     time.Time
+}
+
+// reviewOutcome is one graded review of a node
+type reviewOutcome struct {
+	at      time.Time
+	correct bool
+}
+
+// outcomeIndex answers whether something went wrong on a node or its siblings
+// after a point in time
+type outcomeIndex struct {
+	reviews  map[uuid.UUID][]reviewOutcome // Per node, oldest first
+	errors   map[uuid.UUID][]time.Time     // Per node
+	siblings map[uuid.UUID][]uuid.UUID     // Problems testing the same theory
+}
+
+// Analyze estimates for every error type how strongly it predicts a lapse on
+// the next review of the node, or a repeat error on the node or its siblings,
+// within the horizon. Nothing is changed; see Accept.
+func (s *ErrorWeightService) Analyze(ctx context.Context, config ErrorWeightConfig) (*ErrorWeightAnalysis, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return analyzeErrorWeights(ctx, s.client, config)
+}
+
+// Accept re-learns the weights of the accepted error types under config and
+// applies the sufficient ones to base_weight in one transaction, so only
+// weights the revlog supports are stored. Returns the updated error types.
+func (s *ErrorWeightService) Accept(ctx context.Context, config ErrorWeightConfig, errorTypeIDs []uuid.UUID) ([]*ent.ErrorDefinition, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	updated, err := acceptErrorWeights(ctx, tx.Client(), config, errorTypeIDs)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("rolling back transaction: %v (original error: %w)", rerr, err)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return updated, nil
+}
+
+func acceptErrorWeights(
+	ctx context.Context,
+	client *ent.Client,
+	config ErrorWeightConfig,
+	errorTypeIDs []uuid.UUID,
+) ([]*ent.ErrorDefinition, error) {
+	analysis, err := analyzeErrorWeights(ctx, client, config)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make(map[uuid.UUID]bool, len(errorTypeIDs))
+	for _, id := range errorTypeIDs {
+		accepted[id] = true
+	}
+
+	taxonomy := NewErrorTaxonomyService(client)
+	var updated []*ent.ErrorDefinition
+	for _, p := range analysis.Proposals {
+		if !accepted[p.ErrorTypeID] || !p.Sufficient {
+			continue
+		}
+		def, err := taxonomy.UpdateWeight(ctx, p.ErrorTypeID, p.Proposed)
+		if err != nil {
+			return nil, fmt.Errorf("applying weight for %s: %w", p.Label, err)
+		}
+		updated = append(updated, def)
+	}
+	return updated, nil
+}
+
+// analyzeErrorWeights loads the revlog and learns the proposals
+func analyzeErrorWeights(ctx context.Context, client *ent.Client, config ErrorWeightConfig) (*ErrorWeightAnalysis, error) {
+	defs, err := client.ErrorDefinition.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading error types: %w", err)
+	}
+
+	reviews, err := client.Attempt.Query().
+		Where(attempt.KindEQ(attempt.KindReview)).
+		WithCard().
+		WithErrors().
+		Order(ent.Asc(attempt.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading attempts: %w", err)
+	}
+
+	resolutions, err := client.ErrorResolution.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading error resolutions: %w", err)
+	}
+
+	links, err := client.NodeAssociation.Query().
+		Where(nodeassociation.RelTypeIn(nodeassociation.RelTypeTests, nodeassociation.RelTypeDefines)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading tests links: %w", err)
+	}
+
+	events, ix := buildErrorEvents(reviews, resolutions, links)
+	return learnErrorWeights(defs, events, ix, config), nil
+}
+
+// buildErrorEvents collects error occurrences from typed attempt links, the
+// attempt's own error type for older attempts, and resolutions opened without
+// an attempt (e.g. by leech detection)
+func buildErrorEvents(
+	reviews []*ent.Attempt,
+	resolutions []*ent.ErrorResolution,
+	links []*ent.NodeAssociation,
+) ([]errorEvent, *outcomeIndex) {
+	ix := &outcomeIndex{
+		reviews:  make(map[uuid.UUID][]reviewOutcome),
+		errors:   make(map[uuid.UUID][]time.Time),
+		siblings: make(map[uuid.UUID][]uuid.UUID),
+	}
+
+	type nodeType struct{ node, typ uuid.UUID }
+	seen := make(map[nodeType]bool)
+	var events []errorEvent
+	add := func(typeID, nodeID uuid.UUID, at time.Time) {
+		events = append(events, errorEvent{typeID: typeID, nodeID: nodeID, at: at})
+		ix.errors[nodeID] = append(ix.errors[nodeID], at)
+		seen[nodeType{nodeID, typeID}] = true
+	}
+
+	for _, a := range reviews {
+		if a.Edges.Card == nil {
+			continue
+		}
+		nodeID := a.Edges.Card.NodeID
+		ix.reviews[nodeID] = append(ix.reviews[nodeID], reviewOutcome{at: a.CreatedAt, correct: a.IsCorrect})
+
+		switch {
+		case len(a.Edges.Errors) > 0:
+			for _, e := range a.Edges.Errors {
+				add(e.ErrorTypeID, nodeID, a.CreatedAt)
+			}
+		case a.ErrorTypeID != nil:
+			add(*a.ErrorTypeID, nodeID, a.CreatedAt)
+		}
+	}
+	for _, r := range resolutions {
+		if !seen[nodeType{r.NodeID, r.ErrorTypeID}] {
+			add(r.ErrorTypeID, r.NodeID, r.CreatedAt)
+		}
+	}
+
+	// "problem tests theory" or "theory defines problem", whichever way it was stored
+	tested := make(map[uuid.UUID][]uuid.UUID) // theory -> problems
+	for _, l := range links {
+		problem, theory := l.SourceID, l.TargetID
+		if l.RelType == nodeassociation.RelTypeDefines {
+			problem, theory = theory, problem
+		}
+		tested[theory] = append(tested[theory], problem)
+	}
+	for _, problems := range tested {
+		for _, p := range problems {
+			for _, q := range problems {
+				if p != q {
+					ix.siblings[p] = append(ix.siblings[p], q)
+				}
+			}
+		}
+	}
+	return events, ix
+}
+
+// badOutcome reports whether the next review of the node within the horizon
+// lapsed, or an error was recorded on the node or a sibling within it
+func (ix *outcomeIndex) badOutcome(nodeID uuid.UUID, at time.Time, horizon time.Duration) bool {
+	end := at.Add(horizon)
+	within := func(t time.Time) bool { return t.After(at) && !t.After(end) }
+
+	for _, r := range ix.reviews[nodeID] {
+		if r.at.After(at) {
+			if within(r.at) && !r.correct {
+				return true
+			}
+			break // Only the next review counts as a lapse
+		}
+	}
+
+	for _, id := range append([]uuid.UUID{nodeID}, ix.siblings[nodeID]...) {
+		for _, t := range ix.errors[id] {
+			if within(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// learnErrorWeights scores each error type's bad-outcome rate against the rate
+// after any review. Weights come with Wilson 95% intervals; types with too few
+// events keep their current weight and are marked insufficient.
+func learnErrorWeights(
+	defs []*ent.ErrorDefinition,
+	events []errorEvent,
+	ix *outcomeIndex,
+	config ErrorWeightConfig,
+) *ErrorWeightAnalysis {
+	horizon := time.Duration(config.HorizonDays) * 24 * time.Hour
+	analysis := &ErrorWeightAnalysis{Config: config, Proposals: []ErrorWeightProposal{}}
+
+	bad := 0
+	for nodeID, reviews := range ix.reviews {
+		for _, r := range reviews {
+			analysis.BaselineReviews++
+			if ix.badOutcome(nodeID, r.at, horizon) {
+				bad++
+			}
+		}
+	}
+	if analysis.BaselineReviews > 0 {
+		analysis.BaselineRate = float64(bad) / float64(analysis.BaselineReviews)
+	}
+
+	counts := make(map[uuid.UUID]int)
+	outcomes := make(map[uuid.UUID]int)
+	for _, e := range events {
+		counts[e.typeID]++
+		if ix.badOutcome(e.nodeID, e.at, horizon) {
+			outcomes[e.typeID]++
+		}
+	}
+
+	for _, def := range defs {
+		n, k := counts[def.ID], outcomes[def.ID]
+		p := ErrorWeightProposal{
+			ErrorTypeID: def.ID,
+			Label:       def.Label,
+			Current:     def.BaseWeight,
+			Proposed:    def.BaseWeight,
+			Lower:       def.BaseWeight,
+			Upper:       def.BaseWeight,
+			Events:      n,
+			Outcomes:    k,
+		}
+		if n > 0 {
+			p.Rate = float64(k) / float64(n)
+		}
+		if n >= config.MinEvents && analysis.BaselineRate > 0 {
+			lo, hi := wilsonInterval(k, n)
+			p.Proposed = roundWeight(math.Max(p.Rate/analysis.BaselineRate, minLearnedWeight))
+			p.Lower = roundWeight(lo / analysis.BaselineRate)
+			p.Upper = roundWeight(hi / analysis.BaselineRate)
+			p.Sufficient = true
+		}
+		analysis.Proposals = append(analysis.Proposals, p)
+	}
+
+	sort.SliceStable(analysis.Proposals, func(i, j int) bool {
+		return analysis.Proposals[i].Events > analysis.Proposals[j].Events
+	})
+	return analysis
+}
+
+// wilsonInterval is the Wilson score interval of k successes in n trials
+func wilsonInterval(k, n int) (float64, float64) {
+	if n == 0 {
+		return 0, 1
+	}
+	p := float64(k) / float64(n)
+	z2 := wilsonZ * wilsonZ
+	nf := float64(n)
+	center := (p + z2/(2*nf)) / (1 + z2/nf)
+	half := wilsonZ * math.Sqrt(p*(1-p)/nf+z2/(4*nf*nf)) / (1 + z2/nf)
+	return math.Max(0, center-half), math.Min(1, center+half)
+}
+
+func roundWeight(w float64) float64 {
+	return math.Round(w*100) / 100
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLearnErrorWeights(t *testing.T) {
+	t0 := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	predictive := &ent.ErrorDefinition{ID: uuid.New(), Label: "Concept", BaseWeight: 2.5}
+	harmless := &ent.ErrorDefinition{ID: uuid.New(), Label: "Typo", BaseWeight: 1.2}
+	rare := &ent.ErrorDefinition{ID: uuid.New(), Label: "Rare", BaseWeight: 1.0}
+
+	ix := &outcomeIndex{
+		reviews:  make(map[uuid.UUID][]reviewOutcome),
+		errors:   make(map[uuid.UUID][]time.Time),
+		siblings: make(map[uuid.UUID][]uuid.UUID),
+	}
+	var events []errorEvent
+	record := func(def *ent.ErrorDefinition, nextCorrect bool) {
+		n := uuid.New()
+		ix.reviews[n] = []reviewOutcome{{at: t0}, {at: t0.Add(day), correct: nextCorrect}}
+		ix.errors[n] = []time.Time{t0}
+		events = append(events, errorEvent{typeID: def.ID, nodeID: n, at: t0})
+	}
+	for i := 0; i < 10; i++ {
+		record(predictive, false) // Lapses again on the next review
+		record(harmless, true)
+	}
+	for i := 0; i < 2; i++ {
+		events = append(events, errorEvent{typeID: rare.ID, nodeID: uuid.New(), at: t0})
+	}
+
+	analysis := learnErrorWeights(
+		[]*ent.ErrorDefinition{rare, harmless, predictive},
+		events, ix, DefaultErrorWeightConfig(),
+	)
+
+	// 40 reviews; only the first review of each predictive node is followed by a lapse
+	assert.Equal(t, 40, analysis.BaselineReviews)
+	assert.InDelta(t, 0.25, analysis.BaselineRate, 1e-9)
+
+	byID := make(map[uuid.UUID]ErrorWeightProposal)
+	for _, p := range analysis.Proposals {
+		byID[p.ErrorTypeID] = p
+	}
+	require.Len(t, byID, 3)
+
+	p := byID[predictive.ID]
+	assert.True(t, p.Sufficient)
+	assert.Equal(t, 10, p.Outcomes)
+	assert.Equal(t, 4.0, p.Proposed)
+	assert.Less(t, p.Lower, p.Proposed)
+	assert.LessOrEqual(t, p.Proposed, p.Upper)
+	assert.Equal(t, 2.5, p.Current)
+
+	p = byID[harmless.ID]
+	assert.True(t, p.Sufficient)
+	assert.Equal(t, minLearnedWeight, p.Proposed)
+	assert.Equal(t, 0.0, p.Lower)
+
+	p = byID[rare.ID]
+	assert.False(t, p.Sufficient)
+	assert.Equal(t, 1.0, p.Proposed) // Too few events keeps the current weight
+}
+
+func TestOutcomeIndex_CountsSiblingErrorsWithinHorizon(t *testing.T) {
+	t0 := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	p, q := uuid.New(), uuid.New()
+	ix := &outcomeIndex{
+		reviews: map[uuid.UUID][]reviewOutcome{p: {
+			{at: t0.Add(day), correct: true},
+			{at: t0.Add(2 * day), correct: false}, // Not the next review
+		}},
+		errors:   map[uuid.UUID][]time.Time{q: {t0.Add(5 * day)}},
+		siblings: map[uuid.UUID][]uuid.UUID{p: {q}},
+	}
+
+	assert.True(t, ix.badOutcome(p, t0, 7*day))
+	assert.False(t, ix.badOutcome(p, t0, 3*day))
+	assert.True(t, ix.badOutcome(p, t0.Add(day+time.Hour), 3*day))
+}
+
+func TestWilsonInterval(t *testing.T) {
+	lo, hi := wilsonInterval(10, 10)
+	assert.InDelta(t, 0.722, lo, 0.001)
+	assert.Equal(t, 1.0, hi)
+
+	lo, hi = wilsonInterval(0, 0)
+	assert.Equal(t, 0.0, lo)
+	assert.Equal(t, 1.0, hi)
+}
+
+func TestErrorWeightService_AcceptRecomputesProposals(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	concept := client.ErrorDefinition.Create().SetLabel("Concept " + uuid.NewString()).SetBaseWeight(1).SaveX(ctx)
+	unseen := client.ErrorDefinition.Create().SetLabel("Unseen " + uuid.NewString()).SetBaseWeight(1).SaveX(ctx)
+
+	// The error is followed by a lapse, but only one of the two reviews is, so
+	// the error doubles the baseline rate
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Limits").SaveX(ctx)
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(problem.ID)).OnlyX(ctx)
+	client.ErrorResolution.Create().
+		SetNodeID(problem.ID).
+		SetErrorTypeID(concept.ID).
+		SetCreatedAt(time.Now().Add(-time.Hour)).
+		ExecX(ctx)
+	attempts := data.NewAttemptRepository(client)
+	for i := 0; i < 2; i++ {
+		_, err := attempts.CreateAttempt(ctx, card, 1, 1000, "", nil)
+		require.NoError(t, err)
+	}
+
+	config := ErrorWeightConfig{HorizonDays: 30, MinEvents: 1}
+	updated, err := NewErrorWeightService(client).Accept(ctx, config, []uuid.UUID{concept.ID, unseen.ID})
+	require.NoError(t, err)
+
+	// The weight comes from the revlog; the type without events is left alone
+	require.Len(t, updated, 1)
+	assert.Equal(t, concept.ID, updated[0].ID)
+	assert.Equal(t, 2.0, updated[0].BaseWeight)
+	assert.Equal(t, 1.0, client.ErrorDefinition.GetX(ctx, unseen.ID).BaseWeight)
+
+	// Types not accepted are not applied
+	updated, err = NewErrorWeightService(client).Accept(ctx, config, nil)
+	require.NoError(t, err)
+	assert.Empty(t, updated)
+}
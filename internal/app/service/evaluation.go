@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+
+	"github.com/google/uuid"
+)
+
+// ErrWorseWeights means candidate weights predict the revlog worse than the current ones
+var ErrWorseWeights = errors.New("candidate weights perform worse than the current weights")
+
+// calibrationBins is the number of equal-width retrievability buckets
+const calibrationBins = 10
+
+// CalibrationBin compares predicted and actual recall in one retrievability bucket
+type CalibrationBin struct {
+	Low       float64 `json:"low"`
+	High      float64 `json:"high"`
+	Reviews   int     `json:"reviews"`
+	Predicted float64 `json:"predicted"` // Mean predicted retrievability
+	Actual    float64 `json:"actual"`    // Observed recall rate
+}
+
+// WeightEvaluation scores one weight set against the revlog
+type WeightEvaluation struct {
+	Weights     []float64        `json:"weights"`
+	LogLoss     float64          `json:"log_loss"`
+	RMSEBins    float64          `json:"rmse_bins"`
+	Reviews     int              `json:"reviews"`
+	Calibration []CalibrationBin `json:"calibration"`
+}
+
+// WeightComparison is the current weights against a candidate on the same revlog
+type WeightComparison struct {
+	Current   WeightEvaluation `json:"current"`
+	Candidate WeightEvaluation `json:"candidate"`
+	Worse     bool             `json:"worse"` // Candidate loses on log-loss or RMSE(bins)
+}
+
+// EvaluationService replays the attempt revlog to score FSRS weight sets
+type EvaluationService struct {
+	client *ent.Client
+}
+
+// NewEvaluationService creates a new EvaluationService
+func NewEvaluationService(client *ent.Client) *EvaluationService {
+	return &EvaluationService{client: client}
+}
+
+// CompareWeights replays every review under the config's weights and under the
+// candidate weights, and reports both with calibration tables
+func (s *EvaluationService) CompareWeights(ctx context.Context, current FSRSConfig, candidate []float64) (*WeightComparison, error) {
+	proposed := current
+	proposed.W = candidate
+	if err := proposed.Validate(); err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.client.Attempt.Query().
+		Where(attempt.KindEQ(attempt.KindReview)).
+		Order(ent.Asc(attempt.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching attempts: %w", err)
+	}
+
+	day, err := data.NewSettingsRepository(s.client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// FSRS-5+ updates stability on same-day reviews, so replay them too
+	histories := buildReviewHistories(attempts, day, (&FSRSService{config: current}).supportsShortTerm())
+	if len(histories) == 0 {
+		return nil, fmt.Errorf("not enough data to evaluate weights")
+	}
+	return compareWeights(histories, current, proposed), nil
+}
+
+// ApplyWeights stores candidate weights on a preset only if they are not worse
+// than the preset's current weights. Worse weights return ErrWorseWeights with
+// the comparison so the caller can show why.
+func (s *EvaluationService) ApplyWeights(ctx context.Context, presetID uuid.UUID, candidate []float64) (*WeightComparison, error) {
+	presets := NewPresetService(s.client)
+	preset, err := presets.GetPreset(ctx, presetID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, _ := PresetConfigs(preset)
+	comparison, err := s.CompareWeights(ctx, current, candidate)
+	if err != nil {
+		return nil, err
+	}
+	if comparison.Worse {
+		return comparison, ErrWorseWeights
+	}
+
+	if _, err := presets.UpdateWeights(ctx, presetID, candidate); err != nil {
+		return nil, fmt.Errorf("saving weights: %w", err)
+	}
+	return comparison, nil
+}
+
+// compareWeights scores both configs on the same histories
+func compareWeights(histories [][]reviewEvent, current, candidate FSRSConfig) *WeightComparison {
+	c := &WeightComparison{
+		Current:   evaluateWeights(&FSRSService{config: current}, histories),
+		Candidate: evaluateWeights(&FSRSService{config: candidate}, histories),
+	}
+	c.Worse = c.Candidate.LogLoss > c.Current.LogLoss || c.Candidate.RMSEBins > c.Current.RMSEBins
+	return c
+}
+
+// evaluateWeights replays histories like evaluateHistories, additionally
+// bucketing predictions by retrievability for RMSE(bins) and calibration
+func evaluateWeights(model *FSRSService, histories [][]reviewEvent) WeightEvaluation {
+	eval := WeightEvaluation{
+		Weights:     model.config.W,
+		Calibration: make([]CalibrationBin, calibrationBins),
+	}
+	for i := range eval.Calibration {
+		eval.Calibration[i].Low = float64(i) / calibrationBins
+		eval.Calibration[i].High = float64(i+1) / calibrationBins
+	}
+
+	var logLoss float64
+	for _, history := range histories {
+		first := history[0].Rating
+		stability := model.calculateInitialStability(first)
+		difficulty := model.calculateInitialDifficulty(first)
+
+		for _, event := range history[1:] {
+			// Same-day reviews update the state like the live scheduler but
+			// predict nothing
+			if event.ElapsedDays == 0 && model.supportsShortTerm() {
+				stability = math.Max(model.calculateShortTermStability(stability, event.Rating), 0.01)
+				difficulty = model.calculateNewDifficulty(difficulty, event.Rating)
+				continue
+			}
+
+			r := model.calculateRetrievability(event.ElapsedDays, stability)
+			r = math.Min(math.Max(r, 1e-6), 1-1e-6)
+
+			y := 0.0
+			if event.Rating > GradeAgain {
+				y = 1
+			}
+			logLoss += -(y*math.Log(r) + (1-y)*math.Log(1-r))
+			eval.Reviews++
+
+			bin := &eval.Calibration[min(int(r*calibrationBins), calibrationBins-1)]
+			bin.Reviews++
+			bin.Predicted += r
+			bin.Actual += y
+
+			stability = math.Max(model.calculateNewStability(difficulty, stability, r, event.Rating), 0.01)
+			difficulty = model.calculateNewDifficulty(difficulty, event.Rating)
+		}
+	}
+	if eval.Reviews == 0 {
+		return eval
+	}
+	eval.LogLoss = logLoss / float64(eval.Reviews)
+
+	// RMSE(bins): per-bucket calibration error weighted by review count
+	var squared float64
+	for i := range eval.Calibration {
+		bin := &eval.Calibration[i]
+		if bin.Reviews == 0 {
+			continue
+		}
+		bin.Predicted /= float64(bin.Reviews)
+		bin.Actual /= float64(bin.Reviews)
+		squared += float64(bin.Reviews) * (bin.Predicted - bin.Actual) * (bin.Predicted - bin.Actual)
+	}
+	eval.RMSEBins = math.Sqrt(squared / float64(eval.Reviews))
+	return eval
+}
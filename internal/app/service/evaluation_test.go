@@ -0,0 +1,70 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareWeights_RefusesWorseWeights(t *testing.T) {
+	truth := DefaultFSRSConfig().W
+	truth[8] = 1.9
+	truth[11] = 1.2
+	histories := simulateHistories(truth, 300, 6, 11)
+
+	current := DefaultFSRSConfig()
+	current.W = truth
+
+	// Weights that overestimate memory badly
+	candidate := current
+	candidate.W = append([]float64(nil), truth...)
+	candidate.W[0], candidate.W[1], candidate.W[2], candidate.W[3] = 20, 30, 40, 60
+
+	c := compareWeights(histories, current, candidate)
+	assert.True(t, c.Worse)
+	assert.Greater(t, c.Candidate.LogLoss, c.Current.LogLoss)
+
+	// The generating weights are not worse than themselves
+	c = compareWeights(histories, current, current)
+	assert.False(t, c.Worse)
+}
+
+func TestEvaluateWeights_CalibrationTable(t *testing.T) {
+	histories := simulateHistories(DefaultFSRSConfig().W, 200, 5, 3)
+	eval := evaluateWeights(&FSRSService{config: DefaultFSRSConfig()}, histories)
+
+	require.Len(t, eval.Calibration, calibrationBins)
+	total := 0
+	for _, bin := range eval.Calibration {
+		total += bin.Reviews
+		if bin.Reviews > 0 {
+			assert.GreaterOrEqual(t, bin.Predicted, bin.Low)
+			assert.LessOrEqual(t, bin.Predicted, bin.High)
+		}
+	}
+	assert.Equal(t, eval.Reviews, total)
+	assert.Equal(t, 200*5, eval.Reviews)
+
+	// The model that generated the data is well calibrated
+	assert.Less(t, eval.RMSEBins, 0.05)
+	assert.Greater(t, eval.LogLoss, 0.0)
+}
+
+func TestEvaluateWeights_ReplaysSameDayReviewsShortTerm(t *testing.T) {
+	model := &FSRSService{config: DefaultFSRSConfigFor(FSRSv5)}
+	history := []reviewEvent{
+		{Rating: GradeGood},
+		{ElapsedDays: 0, Rating: GradeAgain},
+		{ElapsedDays: 3, Rating: GradeGood},
+	}
+
+	eval := evaluateWeights(model, [][]reviewEvent{history})
+	assert.Equal(t, 1, eval.Reviews, "same-day reviews are not scored")
+
+	// Stability after the same-day Again follows the live short-term formula
+	stability := model.calculateShortTermStability(model.calculateInitialStability(GradeGood), GradeAgain)
+	r := model.calculateRetrievability(3, stability)
+	assert.InDelta(t, -math.Log(r), eval.LogLoss, 1e-9)
+}
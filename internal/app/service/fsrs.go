@@ -7,7 +7,9 @@ import (
 	"math"
 	"time"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
 )
 
 // FSRSGrade represents review grades
@@ -20,38 +22,150 @@ const (
 	GradeEasy  FSRSGrade = 4
 )
 
+// FSRSVersion selects the memory model formulas
+type FSRSVersion string
+
+const (
+	FSRSv4 FSRSVersion = "v4" // 17 weights, (1+t/9S)^-1 forgetting curve
+	FSRSv5 FSRSVersion = "v5" // 19 weights, mean reversion, same-day stability
+	FSRSv6 FSRSVersion = "v6" // 21 weights, trainable forgetting curve decay
+)
+
 // FSRSConfig for algorithm parameters
 type FSRSConfig struct {
-	DesiredRetention float64   `json:"desired_retention"`
-	MaxInterval      int       `json:"max_interval"`
-	W                []float64 `json:"w"` // FSRS weights
+	Version          FSRSVersion `json:"version"` // Empty is treated as v4
+	DesiredRetention float64     `json:"desired_retention"`
+	MaxInterval      int         `json:"max_interval"`
+	W                []float64   `json:"w"` // FSRS weights
+
+	EnableFuzz        bool `json:"enable_fuzz"`         // Spread intervals within the FSRS fuzz ranges
+	EnableLoadBalance bool `json:"enable_load_balance"` // Pick the least loaded day in the fuzz window
 }
 
 // DefaultFSRSConfig returns default FSRS v4 parameters
 func DefaultFSRSConfig() FSRSConfig {
+	return DefaultFSRSConfigFor(FSRSv4)
+}
+
+// DefaultFSRSConfigFor returns the published default parameters of a version
+func DefaultFSRSConfigFor(version FSRSVersion) FSRSConfig {
 	return FSRSConfig{
+		Version:          version,
 		DesiredRetention: 0.9,
 		MaxInterval:      36500, // ~100 years
-		W: []float64{
+		W:                DefaultWeights(version),
+		EnableFuzz:       true,
+	}
+}
+
+// DefaultWeights returns the reference default weights of a version
+func DefaultWeights(version FSRSVersion) []float64 {
+	switch version {
+	case FSRSv5:
+		return []float64{
+			0.40255, 1.18385, 3.173, 15.69105, 7.1949, 0.5345, 1.4604, 0.0046, 1.54575, 0.1192,
+			1.01925, 1.9395, 0.11, 0.29605, 2.2698, 0.2315, 2.9898, 0.51655, 0.6621,
+		}
+	case FSRSv6:
+		return []float64{
+			0.212, 1.2931, 2.3065, 8.2956, 6.4133, 0.8334, 3.0194, 0.001, 1.8722, 0.1666,
+			0.796, 1.4835, 0.0614, 0.2629, 1.6483, 0.6014, 1.8729, 0.5425, 0.0912, 0.0658,
+			0.1542,
+		}
+	default:
+		return []float64{
 			0.4, 0.6, 2.4, 5.8, 4.93, 0.94, 0.86, 0.01, 1.49, 0.14, 0.94, 2.18, 0.05, 0.34, 1.26, 0.29, 2.61,
-		},
+		}
+	}
+}
+
+// WeightCount returns how many weights a version expects
+func WeightCount(version FSRSVersion) int {
+	return len(DefaultWeights(version))
+}
+
+// ParseFSRSVersion validates a version string; empty means v4
+func ParseFSRSVersion(v string) (FSRSVersion, error) {
+	switch FSRSVersion(v) {
+	case "", FSRSv4:
+		return FSRSv4, nil
+	case FSRSv5, FSRSv6:
+		return FSRSVersion(v), nil
+	default:
+		return "", fmt.Errorf("unknown FSRS version: %s", v)
+	}
+}
+
+// version returns the configured version, treating empty as v4
+func (c FSRSConfig) version() FSRSVersion {
+	if c.Version == "" {
+		return FSRSv4
+	}
+	return c.Version
+}
+
+// Validate checks the weight vector matches the algorithm version
+func (c FSRSConfig) Validate() error {
+	version, err := ParseFSRSVersion(string(c.Version))
+	if err != nil {
+		return err
+	}
+	if len(c.W) != WeightCount(version) {
+		return fmt.Errorf("FSRS %s expects %d weights, got %d", version, WeightCount(version), len(c.W))
 	}
+	return nil
 }
 
 // FSRSService handles spaced repetition calculations
 type FSRSService struct {
 	client *ent.Client
 	config FSRSConfig
+	day    data.DayBoundary
+	clock  data.Clock
 }
 
 // NewFSRSService creates a new FSRS service
-func NewFSRSService(client *ent.Client, config FSRSConfig) *FSRSService {
+func NewFSRSService(client *ent.Client, config FSRSConfig, clock data.Clock) *FSRSService {
 	return &FSRSService{
 		client: client,
 		config: config,
+		day:    data.DefaultDayBoundary(),
+		clock:  clock,
 	}
 }
 
+// WithDayBoundary returns a copy of the service that counts days by the given boundary
+func (s *FSRSService) WithDayBoundary(day data.DayBoundary) *FSRSService {
+	copied := *s
+	copied.day = day
+	return &copied
+}
+
+// Kind identifies FSRS as a Scheduler
+func (s *FSRSService) Kind() SchedulerKind {
+	return SchedulerFSRS
+}
+
+// elapsedDays counts study days since the card was last reviewed.
+// Cards without a review time fall back to the interval they were scheduled with.
+func (s *FSRSService) elapsedDays(card *ent.FsrsCard, now time.Time) int {
+	var elapsed int
+	if card.LastReview != nil {
+		elapsed = s.day.DaysBetween(*card.LastReview, now)
+	} else {
+		elapsed = card.ScheduledDays + s.day.DaysBetween(card.Due, now)
+	}
+	if elapsed < 0 {
+		return 0
+	}
+	return elapsed
+}
+
+// Config returns the parameters this service schedules with
+func (s *FSRSService) Config() FSRSConfig {
+	return s.config
+}
+
 // FSRSResult contains the scheduling outcome
 type FSRSResult struct {
 	Stability       float64   `json:"stability"`
@@ -67,20 +181,24 @@ func (s *FSRSService) ReviewCard(
 	card *ent.FsrsCard,
 	grade FSRSGrade,
 ) (*FSRSResult, error) {
+	return s.ReviewCardAt(ctx, card, grade, s.clock.Now())
+}
+
+// ReviewCardAt processes a review as if it happened at the given time (used by replay)
+func (s *FSRSService) ReviewCardAt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	grade FSRSGrade,
+	now time.Time,
+) (*FSRSResult, error) {
 
 	// Ensure card is in review state
-	if CardState(card.CardState) != StateReview {
+	if CardState(card.State) != StateReview {
 		return nil, fmt.Errorf("card must be in review state to use FSRS")
 	}
 
-	// ✅ FIX: Calculate days since scheduled review (not since creation)
-	// If NextReview is in the past, card is overdue
-	var daysSinceLastReview int
-	if time.Now().After(card.NextReview) {
-		daysSinceLastReview = int(time.Since(card.NextReview).Hours() / 24)
-	} else {
-		daysSinceLastReview = 0 // Reviewed early
-	}
+	// Calendar days since the last review, by the configured day boundary
+	daysSinceLastReview := s.elapsedDays(card, now)
 
 	// Calculate retrievability
 	retrievability := s.calculateRetrievability(
@@ -89,26 +207,30 @@ func (s *FSRSService) ReviewCard(
 	)
 
 	// Calculate new stability
-	newStability := s.calculateNewStability(
-		card.Difficulty,
-		card.Stability,
-		retrievability,
-		grade,
-	)
+	// FSRS-5+ uses the short-term formula for a second review on the same day
+	var newStability float64
+	if s.supportsShortTerm() && card.LastReview != nil && daysSinceLastReview == 0 {
+		newStability = s.calculateShortTermStability(card.Stability, grade)
+	} else {
+		newStability = s.calculateNewStability(
+			card.Difficulty,
+			card.Stability,
+			retrievability,
+			grade,
+		)
+	}
 
 	// Calculate new difficulty
 	newDifficulty := s.calculateNewDifficulty(card.Difficulty, grade)
 
-	// Calculate next interval
+	// Calculate next interval (fuzzed and capped at max interval)
 	interval := s.calculateInterval(newStability, s.config.DesiredRetention)
-	intervalDays := int(math.Round(interval))
-
-	// Cap at max interval
-	if intervalDays > s.config.MaxInterval {
-		intervalDays = s.config.MaxInterval
+	intervalDays, err := s.scheduleInterval(ctx, card, interval, daysSinceLastReview)
+	if err != nil {
+		return nil, err
 	}
 
-	nextReview := time.Now().Add(time.Duration(intervalDays) * 24 * time.Hour)
+	nextReview := s.day.AddDays(now, intervalDays)
 
 	// Update card
 	// Update card - FIX: Save returns (card, error)
@@ -117,17 +239,18 @@ func (s *FSRSService) ReviewCard(
 		SetDifficulty(newDifficulty).
 		SetScheduledDays(intervalDays).
 		SetElapsedDays(daysSinceLastReview).
-		SetNextReview(nextReview).
+		SetDue(nextReview).
+		SetLastReview(now).
 		SetReps(card.Reps + 1)
 
 	if grade == GradeAgain {
 		updateBuilder = updateBuilder.
 			SetLapses(card.Lapses + 1).
-			SetCardState(string(StateRelearning)).
+			SetState(fsrscard.StateRelearning).
 			SetCurrentStep(0)
 	}
 
-	_, err := updateBuilder.Save(ctx) // FIX: Capture both return values
+	_, err = updateBuilder.Save(ctx) // FIX: Capture both return values
 	if err != nil {
 		return nil, err
 	}
@@ -149,25 +272,37 @@ func (s *FSRSService) GraduateCard(
 	grade FSRSGrade,
 	graduatingInterval int,
 ) (*FSRSResult, error) {
+	return s.GraduateCardAt(ctx, card, grade, graduatingInterval, s.clock.Now())
+}
+
+// GraduateCardAt graduates a card as if it happened at the given time (used by replay)
+func (s *FSRSService) GraduateCardAt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	grade FSRSGrade,
+	graduatingInterval int,
+	now time.Time,
+) (*FSRSResult, error) {
 
 	stability := s.calculateInitialStability(grade)
 	difficulty := s.calculateInitialDifficulty(grade)
 
-	intervalDays := graduatingInterval
-	if grade == GradeEasy {
-		intervalDays = graduatingInterval * 4
+	intervalDays, err := s.scheduleInterval(ctx, card, float64(graduatingInterval), 0)
+	if err != nil {
+		return nil, err
 	}
 
-	nextReview := time.Now().Add(time.Duration(intervalDays) * 24 * time.Hour)
+	nextReview := s.day.AddDays(now, intervalDays)
 
 	// FIX: Save returns (card, error)
-	_, err := card.Update().
-		SetCardState(string(StateReview)).
+	_, err = card.Update().
+		SetState(fsrscard.StateReview).
 		SetStability(stability).
 		SetDifficulty(difficulty).
 		SetScheduledDays(intervalDays).
 		SetElapsedDays(0).
-		SetNextReview(nextReview).
+		SetDue(nextReview).
+		SetLastReview(now).
 		SetReps(1).
 		SetCurrentStep(-1).
 		Save(ctx)
@@ -188,6 +323,7 @@ func (s *FSRSService) GraduateCard(
 // GetNextIntervals predicts intervals for all grades
 func (s *FSRSService) GetNextIntervals(card *ent.FsrsCard) map[int]string {
 	intervals := make(map[int]string)
+	daysSinceLastReview := s.elapsedDays(card, s.clock.Now())
 
 	for grade := 1; grade <= 4; grade++ {
 		if grade == 1 {
@@ -196,24 +332,23 @@ func (s *FSRSService) GetNextIntervals(card *ent.FsrsCard) map[int]string {
 			continue
 		}
 
-		// Predict stability for this grade
-		daysSinceLastReview := int(time.Since(card.NextReview).Hours() / 24)
-		if daysSinceLastReview < 0 {
-			daysSinceLastReview = 0
+		// Predict stability for this grade, as ReviewCardAt would
+		var newStability float64
+		if s.supportsShortTerm() && card.LastReview != nil && daysSinceLastReview == 0 {
+			newStability = s.calculateShortTermStability(card.Stability, FSRSGrade(grade))
+		} else {
+			retrievability := s.calculateRetrievability(
+				float64(daysSinceLastReview),
+				card.Stability,
+			)
+			newStability = s.calculateNewStability(
+				card.Difficulty,
+				card.Stability,
+				retrievability,
+				FSRSGrade(grade),
+			)
 		}
 
-		retrievability := s.calculateRetrievability(
-			float64(daysSinceLastReview),
-			card.Stability,
-		)
-
-		newStability := s.calculateNewStability(
-			card.Difficulty,
-			card.Stability,
-			retrievability,
-			FSRSGrade(grade),
-		)
-
 		interval := s.calculateInterval(newStability, s.config.DesiredRetention)
 		intervalDays := int(math.Round(interval))
 
@@ -221,6 +356,13 @@ func (s *FSRSService) GetNextIntervals(card *ent.FsrsCard) map[int]string {
 			intervalDays = s.config.MaxInterval
 		}
 
+		// Show the window the review may land in when fuzzing is on
+		if s.config.EnableFuzz || s.config.EnableLoadBalance {
+			minIvl, maxIvl := fuzzBounds(interval, daysSinceLastReview, s.config.MaxInterval)
+			intervals[grade] = s.formatIntervalRange(minIvl, maxIvl)
+			continue
+		}
+
 		intervals[grade] = s.formatInterval(intervalDays)
 	}
 
@@ -228,17 +370,49 @@ func (s *FSRSService) GetNextIntervals(card *ent.FsrsCard) map[int]string {
 }
 
 // FSRS Algorithm Core Functions
+// Formulas follow the reference implementation of each version.
+
+// FSRS-5 forgetting curve constants: R(t=S) = 0.9
+const (
+	fsrs5Decay  = -0.5
+	fsrs5Factor = 19.0 / 81.0
+)
 
 func (s *FSRSService) calculateInitialStability(grade FSRSGrade) float64 {
 	return s.config.W[int(grade)-1]
 }
 
 func (s *FSRSService) calculateInitialDifficulty(grade FSRSGrade) float64 {
-	return s.config.W[4] - (float64(grade)-3)*s.config.W[5]
+	if s.config.version() == FSRSv4 {
+		return s.config.W[4] - (float64(grade)-3)*s.config.W[5]
+	}
+	return clampDifficulty(s.rawInitialDifficulty(grade))
+}
+
+// rawInitialDifficulty is the unclamped FSRS-5+ initial difficulty
+func (s *FSRSService) rawInitialDifficulty(grade FSRSGrade) float64 {
+	return s.config.W[4] - math.Exp(s.config.W[5]*(float64(grade)-1)) + 1
+}
+
+// decayAndFactor returns the forgetting curve shape R = (1 + factor*t/S)^decay
+func (s *FSRSService) decayAndFactor() (float64, float64) {
+	switch s.config.version() {
+	case FSRSv5:
+		return fsrs5Decay, fsrs5Factor
+	case FSRSv6:
+		decay := -s.config.W[20]
+		return decay, math.Pow(0.9, 1/decay) - 1
+	default:
+		return -1, 1.0 / 9.0
+	}
 }
 
 func (s *FSRSService) calculateRetrievability(elapsedDays, stability float64) float64 {
-	return math.Pow(1+elapsedDays/(9*stability), -1)
+	if s.config.version() == FSRSv4 {
+		return math.Pow(1+elapsedDays/(9*stability), -1)
+	}
+	decay, factor := s.decayAndFactor()
+	return math.Pow(1+factor*elapsedDays/stability, decay)
 }
 
 func (s *FSRSService) calculateNewStability(
@@ -249,9 +423,15 @@ func (s *FSRSService) calculateNewStability(
 	easyBonus := s.config.W[16]
 
 	if grade == GradeAgain {
-		return s.config.W[11] * math.Pow(difficulty, -s.config.W[12]) *
+		forget := s.config.W[11] * math.Pow(difficulty, -s.config.W[12]) *
 			(math.Pow(stability+1, s.config.W[13]) - 1) *
 			math.Exp(s.config.W[14]*(1-retrievability))
+
+		// FSRS-5+: post-lapse stability may not exceed the same-day Again stability
+		if s.config.version() != FSRSv4 {
+			forget = math.Min(forget, stability/math.Exp(s.config.W[17]*s.config.W[18]))
+		}
+		return forget
 	}
 
 	multiplier := 1.0
@@ -270,33 +450,65 @@ func (s *FSRSService) calculateNewStability(
 			multiplier)
 }
 
+// supportsShortTerm reports whether same-day reviews update stability (FSRS-5+)
+func (s *FSRSService) supportsShortTerm() bool {
+	return s.config.version() != FSRSv4
+}
+
+// calculateShortTermStability updates stability for a review on the same day
+func (s *FSRSService) calculateShortTermStability(stability float64, grade FSRSGrade) float64 {
+	increase := math.Exp(s.config.W[17] * (float64(grade) - 3 + s.config.W[18]))
+
+	if s.config.version() == FSRSv6 {
+		increase *= math.Pow(stability, -s.config.W[19])
+		if grade >= GradeGood {
+			increase = math.Max(increase, 1)
+		}
+	}
+	return stability * increase
+}
+
 func (s *FSRSService) calculateNewDifficulty(difficulty float64, grade FSRSGrade) float64 {
 	deltaDifficulty := -s.config.W[6] * (float64(grade) - 3)
-	newDifficulty := difficulty + deltaDifficulty
 
-	// Clamp between 1 and 10
-	if newDifficulty < 1 {
+	if s.config.version() == FSRSv4 {
+		// Mean reversion towards D0(Good) = w4
+		return clampDifficulty(s.config.W[7]*s.config.W[4] + (1-s.config.W[7])*(difficulty+deltaDifficulty))
+	}
+
+	// FSRS-5+: linear damping towards 10, then mean reversion to D0(Easy)
+	damped := difficulty + deltaDifficulty*(10-difficulty)/9
+	reverted := s.config.W[7]*s.rawInitialDifficulty(GradeEasy) + (1-s.config.W[7])*damped
+	return clampDifficulty(reverted)
+}
+
+// clampDifficulty keeps difficulty between 1 and 10
+func clampDifficulty(d float64) float64 {
+	if d < 1 {
 		return 1
 	}
-	if newDifficulty > 10 {
+	if d > 10 {
 		return 10
 	}
-	return newDifficulty
+	return d
 }
 
 func (s *FSRSService) calculateInterval(stability, desiredRetention float64) float64 {
-	// ✅ Correct FSRS formula: I = S × 9 × (1/R - 1)
-	return stability * 9.0 * (1.0/desiredRetention - 1.0)
+	if s.config.version() == FSRSv4 {
+		// ✅ Correct FSRS formula: I = S × 9 × (1/R - 1)
+		return stability * 9.0 * (1.0/desiredRetention - 1.0)
+	}
+	// Inverse of the forgetting curve: I = S / factor × (R^(1/decay) - 1)
+	decay, factor := s.decayAndFactor()
+	return stability / factor * (math.Pow(desiredRetention, 1/decay) - 1)
+}
+
+// intervalStability is the stability an interval of days was scheduled for at
+// the desired retention, the inverse of calculateInterval
+func (s *FSRSService) intervalStability(days int) float64 {
+	return float64(days) / s.calculateInterval(1, s.config.DesiredRetention)
 }
 
 func (s *FSRSService) formatInterval(days int) string {
-	if days < 30 {
-		return fmt.Sprintf("%dd", days)
-	}
-	if days < 365 {
-		months := days / 30
-		return fmt.Sprintf("%dmo", months)
-	}
-	years := float64(days) / 365.0
-	return fmt.Sprintf("%.1fy", years)
+	return formatDays(days)
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
 
 	_ "github.com/lib/pq"
@@ -18,7 +20,7 @@ func TestFSRSService_GraduateCard(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultFSRSConfig()
-	svc := service.NewFSRSService(client, config)
+	svc := service.NewFSRSService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -29,9 +31,9 @@ func TestFSRSService_GraduateCard(t *testing.T) {
 	// New card ready to graduate
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("learning").
+		SetState(fsrscard.StateLearning).
 		SetCurrentStep(1).
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -46,7 +48,7 @@ func TestFSRSService_GraduateCard(t *testing.T) {
 
 	// Verify card updated
 	card, _ = client.FsrsCard.Get(ctx, card.ID)
-	assert.Equal(t, "review", card.CardState)
+	assert.Equal(t, fsrscard.StateReview, card.State)
 	assert.Equal(t, 1, card.Reps)
 }
 
@@ -55,7 +57,7 @@ func TestFSRSService_ReviewCard_Good(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultFSRSConfig()
-	svc := service.NewFSRSService(client, config)
+	svc := service.NewFSRSService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -67,8 +69,8 @@ func TestFSRSService_ReviewCard_Good(t *testing.T) {
 	initialStability := 2.5
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now().Add(-3 * 24 * time.Hour)). // ✅ Due 3 DAYS AGO (more overdue)
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now().Add(-3 * 24 * time.Hour)). // ✅ Due 3 DAYS AGO (more overdue)
 		SetStability(initialStability).
 		SetDifficulty(5.0).
 		SetReps(1).
@@ -87,7 +89,7 @@ func TestFSRSService_ReviewCard_Again_EntersRelearning(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultFSRSConfig()
-	svc := service.NewFSRSService(client, config)
+	svc := service.NewFSRSService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -97,8 +99,8 @@ func TestFSRSService_ReviewCard_Again_EntersRelearning(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now().Add(-2 * 24 * time.Hour)).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now().Add(-2 * 24 * time.Hour)).
 		SetStability(3.0).
 		SetDifficulty(5.0).
 		SetReps(2).
@@ -112,7 +114,7 @@ func TestFSRSService_ReviewCard_Again_EntersRelearning(t *testing.T) {
 	assert.True(t, result.Stability > 0) // New stability calculated
 
 	card, _ = client.FsrsCard.Get(ctx, card.ID)
-	assert.Equal(t, "relearning", card.CardState)
+	assert.Equal(t, fsrscard.StateRelearning, card.State)
 	assert.Equal(t, 1, card.Lapses)
 	assert.Equal(t, 0, card.CurrentStep)
 }
@@ -122,7 +124,7 @@ func TestFSRSService_DifficultyCalculation(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultFSRSConfig()
-	svc := service.NewFSRSService(client, config)
+	svc := service.NewFSRSService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -132,8 +134,8 @@ func TestFSRSService_DifficultyCalculation(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now().Add(-1 * 24 * time.Hour)).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now().Add(-1 * 24 * time.Hour)).
 		SetStability(2.0).
 		SetDifficulty(5.0).
 		SetReps(1).
@@ -153,7 +155,7 @@ func TestFSRSService_GetNextIntervals(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultFSRSConfig()
-	svc := service.NewFSRSService(client, config)
+	svc := service.NewFSRSService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -163,8 +165,8 @@ func TestFSRSService_GetNextIntervals(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now().Add(-1 * 24 * time.Hour)).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now().Add(-1 * 24 * time.Hour)).
 		SetStability(3.0).
 		SetDifficulty(5.0).
 		SetReps(2).
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Golden values are computed from the reference FSRS-5 / FSRS-6 formulas
+// (fsrs-rs, py-fsrs) with each version's published default parameters.
+
+func modelFor(version FSRSVersion) *FSRSService {
+	return NewFSRSService(nil, DefaultFSRSConfigFor(version), data.SystemClock())
+}
+
+// referenceIntervals replays ratings the way the py-fsrs example test does:
+// each review lands on its due date, a new card steps through learning before
+// its first interval, and a lapse relearns before returning to review. Returns
+// the scheduled days after each rating (0 while learning).
+func referenceIntervals(m *FSRSService, ratings []FSRSGrade) []int {
+	state := StateNew
+	var stability, difficulty float64
+	var intervals []int
+	elapsed := 0
+
+	for _, grade := range ratings {
+		interval := 0
+		switch state {
+		case StateNew:
+			stability = m.calculateInitialStability(grade)
+			difficulty = m.calculateInitialDifficulty(grade)
+			state = StateLearning
+		case StateLearning, StateRelearning:
+			if m.supportsShortTerm() {
+				stability = m.calculateShortTermStability(stability, grade)
+				difficulty = m.calculateNewDifficulty(difficulty, grade)
+			}
+			if grade >= GradeGood {
+				state = StateReview
+				interval = m.simulatedInterval(stability)
+			}
+		case StateReview:
+			r := m.calculateRetrievability(float64(elapsed), stability)
+			stability = m.calculateNewStability(difficulty, stability, r, grade)
+			difficulty = m.calculateNewDifficulty(difficulty, grade)
+			if grade == GradeAgain {
+				state = StateRelearning
+			} else {
+				interval = m.simulatedInterval(stability)
+			}
+		}
+		intervals = append(intervals, interval)
+		elapsed = interval
+	}
+	return intervals
+}
+
+// exampleRatings is the review sequence of the py-fsrs example test
+var exampleRatings = []FSRSGrade{
+	GradeGood, GradeGood, GradeGood, GradeGood, GradeGood, GradeGood,
+	GradeAgain, GradeAgain,
+	GradeGood, GradeGood, GradeGood, GradeGood, GradeGood,
+}
+
+func TestFSRSVersions_MatchReferenceSequences(t *testing.T) {
+	// py-fsrs 2.x (FSRS v4) example test, with the weights it sets
+	v4 := DefaultFSRSConfigFor(FSRSv4)
+	v4.W = []float64{
+		1.14, 1.01, 5.44, 14.67, 5.3024, 1.5662, 1.2503, 0.0028, 1.5489, 0.1763, 0.9953, 2.7473, 0.0179, 0.3105, 0.3976, 0.0, 2.0902,
+	}
+	assert.Equal(t,
+		[]int{0, 5, 16, 43, 106, 236, 0, 0, 12, 25, 47, 85, 147},
+		referenceIntervals(&FSRSService{config: v4}, exampleRatings))
+
+	// py-fsrs 5.x (FSRS-6) example test, default weights
+	assert.Equal(t,
+		[]int{0, 2, 11, 46, 163, 498, 0, 0, 2, 4, 7, 12, 21},
+		referenceIntervals(modelFor(FSRSv6), exampleRatings))
+
+	// go-fsrs / fsrs-rs next_interval for S = 1 at retention 0.1..1.0, on the
+	// decay -0.5 curve FSRS-5 shares with FSRS-4.5
+	m := modelFor(FSRSv5)
+	var intervals []int
+	for i := 1; i <= 10; i++ {
+		m.config.DesiredRetention = float64(i) / 10
+		intervals = append(intervals, m.simulatedInterval(1))
+	}
+	assert.Equal(t, []int{422, 102, 43, 22, 13, 8, 4, 2, 1, 1}, intervals)
+}
+
+func TestFSRSVersions_DefaultWeightCounts(t *testing.T) {
+	assert.Equal(t, 17, WeightCount(FSRSv4))
+	assert.Equal(t, 19, WeightCount(FSRSv5))
+	assert.Equal(t, 21, WeightCount(FSRSv6))
+
+	for _, v := range []FSRSVersion{FSRSv4, FSRSv5, FSRSv6} {
+		require.NoError(t, DefaultFSRSConfigFor(v).Validate())
+	}
+
+	mismatched := DefaultFSRSConfigFor(FSRSv6)
+	mismatched.W = DefaultWeights(FSRSv5)
+	assert.Error(t, mismatched.Validate())
+
+	// Empty version keeps existing v4 configs valid
+	legacy := DefaultFSRSConfig()
+	legacy.Version = ""
+	assert.NoError(t, legacy.Validate())
+}
+
+func TestFSRSVersions_RetrievabilityAtStabilityIsNinety(t *testing.T) {
+	for _, v := range []FSRSVersion{FSRSv4, FSRSv5, FSRSv6} {
+		m := modelFor(v)
+		assert.InDelta(t, 0.9, m.calculateRetrievability(5, 5), 1e-9, "version %s", v)
+		assert.InDelta(t, 5.0, m.calculateInterval(5, 0.9), 1e-9, "version %s", v)
+	}
+}
+
+func TestFSRS5_NextIntervalsUseShortTermSameDay(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	config := DefaultFSRSConfigFor(FSRSv5)
+	config.EnableFuzz = false
+	m := NewFSRSService(nil, config, data.FixedClock(now))
+	reviewed := now.Add(-2 * time.Hour)
+	card := &ent.FsrsCard{State: fsrscard.StateReview, Stability: 5, Difficulty: 5, LastReview: &reviewed}
+
+	// Same day: S * e^(w17(G-3+w18)) = 7.04 for Good, not the unchanged 5 of R = 1
+	intervals := m.GetNextIntervals(card)
+	assert.Equal(t, m.formatInterval(7), intervals[int(GradeGood)])
+	assert.Equal(t, m.formatInterval(12), intervals[int(GradeEasy)])
+}
+
+func TestFSRS5_GoldenValues(t *testing.T) {
+	m := modelFor(FSRSv5)
+	const delta = 1e-5
+
+	// Initial difficulty D0(G) = w4 - e^(w5(G-1)) + 1
+	expectedD0 := []float64{7.1949, 6.488305, 5.282434, 3.224502}
+	for g := GradeAgain; g <= GradeEasy; g++ {
+		assert.InDelta(t, expectedD0[g-1], m.calculateInitialDifficulty(g), delta, "D0 grade %d", g)
+	}
+
+	// Power forgetting curve with decay -0.5, factor 19/81
+	assert.InDelta(t, 0.825029, m.calculateRetrievability(10, 5), delta)
+	assert.InDelta(t, 11.990132, m.calculateInterval(5, 0.8), delta)
+
+	// Linear damping + mean reversion towards D0(Easy)
+	expectedD := []float64{6.607035, 5.799434, 4.991833, 4.184232}
+	for g := GradeAgain; g <= GradeEasy; g++ {
+		assert.InDelta(t, expectedD[g-1], m.calculateNewDifficulty(5, g), delta, "next D grade %d", g)
+	}
+
+	r := m.calculateRetrievability(10, 5)
+	assert.InDelta(t, 10.25067, m.calculateNewStability(5, 5, r, GradeHard), delta)
+	assert.InDelta(t, 27.681079, m.calculateNewStability(5, 5, r, GradeGood), delta)
+	assert.InDelta(t, 72.811891, m.calculateNewStability(5, 5, r, GradeEasy), delta)
+	assert.InDelta(t, 1.691194, m.calculateNewStability(5, 5, r, GradeAgain), delta)
+
+	// Post-lapse stability is capped at S / e^(w17*w18)
+	lowR := m.calculateRetrievability(5, 0.5)
+	assert.InDelta(t, 0.355171, m.calculateNewStability(5, 0.5, lowR, GradeAgain), delta)
+
+	// Same-day stability S * e^(w17(G-3+w18))
+	expectedShort := []float64{2.505143, 4.199207, 7.038856, 11.798774}
+	for g := GradeAgain; g <= GradeEasy; g++ {
+		assert.InDelta(t, expectedShort[g-1], m.calculateShortTermStability(5, g), delta, "short-term grade %d", g)
+	}
+}
+
+func TestFSRS6_GoldenValues(t *testing.T) {
+	m := modelFor(FSRSv6)
+	const delta = 1e-5
+
+	// D0(Easy) falls below 1 with the v6 defaults and is clamped
+	expectedD0 := []float64{6.4133, 5.112171, 2.118104, 1}
+	for g := GradeAgain; g <= GradeEasy; g++ {
+		assert.InDelta(t, expectedD0[g-1], m.calculateInitialDifficulty(g), delta, "D0 grade %d", g)
+	}
+
+	// Trainable decay w20
+	assert.InDelta(t, 0.845885, m.calculateRetrievability(10, 5), delta)
+	assert.InDelta(t, 16.579799, m.calculateInterval(5, 0.8), delta)
+
+	expectedD := []float64{8.341762, 6.665995, 4.990228, 3.314461}
+	for g := GradeAgain; g <= GradeEasy; g++ {
+		assert.InDelta(t, expectedD[g-1], m.calculateNewDifficulty(5, g), delta, "next D grade %d", g)
+	}
+
+	r := m.calculateRetrievability(10, 5)
+	assert.InDelta(t, 16.710957, m.calculateNewStability(5, 5, r, GradeHard), delta)
+	assert.InDelta(t, 24.472825, m.calculateNewStability(5, 5, r, GradeGood), delta)
+	assert.InDelta(t, 41.470655, m.calculateNewStability(5, 5, r, GradeEasy), delta)
+	assert.InDelta(t, 1.042462, m.calculateNewStability(5, 5, r, GradeAgain), delta)
+
+	// Same-day stability saturates with S^-w19 and never shrinks on Good/Easy
+	expectedShort := []float64{1.596818, 2.74701, 5, 8.12961}
+	for g := GradeAgain; g <= GradeEasy; g++ {
+		assert.InDelta(t, expectedShort[g-1], m.calculateShortTermStability(5, g), delta, "short-term grade %d", g)
+	}
+}
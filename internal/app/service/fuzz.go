@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+)
+
+// fuzzRange is one band of the standard FSRS fuzz table
+type fuzzRange struct {
+	start, end, factor float64
+}
+
+// fuzzRanges match the reference FSRS implementations (py-fsrs, ts-fsrs)
+var fuzzRanges = []fuzzRange{
+	{start: 2.5, end: 7.0, factor: 0.15},
+	{start: 7.0, end: 20.0, factor: 0.1},
+	{start: 20.0, end: math.Inf(1), factor: 0.05},
+}
+
+// fuzzBounds returns the inclusive [min, max] day window an interval may be fuzzed into.
+// Intervals below 2.5 days are never fuzzed.
+func fuzzBounds(interval float64, elapsedDays, maxInterval int) (int, int) {
+	interval = math.Min(interval, float64(maxInterval))
+	if interval < 2.5 {
+		days := int(math.Round(interval))
+		return days, days
+	}
+
+	delta := 1.0
+	for _, r := range fuzzRanges {
+		delta += r.factor * math.Max(math.Min(interval, r.end)-r.start, 0)
+	}
+
+	minIvl := int(math.Max(2, math.Round(interval-delta)))
+	maxIvl := int(math.Min(math.Round(interval+delta), float64(maxInterval)))
+
+	// Never schedule a review earlier than the gap that was just survived
+	if interval > float64(elapsedDays) && minIvl < elapsedDays+1 {
+		minIvl = elapsedDays + 1
+	}
+	if minIvl > maxIvl {
+		minIvl = maxIvl
+	}
+	return minIvl, maxIvl
+}
+
+// fuzzFactor derives a deterministic value in [0, 1) from the card and its review count,
+// so the same review always lands on the same day but siblings spread out.
+func fuzzFactor(card *ent.FsrsCard) float64 {
+	h := fnv.New64a()
+	h.Write(card.ID[:])
+	var reps [8]byte
+	binary.LittleEndian.PutUint64(reps[:], uint64(card.Reps))
+	h.Write(reps[:])
+	return float64(h.Sum64()>>11) / float64(1<<53)
+}
+
+// scheduleInterval turns a raw interval into whole days, applying fuzz and,
+// when enabled, moving the review to the least loaded day in the fuzz window.
+func (s *FSRSService) scheduleInterval(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	interval float64,
+	elapsedDays int,
+) (int, error) {
+	intervalDays := int(math.Round(interval))
+	if intervalDays > s.config.MaxInterval {
+		intervalDays = s.config.MaxInterval
+	}
+
+	if !s.config.EnableFuzz && !s.config.EnableLoadBalance {
+		return intervalDays, nil
+	}
+
+	minIvl, maxIvl := fuzzBounds(interval, elapsedDays, s.config.MaxInterval)
+	if minIvl == maxIvl {
+		return minIvl, nil
+	}
+
+	fuzzed := intervalDays
+	if s.config.EnableFuzz {
+		fuzzed = minIvl + int(fuzzFactor(card)*float64(maxIvl-minIvl+1))
+	}
+
+	if !s.config.EnableLoadBalance || s.client == nil {
+		return fuzzed, nil
+	}
+
+	return s.leastLoadedDay(ctx, card, minIvl, maxIvl, fuzzed)
+}
+
+// leastLoadedDay picks the day in [minIvl, maxIvl] with the fewest cards already due.
+// Ties go to the day closest to the preferred interval.
+func (s *FSRSService) leastLoadedDay(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	minIvl, maxIvl, preferred int,
+) (int, error) {
+	now := s.clock.Now()
+	windowStart := s.day.AddDays(now, minIvl)
+	windowEnd := s.day.AddDays(now, maxIvl+1)
+
+	scheduled, err := s.client.FsrsCard.Query().
+		Where(
+			fsrscard.IDNEQ(card.ID),
+			fsrscard.DueGTE(windowStart),
+			fsrscard.DueLT(windowEnd),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("counting due load: %w", err)
+	}
+
+	load := make(map[int]int)
+	for _, c := range scheduled {
+		load[s.day.DaysBetween(now, c.Due)]++
+	}
+
+	best := preferred
+	for day := minIvl; day <= maxIvl; day++ {
+		if load[day] < load[best] ||
+			(load[day] == load[best] && absInt(day-preferred) < absInt(best-preferred)) {
+			best = day
+		}
+	}
+	return best, nil
+}
+
+// formatIntervalRange renders a fuzz window for button previews
+func (s *FSRSService) formatIntervalRange(minIvl, maxIvl int) string {
+	if minIvl == maxIvl {
+		return s.formatInterval(minIvl)
+	}
+	if maxIvl < 30 {
+		return fmt.Sprintf("%d-%dd", minIvl, maxIvl)
+	}
+	return s.formatInterval(minIvl) + "-" + s.formatInterval(maxIvl)
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzBounds_StandardRanges(t *testing.T) {
+	// Below 2.5 days nothing is fuzzed
+	minIvl, maxIvl := fuzzBounds(2, 0, 36500)
+	assert.Equal(t, 2, minIvl)
+	assert.Equal(t, 2, maxIvl)
+
+	// 10d: delta = 1 + 0.15*4.5 + 0.1*3 = 1.975
+	minIvl, maxIvl = fuzzBounds(10, 0, 36500)
+	assert.Equal(t, 8, minIvl)
+	assert.Equal(t, 12, maxIvl)
+
+	// 100d: delta = 1 + 0.675 + 1.3 + 0.05*80 = 6.975
+	minIvl, maxIvl = fuzzBounds(100, 0, 36500)
+	assert.Equal(t, 93, minIvl)
+	assert.Equal(t, 107, maxIvl)
+
+	// Capped by max interval
+	minIvl, maxIvl = fuzzBounds(100, 0, 100)
+	assert.Equal(t, 93, minIvl)
+	assert.Equal(t, 100, maxIvl)
+
+	// Never earlier than the elapsed gap
+	minIvl, _ = fuzzBounds(10, 9, 36500)
+	assert.Equal(t, 10, minIvl)
+}
+
+func TestScheduleInterval_DeterministicPerCard(t *testing.T) {
+	svc := NewFSRSService(nil, DefaultFSRSConfig(), data.SystemClock())
+
+	card := &ent.FsrsCard{ID: uuid.New(), Reps: 3}
+	first, err := svc.scheduleInterval(context.Background(), card, 30, 0)
+	require.NoError(t, err)
+	second, err := svc.scheduleInterval(context.Background(), card, 30, 0)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	minIvl, maxIvl := fuzzBounds(30, 0, 36500)
+	spread := make(map[int]bool)
+	for i := 0; i < 50; i++ {
+		days, err := svc.scheduleInterval(context.Background(), &ent.FsrsCard{ID: uuid.New()}, 30, 0)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, days, minIvl)
+		assert.LessOrEqual(t, days, maxIvl)
+		spread[days] = true
+	}
+	assert.Greater(t, len(spread), 1, "cards reviewed together should not all land on one day")
+
+	// Fuzz disabled: exact rounding
+	config := DefaultFSRSConfig()
+	config.EnableFuzz = false
+	exact, err := NewFSRSService(nil, config, data.SystemClock()).scheduleInterval(context.Background(), card, 30.4, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 30, exact)
+}
+
+func TestScheduleInterval_LoadBalancePicksQuietDay(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	config := DefaultFSRSConfig()
+	config.EnableFuzz = false
+	config.EnableLoadBalance = true
+	svc := NewFSRSService(client, config, data.SystemClock())
+
+	// 10d window is [8, 12]; load every day except day 11
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 12, 0, 0, 0, now.Location())
+	for day := 8; day <= 12; day++ {
+		if day == 11 {
+			continue
+		}
+		n := client.Node.Create().SetType(node.TypeProblem).SetTitle("Load").SaveX(ctx)
+		client.FsrsCard.Update().
+			Where(fsrscard.NodeID(n.ID)).
+			SetDue(today.AddDate(0, 0, day)).
+			ExecX(ctx)
+	}
+
+	target := client.Node.Create().SetType(node.TypeProblem).SetTitle("Target").SaveX(ctx)
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(target.ID)).OnlyX(ctx)
+
+	days, err := svc.scheduleInterval(ctx, card, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 11, days)
+}
+
+func TestGetNextIntervals_ShowsFuzzRange(t *testing.T) {
+	svc := NewFSRSService(nil, DefaultFSRSConfig(), data.SystemClock())
+	card := &ent.FsrsCard{
+		ID:         uuid.New(),
+		State:      fsrscard.StateReview,
+		Stability:  10,
+		Difficulty: 5,
+		Due:        time.Now(),
+	}
+
+	intervals := svc.GetNextIntervals(card)
+	assert.Contains(t, intervals[3], "-")
+}
+
+func TestElapsedDays_CountsStudyDaysSinceLastReview(t *testing.T) {
+	day := data.DayBoundary{StartHour: 4, Location: time.UTC}
+	svc := NewFSRSService(nil, DefaultFSRSConfig(), data.SystemClock()).WithDayBoundary(day)
+	now := time.Date(2025, 6, 10, 0, 30, 0, 0, time.UTC) // Still 9 June's study day
+
+	lastReview := time.Date(2025, 6, 6, 22, 0, 0, 0, time.UTC)
+	card := &ent.FsrsCard{
+		State:         fsrscard.StateReview,
+		ScheduledDays: 3,
+		LastReview:    &lastReview,
+		Due:           day.AddDays(lastReview, 3),
+	}
+	assert.Equal(t, 3, svc.elapsedDays(card, now), "on time, not 0 days since due")
+
+	// Imported cards without a review time derive it from the schedule
+	card.LastReview = nil
+	assert.Equal(t, 3, svc.elapsedDays(card, now))
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+
+	"github.com/google/uuid"
+)
+
+// ImplicitCreditConfig controls how reviews of a problem affect the theory it tests
+type ImplicitCreditConfig struct {
+	Credit    float64 `json:"credit"`     // Fraction of a Good review's stability gain given on success; 0 disables
+	LapsePull float64 `json:"lapse_pull"` // Fraction of the remaining interval removed on a lapse; 0 disables
+}
+
+// DefaultImplicitCreditConfig gives a quarter repetition and halves the wait after a lapse
+func DefaultImplicitCreditConfig() ImplicitCreditConfig {
+	return ImplicitCreditConfig{
+		Credit:    0.25,
+		LapsePull: 0.5,
+	}
+}
+
+// Validate checks both strengths are fractions
+func (c ImplicitCreditConfig) Validate() error {
+	if c.Credit < 0 || c.Credit > 1 || c.LapsePull < 0 || c.LapsePull > 1 {
+		return fmt.Errorf("implicit credit and lapse pull must be between 0 and 1")
+	}
+	return nil
+}
+
+// ImplicitCreditService propagates review outcomes along tests/defines associations
+type ImplicitCreditService struct {
+	client *ent.Client
+}
+
+// NewImplicitCreditService creates a new ImplicitCreditService
+func NewImplicitCreditService(client *ent.Client) *ImplicitCreditService {
+	return &ImplicitCreditService{client: client}
+}
+
+// Propagate applies a review of nodeID to the review cards of the theory it tests.
+// Good or Easy gives them a fractional repetition (see implicitCredit); Again
+// pulls their due date in by LapsePull of the time remaining. Hard changes
+// nothing. Each theory card is credited under the rules and memory model that
+// rulesFor returns for its effective preset. Each change is logged as an
+// implicit attempt on the theory card. Returns the implicit attempts.
+func (s *ImplicitCreditService) Propagate(
+	ctx context.Context,
+	nodeID uuid.UUID,
+	grade FSRSGrade,
+	rulesFor func(preset *ent.SchedulerPreset) (ImplicitCreditConfig, *FSRSService),
+	now time.Time,
+) ([]uuid.UUID, error) {
+	if grade == GradeHard {
+		return nil, nil
+	}
+
+	cards, err := s.client.FsrsCard.Query().
+		Where(
+			fsrscard.StateEQ(fsrscard.StateReview),
+			fsrscard.IsSuspended(false),
+			fsrscard.HasNodeWith(data.TestedBy(nodeID), node.IDNEQ(nodeID)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading linked theory: %w", err)
+	}
+	if len(cards) == 0 {
+		return nil, nil
+	}
+
+	nodeIDs := make([]uuid.UUID, len(cards))
+	for i, card := range cards {
+		nodeIDs[i] = card.NodeID
+	}
+	presets, err := NewPresetService(s.client).ResolveForNodes(ctx, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := data.NewAttemptRepository(s.client)
+	var logged []uuid.UUID
+	for _, card := range cards {
+		config, fsrs := rulesFor(presets[card.NodeID])
+		strength := config.Credit
+		if grade == GradeAgain {
+			strength = config.LapsePull
+		}
+		if strength <= 0 {
+			continue
+		}
+
+		var due time.Time
+		stability := card.Stability
+		if grade == GradeAgain {
+			due = implicitPull(card, strength, fsrs.day, now)
+		} else {
+			due, stability = implicitCredit(card, strength, fsrs, now)
+		}
+		if due.Equal(card.Due) && stability == card.Stability {
+			continue
+		}
+
+		// scheduled_days keeps the last real interval, so credit never compounds
+		err := card.Update().
+			SetDue(due).
+			SetStability(stability).
+			AddVersion(1).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("crediting linked card: %w", err)
+		}
+
+		a, err := attempts.CreateImplicitAttempt(ctx, card, int(grade), map[string]interface{}{
+			"source_node_id": nodeID.String(),
+			"strength":       strength,
+			"due_before":     card.Due,
+			"due_after":      due,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("logging implicit attempt: %w", err)
+		}
+		logged = append(logged, a.ID)
+	}
+	return logged, nil
+}
+
+// implicitPull returns the card's due date after a lapse on a linked problem
+func implicitPull(card *ent.FsrsCard, strength float64, day data.DayBoundary, now time.Time) time.Time {
+	remaining := card.Due.Sub(now)
+	if remaining <= 0 {
+		return card.Due // Already due
+	}
+	pulled := now.Add(time.Duration(float64(remaining) * (1 - strength)))
+	return day.StartOf(pulled)
+}
+
+// implicitCredit returns the card's due date and stability after a success on
+// a linked problem. An FSRS card moves strength of the way towards the
+// stability a Good review would give now, judged from its last real interval,
+// so repeated credit approaches one real review rather than compounding. A card
+// without stability (SM-2, Leitner) is due strength of its last real interval
+// after that interval ends, however often it is credited. The due date never
+// moves in, nor past MaxInterval from the last review.
+func implicitCredit(card *ent.FsrsCard, strength float64, fsrs *FSRSService, now time.Time) (time.Time, float64) {
+	if card.LastReview == nil {
+		return card.Due, card.Stability
+	}
+
+	due, stability := card.Due, card.Stability
+	if card.Stability > 0 {
+		// Stability the last real review gave, recovered from its interval
+		base := card.Stability
+		if card.ScheduledDays > 0 {
+			base = fsrs.intervalStability(card.ScheduledDays)
+		}
+
+		elapsed := fsrs.elapsedDays(card, now)
+		var good float64
+		if fsrs.supportsShortTerm() && elapsed == 0 {
+			good = fsrs.calculateShortTermStability(base, GradeGood)
+		} else {
+			r := fsrs.calculateRetrievability(float64(elapsed), base)
+			good = fsrs.calculateNewStability(card.Difficulty, base, r, GradeGood)
+		}
+		if good > card.Stability {
+			stability = card.Stability + strength*(good-card.Stability)
+		}
+		interval := int(math.Round(fsrs.calculateInterval(stability, fsrs.config.DesiredRetention)))
+		due = fsrs.day.AddDays(*card.LastReview, interval)
+	} else if extra := int(math.Round(float64(card.ScheduledDays) * strength)); extra >= 1 {
+		due = fsrs.day.AddDays(*card.LastReview, card.ScheduledDays+extra)
+	}
+
+	if fsrs.config.MaxInterval > 0 {
+		if latest := fsrs.day.AddDays(*card.LastReview, fsrs.config.MaxInterval); due.After(latest) {
+			due = latest
+		}
+	}
+	if due.Before(card.Due) {
+		due = card.Due
+	}
+	return due, stability
+}
@@ -0,0 +1,213 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
+	"profen/internal/data/hooks"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewCoordinator_PropagatesImplicitCredit(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Calculus").SaveX(ctx)
+	theory := client.Node.Create().SetType(node.TypeTheory).SetTitle("Chain rule").SetParentID(topic.ID).SaveX(ctx)
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("d/dx sin(x^2)").SetParentID(topic.ID).SaveX(ctx)
+
+	// Stored in ID order, so the relation may be inverted to "defines"
+	require.NoError(t, data.NewNodeRepository(client).
+		CreateAssociation(ctx, problem.ID, theory.ID, nodeassociation.RelTypeTests))
+	tested, err := client.Node.Query().Where(data.TestedBy(problem.ID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tested, 1)
+	assert.Equal(t, theory.ID, tested[0].ID)
+
+	lastReview := time.Now().AddDate(0, 0, -2)
+	theoryCard := client.FsrsCard.Create().
+		SetNodeID(theory.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(10).
+		SetDifficulty(5).
+		SetReps(3).
+		SetScheduledDays(10).
+		SetLastReview(lastReview).
+		SetDue(time.Now().AddDate(0, 0, 8)).
+		SaveX(ctx)
+	client.FsrsCard.Create().
+		SetNodeID(problem.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(5).
+		SetDifficulty(5).
+		SetReps(3).
+		SetScheduledDays(5).
+		SetLastReview(time.Now().AddDate(0, 0, -5)).
+		SetDue(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+
+	// Solving the problem gives the theory a quarter repetition
+	result, err := coordinator.ProcessReview(ctx, problem.ID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ImplicitCredits)
+
+	credited := client.FsrsCard.GetX(ctx, theoryCard.ID)
+	assert.True(t, credited.Due.After(theoryCard.Due))
+	assert.Equal(t, 3, credited.Reps, "implicit credit is not a review")
+	assert.Greater(t, credited.Stability, theoryCard.Stability)
+	assert.Equal(t, 10, credited.ScheduledDays, "the last real interval is kept")
+
+	// Failing it pulls the theory forward
+	client.FsrsCard.Update().
+		Where(fsrscard.NodeID(problem.ID)).
+		SetDue(time.Now().Add(-time.Hour)).
+		ExecX(ctx)
+	result, err = coordinator.ProcessReview(ctx, problem.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ImplicitCredits)
+
+	pulled := client.FsrsCard.GetX(ctx, theoryCard.ID)
+	assert.True(t, pulled.Due.Before(credited.Due))
+
+	// Both changes are logged on the theory card as implicit attempts
+	logged := client.Attempt.Query().
+		Where(attempt.CardID(theoryCard.ID)).
+		AllX(ctx)
+	require.Len(t, logged, 2)
+	for _, a := range logged {
+		assert.Equal(t, attempt.KindImplicit, a.Kind)
+		assert.Equal(t, problem.ID.String(), a.Metadata["source_node_id"])
+	}
+
+	// They don't count as reviews; only the two problem reviews do
+	dashboard, err := data.NewStatsRepository(client, data.SystemClock()).GetDashboardStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, dashboard.TotalAttempts)
+}
+
+func TestReviewCoordinator_ImplicitCreditFollowsTheoryPreset(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	// The theory lives under a preset that disables credit
+	presets := service.NewPresetService(client)
+	preset, err := presets.CreatePreset(ctx, service.PresetSettings{
+		Name:     "No credit",
+		FSRS:     service.DefaultFSRSConfig(),
+		Learning: service.DefaultLearningConfig(),
+		Leech:    service.DefaultLeechConfig(),
+		Implicit: service.ImplicitCreditConfig{Credit: 0, LapsePull: 0.5},
+	})
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Maths").SaveX(ctx)
+	require.NoError(t, presets.AssignPreset(ctx, subject.ID, &preset.ID))
+	theory := client.Node.Create().SetType(node.TypeTheory).SetTitle("Chain rule").SetParentID(subject.ID).SaveX(ctx)
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Drills").SaveX(ctx)
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("d/dx sin(x^2)").SetParentID(topic.ID).SaveX(ctx)
+	require.NoError(t, data.NewNodeRepository(client).
+		CreateAssociation(ctx, problem.ID, theory.ID, nodeassociation.RelTypeTests))
+
+	theoryCard := client.FsrsCard.Create().
+		SetNodeID(theory.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(10).
+		SetDifficulty(5).
+		SetReps(3).
+		SetScheduledDays(10).
+		SetLastReview(time.Now().AddDate(0, 0, -2)).
+		SetDue(time.Now().AddDate(0, 0, 8)).
+		SaveX(ctx)
+	client.FsrsCard.Create().
+		SetNodeID(problem.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(5).
+		SetDifficulty(5).
+		SetReps(3).
+		SetScheduledDays(5).
+		SetLastReview(time.Now().AddDate(0, 0, -5)).
+		SetDue(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+
+	// The problem's own (default) rules would credit the theory; its preset's don't
+	result, err := coordinator.ProcessReview(ctx, problem.ID, 3)
+	require.NoError(t, err)
+	assert.Zero(t, result.ImplicitCredits)
+	assert.Equal(t, theoryCard.Due.Unix(), client.FsrsCard.GetX(ctx, theoryCard.ID).Due.Unix())
+}
+
+func TestUndoService_RevertsImplicitCredit(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Calculus").SaveX(ctx)
+	theory := client.Node.Create().SetType(node.TypeTheory).SetTitle("Chain rule").SetParentID(topic.ID).SaveX(ctx)
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("d/dx sin(x^2)").SetParentID(topic.ID).SaveX(ctx)
+	require.NoError(t, data.NewNodeRepository(client).
+		CreateAssociation(ctx, problem.ID, theory.ID, nodeassociation.RelTypeTests))
+
+	theoryCard := client.FsrsCard.Create().
+		SetNodeID(theory.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(10).
+		SetDifficulty(5).
+		SetReps(3).
+		SetScheduledDays(10).
+		SetLastReview(time.Now().AddDate(0, 0, -2)).
+		SetDue(time.Now().AddDate(0, 0, 8)).
+		SaveX(ctx)
+	problemCard := client.FsrsCard.Create().
+		SetNodeID(problem.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(5).
+		SetDifficulty(5).
+		SetReps(3).
+		SetScheduledDays(5).
+		SetLastReview(time.Now().AddDate(0, 0, -5)).
+		SetDue(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+	result, _, err := coordinator.SubmitReview(ctx, service.ReviewSubmission{NodeID: problem.ID, Grade: 3})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.ImplicitCredits)
+
+	// After a restart only the review is a target; its credit goes with it
+	undone, err := service.NewUndoService(client).UndoLast(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, problem.ID, undone.NodeID)
+
+	assert.Equal(t, 3, client.FsrsCard.GetX(ctx, problemCard.ID).Reps)
+	restored := client.FsrsCard.GetX(ctx, theoryCard.ID)
+	assert.WithinDuration(t, theoryCard.Due, restored.Due, time.Millisecond)
+	assert.Equal(t, 10, restored.ScheduledDays)
+	assert.Zero(t, client.Attempt.Query().CountX(ctx))
+}
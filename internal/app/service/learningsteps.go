@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"time"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
 )
 
 // CardState represents where the card is in the learning pipeline
@@ -50,19 +52,30 @@ type StepResult struct {
 type LearningStepsService struct {
 	client *ent.Client
 	config LearningStepsConfig
+	day    data.DayBoundary
+	clock  data.Clock
 }
 
 // NewLearningStepsService creates a new service
-func NewLearningStepsService(client *ent.Client, config LearningStepsConfig) *LearningStepsService {
+func NewLearningStepsService(client *ent.Client, config LearningStepsConfig, clock data.Clock) *LearningStepsService {
 	return &LearningStepsService{
 		client: client,
 		config: config,
+		day:    data.DefaultDayBoundary(),
+		clock:  clock,
 	}
 }
 
+// WithDayBoundary returns a copy of the service that counts days by the given boundary
+func (s *LearningStepsService) WithDayBoundary(day data.DayBoundary) *LearningStepsService {
+	copied := *s
+	copied.day = day
+	return &copied
+}
+
 // GetCurrentState determines what state a card is in
 func (s *LearningStepsService) GetCurrentState(card *ent.FsrsCard) CardState {
-	return CardState(card.CardState)
+	return CardState(card.State)
 }
 
 // ProcessReview handles a review in the learning/relearning phase
@@ -71,14 +84,24 @@ func (s *LearningStepsService) ProcessReview(
 	card *ent.FsrsCard,
 	grade int, // 1-4 (Again, Hard, Good, Easy)
 ) (*StepResult, error) {
+	return s.ProcessReviewAt(ctx, card, grade, s.clock.Now())
+}
+
+// ProcessReviewAt handles a review as if it happened at the given time (used by replay)
+func (s *LearningStepsService) ProcessReviewAt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	grade int,
+	now time.Time,
+) (*StepResult, error) {
 
 	state := s.GetCurrentState(card)
 
 	switch state {
 	case StateNew, StateLearning:
-		return s.processLearning(ctx, card, grade)
+		return s.processLearning(ctx, card, grade, now)
 	case StateRelearning:
-		return s.processRelearning(ctx, card, grade)
+		return s.processRelearning(ctx, card, grade, now)
 	case StateReview:
 		// This shouldn't be called for review cards - return error
 		return nil, fmt.Errorf("card is in review state, should use FSRS service")
@@ -92,19 +115,20 @@ func (s *LearningStepsService) processLearning(
 	ctx context.Context,
 	card *ent.FsrsCard,
 	grade int,
+	now time.Time,
 ) (*StepResult, error) {
 
 	steps := s.config.LearningSteps
 	currentStep := card.CurrentStep
 
 	if grade == 1 {
-		nextReview := time.Now().Add(time.Duration(steps[0]) * time.Minute)
+		nextReview := now.Add(time.Duration(steps[0]) * time.Minute)
 
 		// FIX: Save returns (card, error)
 		_, err := card.Update().
-			SetCardState(string(StateLearning)).
+			SetState(fsrscard.StateLearning).
 			SetCurrentStep(0).
-			SetNextReview(nextReview).
+			SetDue(nextReview).
 			Save(ctx)
 
 		if err != nil {
@@ -123,7 +147,7 @@ func (s *LearningStepsService) processLearning(
 	// Grade 4 (Easy) - graduate immediately with easy interval
 	if grade == 4 {
 		return &StepResult{
-			NextReviewAt:    time.Now().Add(time.Duration(s.config.EasyInterval) * 24 * time.Hour),
+			NextReviewAt:    s.day.AddDays(now, s.GraduationInterval(grade)),
 			NextState:       StateReview,
 			CurrentStep:     -1,
 			ShouldGraduate:  true,
@@ -135,13 +159,13 @@ func (s *LearningStepsService) processLearning(
 	nextStep := currentStep + 1
 
 	if nextStep < len(steps) {
-		nextReview := time.Now().Add(time.Duration(steps[nextStep]) * time.Minute)
+		nextReview := now.Add(time.Duration(steps[nextStep]) * time.Minute)
 
 		// FIX: Save returns (card, error)
 		_, err := card.Update().
-			SetCardState(string(StateLearning)).
+			SetState(fsrscard.StateLearning).
 			SetCurrentStep(nextStep).
-			SetNextReview(nextReview).
+			SetDue(nextReview).
 			Save(ctx)
 
 		if err != nil {
@@ -159,7 +183,7 @@ func (s *LearningStepsService) processLearning(
 
 	// Completed all learning steps - graduate!
 	return &StepResult{
-		NextReviewAt:    time.Now().Add(time.Duration(s.config.GraduatingInterval) * 24 * time.Hour),
+		NextReviewAt:    s.day.AddDays(now, s.config.GraduatingInterval),
 		NextState:       StateReview,
 		CurrentStep:     -1,
 		ShouldGraduate:  true,
@@ -172,19 +196,20 @@ func (s *LearningStepsService) processRelearning(
 	ctx context.Context,
 	card *ent.FsrsCard,
 	grade int,
+	now time.Time,
 ) (*StepResult, error) {
 
 	steps := s.config.RelearningSteps
 	currentStep := card.CurrentStep
 
 	if grade == 1 {
-		nextReview := time.Now().Add(time.Duration(steps[0]) * time.Minute)
+		nextReview := now.Add(time.Duration(steps[0]) * time.Minute)
 
 		// FIX: Save returns (card, error)
 		_, err := card.Update().
-			SetCardState(string(StateRelearning)).
+			SetState(fsrscard.StateRelearning).
 			SetCurrentStep(0).
-			SetNextReview(nextReview).
+			SetDue(nextReview).
 			SetLapses(card.Lapses + 1).
 			Save(ctx)
 
@@ -205,13 +230,13 @@ func (s *LearningStepsService) processRelearning(
 	nextStep := currentStep + 1
 
 	if nextStep < len(steps) {
-		nextReview := time.Now().Add(time.Duration(steps[nextStep]) * time.Minute)
+		nextReview := now.Add(time.Duration(steps[nextStep]) * time.Minute)
 
 		// FIX: Save returns (card, error)
 		_, err := card.Update().
-			SetCardState(string(StateRelearning)).
+			SetState(fsrscard.StateRelearning).
 			SetCurrentStep(nextStep).
-			SetNextReview(nextReview).
+			SetDue(nextReview).
 			Save(ctx)
 
 		if err != nil {
@@ -229,7 +254,7 @@ func (s *LearningStepsService) processRelearning(
 
 	// Completed relearning - back to review state
 	return &StepResult{
-		NextReviewAt:    time.Now(), // FSRS will calculate the actual interval
+		NextReviewAt:    now, // FSRS will calculate the actual interval
 		NextState:       StateReview,
 		CurrentStep:     -1,
 		ShouldGraduate:  true,
@@ -303,6 +328,15 @@ func (s *LearningStepsService) formatMinutes(minutes int) string {
 	return fmt.Sprintf("%dd", days)
 }
 
+// GraduationInterval is the interval in days a card graduates with: the easy
+// interval for Easy, else the graduating interval
+func (s *LearningStepsService) GraduationInterval(grade int) int {
+	if FSRSGrade(grade) == GradeEasy {
+		return s.config.EasyInterval
+	}
+	return s.config.GraduatingInterval
+}
+
 // ShouldUseLearningSteps determines if this card should use learning steps
 func (s *LearningStepsService) ShouldUseLearningSteps(card *ent.FsrsCard) bool {
 	state := s.GetCurrentState(card)
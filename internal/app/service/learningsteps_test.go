@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"profen/internal/app/service"
+	"profen/internal/data"
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/enttest"
+	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
 
 	_ "github.com/lib/pq"
@@ -23,9 +25,11 @@ func setupTestDB(t *testing.T) (*ent.Client, context.Context) {
 	// Clean in correct order (foreign key dependencies)
 	client.Attempt.Delete().ExecX(ctx) // ✅ DELETE ATTEMPTS FIRST
 	client.NodeAssociation.Delete().ExecX(ctx)
+	client.ErrorResolution.Delete().ExecX(ctx)
 	client.FsrsCard.Delete().ExecX(ctx)
 	client.NodeClosure.Delete().ExecX(ctx)
 	client.Node.Delete().ExecX(ctx)
+	client.SchedulerPreset.Delete().ExecX(ctx)
 
 	return client, ctx
 }
@@ -42,7 +46,7 @@ func TestLearningStepsService_NewCard(t *testing.T) {
 		GraduatingInterval: 1,
 		EasyInterval:       4,
 	}
-	svc := service.NewLearningStepsService(client, config)
+	svc := service.NewLearningStepsService(client, config, data.SystemClock())
 
 	// Create a new card
 	testNode, err := client.Node.Create().
@@ -54,9 +58,9 @@ func TestLearningStepsService_NewCard(t *testing.T) {
 
 	card, err := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("new").
+		SetState(fsrscard.StateNew).
 		SetCurrentStep(0). // NEW card at step 0
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -73,7 +77,7 @@ func TestLearningStepsService_NewCard(t *testing.T) {
 
 	// Reload card to verify state
 	card, _ = client.FsrsCard.Get(ctx, card.ID)
-	assert.Equal(t, "learning", card.CardState)
+	assert.Equal(t, fsrscard.StateLearning, card.State)
 	assert.Equal(t, 1, card.CurrentStep)
 }
 
@@ -82,7 +86,7 @@ func TestLearningStepsService_GradeAgain_ResetsProgress(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultLearningConfig()
-	svc := service.NewLearningStepsService(client, config)
+	svc := service.NewLearningStepsService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -93,9 +97,9 @@ func TestLearningStepsService_GradeAgain_ResetsProgress(t *testing.T) {
 	// Card already in learning at step 1
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("learning").
+		SetState(fsrscard.StateLearning).
 		SetCurrentStep(1).
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -117,7 +121,7 @@ func TestLearningStepsService_GradeEasy_ImmediateGraduation(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultLearningConfig()
-	svc := service.NewLearningStepsService(client, config)
+	svc := service.NewLearningStepsService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -127,9 +131,9 @@ func TestLearningStepsService_GradeEasy_ImmediateGraduation(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("learning").
+		SetState(fsrscard.StateLearning).
 		SetCurrentStep(0).
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -152,7 +156,7 @@ func TestLearningStepsService_CompleteLearningSteps_Graduates(t *testing.T) {
 		GraduatingInterval: 1,
 		EasyInterval:       4,
 	}
-	svc := service.NewLearningStepsService(client, config)
+	svc := service.NewLearningStepsService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -162,9 +166,9 @@ func TestLearningStepsService_CompleteLearningSteps_Graduates(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("learning").
+		SetState(fsrscard.StateLearning).
 		SetCurrentStep(1). // Already at last step
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -183,7 +187,7 @@ func TestLearningStepsService_Relearning(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultLearningConfig()
-	svc := service.NewLearningStepsService(client, config)
+	svc := service.NewLearningStepsService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -194,9 +198,9 @@ func TestLearningStepsService_Relearning(t *testing.T) {
 	// Card in relearning state
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("relearning").
+		SetState(fsrscard.StateRelearning).
 		SetCurrentStep(0).
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(2.5).
 		SetDifficulty(6.0).
 		SetLapses(1).
@@ -215,7 +219,7 @@ func TestLearningStepsService_GetNextIntervals(t *testing.T) {
 	defer client.Close()
 
 	config := service.DefaultLearningConfig()
-	svc := service.NewLearningStepsService(client, config)
+	svc := service.NewLearningStepsService(client, config, data.SystemClock())
 
 	testNode, _ := client.Node.Create().
 		SetType(node.TypeProblem).
@@ -225,9 +229,9 @@ func TestLearningStepsService_GetNextIntervals(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("learning").
+		SetState(fsrscard.StateLearning).
 		SetCurrentStep(0).
-		SetNextReview(time.Now()).
+		SetDue(time.Now()).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		Save(ctx)
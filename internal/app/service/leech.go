@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeclosure"
+
+	"github.com/google/uuid"
+)
+
+// LeechConfig controls when a repeatedly forgotten card is flagged
+type LeechConfig struct {
+	Threshold int  `json:"threshold"` // Lapses before a card is a leech; 0 disables detection
+	Suspend   bool `json:"suspend"`   // Also take the card out of study sessions
+}
+
+// DefaultLeechConfig flags cards after 8 lapses without suspending them
+func DefaultLeechConfig() LeechConfig {
+	return LeechConfig{
+		Threshold: 8,
+		Suspend:   false,
+	}
+}
+
+// LeechService detects cards that keep lapsing and lists them
+type LeechService struct {
+	client *ent.Client
+}
+
+// NewLeechService creates a new LeechService
+func NewLeechService(client *ent.Client) *LeechService {
+	return &LeechService{client: client}
+}
+
+// CheckCard flags the card as a leech once its lapses reach the threshold,
+// suspends it if configured, and opens a Memory Lapse error on its node so it
+// surfaces as a diagnostic gap. Returns true when the card just became a leech.
+func (s *LeechService) CheckCard(ctx context.Context, cardID uuid.UUID, config LeechConfig) (bool, error) {
+	if config.Threshold <= 0 {
+		return false, nil
+	}
+
+	card, err := s.client.FsrsCard.Get(ctx, cardID)
+	if err != nil {
+		return false, err
+	}
+	if card.IsLeech || card.Lapses < config.Threshold {
+		return false, nil
+	}
+
+	update := s.client.FsrsCard.UpdateOneID(card.ID).SetIsLeech(true)
+	if config.Suspend {
+		update = update.SetIsSuspended(true)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return false, fmt.Errorf("flagging leech: %w", err)
+	}
+
+	if err := s.openLapseError(ctx, card.NodeID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// openLapseError records an unresolved ERR_LAPSE on the node unless one is already open
+func (s *LeechService) openLapseError(ctx context.Context, nodeID uuid.UUID) error {
+	def, err := systemErrorType(ctx, s.client, data.ErrorCodeLapse)
+	if err != nil {
+		return fmt.Errorf("loading memory lapse definition: %w", err)
+	}
+
+	open, err := s.client.ErrorResolution.Query().
+		Where(
+			errorresolution.NodeID(nodeID),
+			errorresolution.ErrorTypeID(def.ID),
+			errorresolution.IsResolved(false),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if open {
+		return nil
+	}
+
+	return s.client.ErrorResolution.Create().
+		SetNodeID(nodeID).
+		SetErrorTypeID(def.ID).
+		SetWeightImpact(def.BaseWeight).
+		Exec(ctx)
+}
+
+// ListLeeches returns the leech nodes under a subject (or any ancestor), with their cards
+func (s *LeechService) ListLeeches(ctx context.Context, rootID uuid.UUID) ([]*ent.Node, error) {
+	return s.client.Node.Query().
+		Where(
+			node.HasParentClosuresWith(nodeclosure.AncestorID(rootID)),
+			node.HasFsrsCardWith(fsrscard.IsLeech(true)),
+		).
+		WithFsrsCard().
+		Order(ent.Asc(node.FieldTitle)).
+		All(ctx)
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/hooks"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeechService_FlagsSuspendsAndOpensError(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	presets := service.NewPresetService(client)
+	settings := service.PresetSettings{
+		Name:     "Strict",
+		FSRS:     service.DefaultFSRSConfig(),
+		Learning: service.DefaultLearningConfig(),
+		Leech:    service.LeechConfig{Threshold: 2, Suspend: true},
+	}
+	preset, err := presets.CreatePreset(ctx, settings)
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Chem").SaveX(ctx)
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Bonds").SetParentID(subject.ID).SaveX(ctx)
+	require.NoError(t, presets.AssignPreset(ctx, subject.ID, &preset.ID))
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Ionic").SetParentID(topic.ID).SaveX(ctx)
+
+	// A review card one lapse away from the threshold
+	client.FsrsCard.Create().
+		SetNodeID(problem.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(3).
+		SetDifficulty(7).
+		SetReps(6).
+		SetLapses(1).
+		SetLastReview(time.Now().AddDate(0, 0, -3)).
+		SetDue(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+
+	result, err := coordinator.ProcessReview(ctx, problem.ID, 1)
+	require.NoError(t, err)
+	assert.True(t, result.BecameLeech)
+
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(problem.ID)).OnlyX(ctx)
+	assert.True(t, card.IsLeech)
+	assert.True(t, card.IsSuspended)
+
+	// Suspended leeches leave the study queue
+	queue, err := service.NewStudyCoordinator(client, data.SystemClock()).GetDueCardsQueue(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+
+	// The open Memory Lapse error surfaces as a diagnostic gap
+	gaps, err := data.NewSuggestionRepository(client, data.SystemClock()).GetDiagnosticGaps(ctx, topic.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, gaps, 1)
+	assert.Equal(t, problem.ID, gaps[0].ID)
+
+	// Further lapses neither re-flag nor duplicate the error
+	result, err = coordinator.ProcessReview(ctx, problem.ID, 1)
+	require.NoError(t, err)
+	assert.False(t, result.BecameLeech)
+	assert.Equal(t, 1, client.ErrorResolution.Query().
+		Where(errorresolution.NodeID(problem.ID)).
+		CountX(ctx))
+
+	leeches, err := service.NewLeechService(client).ListLeeches(ctx, subject.ID)
+	require.NoError(t, err)
+	require.Len(t, leeches, 1)
+	assert.Equal(t, problem.ID, leeches[0].ID)
+	require.NotNil(t, leeches[0].Edges.FsrsCard)
+}
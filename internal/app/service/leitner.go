@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+)
+
+// LeitnerConfig holds the review interval of each Leitner box
+type LeitnerConfig struct {
+	Intervals []int `json:"intervals"` // Days between reviews, box 0 first
+}
+
+// DefaultLeitnerConfig returns six boxes doubling from one day
+func DefaultLeitnerConfig() LeitnerConfig {
+	return LeitnerConfig{
+		Intervals: []int{1, 2, 4, 8, 16, 32},
+	}
+}
+
+// Validate checks every box has a positive interval
+func (c LeitnerConfig) Validate() error {
+	if len(c.Intervals) == 0 {
+		return fmt.Errorf("leitner intervals must not be empty")
+	}
+	for i, days := range c.Intervals {
+		if days < 1 {
+			return fmt.Errorf("leitner box %d interval must be at least 1 day", i)
+		}
+	}
+	return nil
+}
+
+// LeitnerService schedules review cards by moving them between boxes
+type LeitnerService struct {
+	client *ent.Client
+	config LeitnerConfig
+	day    data.DayBoundary
+	clock  data.Clock
+}
+
+// NewLeitnerService creates a new Leitner scheduler. Empty intervals use the defaults.
+func NewLeitnerService(client *ent.Client, config LeitnerConfig, clock data.Clock) *LeitnerService {
+	if len(config.Intervals) == 0 {
+		config = DefaultLeitnerConfig()
+	}
+	return &LeitnerService{
+		client: client,
+		config: config,
+		day:    data.DefaultDayBoundary(),
+		clock:  clock,
+	}
+}
+
+// WithDayBoundary returns a copy of the service that counts days by the given boundary
+func (s *LeitnerService) WithDayBoundary(day data.DayBoundary) *LeitnerService {
+	copied := *s
+	copied.day = day
+	return &copied
+}
+
+// Kind identifies Leitner as a Scheduler
+func (s *LeitnerService) Kind() SchedulerKind {
+	return SchedulerLeitner
+}
+
+// ReviewCardAt moves the card between boxes: Good up one, Easy up two,
+// Hard stays, Again back to the first box through relearning.
+func (s *LeitnerService) ReviewCardAt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	grade FSRSGrade,
+	now time.Time,
+) (*FSRSResult, error) {
+	if CardState(card.State) != StateReview {
+		return nil, fmt.Errorf("card must be in review state to use Leitner")
+	}
+
+	elapsed := 0
+	if card.LastReview != nil {
+		elapsed = s.day.DaysBetween(*card.LastReview, now)
+	}
+	box := s.nextBox(card.LeitnerBox, grade)
+
+	update := card.Update().
+		SetLeitnerBox(box).
+		SetElapsedDays(elapsed).
+		SetLastReview(now).
+		SetReps(card.Reps + 1)
+
+	if grade == GradeAgain {
+		_, err := update.
+			SetLapses(card.Lapses + 1).
+			SetState(fsrscard.StateRelearning).
+			SetCurrentStep(0).
+			SetScheduledDays(0).
+			SetDue(now).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &FSRSResult{
+			Stability:       card.Stability,
+			Difficulty:      card.Difficulty,
+			NextReviewAt:    now,
+			IntervalDisplay: "relearn",
+		}, nil
+	}
+
+	intervalDays := s.config.Intervals[box]
+	nextReview := s.day.AddDays(now, intervalDays)
+	_, err := update.
+		SetScheduledDays(intervalDays).
+		SetDue(nextReview).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FSRSResult{
+		Stability:       card.Stability,
+		Difficulty:      card.Difficulty,
+		NextReviewAt:    nextReview,
+		IntervalDays:    intervalDays,
+		IntervalDisplay: formatDays(intervalDays),
+	}, nil
+}
+
+// GraduateCardAt puts a card leaving learning steps into the first box
+// (the second for Easy). The preset's graduating interval is not used.
+func (s *LeitnerService) GraduateCardAt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	grade FSRSGrade,
+	graduatingInterval int,
+	now time.Time,
+) (*FSRSResult, error) {
+	box := 0
+	if grade == GradeEasy {
+		box = s.clampBox(1)
+	}
+	intervalDays := s.config.Intervals[box]
+	nextReview := s.day.AddDays(now, intervalDays)
+
+	_, err := card.Update().
+		SetState(fsrscard.StateReview).
+		SetLeitnerBox(box).
+		SetScheduledDays(intervalDays).
+		SetElapsedDays(0).
+		SetDue(nextReview).
+		SetLastReview(now).
+		SetReps(card.Reps + 1).
+		SetCurrentStep(-1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FSRSResult{
+		Stability:       card.Stability,
+		Difficulty:      card.Difficulty,
+		NextReviewAt:    nextReview,
+		IntervalDays:    intervalDays,
+		IntervalDisplay: formatDays(intervalDays),
+	}, nil
+}
+
+// GetNextIntervals previews the box interval for each grade
+func (s *LeitnerService) GetNextIntervals(card *ent.FsrsCard) map[int]string {
+	intervals := map[int]string{1: "relearn"}
+	for grade := GradeHard; grade <= GradeEasy; grade++ {
+		intervals[int(grade)] = formatDays(s.config.Intervals[s.nextBox(card.LeitnerBox, grade)])
+	}
+	return intervals
+}
+
+// nextBox returns the box a grade moves the card to
+func (s *LeitnerService) nextBox(box int, grade FSRSGrade) int {
+	switch grade {
+	case GradeAgain:
+		return 0
+	case GradeHard:
+		return s.clampBox(box)
+	case GradeGood:
+		return s.clampBox(box + 1)
+	default:
+		return s.clampBox(box + 2)
+	}
+}
+
+func (s *LeitnerService) clampBox(box int) int {
+	if box < 0 {
+		return 0
+	}
+	if last := len(s.config.Intervals) - 1; box > last {
+		return last
+	}
+	return box
+}
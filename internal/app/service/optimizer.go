@@ -2,94 +2,345 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"os"
-	"os/exec"
-	"path/filepath"
-	"time"
+	"math"
+	"math/rand"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/attempt"
+
+	"github.com/google/uuid"
 )
 
+// OptimizerConfig controls the gradient descent fit of the FSRS weights
+type OptimizerConfig struct {
+	LearningRate    float64 `json:"learning_rate"`
+	MaxIterations   int     `json:"max_iterations"`
+	ValidationSplit float64 `json:"validation_split"` // fraction of cards held out
+	Tolerance       float64 `json:"tolerance"`        // minimum validation improvement
+	Patience        int     `json:"patience"`         // iterations without improvement before stopping
+	MinReviews      int     `json:"min_reviews"`
+	Seed            int64   `json:"seed"`
+}
+
+// DefaultOptimizerConfig returns sensible defaults for a personal revlog
+func DefaultOptimizerConfig() OptimizerConfig {
+	return OptimizerConfig{
+		LearningRate:    0.02,
+		MaxIterations:   500,
+		ValidationSplit: 0.2,
+		Tolerance:       1e-5,
+		Patience:        20,
+		MinReviews:      100,
+		Seed:            42,
+	}
+}
+
+// OptimizerMetrics summarizes how well a weight set predicts recall
+type OptimizerMetrics struct {
+	LogLoss float64 `json:"log_loss"`
+	RMSE    float64 `json:"rmse"`
+	Reviews int     `json:"reviews"`
+}
+
+// OptimizerIteration is one step of the convergence report
+type OptimizerIteration struct {
+	Iteration      int     `json:"iteration"`
+	TrainLoss      float64 `json:"train_loss"`
+	ValidationLoss float64 `json:"validation_loss"`
+}
+
+// OptimizationResult contains the fitted weights and before/after metrics
+type OptimizationResult struct {
+	Weights          []float64            `json:"weights"`
+	InitialWeights   []float64            `json:"initial_weights"`
+	TrainBefore      OptimizerMetrics     `json:"train_before"`
+	TrainAfter       OptimizerMetrics     `json:"train_after"`
+	ValidationBefore OptimizerMetrics     `json:"validation_before"`
+	ValidationAfter  OptimizerMetrics     `json:"validation_after"`
+	TrainCards       int                  `json:"train_cards"`
+	ValidationCards  int                  `json:"validation_cards"`
+	Iterations       int                  `json:"iterations"`
+	Converged        bool                 `json:"converged"`
+	History          []OptimizerIteration `json:"history"`
+
+	Evaluation *WeightComparison `json:"evaluation,omitempty"` // Set when compared with the preset's weights
+	Applied    bool              `json:"applied"`              // False when refused as worse
+}
+
+// reviewEvent is a single review in a card's history; ElapsedDays is 0 only
+// for same-day reviews kept for FSRS-5+ evaluation
+type reviewEvent struct {
+	ElapsedDays float64
+	Rating      FSRSGrade
+}
+
+// OptimizerService fits FSRS weights to the attempt revlog
 type OptimizerService struct {
 	client *ent.Client
+	config OptimizerConfig
 }
 
-func NewOptimizerService(client *ent.Client) *OptimizerService {
-	return &OptimizerService{client: client}
+// NewOptimizerService creates a new optimizer
+func NewOptimizerService(client *ent.Client, config OptimizerConfig) *OptimizerService {
+	return &OptimizerService{
+		client: client,
+		config: config,
+	}
 }
 
-// RunOptimization exports history and calls the Python script.
-func (s *OptimizerService) RunOptimization(ctx context.Context) ([]float64, error) {
-	// 1. Fetch All Attempts (Revlog)
+// RunOptimization fits the weights of start to the full attempt history.
+// The returned weights are not persisted; callers decide whether to apply them.
+func (s *OptimizerService) RunOptimization(ctx context.Context, start FSRSConfig) (*OptimizationResult, error) {
 	attempts, err := s.client.Attempt.Query().
+		Where(attempt.KindEQ(attempt.KindReview)).
 		Order(ent.Asc(attempt.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fetching attempts: %w", err)
 	}
 
-	if len(attempts) < 100 {
-		return nil, fmt.Errorf("not enough data to optimize (min 100, have %d)", len(attempts))
-	}
-
-	// 2. Export to JSON file
-	tempFile := "revlog_export.json"
-	file, err := os.Create(tempFile)
+	day, err := data.NewSettingsRepository(s.client).DayBoundary(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("creating temp file: %w", err)
+		return nil, err
 	}
-	defer os.Remove(tempFile) // Cleanup
 
-	// Map to simplified struct for JSON
-	type exportRow struct {
-		CardID    string `json:"card_id"`
-		Rating    int    `json:"rating"`
-		CreatedAt string `json:"created_at"` // ISO8601
-		State     string `json:"state"`
+	// FSRS-5+ updates stability on same-day reviews, so fit them too
+	histories := buildReviewHistories(attempts, day, (&FSRSService{config: start}).supportsShortTerm())
+
+	reviews := 0
+	for _, h := range histories {
+		for _, event := range h[1:] {
+			if event.ElapsedDays > 0 {
+				reviews++
+			}
+		}
+	}
+	if reviews < s.config.MinReviews {
+		return nil, fmt.Errorf("not enough data to optimize (min %d, have %d)", s.config.MinReviews, reviews)
 	}
 
-	var rows []exportRow
+	return fitWeights(histories, start, s.config), nil
+}
+
+// buildReviewHistories groups attempts by card into day-level review sequences.
+// Attempts must be ordered by created_at. Same-day repeats (by the study-day
+// boundary) are dropped unless sameDay is set, in which case those graded by
+// a scheduler rather than learning steps are kept with 0 elapsed days. The
+// first event of each sequence initializes the memory state.
+func buildReviewHistories(attempts []*ent.Attempt, day data.DayBoundary, sameDay bool) [][]reviewEvent {
+	byCard := make(map[uuid.UUID][]*ent.Attempt)
+	var order []uuid.UUID
 	for _, a := range attempts {
-		rows = append(rows, exportRow{
-			CardID:    a.CardID.String(),
-			Rating:    a.Rating,
-			CreatedAt: a.CreatedAt.Format(time.RFC3339),
-			State:     a.State.String(),
+		if _, ok := byCard[a.CardID]; !ok {
+			order = append(order, a.CardID)
+		}
+		byCard[a.CardID] = append(byCard[a.CardID], a)
+	}
+
+	histories := make([][]reviewEvent, 0, len(order))
+	for _, cardID := range order {
+		cardAttempts := byCard[cardID]
+
+		history := []reviewEvent{{Rating: FSRSGrade(cardAttempts[0].Rating)}}
+		last := cardAttempts[0].CreatedAt
+		for _, a := range cardAttempts[1:] {
+			days := day.DaysBetween(last, a.CreatedAt)
+			if days < 1 && (!sameDay || isLearningStep(a)) {
+				continue
+			}
+			history = append(history, reviewEvent{ElapsedDays: float64(days), Rating: FSRSGrade(a.Rating)})
+			last = a.CreatedAt
+		}
+
+		// A single event has nothing to predict
+		if len(history) > 1 {
+			histories = append(histories, history)
+		}
+	}
+	return histories
+}
+
+// isLearningStep reports whether learning steps, not a scheduler, graded the attempt
+func isLearningStep(a *ent.Attempt) bool {
+	return a.Scheduler != nil && *a.Scheduler == attempt.SchedulerLearningSteps
+}
+
+// fitWeights runs Adam on the training log-loss, keeping the weights with the
+// best validation loss (early stopping).
+func fitWeights(histories [][]reviewEvent, start FSRSConfig, config OptimizerConfig) *OptimizationResult {
+	train, validation := splitHistories(histories, config.ValidationSplit, config.Seed)
+
+	weights := append([]float64(nil), start.W...)
+	model := &FSRSService{config: start}
+
+	result := &OptimizationResult{
+		InitialWeights:  append([]float64(nil), start.W...),
+		TrainCards:      len(train),
+		ValidationCards: len(validation),
+	}
+	result.TrainBefore = evaluateHistories(model, train)
+	result.ValidationBefore = evaluateHistories(model, validation)
+
+	best := append([]float64(nil), weights...)
+	bestLoss := result.ValidationBefore.LogLoss
+	stale := 0
+
+	m := make([]float64, len(weights))
+	v := make([]float64, len(weights))
+	const beta1, beta2, epsilon = 0.9, 0.999, 1e-8
+
+	for iter := 1; iter <= config.MaxIterations; iter++ {
+		grad := lossGradient(start, weights, train)
+
+		for i := range weights {
+			m[i] = beta1*m[i] + (1-beta1)*grad[i]
+			v[i] = beta2*v[i] + (1-beta2)*grad[i]*grad[i]
+			mHat := m[i] / (1 - math.Pow(beta1, float64(iter)))
+			vHat := v[i] / (1 - math.Pow(beta2, float64(iter)))
+			weights[i] -= config.LearningRate * mHat / (math.Sqrt(vHat) + epsilon)
+		}
+		clampWeights(weights)
+
+		model.config.W = weights
+		trainLoss := evaluateHistories(model, train).LogLoss
+		valLoss := evaluateHistories(model, validation).LogLoss
+
+		result.Iterations = iter
+		result.History = append(result.History, OptimizerIteration{
+			Iteration:      iter,
+			TrainLoss:      trainLoss,
+			ValidationLoss: valLoss,
 		})
+
+		if bestLoss-valLoss > config.Tolerance {
+			bestLoss = valLoss
+			copy(best, weights)
+			stale = 0
+			continue
+		}
+
+		stale++
+		if stale >= config.Patience {
+			result.Converged = true
+			break
+		}
 	}
 
-	if err := json.NewEncoder(file).Encode(rows); err != nil {
-		return nil, fmt.Errorf("writing json: %w", err)
+	model.config.W = best
+	result.Weights = best
+	result.TrainAfter = evaluateHistories(model, train)
+	result.ValidationAfter = evaluateHistories(model, validation)
+	return result
+}
+
+// splitHistories shuffles cards deterministically and holds out a validation set.
+// Splitting by card keeps a card's reviews on one side of the split.
+func splitHistories(histories [][]reviewEvent, fraction float64, seed int64) ([][]reviewEvent, [][]reviewEvent) {
+	shuffled := append([][]reviewEvent(nil), histories...)
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	n := int(math.Round(float64(len(shuffled)) * fraction))
+	if n == 0 || n >= len(shuffled) {
+		// Too few cards to hold any out, validate on the training set
+		return shuffled, shuffled
 	}
-	file.Close()
+	return shuffled[n:], shuffled[:n]
+}
 
-	// 3. Call Python Script
-	// Assuming script is in ./scripts/optimizer.py
-	cmd := exec.Command("python", filepath.Join("scripts", "optimizer.py"), tempFile)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return nil, fmt.Errorf("python execution failed: %s", string(output))
+// lossGradient estimates d(log-loss)/dw with central differences
+func lossGradient(base FSRSConfig, weights []float64, histories [][]reviewEvent) []float64 {
+	grad := make([]float64, len(weights))
+	probe := append([]float64(nil), weights...)
+	model := &FSRSService{config: base}
+	model.config.W = probe
+
+	for i := range weights {
+		h := 1e-4 * math.Max(1, math.Abs(weights[i]))
+
+		probe[i] = weights[i] + h
+		up := evaluateHistories(model, histories).LogLoss
+		probe[i] = weights[i] - h
+		down := evaluateHistories(model, histories).LogLoss
+		probe[i] = weights[i]
+
+		grad[i] = (up - down) / (2 * h)
 	}
+	return grad
+}
+
+// evaluateHistories replays each history through the model and scores the
+// predicted retrievability against the observed pass/fail outcome. Same-day
+// reviews update the state like the live scheduler but predict nothing.
+func evaluateHistories(model *FSRSService, histories [][]reviewEvent) OptimizerMetrics {
+	var logLoss, squared float64
+	n := 0
+
+	for _, history := range histories {
+		first := history[0].Rating
+		stability := model.calculateInitialStability(first)
+		difficulty := model.calculateInitialDifficulty(first)
+
+		for _, event := range history[1:] {
+			if event.ElapsedDays == 0 && model.supportsShortTerm() {
+				stability = math.Max(model.calculateShortTermStability(stability, event.Rating), 0.01)
+				difficulty = model.calculateNewDifficulty(difficulty, event.Rating)
+				continue
+			}
 
-	// 4. Parse Result
-	var result struct {
-		Parameters []float64 `json:"parameters"`
-		Status     string    `json:"status"`
-		Error      string    `json:"error"`
+			r := model.calculateRetrievability(event.ElapsedDays, stability)
+			r = math.Min(math.Max(r, 1e-6), 1-1e-6)
+
+			y := 0.0
+			if event.Rating > GradeAgain {
+				y = 1
+			}
+			logLoss += -(y*math.Log(r) + (1-y)*math.Log(1-r))
+			squared += (y - r) * (y - r)
+			n++
+
+			stability = math.Max(model.calculateNewStability(difficulty, stability, r, event.Rating), 0.01)
+			difficulty = model.calculateNewDifficulty(difficulty, event.Rating)
+		}
 	}
 
-	if err := json.Unmarshal(output, &result); err != nil {
-		return nil, fmt.Errorf("parsing python output: %w. Raw: %s", err, string(output))
+	if n == 0 {
+		return OptimizerMetrics{}
 	}
+	return OptimizerMetrics{
+		LogLoss: logLoss / float64(n),
+		RMSE:    math.Sqrt(squared / float64(n)),
+		Reviews: n,
+	}
+}
 
-	if result.Error != "" {
-		return nil, fmt.Errorf("optimizer error: %s", result.Error)
+// weightBounds keeps each FSRS weight within the reference optimizer's range.
+// v4 uses the first 17 entries, v5 the first 19 and v6 all 21.
+var weightBounds = [][2]float64{
+	{0.1, 100}, {0.1, 100}, {0.1, 100}, {0.1, 100}, // initial stability per grade
+	{1, 10}, {0.1, 5}, {0.1, 5}, {0, 0.5}, // difficulty
+	{0, 3}, {0.1, 0.8}, {0.01, 2.5}, // recall stability
+	{0.5, 5}, {0.01, 0.2}, {0.01, 0.9}, {0.01, 2}, // forget stability
+	{0, 1}, {1, 4}, // hard penalty, easy bonus
+	{0, 2}, {0, 2}, // same-day stability (v5+)
+	{0, 0.8},   // same-day stability saturation (v6)
+	{0.1, 0.8}, // forgetting curve decay (v6)
+}
+
+func clampWeights(weights []float64) {
+	for i := range weights {
+		if i >= len(weightBounds) {
+			break
+		}
+		weights[i] = math.Min(math.Max(weights[i], weightBounds[i][0]), weightBounds[i][1])
 	}
 
-	// 5. Save New Parameters (TODO: Persist to DB Config table)
-	// For now, just return them.
-	return result.Parameters, nil
+	// Initial stabilities must stay ordered Again <= Hard <= Good <= Easy
+	for i := 1; i < 4 && i < len(weights); i++ {
+		weights[i] = math.Max(weights[i], weights[i-1])
+	}
 }
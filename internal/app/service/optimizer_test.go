@@ -0,0 +1,133 @@
+package service
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// simulateHistories generates review sequences from a known weight set
+func simulateHistories(w []float64, cards, reviews int, seed int64) [][]reviewEvent {
+	model := &FSRSService{config: FSRSConfig{DesiredRetention: 0.9, MaxInterval: 36500, W: w}}
+	rng := rand.New(rand.NewSource(seed))
+
+	histories := make([][]reviewEvent, 0, cards)
+	for c := 0; c < cards; c++ {
+		first := FSRSGrade(rng.Intn(4) + 1)
+		stability := model.calculateInitialStability(first)
+		difficulty := model.calculateInitialDifficulty(first)
+
+		history := []reviewEvent{{Rating: first}}
+		for r := 0; r < reviews; r++ {
+			// Jitter the interval so the model sees a spread of retrievabilities
+			elapsed := math.Max(1, math.Round(stability*(0.5+rng.Float64())))
+			recall := model.calculateRetrievability(elapsed, stability)
+
+			grade := GradeAgain
+			if rng.Float64() < recall {
+				grade = GradeGood
+			}
+			history = append(history, reviewEvent{ElapsedDays: elapsed, Rating: grade})
+
+			stability = math.Max(model.calculateNewStability(difficulty, stability, recall, grade), 0.01)
+			difficulty = model.calculateNewDifficulty(difficulty, grade)
+		}
+		histories = append(histories, history)
+	}
+	return histories
+}
+
+func TestFitWeights_ImprovesLogLoss(t *testing.T) {
+	truth := DefaultFSRSConfig().W
+	truth[8] = 1.9  // faster stability growth
+	truth[11] = 1.2 // harsher lapses
+
+	histories := simulateHistories(truth, 300, 6, 7)
+
+	config := DefaultOptimizerConfig()
+	config.MaxIterations = 60
+
+	result := fitWeights(histories, DefaultFSRSConfig(), config)
+
+	require.Len(t, result.Weights, 17)
+	assert.Equal(t, DefaultFSRSConfig().W, result.InitialWeights)
+	assert.Greater(t, result.TrainCards, 0)
+	assert.Greater(t, result.ValidationCards, 0)
+	assert.NotEmpty(t, result.History)
+	assert.LessOrEqual(t, result.ValidationAfter.LogLoss, result.ValidationBefore.LogLoss)
+	assert.Less(t, result.TrainAfter.LogLoss, result.TrainBefore.LogLoss)
+
+	for i, w := range result.Weights {
+		assert.GreaterOrEqual(t, w, weightBounds[i][0], "weight %d below bound", i)
+		assert.LessOrEqual(t, w, weightBounds[i][1], "weight %d above bound", i)
+	}
+}
+
+func TestEvaluateHistories_FitsSameDayWeights(t *testing.T) {
+	config := DefaultFSRSConfigFor(FSRSv5)
+	model := &FSRSService{config: config}
+	histories := [][]reviewEvent{{
+		{Rating: GradeGood},
+		{ElapsedDays: 0, Rating: GradeAgain},
+		{ElapsedDays: 3, Rating: GradeGood},
+	}}
+
+	// Scored like the evaluator, same-day review included in the state
+	metrics := evaluateHistories(model, histories)
+	assert.Equal(t, 1, metrics.Reviews)
+	assert.InDelta(t, evaluateWeights(model, histories).LogLoss, metrics.LogLoss, 1e-12)
+
+	// so the same-day weights get a gradient
+	grad := lossGradient(config, config.W, histories)
+	assert.NotZero(t, grad[17])
+	assert.NotZero(t, grad[18])
+}
+
+func TestBuildReviewHistories_DropsSameDayRepeats(t *testing.T) {
+	cardID := uuid.New()
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	attempts := []*ent.Attempt{
+		{CardID: cardID, Rating: 3, CreatedAt: start},
+		{CardID: cardID, Rating: 3, CreatedAt: start.Add(10 * time.Minute)}, // learning step
+		{CardID: cardID, Rating: 1, CreatedAt: start.Add(3 * 24 * time.Hour)},
+		{CardID: uuid.New(), Rating: 3, CreatedAt: start}, // single review, nothing to predict
+	}
+
+	histories := buildReviewHistories(attempts, data.DayBoundary{StartHour: 4, Location: time.UTC}, false)
+
+	require.Len(t, histories, 1)
+	require.Len(t, histories[0], 2)
+	assert.Equal(t, GradeGood, histories[0][0].Rating)
+	assert.Equal(t, 3.0, histories[0][1].ElapsedDays)
+	assert.Equal(t, GradeAgain, histories[0][1].Rating)
+}
+
+func TestBuildReviewHistories_KeepsSameDayReviews(t *testing.T) {
+	cardID := uuid.New()
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	steps, fsrs := attempt.SchedulerLearningSteps, attempt.SchedulerFsrs
+
+	attempts := []*ent.Attempt{
+		{CardID: cardID, Rating: 3, CreatedAt: start},
+		{CardID: cardID, Rating: 3, CreatedAt: start.Add(10 * time.Minute), Scheduler: &steps},
+		{CardID: cardID, Rating: 1, CreatedAt: start.Add(2 * time.Hour), Scheduler: &fsrs},
+		{CardID: cardID, Rating: 3, CreatedAt: start.Add(3 * 24 * time.Hour), Scheduler: &fsrs},
+	}
+
+	histories := buildReviewHistories(attempts, data.DayBoundary{StartHour: 4, Location: time.UTC}, true)
+
+	require.Len(t, histories, 1)
+	require.Len(t, histories[0], 3, "the learning step is still dropped")
+	assert.Equal(t, reviewEvent{ElapsedDays: 0, Rating: GradeAgain}, histories[0][1])
+	assert.Equal(t, reviewEvent{ElapsedDays: 3, Rating: GradeGood}, histories[0][2])
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/nodeassociation"
+
+	"github.com/google/uuid"
+)
+
+// GateMode selects what a prerequisite must reach to unlock its dependents
+type GateMode string
+
+const (
+	GateOff       GateMode = "off"       // New cards are never held back
+	GateStability GateMode = "stability" // Every prerequisite card reaches MinStability (its interval under SM-2 and Leitner)
+	GateMastery   GateMode = "mastery"   // Every prerequisite card is mastered (last 3 attempts correct)
+)
+
+// PrerequisiteGate holds back new cards until their comes_before prerequisites are learned
+type PrerequisiteGate struct {
+	Mode         GateMode `json:"mode"`          // Empty is treated as off
+	MinStability float64  `json:"min_stability"` // Days, used by the stability gate
+}
+
+// DefaultPrerequisiteGate requires three days of stability on every prerequisite
+func DefaultPrerequisiteGate() PrerequisiteGate {
+	return PrerequisiteGate{
+		Mode:         GateStability,
+		MinStability: 3,
+	}
+}
+
+// mode returns the gate mode, defaulting to off when unset
+func (g PrerequisiteGate) mode() GateMode {
+	if g.Mode == "" {
+		return GateOff
+	}
+	return g.Mode
+}
+
+// Validate checks the gate is usable
+func (g PrerequisiteGate) Validate() error {
+	switch g.mode() {
+	case GateOff, GateMastery:
+		return nil
+	case GateStability:
+		if g.MinStability <= 0 {
+			return fmt.Errorf("prerequisite stability must be positive")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown prerequisite gate %q", g.Mode)
+	}
+}
+
+// PrerequisiteBlock is one unmet prerequisite of a locked node
+type PrerequisiteBlock struct {
+	NodeID uuid.UUID `json:"node_id"`
+	Title  string    `json:"title"`
+	Depth  int       `json:"depth"` // 1 for a direct prerequisite
+	Reason string    `json:"reason"`
+}
+
+// PrerequisiteStatus explains whether a node's new card is held back and by what
+type PrerequisiteStatus struct {
+	NodeID   uuid.UUID           `json:"node_id"`
+	Locked   bool                `json:"locked"`
+	Gate     PrerequisiteGate    `json:"gate"`
+	Blocking []PrerequisiteBlock `json:"blocking"`
+}
+
+// prerequisiteGraph maps a node to its direct prerequisites
+type prerequisiteGraph map[uuid.UUID][]uuid.UUID
+
+// prerequisiteRef is a transitive prerequisite and how far away it is
+type prerequisiteRef struct {
+	id    uuid.UUID
+	depth int
+}
+
+// PrerequisiteService gates new cards on their comes_before prerequisites
+type PrerequisiteService struct {
+	client *ent.Client
+}
+
+// NewPrerequisiteService creates a new PrerequisiteService
+func NewPrerequisiteService(client *ent.Client) *PrerequisiteService {
+	return &PrerequisiteService{client: client}
+}
+
+// Explain reports whether the node is locked under its preset's gate and lists
+// every transitive prerequisite that is not yet learned
+func (s *PrerequisiteService) Explain(ctx context.Context, nodeID uuid.UUID) (*PrerequisiteStatus, error) {
+	gate := DefaultPrerequisiteGate()
+	preset, err := NewPresetService(s.client).ResolveForNode(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	if preset != nil {
+		gate = PresetPrerequisiteGate(preset)
+	}
+
+	status := &PrerequisiteStatus{NodeID: nodeID, Gate: gate, Blocking: []PrerequisiteBlock{}}
+	if gate.mode() == GateOff {
+		return status, nil
+	}
+
+	graph, err := s.loadGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := newPrerequisiteChecker(s.client)
+	for _, ref := range graph.transitive(nodeID) {
+		ok, reason, err := checker.satisfied(ctx, ref.id, gate)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			continue
+		}
+		n, err := s.client.Node.Get(ctx, ref.id)
+		if err != nil {
+			return nil, err
+		}
+		status.Blocking = append(status.Blocking, PrerequisiteBlock{
+			NodeID: ref.id,
+			Title:  n.Title,
+			Depth:  ref.depth,
+			Reason: reason,
+		})
+	}
+	status.Locked = len(status.Blocking) > 0
+	return status, nil
+}
+
+// lockedNodes returns which of the nodes have an unmet prerequisite under their own gate
+func (s *PrerequisiteService) lockedNodes(
+	ctx context.Context,
+	nodeIDs []uuid.UUID,
+	gateOf func(uuid.UUID) PrerequisiteGate,
+) (map[uuid.UUID]bool, error) {
+	locked := make(map[uuid.UUID]bool)
+	if len(nodeIDs) == 0 {
+		return locked, nil
+	}
+
+	graph, err := s.loadGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(graph) == 0 {
+		return locked, nil
+	}
+
+	checker := newPrerequisiteChecker(s.client)
+	for _, id := range nodeIDs {
+		gate := gateOf(id)
+		if gate.mode() == GateOff {
+			continue
+		}
+		for _, ref := range graph.transitive(id) {
+			ok, _, err := checker.satisfied(ctx, ref.id, gate)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				locked[id] = true
+				break
+			}
+		}
+	}
+	return locked, nil
+}
+
+// loadGraph reads every comes_before/comes_after link. "A comes_before B"
+// makes A a prerequisite of B, whichever way round it was stored.
+func (s *PrerequisiteService) loadGraph(ctx context.Context) (prerequisiteGraph, error) {
+	links, err := s.client.NodeAssociation.Query().
+		Where(nodeassociation.RelTypeIn(
+			nodeassociation.RelTypeComesBefore,
+			nodeassociation.RelTypeComesAfter,
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading prerequisites: %w", err)
+	}
+
+	graph := make(prerequisiteGraph)
+	for _, link := range links {
+		if link.RelType == nodeassociation.RelTypeComesBefore {
+			graph[link.TargetID] = append(graph[link.TargetID], link.SourceID)
+		} else {
+			graph[link.SourceID] = append(graph[link.SourceID], link.TargetID)
+		}
+	}
+	return graph, nil
+}
+
+// transitive walks prerequisites breadth first, nearest first. Cycles are cut.
+func (g prerequisiteGraph) transitive(nodeID uuid.UUID) []prerequisiteRef {
+	return g.transitiveFrom([]uuid.UUID{nodeID})
+}
+
+// transitiveFrom walks the prerequisites of several nodes at once; the start
+// nodes themselves are never returned
+func (g prerequisiteGraph) transitiveFrom(nodeIDs []uuid.UUID) []prerequisiteRef {
+	seen := make(map[uuid.UUID]bool, len(nodeIDs))
+	for _, id := range nodeIDs {
+		seen[id] = true
+	}
+	var refs []prerequisiteRef
+	frontier := append([]uuid.UUID{}, nodeIDs...)
+	for depth := 1; len(frontier) > 0; depth++ {
+		var next []uuid.UUID
+		for _, id := range frontier {
+			for _, prereq := range g[id] {
+				if seen[prereq] {
+					continue
+				}
+				seen[prereq] = true
+				refs = append(refs, prerequisiteRef{id: prereq, depth: depth})
+				next = append(next, prereq)
+			}
+		}
+		frontier = next
+	}
+	return refs
+}
+
+// prerequisiteChecker evaluates prerequisites, caching results per gate
+type prerequisiteChecker struct {
+	client     *ent.Client
+	mastery    *data.MasteryService
+	cache      map[prerequisiteKey]prerequisiteResult
+	schedulers map[uuid.UUID]SchedulerKind // Per card node
+}
+
+type prerequisiteKey struct {
+	id   uuid.UUID
+	gate PrerequisiteGate
+}
+
+type prerequisiteResult struct {
+	ok     bool
+	reason string
+}
+
+func newPrerequisiteChecker(client *ent.Client) *prerequisiteChecker {
+	return &prerequisiteChecker{
+		client:     client,
+		mastery:    data.NewMasteryService(client),
+		cache:      make(map[prerequisiteKey]prerequisiteResult),
+		schedulers: make(map[uuid.UUID]SchedulerKind),
+	}
+}
+
+// satisfied checks every active card at or under the prerequisite, so a topic
+// prerequisite needs all of its problems and theories learned
+func (c *prerequisiteChecker) satisfied(ctx context.Context, prereqID uuid.UUID, gate PrerequisiteGate) (bool, string, error) {
+	key := prerequisiteKey{prereqID, gate}
+	if r, ok := c.cache[key]; ok {
+		return r.ok, r.reason, nil
+	}
+
+	cards, err := c.client.FsrsCard.Query().
+		Where(
+			cardsUnder(prereqID, true),
+			fsrscard.IsSuspended(false),
+		).
+		All(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("loading prerequisite cards: %w", err)
+	}
+
+	result := prerequisiteResult{ok: true}
+	if len(cards) == 0 {
+		result = prerequisiteResult{reason: "not started"}
+	}
+	for _, card := range cards {
+		if r, err := c.checkCard(ctx, card, gate); err != nil {
+			return false, "", err
+		} else if !r.ok {
+			result = r
+			break
+		}
+	}
+
+	c.cache[key] = result
+	return result.ok, result.reason, nil
+}
+
+func (c *prerequisiteChecker) checkCard(ctx context.Context, card *ent.FsrsCard, gate PrerequisiteGate) (prerequisiteResult, error) {
+	if card.State == fsrscard.StateNew {
+		return prerequisiteResult{reason: "not started"}, nil
+	}
+
+	switch gate.mode() {
+	case GateMastery:
+		_, _, mastered, err := c.mastery.GetNodeMastery(ctx, card.NodeID)
+		if err != nil {
+			return prerequisiteResult{}, err
+		}
+		if !mastered {
+			return prerequisiteResult{reason: "not mastered"}, nil
+		}
+	default:
+		kind, err := c.schedulerOf(ctx, card.NodeID)
+		if err != nil {
+			return prerequisiteResult{}, err
+		}
+		return stabilityCheck(card, kind, gate.MinStability), nil
+	}
+	return prerequisiteResult{ok: true}, nil
+}
+
+// schedulerOf returns the scheduler of the node's effective preset
+func (c *prerequisiteChecker) schedulerOf(ctx context.Context, nodeID uuid.UUID) (SchedulerKind, error) {
+	if kind, ok := c.schedulers[nodeID]; ok {
+		return kind, nil
+	}
+	preset, err := NewPresetService(c.client).ResolveForNode(ctx, nodeID)
+	if err != nil {
+		return "", err
+	}
+	kind := SchedulerFSRS
+	if preset != nil {
+		kind, _ = PresetScheduler(preset)
+	}
+	c.schedulers[nodeID] = kind
+	return kind, nil
+}
+
+// stabilityCheck compares FSRS stability with the minimum. SM-2 and Leitner
+// never set stability, so their cards must be in review with a scheduled
+// interval of at least the minimum instead.
+func stabilityCheck(card *ent.FsrsCard, kind SchedulerKind, minDays float64) prerequisiteResult {
+	if kind == SchedulerFSRS {
+		if card.Stability < minDays {
+			return prerequisiteResult{
+				reason: fmt.Sprintf("stability %.1fd below %.1fd", card.Stability, minDays),
+			}
+		}
+		return prerequisiteResult{ok: true}
+	}
+
+	if card.State != fsrscard.StateReview {
+		return prerequisiteResult{reason: "still learning"}
+	}
+	if float64(card.ScheduledDays) < minDays {
+		return prerequisiteResult{
+			reason: fmt.Sprintf("interval %dd below %.1fd", card.ScheduledDays, minDays),
+		}
+	}
+	return prerequisiteResult{ok: true}
+}
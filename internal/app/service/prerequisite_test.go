@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrerequisiteGraph_TransitiveNearestFirst(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	graph := prerequisiteGraph{
+		d: {b, c},
+		b: {a},
+		c: {a},
+		a: {d}, // A cycle must not loop forever
+	}
+
+	refs := graph.transitive(d)
+	require.Len(t, refs, 3)
+	assert.Equal(t, 1, refs[0].depth)
+	assert.Equal(t, 1, refs[1].depth)
+	assert.Equal(t, prerequisiteRef{id: a, depth: 2}, refs[2])
+
+	assert.Empty(t, graph.transitive(uuid.New()))
+}
+
+func TestPrerequisiteGate_Validate(t *testing.T) {
+	assert.NoError(t, PrerequisiteGate{}.Validate())
+	assert.NoError(t, DefaultPrerequisiteGate().Validate())
+	assert.NoError(t, PrerequisiteGate{Mode: GateMastery}.Validate())
+	assert.Error(t, PrerequisiteGate{Mode: GateStability}.Validate())
+	assert.Error(t, PrerequisiteGate{Mode: "strict"}.Validate())
+}
+
+func TestStabilityCheck_NonFSRSUsesInterval(t *testing.T) {
+	// SM-2 and Leitner leave stability at zero
+	card := &ent.FsrsCard{State: fsrscard.StateReview, ScheduledDays: 6}
+	assert.False(t, stabilityCheck(card, SchedulerFSRS, 3).ok)
+	assert.True(t, stabilityCheck(card, SchedulerSM2, 3).ok)
+	assert.True(t, stabilityCheck(card, SchedulerLeitner, 3).ok)
+
+	card.ScheduledDays = 2
+	assert.False(t, stabilityCheck(card, SchedulerLeitner, 3).ok)
+
+	relearning := &ent.FsrsCard{State: fsrscard.StateRelearning, ScheduledDays: 10}
+	assert.False(t, stabilityCheck(relearning, SchedulerSM2, 3).ok)
+
+	fsrs := &ent.FsrsCard{State: fsrscard.StateReview, Stability: 4.2}
+	assert.True(t, stabilityCheck(fsrs, SchedulerFSRS, 3).ok)
+}
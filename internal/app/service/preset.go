@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/schedulerpreset"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPresetName is the name of the preset seeded on first startup
+const DefaultPresetName = "Default"
+
+// PresetSettings is the editable part of a scheduler preset
+type PresetSettings struct {
+	Name      string                `json:"name"`
+	Scheduler SchedulerKind         `json:"scheduler"` // Empty is treated as FSRS
+	FSRS      FSRSConfig            `json:"fsrs"`
+	Leitner   LeitnerConfig         `json:"leitner"`
+	Learning  LearningStepsConfig   `json:"learning"`
+	Leech     LeechConfig           `json:"leech"`
+	Queue     QueuePolicy           `json:"queue"`
+	Implicit  ImplicitCreditConfig  `json:"implicit"`
+	Gate      PrerequisiteGate      `json:"gate"`
+	Errors    ErrorResolutionConfig `json:"errors"`
+
+	BuryTranslations bool `json:"bury_translations"` // Bury translation siblings after a review
+}
+
+// PresetService manages persisted scheduler presets and their inheritance
+type PresetService struct {
+	client *ent.Client
+}
+
+// NewPresetService creates a new PresetService
+func NewPresetService(client *ent.Client) *PresetService {
+	return &PresetService{client: client}
+}
+
+// EnsureDefaultPreset creates the default preset from the built-in configs if none exists
+func (s *PresetService) EnsureDefaultPreset(ctx context.Context) (*ent.SchedulerPreset, error) {
+	existing, err := s.client.SchedulerPreset.Query().
+		Where(schedulerpreset.IsDefault(true)).
+		First(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	settings := PresetSettings{
+		Name:     DefaultPresetName,
+		FSRS:     DefaultFSRSConfig(),
+		Learning: DefaultLearningConfig(),
+		Leech:    DefaultLeechConfig(),
+		Queue:    DefaultQueuePolicy(),
+		Implicit: DefaultImplicitCreditConfig(),
+		Gate:     DefaultPrerequisiteGate(),
+		Errors:   DefaultErrorResolutionConfig(),
+	}
+	return s.createPreset(ctx, settings, true)
+}
+
+// ListPresets returns all presets, default first
+func (s *PresetService) ListPresets(ctx context.Context) ([]*ent.SchedulerPreset, error) {
+	return s.client.SchedulerPreset.Query().
+		Order(
+			ent.Desc(schedulerpreset.FieldIsDefault),
+			ent.Asc(schedulerpreset.FieldName),
+		).
+		All(ctx)
+}
+
+// GetPreset returns a single preset
+func (s *PresetService) GetPreset(ctx context.Context, id uuid.UUID) (*ent.SchedulerPreset, error) {
+	return s.client.SchedulerPreset.Get(ctx, id)
+}
+
+// CreatePreset persists a new, non-default preset
+func (s *PresetService) CreatePreset(ctx context.Context, settings PresetSettings) (*ent.SchedulerPreset, error) {
+	return s.createPreset(ctx, settings, false)
+}
+
+func (s *PresetService) createPreset(ctx context.Context, settings PresetSettings, isDefault bool) (*ent.SchedulerPreset, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	create := s.client.SchedulerPreset.Create().
+		SetIsDefault(isDefault)
+	applySettings(create.Mutation(), settings)
+	return create.Save(ctx)
+}
+
+// UpdatePreset replaces the settings of an existing preset
+func (s *PresetService) UpdatePreset(ctx context.Context, id uuid.UUID, settings PresetSettings) (*ent.SchedulerPreset, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	update := s.client.SchedulerPreset.UpdateOneID(id)
+	applySettings(update.Mutation(), settings)
+	return update.Save(ctx)
+}
+
+// applySettings sets every settings field of a preset being created or updated
+func applySettings(m *ent.SchedulerPresetMutation, settings PresetSettings) {
+	m.SetName(settings.Name)
+	m.SetScheduler(schedulerpreset.Scheduler(settings.scheduler()))
+	m.SetLeitnerIntervals(settings.Leitner.Intervals)
+	m.SetAlgorithmVersion(schedulerpreset.AlgorithmVersion(settings.FSRS.version()))
+	m.SetWeights(settings.FSRS.W)
+	m.SetDesiredRetention(settings.FSRS.DesiredRetention)
+	m.SetMaxInterval(settings.FSRS.MaxInterval)
+	m.SetEnableFuzz(settings.FSRS.EnableFuzz)
+	m.SetEnableLoadBalance(settings.FSRS.EnableLoadBalance)
+	m.SetLearningSteps(settings.Learning.LearningSteps)
+	m.SetRelearningSteps(settings.Learning.RelearningSteps)
+	m.SetGraduatingInterval(settings.Learning.GraduatingInterval)
+	m.SetEasyInterval(settings.Learning.EasyInterval)
+	m.SetLeechThreshold(settings.Leech.Threshold)
+	m.SetLeechSuspend(settings.Leech.Suspend)
+	m.SetBuryTranslations(settings.BuryTranslations)
+	m.SetImplicitCredit(settings.Implicit.Credit)
+	m.SetImplicitLapsePull(settings.Implicit.LapsePull)
+	m.SetPrerequisiteGate(schedulerpreset.PrerequisiteGate(settings.Gate.mode()))
+	m.SetPrerequisiteMinStability(settings.Gate.MinStability)
+	m.SetErrorResolveAfter(settings.Errors.ResolveAfter)
+	m.SetNewPerDay(settings.Queue.NewPerDay)
+	m.SetReviewsPerDay(settings.Queue.ReviewsPerDay)
+	m.SetLearnAheadMinutes(settings.Queue.LearnAheadMinutes)
+	m.SetQueueOrder(schedulerpreset.QueueOrder(settings.Queue.order()))
+}
+
+// UpdateWeights stores new FSRS weights (e.g. from the optimizer) on a preset
+func (s *PresetService) UpdateWeights(ctx context.Context, id uuid.UUID, weights []float64) (*ent.SchedulerPreset, error) {
+	preset, err := s.client.SchedulerPreset.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fsrsConfig, _ := PresetConfigs(preset)
+	fsrsConfig.W = weights
+	if err := fsrsConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return preset.Update().
+		SetWeights(weights).
+		Save(ctx)
+}
+
+// UpdateDesiredRetention stores a new retention target (e.g. the computed optimum) on a preset
+func (s *PresetService) UpdateDesiredRetention(ctx context.Context, id uuid.UUID, retention float64) (*ent.SchedulerPreset, error) {
+	if retention <= 0 || retention >= 1 {
+		return nil, fmt.Errorf("desired retention must be between 0 and 1, got %v", retention)
+	}
+	return s.client.SchedulerPreset.UpdateOneID(id).
+		SetDesiredRetention(retention).
+		Save(ctx)
+}
+
+// DeletePreset removes a preset. Nodes assigned to it fall back to their ancestors.
+func (s *PresetService) DeletePreset(ctx context.Context, id uuid.UUID) error {
+	preset, err := s.client.SchedulerPreset.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if preset.IsDefault {
+		return fmt.Errorf("cannot delete the default preset")
+	}
+	return s.client.SchedulerPreset.DeleteOneID(id).Exec(ctx)
+}
+
+// AssignPreset attaches a preset to a subject or topic.
+// A nil presetID clears the assignment so the node inherits again.
+func (s *PresetService) AssignPreset(ctx context.Context, nodeID uuid.UUID, presetID *uuid.UUID) error {
+	n, err := s.client.Node.Get(ctx, nodeID)
+	if err != nil {
+		return fmt.Errorf("node not found: %w", err)
+	}
+	if n.Type != node.TypeSubject && n.Type != node.TypeTopic {
+		return fmt.Errorf("presets can only be assigned to subjects or topics, got %s", n.Type)
+	}
+
+	update := s.client.Node.UpdateOneID(nodeID)
+	if presetID == nil {
+		update = update.ClearPresetID()
+	} else {
+		update = update.SetPresetID(*presetID)
+	}
+	return update.Exec(ctx)
+}
+
+// ResolveForNode returns the effective preset for a node: the preset on the
+// nearest ancestor (or the node itself) via the closure table, otherwise the
+// default preset. Returns nil when no presets exist at all.
+func (s *PresetService) ResolveForNode(ctx context.Context, nodeID uuid.UUID) (*ent.SchedulerPreset, error) {
+	closure, err := s.client.NodeClosure.Query().
+		Where(
+			nodeclosure.DescendantID(nodeID),
+			nodeclosure.HasAncestorWith(node.PresetIDNotNil()),
+		).
+		Order(ent.Asc(nodeclosure.FieldDepth)). // Nearest ancestor first
+		WithAncestor(func(q *ent.NodeQuery) {
+			q.WithPreset()
+		}).
+		First(ctx)
+
+	if err == nil && closure.Edges.Ancestor != nil && closure.Edges.Ancestor.Edges.Preset != nil {
+		return closure.Edges.Ancestor.Edges.Preset, nil
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("resolving preset: %w", err)
+	}
+
+	preset, err := s.client.SchedulerPreset.Query().
+		Where(schedulerpreset.IsDefault(true)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return preset, err
+}
+
+// ResolveForNodes resolves the effective preset of many nodes at once.
+// Nodes without an assigned ancestor map to the default preset (nil if none exists).
+func (s *PresetService) ResolveForNodes(ctx context.Context, nodeIDs []uuid.UUID) (map[uuid.UUID]*ent.SchedulerPreset, error) {
+	closures, err := s.client.NodeClosure.Query().
+		Where(
+			nodeclosure.DescendantIDIn(nodeIDs...),
+			nodeclosure.HasAncestorWith(node.PresetIDNotNil()),
+		).
+		Order(ent.Asc(nodeclosure.FieldDepth)). // Nearest ancestor first
+		WithAncestor(func(q *ent.NodeQuery) {
+			q.WithPreset()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resolving presets: %w", err)
+	}
+
+	resolved := make(map[uuid.UUID]*ent.SchedulerPreset, len(nodeIDs))
+	for _, c := range closures {
+		if _, seen := resolved[c.DescendantID]; seen {
+			continue
+		}
+		if c.Edges.Ancestor != nil && c.Edges.Ancestor.Edges.Preset != nil {
+			resolved[c.DescendantID] = c.Edges.Ancestor.Edges.Preset
+		}
+	}
+
+	if len(resolved) < len(nodeIDs) {
+		fallback, err := s.client.SchedulerPreset.Query().
+			Where(schedulerpreset.IsDefault(true)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		for _, id := range nodeIDs {
+			if _, ok := resolved[id]; !ok {
+				resolved[id] = fallback
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// PresetConfigs converts a stored preset into service configs
+func PresetConfigs(p *ent.SchedulerPreset) (FSRSConfig, LearningStepsConfig) {
+	fsrsConfig := FSRSConfig{
+		Version:          FSRSVersion(p.AlgorithmVersion),
+		DesiredRetention: p.DesiredRetention,
+		MaxInterval:      p.MaxInterval,
+		W:                p.Weights,
+
+		EnableFuzz:        p.EnableFuzz,
+		EnableLoadBalance: p.EnableLoadBalance,
+	}
+	learningConfig := LearningStepsConfig{
+		LearningSteps:      p.LearningSteps,
+		RelearningSteps:    p.RelearningSteps,
+		GraduatingInterval: p.GraduatingInterval,
+		EasyInterval:       p.EasyInterval,
+	}
+	return fsrsConfig, learningConfig
+}
+
+// PresetQueuePolicy converts a stored preset's queue settings
+func PresetQueuePolicy(p *ent.SchedulerPreset) QueuePolicy {
+	return QueuePolicy{
+		NewPerDay:         p.NewPerDay,
+		ReviewsPerDay:     p.ReviewsPerDay,
+		LearnAheadMinutes: p.LearnAheadMinutes,
+		Order:             QueueOrder(p.QueueOrder),
+	}
+}
+
+// PresetScheduler returns the scheduler a stored preset selects, with its Leitner boxes
+func PresetScheduler(p *ent.SchedulerPreset) (SchedulerKind, LeitnerConfig) {
+	kind, err := ParseSchedulerKind(string(p.Scheduler))
+	if err != nil {
+		kind = SchedulerFSRS
+	}
+	return kind, LeitnerConfig{Intervals: p.LeitnerIntervals}
+}
+
+// PresetImplicitCreditConfig converts a stored preset's implicit credit settings
+func PresetImplicitCreditConfig(p *ent.SchedulerPreset) ImplicitCreditConfig {
+	return ImplicitCreditConfig{
+		Credit:    p.ImplicitCredit,
+		LapsePull: p.ImplicitLapsePull,
+	}
+}
+
+// PresetPrerequisiteGate converts a stored preset's prerequisite gate
+func PresetPrerequisiteGate(p *ent.SchedulerPreset) PrerequisiteGate {
+	return PrerequisiteGate{
+		Mode:         GateMode(p.PrerequisiteGate),
+		MinStability: p.PrerequisiteMinStability,
+	}
+}
+
+// PresetErrorResolutionConfig converts a stored preset's error resolution settings
+func PresetErrorResolutionConfig(p *ent.SchedulerPreset) ErrorResolutionConfig {
+	return ErrorResolutionConfig{ResolveAfter: p.ErrorResolveAfter}
+}
+
+// PresetLeechConfig converts a stored preset's leech settings
+func PresetLeechConfig(p *ent.SchedulerPreset) LeechConfig {
+	return LeechConfig{
+		Threshold: p.LeechThreshold,
+		Suspend:   p.LeechSuspend,
+	}
+}
+
+// scheduler returns the selected scheduler, defaulting to FSRS when unset
+func (p PresetSettings) scheduler() SchedulerKind {
+	if p.Scheduler == "" {
+		return SchedulerFSRS
+	}
+	return p.Scheduler
+}
+
+// Validate checks the settings can be scheduled with
+func (p PresetSettings) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("preset name is required")
+	}
+	if _, err := ParseSchedulerKind(string(p.Scheduler)); err != nil {
+		return err
+	}
+	if len(p.Leitner.Intervals) > 0 { // Empty uses the default boxes
+		if err := p.Leitner.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := p.FSRS.Validate(); err != nil {
+		return err
+	}
+	if p.FSRS.DesiredRetention <= 0 || p.FSRS.DesiredRetention >= 1 {
+		return fmt.Errorf("desired retention must be between 0 and 1, got %v", p.FSRS.DesiredRetention)
+	}
+	if p.FSRS.MaxInterval < 1 {
+		return fmt.Errorf("max interval must be at least 1 day")
+	}
+	if len(p.Learning.LearningSteps) == 0 || len(p.Learning.RelearningSteps) == 0 {
+		return fmt.Errorf("learning and relearning steps must not be empty")
+	}
+	if p.Learning.GraduatingInterval < 1 || p.Learning.EasyInterval < 1 {
+		return fmt.Errorf("graduating and easy intervals must be at least 1 day")
+	}
+	if p.Leech.Threshold < 0 {
+		return fmt.Errorf("leech threshold must not be negative")
+	}
+	if err := p.Queue.Validate(); err != nil {
+		return err
+	}
+	if err := p.Implicit.Validate(); err != nil {
+		return err
+	}
+	if err := p.Gate.Validate(); err != nil {
+		return err
+	}
+	if err := p.Errors.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/hooks"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresetService_InheritsDownClosure(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	svc := service.NewPresetService(client)
+
+	defaultPreset, err := svc.EnsureDefaultPreset(ctx)
+	require.NoError(t, err)
+
+	settings := service.PresetSettings{
+		Name:     "Exam Cram",
+		FSRS:     service.DefaultFSRSConfig(),
+		Learning: service.DefaultLearningConfig(),
+	}
+	settings.FSRS.DesiredRetention = 0.95
+	examPreset, err := svc.CreatePreset(ctx, settings)
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Math").SaveX(ctx)
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Algebra").SetParentID(subject.ID).SaveX(ctx)
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Solve").SetParentID(topic.ID).SaveX(ctx)
+
+	// No assignment: falls back to default
+	resolved, err := svc.ResolveForNode(ctx, problem.ID)
+	require.NoError(t, err)
+	assert.Equal(t, defaultPreset.ID, resolved.ID)
+
+	// Subject assignment is inherited by the problem
+	require.NoError(t, svc.AssignPreset(ctx, subject.ID, &examPreset.ID))
+	resolved, err = svc.ResolveForNode(ctx, problem.ID)
+	require.NoError(t, err)
+	assert.Equal(t, examPreset.ID, resolved.ID)
+
+	// Topic assignment is nearer and wins
+	require.NoError(t, svc.AssignPreset(ctx, topic.ID, &defaultPreset.ID))
+	resolved, err = svc.ResolveForNode(ctx, problem.ID)
+	require.NoError(t, err)
+	assert.Equal(t, defaultPreset.ID, resolved.ID)
+
+	// Presets cannot be attached to leaf nodes
+	assert.Error(t, svc.AssignPreset(ctx, problem.ID, &examPreset.ID))
+}
+
+func TestReviewCoordinator_UsesEffectivePreset(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	presets := service.NewPresetService(client)
+	settings := service.PresetSettings{
+		Name:     "Single Step",
+		FSRS:     service.DefaultFSRSConfig(),
+		Learning: service.DefaultLearningConfig(),
+	}
+	settings.Learning.LearningSteps = []int{15}
+	preset, err := presets.CreatePreset(ctx, settings)
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Lang").SaveX(ctx)
+	require.NoError(t, presets.AssignPreset(ctx, subject.ID, &preset.ID))
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Word").SetParentID(subject.ID).SaveX(ctx)
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+
+	// With a single learning step, Good graduates immediately
+	result, err := coordinator.ProcessReview(ctx, problem.ID, 3)
+	require.NoError(t, err)
+	assert.True(t, result.Graduated)
+
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(problem.ID)).OnlyX(ctx)
+	assert.Equal(t, fsrscard.StateReview, card.State)
+}
+
+func TestReviewCoordinator_UsesPresetScheduler(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	presets := service.NewPresetService(client)
+	settings := service.PresetSettings{
+		Name:      "Boxes",
+		Scheduler: service.SchedulerLeitner,
+		FSRS:      service.DefaultFSRSConfig(),
+		Leitner:   service.LeitnerConfig{Intervals: []int{1, 3, 7}},
+		Learning:  service.DefaultLearningConfig(),
+	}
+	settings.Learning.LearningSteps = []int{15}
+	preset, err := presets.CreatePreset(ctx, settings)
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Geo").SaveX(ctx)
+	require.NoError(t, presets.AssignPreset(ctx, subject.ID, &preset.ID))
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Capitals").SetParentID(subject.ID).SaveX(ctx)
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+	submit := func(grade int) (*service.ReviewResult, *attempt.Scheduler) {
+		result, recorded, err := coordinator.SubmitReview(ctx, service.ReviewSubmission{
+			NodeID: problem.ID,
+			Grade:  grade,
+		})
+		require.NoError(t, err)
+		return result, recorded.Scheduler
+	}
+	makeDue := func() {
+		client.FsrsCard.Update().
+			Where(fsrscard.NodeID(problem.ID)).
+			SetDue(time.Now().Add(-time.Hour)).
+			ExecX(ctx)
+	}
+
+	// Graduating hands the card to Leitner's first box
+	result, recorded := submit(3)
+	assert.True(t, result.Graduated)
+	assert.Equal(t, service.SchedulerLeitner, result.Scheduler)
+	require.NotNil(t, recorded)
+	assert.Equal(t, attempt.SchedulerLeitner, *recorded)
+
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(problem.ID)).OnlyX(ctx)
+	assert.Equal(t, 0, card.LeitnerBox)
+	assert.Equal(t, 1, card.ScheduledDays)
+
+	// Good moves it up a box
+	makeDue()
+	submit(3)
+	card = client.FsrsCard.Query().Where(fsrscard.NodeID(problem.ID)).OnlyX(ctx)
+	assert.Equal(t, 1, card.LeitnerBox)
+	assert.Equal(t, 3, card.ScheduledDays)
+
+	// Again drops it to the first box and relearning steps take over
+	makeDue()
+	result, _ = submit(1)
+	assert.Equal(t, service.StateRelearning, result.CardState)
+	card = client.FsrsCard.Query().Where(fsrscard.NodeID(problem.ID)).OnlyX(ctx)
+	assert.Equal(t, 0, card.LeitnerBox)
+	assert.Equal(t, 1, card.Lapses)
+
+	result, recorded = submit(1)
+	assert.Equal(t, service.SchedulerLearningSteps, result.Scheduler)
+	assert.Equal(t, attempt.SchedulerLearningSteps, *recorded)
+}
+
+func TestPresetSettings_Validate(t *testing.T) {
+	settings := service.PresetSettings{
+		Name:     "Broken",
+		FSRS:     service.DefaultFSRSConfig(),
+		Learning: service.DefaultLearningConfig(),
+	}
+	require.NoError(t, settings.Validate())
+
+	settings.FSRS.DesiredRetention = 1.2
+	assert.Error(t, settings.Validate())
+
+	settings.FSRS.DesiredRetention = 0.9
+	settings.FSRS.W = settings.FSRS.W[:10]
+	assert.Error(t, settings.Validate())
+
+	settings.FSRS.W = service.DefaultFSRSConfig().W
+	settings.Learning.LearningSteps = nil
+	assert.Error(t, settings.Validate())
+
+	settings.Learning = service.DefaultLearningConfig()
+	settings.Scheduler = "anki"
+	assert.Error(t, settings.Validate())
+
+	settings.Scheduler = service.SchedulerLeitner
+	settings.Leitner.Intervals = []int{1, 0}
+	assert.Error(t, settings.Validate())
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"profen/internal/data/ent/fsrscard"
+
+	"github.com/google/uuid"
+)
+
+// QueueOrder controls how new cards are interleaved with reviews
+type QueueOrder string
+
+const (
+	QueueOrderMixed        QueueOrder = "mixed"         // New cards spread evenly between reviews
+	QueueOrderNewFirst     QueueOrder = "new_first"     // All new cards before reviews
+	QueueOrderReviewsFirst QueueOrder = "reviews_first" // All reviews before new cards
+)
+
+// QueuePolicy controls how a study session is assembled
+type QueuePolicy struct {
+	NewPerDay         int        `json:"new_per_day"`         // New cards introduced per day
+	ReviewsPerDay     int        `json:"reviews_per_day"`     // Review cards shown per day
+	LearnAheadMinutes int        `json:"learn_ahead_minutes"` // Show learning cards due this soon
+	Order             QueueOrder `json:"order"`
+}
+
+// DefaultQueuePolicy returns Anki-like defaults
+func DefaultQueuePolicy() QueuePolicy {
+	return QueuePolicy{
+		NewPerDay:         20,
+		ReviewsPerDay:     200,
+		LearnAheadMinutes: 20,
+		Order:             QueueOrderMixed,
+	}
+}
+
+// order returns the interleaving, defaulting to mixed when unset
+func (p QueuePolicy) order() QueueOrder {
+	if p.Order == "" {
+		return QueueOrderMixed
+	}
+	return p.Order
+}
+
+// learnAhead returns the learn-ahead window as a duration
+func (p QueuePolicy) learnAhead() time.Duration {
+	return time.Duration(p.LearnAheadMinutes) * time.Minute
+}
+
+// Validate checks the limits are usable
+func (p QueuePolicy) Validate() error {
+	if p.NewPerDay < 0 || p.ReviewsPerDay < 0 || p.LearnAheadMinutes < 0 {
+		return fmt.Errorf("daily limits and learn-ahead must not be negative")
+	}
+	switch p.order() {
+	case QueueOrderMixed, QueueOrderNewFirst, QueueOrderReviewsFirst:
+		return nil
+	default:
+		return fmt.Errorf("unknown queue order %q", p.Order)
+	}
+}
+
+// queueCard is a candidate for the study queue
+type queueCard struct {
+	nodeID uuid.UUID
+	state  fsrscard.State
+	due    time.Time
+	preset uuid.UUID // uuid.Nil when no presets exist
+}
+
+// dailyUsage counts what was already studied today under one preset
+type dailyUsage struct {
+	newCards int
+	reviews  int
+}
+
+// assembleQueue applies the policy to due candidates (sorted by due date):
+// learning cards inside the learn-ahead window first, then new cards and
+// reviews within each preset's remaining daily budget, interleaved by order.
+func assembleQueue(
+	candidates []queueCard,
+	policies map[uuid.UUID]QueuePolicy,
+	usage map[uuid.UUID]dailyUsage,
+	order QueueOrder,
+	now time.Time,
+	limit int,
+) []uuid.UUID {
+	var learning, reviews, newCards []uuid.UUID
+	remaining := make(map[uuid.UUID]dailyUsage, len(policies))
+	for id, policy := range policies {
+		used := usage[id]
+		remaining[id] = dailyUsage{
+			newCards: policy.NewPerDay - used.newCards,
+			reviews:  policy.ReviewsPerDay - used.reviews,
+		}
+	}
+
+	for _, c := range candidates {
+		policy := policies[c.preset]
+		left := remaining[c.preset]
+
+		switch c.state {
+		case fsrscard.StateLearning, fsrscard.StateRelearning:
+			if !c.due.After(now.Add(policy.learnAhead())) {
+				learning = append(learning, c.nodeID)
+			}
+		case fsrscard.StateNew:
+			if left.newCards > 0 {
+				newCards = append(newCards, c.nodeID)
+				left.newCards--
+			}
+		default:
+			if left.reviews > 0 {
+				reviews = append(reviews, c.nodeID)
+				left.reviews--
+			}
+		}
+		remaining[c.preset] = left
+	}
+
+	queue := append([]uuid.UUID{}, learning...)
+	switch order {
+	case QueueOrderNewFirst:
+		queue = append(append(queue, newCards...), reviews...)
+	case QueueOrderReviewsFirst:
+		queue = append(append(queue, reviews...), newCards...)
+	default:
+		queue = append(queue, interleave(reviews, newCards)...)
+	}
+
+	if limit > 0 && len(queue) > limit {
+		queue = queue[:limit]
+	}
+	return queue
+}
+
+// interleave spreads the minor list evenly through the major one
+func interleave(major, minor []uuid.UUID) []uuid.UUID {
+	if len(minor) > len(major) {
+		major, minor = minor, major
+	}
+	if len(minor) == 0 {
+		return append([]uuid.UUID{}, major...)
+	}
+
+	out := make([]uuid.UUID, 0, len(major)+len(minor))
+	every := float64(len(major)+len(minor)) / float64(len(minor))
+	next := every / 2
+	mi, ni := 0, 0
+	for i := 0; i < len(major)+len(minor); i++ {
+		if ni < len(minor) && (float64(i) >= next || mi == len(major)) {
+			out = append(out, minor[ni])
+			ni++
+			next += every
+			continue
+		}
+		out = append(out, major[mi])
+		mi++
+	}
+	return out
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/data/ent/fsrscard"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssembleQueue_LimitsAndLearnAhead(t *testing.T) {
+	now := time.Now()
+	preset := uuid.New()
+	policies := map[uuid.UUID]QueuePolicy{
+		uuid.Nil: DefaultQueuePolicy(),
+		preset:   {NewPerDay: 2, ReviewsPerDay: 3, LearnAheadMinutes: 10, Order: QueueOrderReviewsFirst},
+	}
+	usage := map[uuid.UUID]dailyUsage{preset: {newCards: 1, reviews: 1}}
+
+	var candidates []queueCard
+	add := func(state fsrscard.State, due time.Time) uuid.UUID {
+		id := uuid.New()
+		candidates = append(candidates, queueCard{nodeID: id, state: state, due: due, preset: preset})
+		return id
+	}
+	soon := add(fsrscard.StateLearning, now.Add(5*time.Minute))
+	add(fsrscard.StateRelearning, now.Add(30*time.Minute)) // Outside learn-ahead
+	r1 := add(fsrscard.StateReview, now.Add(-2*time.Hour))
+	r2 := add(fsrscard.StateReview, now.Add(-time.Hour))
+	add(fsrscard.StateReview, now.Add(-time.Minute)) // Over the review limit
+	n1 := add(fsrscard.StateNew, now)
+	add(fsrscard.StateNew, now) // Over the new limit
+
+	queue := assembleQueue(candidates, policies, usage, QueueOrderReviewsFirst, now, 0)
+	assert.Equal(t, []uuid.UUID{soon, r1, r2, n1}, queue)
+
+	queue = assembleQueue(candidates, policies, usage, QueueOrderNewFirst, now, 2)
+	assert.Equal(t, []uuid.UUID{soon, n1}, queue)
+}
+
+func TestInterleave_SpreadsMinorEvenly(t *testing.T) {
+	ids := func(n int) []uuid.UUID {
+		out := make([]uuid.UUID, n)
+		for i := range out {
+			out[i] = uuid.New()
+		}
+		return out
+	}
+	reviews := ids(4)
+	newCards := ids(2)
+
+	mixed := interleave(reviews, newCards)
+	assert.Equal(t, []uuid.UUID{reviews[0], reviews[1], newCards[0], reviews[2], reviews[3], newCards[1]}, mixed)
+
+	assert.Equal(t, reviews, interleave(reviews, nil))
+	assert.Len(t, interleave(newCards, reviews), 6)
+}
+
+func TestQueuePolicy_Validate(t *testing.T) {
+	assert.NoError(t, DefaultQueuePolicy().Validate())
+	assert.NoError(t, QueuePolicy{}.Validate(), "zero limits pause new cards and reviews")
+	assert.Error(t, QueuePolicy{NewPerDay: -1}.Validate())
+	assert.Error(t, QueuePolicy{Order: "random"}.Validate())
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
+	"profen/internal/data/ent/nodeclosure"
+
+	"github.com/google/uuid"
+)
+
+// ErrAttemptNotFailed is returned when remediation is asked for a correct answer
+var ErrAttemptNotFailed = errors.New("attempt was answered correctly")
+
+// RemediationStep is one node to revisit after a failure
+type RemediationStep struct {
+	NodeID   uuid.UUID `json:"node_id"`
+	Title    string    `json:"title"`
+	Type     string    `json:"type"`
+	Relation string    `json:"relation"` // tests, ancestor, comes_before, similar_to or variant_of
+	Reason   string    `json:"reason"`
+}
+
+// RemediationPlan is the ordered mini-session for a failed attempt
+type RemediationPlan struct {
+	AttemptID uuid.UUID         `json:"attempt_id"`
+	NodeID    uuid.UUID         `json:"node_id"`
+	Steps     []RemediationStep `json:"steps"`
+	Queue     []string          `json:"queue,omitempty"` // The study queue with the steps in front, when one was given
+}
+
+// RemediationService builds the chain of nodes to revisit after a failed problem
+type RemediationService struct {
+	client *ent.Client
+}
+
+// NewRemediationService creates a new RemediationService
+func NewRemediationService(client *ent.Client) *RemediationService {
+	return &RemediationService{client: client}
+}
+
+// ForAttempt plans a remediation for a failed review: the theories the problem
+// tests (or, without tests links, the theories of its nearest ancestor), their
+// comes_before prerequisites nearest first, then similar and variant nodes.
+// Only unsuspended cards are included; limit <= 0 keeps every step. A non-nil
+// queue comes back with the steps inserted at its front.
+func (s *RemediationService) ForAttempt(
+	ctx context.Context,
+	attemptID uuid.UUID,
+	limit int,
+	queue []string,
+) (*RemediationPlan, error) {
+	a, err := s.client.Attempt.Query().
+		Where(attempt.ID(attemptID), attempt.KindEQ(attempt.KindReview)).
+		WithCard().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading attempt: %w", err)
+	}
+	if a.IsCorrect {
+		return nil, ErrAttemptNotFailed
+	}
+
+	nodeID := a.Edges.Card.NodeID
+	steps, err := s.chain(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(steps) > limit {
+		steps = steps[:limit]
+	}
+
+	plan := &RemediationPlan{AttemptID: attemptID, NodeID: nodeID, Steps: steps}
+	if queue != nil {
+		plan.Queue = insertRemediation(queue, steps)
+	}
+	return plan, nil
+}
+
+// chain collects the steps in remediation order, each node once
+func (s *RemediationService) chain(ctx context.Context, nodeID uuid.UUID) ([]RemediationStep, error) {
+	failed, err := s.client.Node.Get(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []RemediationStep
+	seen := map[uuid.UUID]bool{nodeID: true}
+	add := func(n *ent.Node, relation, reason string) {
+		if seen[n.ID] {
+			return
+		}
+		seen[n.ID] = true
+		steps = append(steps, RemediationStep{
+			NodeID:   n.ID,
+			Title:    n.Title,
+			Type:     string(n.Type),
+			Relation: relation,
+			Reason:   reason,
+		})
+	}
+
+	// 1. The theory behind the problem
+	theories, err := s.client.Node.Query().
+		Where(data.TestedBy(nodeID)).
+		Order(ent.Asc(node.FieldTitle)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading tested theories: %w", err)
+	}
+	for _, t := range theories {
+		add(t, string(nodeassociation.RelTypeTests), fmt.Sprintf("%q tests it", failed.Title))
+	}
+	if len(theories) == 0 {
+		ancestor, nearby, err := s.nearestTheories(ctx, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range nearby {
+			add(t, "ancestor", fmt.Sprintf("theory in %q", ancestor.Title))
+		}
+		theories = nearby
+	}
+
+	// 2. What the problem and its theories build on, nearest first
+	graph, err := NewPrerequisiteService(s.client).loadGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roots := []uuid.UUID{nodeID}
+	for _, t := range theories {
+		roots = append(roots, t.ID)
+	}
+	for _, ref := range graph.transitiveFrom(roots) {
+		prereq, err := s.client.Node.Get(ctx, ref.id)
+		if err != nil {
+			return nil, err
+		}
+		reason := fmt.Sprintf("prerequisite, %d steps back", ref.depth)
+		if ref.depth == 1 {
+			reason = "direct prerequisite"
+		}
+		if prereq.Type == node.TypeTheory || prereq.Type == node.TypeProblem || prereq.Type == node.TypeTerm {
+			add(prereq, string(nodeassociation.RelTypeComesBefore), reason)
+			continue
+		}
+		// A container prerequisite is revisited through its theories
+		under, err := s.client.Node.Query().
+			Where(
+				node.TypeEQ(node.TypeTheory),
+				node.HasParentClosuresWith(nodeclosure.AncestorID(prereq.ID)),
+			).
+			Order(ent.Asc(node.FieldTitle)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range under {
+			add(t, string(nodeassociation.RelTypeComesBefore), fmt.Sprintf("%s (%q)", reason, prereq.Title))
+		}
+	}
+
+	// 3. Another go at the same idea
+	links, err := s.client.NodeAssociation.Query().
+		Where(
+			nodeassociation.Or(
+				nodeassociation.SourceID(nodeID),
+				nodeassociation.TargetID(nodeID),
+			),
+			nodeassociation.RelTypeIn(
+				nodeassociation.RelTypeSimilarTo,
+				nodeassociation.RelTypeVariantOf,
+				nodeassociation.RelTypeSourceVariant,
+			),
+		).
+		WithSource().
+		WithTarget().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading similar nodes: %w", err)
+	}
+	sort.SliceStable(links, func(i, j int) bool {
+		// similar_to before variants, then by title
+		si, sj := links[i].RelType == nodeassociation.RelTypeSimilarTo, links[j].RelType == nodeassociation.RelTypeSimilarTo
+		if si != sj {
+			return si
+		}
+		return otherEnd(links[i], nodeID).Title < otherEnd(links[j], nodeID).Title
+	})
+	for _, l := range links {
+		relation := string(nodeassociation.RelTypeVariantOf)
+		if l.RelType == nodeassociation.RelTypeSimilarTo {
+			relation = string(nodeassociation.RelTypeSimilarTo)
+		}
+		add(otherEnd(l, nodeID), relation, fmt.Sprintf("%s %q", relation, failed.Title))
+	}
+
+	return s.studiable(ctx, steps)
+}
+
+// nearestTheories returns the theories under the closest ancestor that has any
+func (s *RemediationService) nearestTheories(ctx context.Context, nodeID uuid.UUID) (*ent.Node, []*ent.Node, error) {
+	closures, err := s.client.NodeClosure.Query().
+		Where(
+			nodeclosure.DescendantID(nodeID),
+			nodeclosure.DepthGT(0),
+		).
+		Order(ent.Asc(nodeclosure.FieldDepth)).
+		WithAncestor().
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading ancestors: %w", err)
+	}
+
+	for _, c := range closures {
+		theories, err := s.client.Node.Query().
+			Where(
+				node.TypeEQ(node.TypeTheory),
+				node.HasParentClosuresWith(nodeclosure.AncestorID(c.AncestorID)),
+			).
+			Order(ent.Asc(node.FieldTitle)).
+			All(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(theories) > 0 {
+			return c.Edges.Ancestor, theories, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// studiable drops steps without an unsuspended card, keeping the order
+func (s *RemediationService) studiable(ctx context.Context, steps []RemediationStep) ([]RemediationStep, error) {
+	ids := make([]uuid.UUID, len(steps))
+	for i, step := range steps {
+		ids[i] = step.NodeID
+	}
+	cards, err := s.client.FsrsCard.Query().
+		Where(
+			fsrscard.NodeIDIn(ids...),
+			fsrscard.IsSuspended(false),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading remediation cards: %w", err)
+	}
+	active := make(map[uuid.UUID]bool, len(cards))
+	for _, c := range cards {
+		active[c.NodeID] = true
+	}
+
+	out := make([]RemediationStep, 0, len(steps))
+	for _, step := range steps {
+		if active[step.NodeID] {
+			out = append(out, step)
+		}
+	}
+	return out, nil
+}
+
+// otherEnd returns the node on the far side of a loaded association
+func otherEnd(l *ent.NodeAssociation, nodeID uuid.UUID) *ent.Node {
+	if l.SourceID == nodeID {
+		return l.Edges.Target
+	}
+	return l.Edges.Source
+}
+
+// insertRemediation puts the steps at the front of the study queue, removing
+// their later occurrences so no card is shown twice
+func insertRemediation(queue []string, steps []RemediationStep) []string {
+	out := make([]string, 0, len(queue)+len(steps))
+	seen := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		id := step.NodeID.String()
+		seen[id] = true
+		out = append(out, id)
+	}
+	for _, id := range queue {
+		if !seen[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"testing"
+
+	"profen/internal/data"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertRemediation_MovesStepsToFront(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	steps := []RemediationStep{{NodeID: c}, {NodeID: d}}
+
+	queue := insertRemediation([]string{a.String(), c.String(), b.String()}, steps)
+	assert.Equal(t, []string{c.String(), d.String(), a.String(), b.String()}, queue)
+
+	assert.Equal(t, []string{a.String()}, insertRemediation([]string{a.String()}, nil))
+}
+
+func TestRemediationService_ChainOrder(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Maths").SaveX(ctx)
+	algebra := client.Node.Create().SetType(node.TypeTopic).SetTitle("Algebra").SetParentID(subject.ID).SaveX(ctx)
+	fractions := client.Node.Create().SetType(node.TypeTheory).SetTitle("Fractions").SetParentID(algebra.ID).SaveX(ctx)
+	equations := client.Node.Create().SetType(node.TypeTheory).SetTitle("Linear equations").SetParentID(algebra.ID).SaveX(ctx)
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Solve 2x/3 = 4").SetParentID(algebra.ID).SaveX(ctx)
+	similar := client.Node.Create().SetType(node.TypeProblem).SetTitle("Solve x/5 = 2").SetParentID(algebra.ID).SaveX(ctx)
+	variant := client.Node.Create().SetType(node.TypeProblem).SetTitle("Solve 3x/4 = 6").SetParentID(algebra.ID).SaveX(ctx)
+	suspended := client.Node.Create().SetType(node.TypeProblem).SetTitle("Solve x/2 = 1").SetParentID(algebra.ID).SaveX(ctx)
+
+	nodes := data.NewNodeRepository(client)
+	require.NoError(t, nodes.CreateAssociation(ctx, problem.ID, equations.ID, nodeassociation.RelTypeTests))
+	require.NoError(t, nodes.CreateAssociation(ctx, fractions.ID, equations.ID, nodeassociation.RelTypeComesBefore))
+	require.NoError(t, nodes.CreateAssociation(ctx, problem.ID, similar.ID, nodeassociation.RelTypeSimilarTo))
+	require.NoError(t, nodes.CreateAssociation(ctx, problem.ID, variant.ID, nodeassociation.RelTypeVariantOf))
+	require.NoError(t, nodes.CreateAssociation(ctx, problem.ID, suspended.ID, nodeassociation.RelTypeSimilarTo))
+	client.FsrsCard.Update().Where(fsrscard.NodeID(suspended.ID)).SetIsSuspended(true).ExecX(ctx)
+
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(problem.ID)).OnlyX(ctx)
+	record := func(rating int) uuid.UUID {
+		return client.Attempt.Create().
+			SetCardID(card.ID).
+			SetRating(rating).
+			SetState(attempt.StateReview).
+			SetStability(5).
+			SetDifficulty(5).
+			SetIsCorrect(rating >= 3).
+			SaveX(ctx).ID
+	}
+
+	svc := NewRemediationService(client)
+	_, err := svc.ForAttempt(ctx, record(3), 0, nil)
+	assert.ErrorIs(t, err, ErrAttemptNotFailed)
+
+	failed := record(1)
+	plan, err := svc.ForAttempt(ctx, failed, 0, []string{similar.ID.String(), fractions.ID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, problem.ID, plan.NodeID)
+
+	var order []uuid.UUID
+	var relations []string
+	for _, step := range plan.Steps {
+		order = append(order, step.NodeID)
+		relations = append(relations, step.Relation)
+	}
+	assert.Equal(t, []uuid.UUID{equations.ID, fractions.ID, similar.ID, variant.ID}, order)
+	assert.Equal(t, []string{"tests", "comes_before", "similar_to", "variant_of"}, relations)
+	assert.Equal(t, []string{
+		equations.ID.String(), fractions.ID.String(), similar.ID.String(), variant.ID.String(),
+	}, plan.Queue)
+
+	plan, err = svc.ForAttempt(ctx, failed, 2, nil)
+	require.NoError(t, err)
+	assert.Len(t, plan.Steps, 2)
+	assert.Nil(t, plan.Queue)
+
+	// Without a tests link, the theories of the topic stand in
+	orphan := client.Node.Create().SetType(node.TypeProblem).SetTitle("Solve x+1 = 2").SetParentID(algebra.ID).SaveX(ctx)
+	orphanCard := client.FsrsCard.Query().Where(fsrscard.NodeID(orphan.ID)).OnlyX(ctx)
+	orphanFail := client.Attempt.Create().
+		SetCardID(orphanCard.ID).
+		SetRating(1).
+		SetState(attempt.StateNew).
+		SetStability(0).
+		SetDifficulty(0).
+		SetIsCorrect(false).
+		SaveX(ctx)
+	plan, err = svc.ForAttempt(ctx, orphanFail.ID, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, plan.Steps, 2)
+	assert.Equal(t, fractions.ID, plan.Steps[0].NodeID)
+	assert.Equal(t, "ancestor", plan.Steps[0].Relation)
+	assert.Equal(t, equations.ID, plan.Steps[1].NodeID)
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeclosure"
+
+	"github.com/google/uuid"
+)
+
+// CardSnapshot is the scheduling state of a card at one point in time
+type CardSnapshot struct {
+	State       string     `json:"state"`
+	Stability   float64    `json:"stability"`
+	Difficulty  float64    `json:"difficulty"`
+	Reps        int        `json:"reps"`
+	Lapses      int        `json:"lapses"`
+	CurrentStep int        `json:"current_step"`
+	EaseFactor  float64    `json:"ease_factor"`
+	LeitnerBox  int        `json:"leitner_box"`
+	Due         time.Time  `json:"due"`
+	LastReview  *time.Time `json:"last_review,omitempty"`
+}
+
+// CardDiff compares a card before and after replaying its revlog
+type CardDiff struct {
+	CardID   uuid.UUID    `json:"card_id"`
+	NodeID   uuid.UUID    `json:"node_id"`
+	Attempts int          `json:"attempts"`
+	Before   CardSnapshot `json:"before"`
+	After    CardSnapshot `json:"after"`
+}
+
+// ReplayReport summarizes a replay. Diffs only lists cards that changed.
+type ReplayReport struct {
+	DryRun        bool       `json:"dry_run"`
+	CardsReplayed int        `json:"cards_replayed"`
+	CardsChanged  int        `json:"cards_changed"`
+	Diffs         []CardDiff `json:"diffs"`
+}
+
+// ReplayService rebuilds card state by feeding the attempt revlog back
+// through the learning steps and FSRS services with historical timestamps
+type ReplayService struct {
+	learningService *LearningStepsService // Fallback when no preset exists
+	fsrsService     *FSRSService          // Fallback when no preset exists
+	client          *ent.Client
+}
+
+// NewReplayService creates a replay engine
+func NewReplayService(
+	learningService *LearningStepsService,
+	fsrsService *FSRSService,
+	client *ent.Client,
+) *ReplayService {
+	return &ReplayService{
+		learningService: learningService,
+		fsrsService:     fsrsService,
+		client:          client,
+	}
+}
+
+// ReplayNode rebuilds the card of a single node
+func (s *ReplayService) ReplayNode(ctx context.Context, nodeID uuid.UUID, dryRun bool) (*ReplayReport, error) {
+	return s.run(ctx, dryRun, func(tx *ent.Tx) ([]uuid.UUID, error) {
+		return tx.FsrsCard.Query().
+			Where(fsrscard.NodeID(nodeID)).
+			IDs(ctx)
+	})
+}
+
+// ReplaySubtree rebuilds every card under (and including) a node
+func (s *ReplayService) ReplaySubtree(ctx context.Context, rootID uuid.UUID, dryRun bool) (*ReplayReport, error) {
+	return s.run(ctx, dryRun, func(tx *ent.Tx) ([]uuid.UUID, error) {
+		return tx.FsrsCard.Query().
+			Where(fsrscard.HasNodeWith(
+				node.HasParentClosuresWith(nodeclosure.AncestorID(rootID)),
+			)).
+			IDs(ctx)
+	})
+}
+
+// ReplayLibrary rebuilds every card
+func (s *ReplayService) ReplayLibrary(ctx context.Context, dryRun bool) (*ReplayReport, error) {
+	return s.run(ctx, dryRun, func(tx *ent.Tx) ([]uuid.UUID, error) {
+		return tx.FsrsCard.Query().IDs(ctx)
+	})
+}
+
+// EditAttempt changes the rating of a historical attempt and re-derives its card
+func (s *ReplayService) EditAttempt(ctx context.Context, attemptID uuid.UUID, rating int, dryRun bool) (*ReplayReport, error) {
+	if rating < 1 || rating > 4 {
+		return nil, fmt.Errorf("rating must be between 1 and 4, got %d", rating)
+	}
+
+	return s.run(ctx, dryRun, func(tx *ent.Tx) ([]uuid.UUID, error) {
+		a, err := tx.Attempt.Get(ctx, attemptID)
+		if err != nil {
+			return nil, fmt.Errorf("attempt not found: %w", err)
+		}
+
+		err = a.Update().
+			SetRating(rating).
+			SetIsCorrect(rating >= 3).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("updating attempt: %w", err)
+		}
+		return []uuid.UUID{a.CardID}, nil
+	})
+}
+
+// DeleteAttempt removes a historical attempt and re-derives its card
+func (s *ReplayService) DeleteAttempt(ctx context.Context, attemptID uuid.UUID, dryRun bool) (*ReplayReport, error) {
+	return s.run(ctx, dryRun, func(tx *ent.Tx) ([]uuid.UUID, error) {
+		a, err := tx.Attempt.Get(ctx, attemptID)
+		if err != nil {
+			return nil, fmt.Errorf("attempt not found: %w", err)
+		}
+
+		if err := tx.Attempt.DeleteOne(a).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("deleting attempt: %w", err)
+		}
+		return []uuid.UUID{a.CardID}, nil
+	})
+}
+
+// run applies prepare and replays the returned cards in one transaction.
+// A dry run rolls everything back and only returns the diff report.
+func (s *ReplayService) run(
+	ctx context.Context,
+	dryRun bool,
+	prepare func(tx *ent.Tx) ([]uuid.UUID, error),
+) (*ReplayReport, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	report, err := s.replay(ctx, tx, dryRun, prepare)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("rolling back transaction: %v (original error: %w)", rerr, err)
+		}
+		return nil, err
+	}
+
+	if dryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("rolling back dry run: %w", err)
+		}
+		return report, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return report, nil
+}
+
+func (s *ReplayService) replay(
+	ctx context.Context,
+	tx *ent.Tx,
+	dryRun bool,
+	prepare func(tx *ent.Tx) ([]uuid.UUID, error),
+) (*ReplayReport, error) {
+	cardIDs, err := prepare(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReplayReport{DryRun: dryRun, Diffs: []CardDiff{}}
+	for _, cardID := range cardIDs {
+		diff, changed, err := s.replayCard(ctx, tx.Client(), cardID)
+		if err != nil {
+			return nil, fmt.Errorf("replaying card %s: %w", cardID, err)
+		}
+
+		report.CardsReplayed++
+		if changed {
+			report.CardsChanged++
+			report.Diffs = append(report.Diffs, *diff)
+		}
+	}
+	return report, nil
+}
+
+// replayCard resets a card to new and re-applies its attempts oldest first.
+// Each attempt's pre-review snapshot (state, stability, difficulty, card_before)
+// is rewritten to match the rebuilt history.
+func (s *ReplayService) replayCard(ctx context.Context, client *ent.Client, cardID uuid.UUID) (*CardDiff, bool, error) {
+	card, err := client.FsrsCard.Get(ctx, cardID)
+	if err != nil {
+		return nil, false, err
+	}
+	before := snapshotCard(card)
+
+	attempts, err := client.Attempt.Query().
+		Where(
+			attempt.CardID(cardID),
+			attempt.KindEQ(attempt.KindReview), // Implicit credit is not replayed
+		).
+		Order(ent.Asc(attempt.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("fetching attempts: %w", err)
+	}
+
+	// Cards that were never reviewed have nothing to rebuild
+	if len(attempts) == 0 && CardState(card.State) == StateNew && card.Reps == 0 {
+		return nil, false, nil
+	}
+
+	learningService, scheduler, err := s.servicesFor(ctx, client, card)
+	if err != nil {
+		return nil, false, err
+	}
+
+	dueAt := time.Now()
+	if len(attempts) > 0 {
+		dueAt = attempts[0].CreatedAt
+	}
+
+	card, err = card.Update().
+		SetState(fsrscard.StateNew).
+		SetStability(0).
+		SetDifficulty(0).
+		SetElapsedDays(0).
+		SetScheduledDays(0).
+		SetReps(0).
+		SetLapses(0).
+		SetCurrentStep(0).
+		SetEaseFactor(DefaultSM2Config().InitialEase).
+		SetLeitnerBox(0).
+		ClearLastReview().
+		SetDue(dueAt).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("resetting card: %w", err)
+	}
+
+	for _, a := range attempts {
+		kind, err := applyAttempt(ctx, learningService, scheduler, card, a)
+		if err != nil {
+			return nil, false, err
+		}
+
+		err = a.Update().
+			SetState(attempt.State(card.State)).
+			SetStability(card.Stability).
+			SetDifficulty(card.Difficulty).
+			SetCardBefore(data.NewCardSnapshot(card)).
+			SetScheduler(attempt.Scheduler(kind)).
+			Exec(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("rewriting attempt snapshot: %w", err)
+		}
+
+		// Services update the row, not the struct we hold
+		card, err = client.FsrsCard.Get(ctx, cardID)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	diff := &CardDiff{
+		CardID:   card.ID,
+		NodeID:   card.NodeID,
+		Attempts: len(attempts),
+		Before:   before,
+		After:    snapshotCard(card),
+	}
+	return diff, !diff.Before.equal(diff.After), nil
+}
+
+// applyAttempt mirrors ReviewCoordinator.ProcessReview at the attempt's timestamp
+// and returns which scheduler made the decision
+func applyAttempt(
+	ctx context.Context,
+	learningService *LearningStepsService,
+	scheduler Scheduler,
+	card *ent.FsrsCard,
+	a *ent.Attempt,
+) (SchedulerKind, error) {
+	if !learningService.ShouldUseLearningSteps(card) {
+		_, err := scheduler.ReviewCardAt(ctx, card, FSRSGrade(a.Rating), a.CreatedAt)
+		return scheduler.Kind(), err
+	}
+
+	stepResult, err := learningService.ProcessReviewAt(ctx, card, a.Rating, a.CreatedAt)
+	if err != nil {
+		return "", err
+	}
+	if !stepResult.ShouldGraduate {
+		return SchedulerLearningSteps, nil
+	}
+
+	_, err = scheduler.GraduateCardAt(
+		ctx,
+		card,
+		FSRSGrade(a.Rating),
+		learningService.GraduationInterval(a.Rating),
+		a.CreatedAt,
+	)
+	return scheduler.Kind(), err
+}
+
+// servicesFor builds services from the card's effective preset. Load balancing
+// is disabled because today's due load says nothing about historical reviews.
+func (s *ReplayService) servicesFor(
+	ctx context.Context,
+	client *ent.Client,
+	card *ent.FsrsCard,
+) (*LearningStepsService, Scheduler, error) {
+	fsrsConfig := s.fsrsService.config
+	learningConfig := s.learningService.config
+	kind, leitnerConfig := SchedulerFSRS, LeitnerConfig{}
+
+	preset, err := NewPresetService(client).ResolveForNode(ctx, card.NodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if preset != nil {
+		fsrsConfig, learningConfig = PresetConfigs(preset)
+		kind, leitnerConfig = PresetScheduler(preset)
+	}
+
+	day, err := data.NewSettingsRepository(client).DayBoundary(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fsrsConfig.EnableLoadBalance = false
+	return NewLearningStepsService(client, learningConfig, s.learningService.clock).WithDayBoundary(day),
+		newScheduler(kind, client, fsrsConfig, leitnerConfig, s.fsrsService.clock, day),
+		nil
+}
+
+func snapshotCard(card *ent.FsrsCard) CardSnapshot {
+	return CardSnapshot{
+		State:       string(card.State),
+		Stability:   card.Stability,
+		Difficulty:  card.Difficulty,
+		Reps:        card.Reps,
+		Lapses:      card.Lapses,
+		CurrentStep: card.CurrentStep,
+		EaseFactor:  card.EaseFactor,
+		LeitnerBox:  card.LeitnerBox,
+		Due:         card.Due,
+		LastReview:  card.LastReview,
+	}
+}
+
+// equal compares snapshots, tolerating float noise and sub-second timestamp precision
+func (c CardSnapshot) equal(other CardSnapshot) bool {
+	sameTime := func(a, b time.Time) bool {
+		d := a.Sub(b)
+		return d > -time.Second && d < time.Second
+	}
+
+	if c.State != other.State ||
+		c.Reps != other.Reps ||
+		c.Lapses != other.Lapses ||
+		c.CurrentStep != other.CurrentStep ||
+		c.LeitnerBox != other.LeitnerBox ||
+		math.Abs(c.EaseFactor-other.EaseFactor) > 1e-6 ||
+		math.Abs(c.Stability-other.Stability) > 1e-6 ||
+		math.Abs(c.Difficulty-other.Difficulty) > 1e-6 ||
+		!sameTime(c.Due, other.Due) {
+		return false
+	}
+
+	if c.LastReview == nil || other.LastReview == nil {
+		return c.LastReview == nil && other.LastReview == nil
+	}
+	return sameTime(*c.LastReview, *other.LastReview)
+}
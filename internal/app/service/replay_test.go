@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayService_RebuildsCardFromAttempts(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+
+	learningService := service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock())
+	fsrsConfig := service.DefaultFSRSConfig()
+	fsrsConfig.EnableFuzz = false
+	fsrsService := service.NewFSRSService(client, fsrsConfig, data.SystemClock())
+	replay := service.NewReplayService(learningService, fsrsService, client)
+
+	n := client.Node.Create().SetType(node.TypeProblem).SetTitle("Replay").SaveX(ctx)
+	card := client.FsrsCard.Create().SetNodeID(n.ID).SaveX(ctx)
+
+	// Default steps are [5m, 10m]: two Goods graduate, the third is an FSRS review
+	start := time.Now().AddDate(0, 0, -10).Truncate(time.Second)
+	times := []time.Time{start, start.Add(5 * time.Minute), start.AddDate(0, 0, 3)}
+	for _, at := range times {
+		client.Attempt.Create().
+			SetCardID(card.ID).
+			SetRating(3).
+			SetIsCorrect(true).
+			SetState("new").
+			SetStability(0).
+			SetDifficulty(0).
+			SetCreatedAt(at).
+			SaveX(ctx)
+	}
+
+	// Dry run reports the change but saves nothing
+	report, err := replay.ReplayNode(ctx, n.ID, true)
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.CardsReplayed)
+	require.Len(t, report.Diffs, 1)
+	assert.Equal(t, string(service.StateReview), report.Diffs[0].After.State)
+	assert.Equal(t, string(service.StateNew), client.FsrsCard.GetX(ctx, card.ID).State)
+
+	// Real run persists the rebuilt state with historical timestamps
+	_, err = replay.ReplaySubtree(ctx, n.ID, false)
+	require.NoError(t, err)
+	rebuilt := client.FsrsCard.GetX(ctx, card.ID)
+	assert.Equal(t, fsrscard.StateReview, rebuilt.State)
+	assert.Equal(t, 2, rebuilt.Reps)
+	require.NotNil(t, rebuilt.LastReview)
+	assert.WithinDuration(t, times[2], *rebuilt.LastReview, time.Second)
+	assert.True(t, rebuilt.Due.After(times[2]))
+
+	// Replaying again is a no-op
+	report, err = replay.ReplayLibrary(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.CardsChanged)
+
+	// Deleting the review rolls the card back to just after graduation
+	attempts := client.Attempt.Query().AllX(ctx)
+	var last = attempts[0]
+	for _, a := range attempts {
+		if a.CreatedAt.After(last.CreatedAt) {
+			last = a
+		}
+	}
+	_, err = replay.DeleteAttempt(ctx, last.ID, false)
+	require.NoError(t, err)
+	rebuilt = client.FsrsCard.GetX(ctx, card.ID)
+	assert.Equal(t, 1, rebuilt.Reps)
+	assert.WithinDuration(t, times[1], *rebuilt.LastReview, time.Second)
+
+	// Editing the first attempt to Again keeps the card in learning
+	var first = attempts[0]
+	for _, a := range attempts {
+		if a.CreatedAt.Before(first.CreatedAt) {
+			first = a
+		}
+	}
+	_, err = replay.EditAttempt(ctx, first.ID, 1, false)
+	require.NoError(t, err)
+	rebuilt = client.FsrsCard.GetX(ctx, card.ID)
+	assert.Equal(t, fsrscard.StateLearning, rebuilt.State)
+	assert.Equal(t, 1, rebuilt.CurrentStep)
+	assert.False(t, client.Attempt.GetX(ctx, first.ID).IsCorrect)
+}
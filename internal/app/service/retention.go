@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+)
+
+// RetentionSearch is the range of desired retentions to compare
+type RetentionSearch struct {
+	MinRetention   float64 `json:"min_retention"`
+	MaxRetention   float64 `json:"max_retention"`
+	Step           float64 `json:"step"`
+	NewCardsPerDay int     `json:"new_cards_per_day"`
+	HorizonDays    int     `json:"horizon_days"`
+}
+
+// DefaultRetentionSearch compares 70% to 97% over a year
+func DefaultRetentionSearch() RetentionSearch {
+	return RetentionSearch{
+		MinRetention:   0.70,
+		MaxRetention:   0.97,
+		Step:           0.01,
+		NewCardsPerDay: 20,
+		HorizonDays:    365,
+	}
+}
+
+// RetentionPoint is the simulated cost of one desired retention
+type RetentionPoint struct {
+	DesiredRetention   float64 `json:"desired_retention"`
+	TotalMinutes       float64 `json:"total_minutes"`
+	TotalLapses        float64 `json:"total_lapses"`
+	ExpectedRetained   float64 `json:"expected_retained"`
+	MinutesPerRetained float64 `json:"minutes_per_retained"` // The quantity being minimized
+}
+
+// OptimalRetentionResult is the best retention with the curve it was picked from
+type OptimalRetentionResult struct {
+	OptimalRetention float64          `json:"optimal_retention"`
+	Search           RetentionSearch  `json:"search"`
+	Curve            []RetentionPoint `json:"curve"`
+	RecallSeconds    float64          `json:"recall_seconds"`
+	LapseSeconds     float64          `json:"lapse_seconds"`
+	NewSeconds       float64          `json:"new_seconds"`
+}
+
+// OptimalRetention simulates the cards of a preset under each desired
+// retention in the search range and returns the one with the fewest review
+// minutes per card remembered at the horizon. Review and lapse costs come from
+// the revlog.
+func (s *SimulatorService) OptimalRetention(
+	ctx context.Context,
+	preset *ent.SchedulerPreset,
+	search RetentionSearch,
+) (*OptimalRetentionResult, error) {
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+
+	all, presets, err := s.loadCards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var cards []*ent.FsrsCard
+	for _, c := range all {
+		if p := presets[c.NodeID]; p != nil && p.ID == preset.ID {
+			cards = append(cards, c)
+		}
+	}
+
+	costs, err := s.averageCosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := data.NewSettingsRepository(s.client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, _ := PresetConfigs(preset)
+	model := NewFSRSService(nil, config, s.clock).WithDayBoundary(day)
+	now := s.clock.Now()
+	result := optimalRetention(search, costs, func(retention float64) *SimulationResult {
+		candidate := *model
+		candidate.config.DesiredRetention = retention
+		modelOf := func(*ent.FsrsCard) *FSRSService { return &candidate }
+		population, newQueue := buildPopulation(modelOf, cards, now)
+		scenario := SimulationScenario{
+			DesiredRetention: retention,
+			NewCardsPerDay:   search.NewCardsPerDay,
+			HorizonDays:      search.HorizonDays,
+		}
+		return simulate(population, newQueue, scenario, costs, 1)
+	})
+	if result.OptimalRetention == 0 {
+		return nil, fmt.Errorf("no cards to simulate: add cards or new cards per day")
+	}
+	return result, nil
+}
+
+// optimalRetention runs the simulation at each step and picks the minimum cost.
+// Every run uses the same seed so the curve reflects retention, not noise.
+func optimalRetention(
+	search RetentionSearch,
+	costs reviewCosts,
+	run func(retention float64) *SimulationResult,
+) *OptimalRetentionResult {
+	result := &OptimalRetentionResult{
+		Search:        search,
+		RecallSeconds: costs.recall,
+		LapseSeconds:  costs.lapse,
+		NewSeconds:    costs.newCard,
+	}
+
+	best := math.Inf(1)
+	steps := int(math.Round((search.MaxRetention - search.MinRetention) / search.Step))
+	for i := 0; i <= steps; i++ {
+		// Round to the step so 0.7+0.01*i doesn't drift
+		retention := math.Round((search.MinRetention+float64(i)*search.Step)*1e4) / 1e4
+		sim := run(retention)
+
+		point := RetentionPoint{
+			DesiredRetention: retention,
+			TotalMinutes:     sim.TotalMinutes,
+			TotalLapses:      sim.TotalLapses,
+			ExpectedRetained: sim.ExpectedRetained,
+		}
+		if sim.ExpectedRetained > 0 {
+			point.MinutesPerRetained = sim.TotalMinutes / sim.ExpectedRetained
+			if point.MinutesPerRetained < best {
+				best = point.MinutesPerRetained
+				result.OptimalRetention = retention
+			}
+		}
+		result.Curve = append(result.Curve, point)
+	}
+	return result
+}
+
+// Validate checks the search range is simulatable
+func (rs RetentionSearch) Validate() error {
+	if rs.MinRetention <= 0 || rs.MaxRetention >= 1 || rs.MinRetention > rs.MaxRetention {
+		return fmt.Errorf("retention range must lie between 0 and 1, got %v-%v", rs.MinRetention, rs.MaxRetention)
+	}
+	if rs.Step <= 0 {
+		return fmt.Errorf("retention step must be positive")
+	}
+	if (rs.MaxRetention-rs.MinRetention)/rs.Step > 100 {
+		return fmt.Errorf("retention search is limited to 100 steps")
+	}
+	return SimulationScenario{
+		DesiredRetention: rs.MinRetention,
+		NewCardsPerDay:   rs.NewCardsPerDay,
+		HorizonDays:      rs.HorizonDays,
+	}.Validate()
+}
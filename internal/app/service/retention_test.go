@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+
+	"profen/internal/data"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimalRetention_PicksCheapestPointOnCurve(t *testing.T) {
+	costs := reviewCosts{recall: 20, lapse: 60, newCard: 45}
+	search := DefaultRetentionSearch()
+	search.HorizonDays = 180
+	require.NoError(t, search.Validate())
+
+	result := optimalRetention(search, costs, func(retention float64) *SimulationResult {
+		config := DefaultFSRSConfig()
+		config.DesiredRetention = retention
+		model := NewFSRSService(nil, config, data.SystemClock())
+		scenario := SimulationScenario{DesiredRetention: retention, NewCardsPerDay: 20, HorizonDays: search.HorizonDays}
+		return simulate(nil, newCards(model, 5000), scenario, costs, 1)
+	})
+
+	require.Len(t, result.Curve, 28)
+	assert.Equal(t, 0.70, result.Curve[0].DesiredRetention)
+	assert.Equal(t, 0.97, result.Curve[27].DesiredRetention)
+
+	// The optimum is the cheapest point, strictly inside the range for this workload
+	assert.Greater(t, result.OptimalRetention, search.MinRetention)
+	assert.Less(t, result.OptimalRetention, search.MaxRetention)
+	var best RetentionPoint
+	for _, p := range result.Curve {
+		if p.DesiredRetention == result.OptimalRetention {
+			best = p
+		}
+	}
+	for _, p := range result.Curve {
+		assert.GreaterOrEqual(t, p.MinutesPerRetained, best.MinutesPerRetained)
+	}
+}
+
+func TestRetentionSearch_Validate(t *testing.T) {
+	assert.NoError(t, DefaultRetentionSearch().Validate())
+
+	bad := DefaultRetentionSearch()
+	bad.MaxRetention = 1
+	assert.Error(t, bad.Validate())
+
+	bad = DefaultRetentionSearch()
+	bad.Step = 0.0001
+	assert.Error(t, bad.Validate())
+}
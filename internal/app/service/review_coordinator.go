@@ -3,17 +3,34 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/errorresolution"
 	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/schema"
 
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrDuplicateReview means the review ID was already recorded (e.g. a double-click)
+	ErrDuplicateReview = errors.New("review already recorded")
+
+	// ErrStaleCard means another review changed the card while this one was in flight
+	ErrStaleCard = errors.New("card was modified by another review")
+)
+
 // ReviewCoordinator orchestrates learning steps and FSRS
 type ReviewCoordinator struct {
-	learningService *LearningStepsService
-	fsrsService     *FSRSService
+	learningService *LearningStepsService // Fallback when no preset exists
+	fsrsService     *FSRSService          // Fallback when no preset exists
+	leechConfig     LeechConfig           // Fallback when no preset exists
+	implicitConfig  ImplicitCreditConfig  // Fallback when no preset exists
+	errorConfig     ErrorResolutionConfig // Fallback when no preset exists
 	client          *ent.Client
 }
 
@@ -26,6 +43,9 @@ func NewReviewCoordinator(
 	return &ReviewCoordinator{
 		learningService: learningService,
 		fsrsService:     fsrsService,
+		leechConfig:     DefaultLeechConfig(),
+		implicitConfig:  DefaultImplicitCreditConfig(),
+		errorConfig:     DefaultErrorResolutionConfig(),
 		client:          client,
 	}
 }
@@ -35,56 +55,296 @@ type ReviewResult struct {
 	NextReviewDisplay string    `json:"next_review_display"`
 	CardState         CardState `json:"card_state"`
 	Graduated         bool      `json:"graduated"`
+	BecameLeech       bool      `json:"became_leech"`
+	ImplicitCredits   int       `json:"implicit_credits"` // Linked theory cards credited or pulled forward
+	ResolvedErrors    int       `json:"resolved_errors"`  // Open errors closed by this answer's correct streak
+	OverTimeLimit     bool      `json:"over_time_limit"`  // Correct but slow; a fluency error was recorded
+
+	Scheduler SchedulerKind `json:"scheduler"` // Which algorithm made the decision
+
+	implicitAttempts []uuid.UUID             // Logged on linked cards, reverted with the review
+	buried           []schema.BuriedSnapshot // Translation siblings, restored with the review
+}
+
+// ReviewSubmission is one graded answer sent by the client
+type ReviewSubmission struct {
+	ReviewID   uuid.UUID // Client-supplied; uuid.Nil disables duplicate detection
+	NodeID     uuid.UUID
+	Grade      int
+	DurationMs int
+	UserAnswer string
+	Metadata   map[string]interface{}
+
+	ErrorTypeIDs []uuid.UUID // Error definitions made in this answer
+	ErrorNote    string      // What went wrong
+}
+
+// SubmitReview schedules the card and records the attempt in one transaction.
+// A repeated ReviewID returns ErrDuplicateReview and changes nothing; a card
+// graded concurrently by someone else returns ErrStaleCard.
+func (rc *ReviewCoordinator) SubmitReview(
+	ctx context.Context,
+	sub ReviewSubmission,
+) (*ReviewResult, *ent.Attempt, error) {
+	if sub.Grade < 1 || sub.Grade > 4 {
+		return nil, nil, fmt.Errorf("grade must be between 1 and 4")
+	}
+
+	tx, err := rc.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	result, recorded, err := rc.submitReview(ctx, tx.Client(), sub)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, nil, fmt.Errorf("rolling back transaction: %v (original error: %w)", rerr, err)
+		}
+		// A concurrent submission of the same review won the unique review_id;
+		// any other constraint violation is a real failure
+		if ent.IsConstraintError(err) && sub.ReviewID != uuid.Nil {
+			seen, qerr := rc.client.Attempt.Query().
+				Where(attempt.ReviewID(sub.ReviewID)).
+				Exist(ctx)
+			if qerr == nil && seen {
+				return nil, nil, ErrDuplicateReview
+			}
+		}
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return result, recorded, nil
+}
+
+func (rc *ReviewCoordinator) submitReview(
+	ctx context.Context,
+	client *ent.Client,
+	sub ReviewSubmission,
+) (*ReviewResult, *ent.Attempt, error) {
+	if sub.ReviewID != uuid.Nil {
+		seen, err := client.Attempt.Query().
+			Where(attempt.ReviewID(sub.ReviewID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if seen {
+			return nil, nil, ErrDuplicateReview
+		}
+	}
+
+	// Pre-review card, snapshotted on the attempt
+	card, err := rc.getOrCreateCard(ctx, client, sub.NodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Pre-review errors on the node, so undo can revert what this review changes
+	resolutionsBefore, err := client.ErrorResolution.Query().
+		Where(errorresolution.NodeID(sub.NodeID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading errors before review: %w", err)
+	}
+
+	// Claim the card; this locks the row until commit
+	claimed, err := client.FsrsCard.Update().
+		Where(
+			fsrscard.ID(card.ID),
+			fsrscard.Version(card.Version),
+		).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claimed == 0 {
+		return nil, nil, ErrStaleCard
+	}
+
+	result, err := rc.reviewCard(ctx, client, card, sub.Grade)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to process review: %w", err)
+	}
+
+	attempts := data.NewAttemptRepository(client)
+	var recorded *ent.Attempt
+	if sub.ReviewID != uuid.Nil {
+		recorded, err = attempts.CreateAttemptWithReviewID(
+			ctx, card, sub.ReviewID, sub.Grade, int64(sub.DurationMs), sub.UserAnswer, sub.Metadata,
+		)
+	} else {
+		recorded, err = attempts.CreateAttempt(
+			ctx, card, sub.Grade, int64(sub.DurationMs), sub.UserAnswer, sub.Metadata,
+		)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recorded, err = recorded.Update().
+		SetScheduler(attempt.Scheduler(result.Scheduler)).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("recording scheduler: %w", err)
+	}
+
+	// A correct answer slower than the node's time limit is a fluency error
+	errorTypeIDs := sub.ErrorTypeIDs
+	if FSRSGrade(sub.Grade) >= GradeGood && sub.DurationMs > 0 {
+		limit, err := NewTimeLimitService(client).Resolve(ctx, sub.NodeID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if limit != nil && sub.DurationMs > limit.Seconds*1000 {
+			fluency, err := systemErrorType(ctx, client, data.ErrorCodeFluency)
+			if err != nil {
+				return nil, nil, fmt.Errorf("loading fluency error type: %w", err)
+			}
+			errorTypeIDs = append(append([]uuid.UUID{}, errorTypeIDs...), fluency.ID)
+			result.OverTimeLimit = true
+		}
+	}
+
+	errs := NewErrorResolutionService(client)
+	if len(errorTypeIDs) > 0 || sub.ErrorNote != "" {
+		if _, err := errs.Record(ctx, recorded, sub.NodeID, errorTypeIDs, sub.ErrorNote); err != nil {
+			return nil, nil, err
+		}
+		if recorded, err = client.Attempt.Get(ctx, recorded.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	errorConfig, err := rc.errorConfigFor(ctx, client, sub.NodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.ResolvedErrors, err = errs.ResolveStreaks(ctx, sub.NodeID, errorConfig, rc.learningService.clock.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolutionsAfter, err := client.ErrorResolution.Query().
+		Where(errorresolution.NodeID(sub.NodeID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading errors after review: %w", err)
+	}
+	effects := &schema.ReviewEffects{
+		CreatedResolutions: createdResolutions(resolutionsBefore, resolutionsAfter),
+		ChangedResolutions: changedResolutions(resolutionsBefore, resolutionsAfter),
+		ImplicitAttempts:   result.implicitAttempts,
+		BuriedCards:        result.buried,
+	}
+	recorded, err = recorded.Update().
+		SetEffects(effects).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("recording review effects: %w", err)
+	}
+
+	return result, recorded, nil
 }
 
-// ProcessReview handles a card review using appropriate service
+// ProcessReview grades the node's card without answer details; it is
+// SubmitReview with only a node and grade, so the attempt is still recorded
 func (rc *ReviewCoordinator) ProcessReview(
 	ctx context.Context,
 	nodeID uuid.UUID,
 	grade int,
 ) (*ReviewResult, error) {
+	result, _, err := rc.SubmitReview(ctx, ReviewSubmission{NodeID: nodeID, Grade: grade})
+	return result, err
+}
 
-	// Get or create card
-	card, err := rc.getOrCreateCard(ctx, nodeID)
+// reviewCard schedules a card with the services of its effective preset,
+// then applies the preset's leech and burying rules
+func (rc *ReviewCoordinator) reviewCard(
+	ctx context.Context,
+	client *ent.Client,
+	card *ent.FsrsCard,
+	grade int,
+) (*ReviewResult, error) {
+	services, err := rc.servicesFor(ctx, client, card)
 	if err != nil {
 		return nil, err
 	}
 
 	// Determine which service to use
-	if rc.learningService.ShouldUseLearningSteps(card) {
-		return rc.processWithLearningSteps(ctx, card, grade)
+	var result *ReviewResult
+	if services.learning.ShouldUseLearningSteps(card) {
+		result, err = rc.processWithLearningSteps(ctx, services.learning, services.scheduler, card, grade)
+	} else {
+		result, err = rc.processWithScheduler(ctx, services.scheduler, card, FSRSGrade(grade))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if FSRSGrade(grade) == GradeAgain {
+		result.BecameLeech, err = NewLeechService(client).CheckCard(ctx, card.ID, services.leech)
+		if err != nil {
+			return nil, fmt.Errorf("checking for leech: %w", err)
+		}
 	}
 
-	return rc.processWithFSRS(ctx, card, FSRSGrade(grade))
+	// Each credited card follows the rules of its own preset
+	rulesFor := func(preset *ent.SchedulerPreset) (ImplicitCreditConfig, *FSRSService) {
+		theory := rc.presetServices(client, preset, services.fsrs.day)
+		return theory.implicit, theory.fsrs
+	}
+	result.implicitAttempts, err = NewImplicitCreditService(client).Propagate(
+		ctx, card.NodeID, FSRSGrade(grade), rulesFor, services.learning.clock.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.ImplicitCredits = len(result.implicitAttempts)
+
+	if services.buryTranslations {
+		result.buried, err = NewSuspendService(client, services.learning.clock).BuryTranslationSiblings(ctx, card.NodeID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
 func (rc *ReviewCoordinator) processWithLearningSteps(
 	ctx context.Context,
+	learningService *LearningStepsService,
+	scheduler Scheduler,
 	card *ent.FsrsCard,
 	grade int,
 ) (*ReviewResult, error) {
 
-	stepResult, err := rc.learningService.ProcessReview(ctx, card, grade)
+	stepResult, err := learningService.ProcessReview(ctx, card, grade)
 	if err != nil {
 		return nil, err
 	}
 
-	// If graduated, initialize FSRS
+	// If graduated, hand the card to the preset's scheduler
 	if stepResult.ShouldGraduate {
-		fsrsResult, err := rc.fsrsService.GraduateCard(
+		schedResult, err := scheduler.GraduateCardAt(
 			ctx,
 			card,
 			FSRSGrade(grade),
-			rc.learningService.config.GraduatingInterval,
+			learningService.GraduationInterval(grade),
+			learningService.clock.Now(),
 		)
 		if err != nil {
 			return nil, err
 		}
 
 		return &ReviewResult{
-			NextReviewDisplay: fsrsResult.IntervalDisplay,
+			NextReviewDisplay: schedResult.IntervalDisplay,
 			CardState:         StateReview,
 			Graduated:         true,
+			Scheduler:         scheduler.Kind(),
 		}, nil
 	}
 
@@ -92,24 +352,31 @@ func (rc *ReviewCoordinator) processWithLearningSteps(
 		NextReviewDisplay: stepResult.IntervalDisplay,
 		CardState:         stepResult.NextState,
 		Graduated:         false,
+		Scheduler:         SchedulerLearningSteps,
 	}, nil
 }
 
-func (rc *ReviewCoordinator) processWithFSRS(
+func (rc *ReviewCoordinator) processWithScheduler(
 	ctx context.Context,
+	scheduler Scheduler,
 	card *ent.FsrsCard,
 	grade FSRSGrade,
 ) (*ReviewResult, error) {
 
-	fsrsResult, err := rc.fsrsService.ReviewCard(ctx, card, grade)
+	schedResult, err := scheduler.ReviewCardAt(ctx, card, grade, rc.fsrsService.clock.Now())
 	if err != nil {
 		return nil, err
 	}
 
+	state := StateReview
+	if grade == GradeAgain {
+		state = StateRelearning
+	}
 	return &ReviewResult{
-		NextReviewDisplay: fsrsResult.IntervalDisplay,
-		CardState:         StateReview,
+		NextReviewDisplay: schedResult.IntervalDisplay,
+		CardState:         state,
 		Graduated:         false,
+		Scheduler:         scheduler.Kind(),
 	}, nil
 }
 
@@ -119,24 +386,103 @@ func (rc *ReviewCoordinator) GetSchedulingInfo(
 	nodeID uuid.UUID,
 ) (map[int]string, error) {
 
-	card, err := rc.getOrCreateCard(ctx, nodeID)
+	card, err := rc.getOrCreateCard(ctx, rc.client, nodeID)
 	if err != nil {
 		return nil, err
 	}
 
-	if rc.learningService.ShouldUseLearningSteps(card) {
-		return rc.learningService.GetNextIntervals(card), nil
+	services, err := rc.servicesFor(ctx, rc.client, card)
+	if err != nil {
+		return nil, err
+	}
+
+	if services.learning.ShouldUseLearningSteps(card) {
+		return services.learning.GetNextIntervals(card), nil
 	}
 
-	return rc.fsrsService.GetNextIntervals(card), nil
+	return services.scheduler.GetNextIntervals(card), nil
+}
+
+// reviewServices are the schedulers and review rules of one preset
+type reviewServices struct {
+	learning         *LearningStepsService
+	scheduler        Scheduler
+	leech            LeechConfig
+	implicit         ImplicitCreditConfig
+	fsrs             *FSRSService // Memory model and maximum interval for implicit credit
+	buryTranslations bool
+}
+
+// servicesFor returns the services and rules of the card's effective preset
+func (rc *ReviewCoordinator) servicesFor(
+	ctx context.Context,
+	client *ent.Client,
+	card *ent.FsrsCard,
+) (*reviewServices, error) {
+	day, err := data.NewSettingsRepository(client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preset, err := NewPresetService(client).ResolveForNode(ctx, card.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return rc.presetServices(client, preset, day), nil
+}
+
+// presetServices returns the services and rules of a preset, or the
+// coordinator's defaults when there is none
+func (rc *ReviewCoordinator) presetServices(
+	client *ent.Client,
+	preset *ent.SchedulerPreset,
+	day data.DayBoundary,
+) *reviewServices {
+	if preset == nil {
+		return &reviewServices{
+			learning:  rc.learningService.WithDayBoundary(day),
+			scheduler: rc.fsrsService.WithDayBoundary(day),
+			leech:     rc.leechConfig,
+			implicit:  rc.implicitConfig,
+			fsrs:      rc.fsrsService.WithDayBoundary(day),
+		}
+	}
+
+	fsrsConfig, learningConfig := PresetConfigs(preset)
+	kind, leitnerConfig := PresetScheduler(preset)
+	return &reviewServices{
+		learning:         NewLearningStepsService(client, learningConfig, rc.learningService.clock).WithDayBoundary(day),
+		scheduler:        newScheduler(kind, client, fsrsConfig, leitnerConfig, rc.fsrsService.clock, day),
+		leech:            PresetLeechConfig(preset),
+		implicit:         PresetImplicitCreditConfig(preset),
+		fsrs:             NewFSRSService(client, fsrsConfig, rc.fsrsService.clock).WithDayBoundary(day),
+		buryTranslations: preset.BuryTranslations,
+	}
+}
+
+// errorConfigFor returns the error resolution rules of the node's effective preset
+func (rc *ReviewCoordinator) errorConfigFor(
+	ctx context.Context,
+	client *ent.Client,
+	nodeID uuid.UUID,
+) (ErrorResolutionConfig, error) {
+	preset, err := NewPresetService(client).ResolveForNode(ctx, nodeID)
+	if err != nil {
+		return ErrorResolutionConfig{}, err
+	}
+	if preset == nil {
+		return rc.errorConfig, nil
+	}
+	return PresetErrorResolutionConfig(preset), nil
 }
 
 func (rc *ReviewCoordinator) getOrCreateCard(
 	ctx context.Context,
+	client *ent.Client,
 	nodeID uuid.UUID,
 ) (*ent.FsrsCard, error) {
 	// Try to find existing card
-	card, err := rc.client.FsrsCard.Query().
+	card, err := client.FsrsCard.Query().
 		Where(fsrscard.NodeID(nodeID)).
 		Only(ctx)
 
@@ -149,9 +495,9 @@ func (rc *ReviewCoordinator) getOrCreateCard(
 	}
 
 	// Create new card
-	return rc.client.FsrsCard.Create().
+	return client.FsrsCard.Create().
 		SetNodeID(nodeID).
-		SetCardState(string(StateNew)).
+		SetState(fsrscard.StateNew).
 		SetStability(0.0).
 		SetDifficulty(5.0).
 		SetElapsedDays(0).
@@ -167,5 +513,5 @@ func (rc *ReviewCoordinator) GetCard(
 	ctx context.Context,
 	nodeID uuid.UUID,
 ) (*ent.FsrsCard, error) {
-	return rc.getOrCreateCard(ctx, nodeID)
+	return rc.getOrCreateCard(ctx, rc.client, nodeID)
 }
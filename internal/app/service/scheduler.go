@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+)
+
+// SchedulerKind names the algorithm behind a scheduling decision
+type SchedulerKind string
+
+const (
+	SchedulerLearningSteps SchedulerKind = "learning_steps"
+	SchedulerFSRS          SchedulerKind = "fsrs"
+	SchedulerSM2           SchedulerKind = "sm2"
+	SchedulerLeitner       SchedulerKind = "leitner"
+)
+
+// Scheduler schedules cards once they leave learning steps.
+// FSRSService, SM2Service and LeitnerService implement it.
+type Scheduler interface {
+	Kind() SchedulerKind
+
+	// ReviewCardAt grades a card in review state; Again sends it to relearning
+	ReviewCardAt(ctx context.Context, card *ent.FsrsCard, grade FSRSGrade, now time.Time) (*FSRSResult, error)
+
+	// GraduateCardAt moves a card from (re)learning steps into review. The
+	// interval is the preset's easy interval for Easy, else its graduating interval.
+	GraduateCardAt(ctx context.Context, card *ent.FsrsCard, grade FSRSGrade, graduatingInterval int, now time.Time) (*FSRSResult, error)
+
+	// GetNextIntervals previews the interval each grade would give a review card
+	GetNextIntervals(card *ent.FsrsCard) map[int]string
+}
+
+// ParseSchedulerKind validates a preset's scheduler name
+func ParseSchedulerKind(s string) (SchedulerKind, error) {
+	switch kind := SchedulerKind(s); kind {
+	case SchedulerFSRS, SchedulerSM2, SchedulerLeitner:
+		return kind, nil
+	case "":
+		return SchedulerFSRS, nil
+	default:
+		return "", fmt.Errorf("unknown scheduler %q", s)
+	}
+}
+
+// newScheduler builds the scheduler a preset selects
+func newScheduler(
+	kind SchedulerKind,
+	client *ent.Client,
+	fsrsConfig FSRSConfig,
+	leitnerConfig LeitnerConfig,
+	clock data.Clock,
+	day data.DayBoundary,
+) Scheduler {
+	switch kind {
+	case SchedulerSM2:
+		config := DefaultSM2Config()
+		config.MaxInterval = fsrsConfig.MaxInterval
+		return NewSM2Service(client, config, clock).WithDayBoundary(day)
+	case SchedulerLeitner:
+		return NewLeitnerService(client, leitnerConfig, clock).WithDayBoundary(day)
+	default:
+		return NewFSRSService(client, fsrsConfig, clock).WithDayBoundary(day)
+	}
+}
+
+// formatDays renders an interval in days for grade buttons
+func formatDays(days int) string {
+	if days < 30 {
+		return fmt.Sprintf("%dd", days)
+	}
+	if days < 365 {
+		months := days / 30
+		return fmt.Sprintf("%dmo", months)
+	}
+	years := float64(days) / 365.0
+	return fmt.Sprintf("%.1fy", years)
+}
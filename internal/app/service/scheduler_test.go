@@ -0,0 +1,109 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSM2_EaseAndIntervals(t *testing.T) {
+	s := NewSM2Service(nil, DefaultSM2Config(), nil)
+
+	// Good (q=4) keeps the ease, Easy raises it, Hard lowers it
+	assert.InDelta(t, 2.5, s.nextEase(2.5, GradeGood), 1e-9)
+	assert.InDelta(t, 2.6, s.nextEase(2.5, GradeEasy), 1e-9)
+	assert.InDelta(t, 2.36, s.nextEase(2.5, GradeHard), 1e-9)
+	assert.Equal(t, sm2MinEase, s.nextEase(1.35, GradeAgain))
+
+	// 1, 6, then previous interval times the ease
+	assert.Equal(t, 1, s.nextInterval(0, 2.5))
+	assert.Equal(t, 6, s.nextInterval(1, 2.5))
+	assert.Equal(t, 15, s.nextInterval(6, 2.5))
+
+	capped := NewSM2Service(nil, SM2Config{InitialEase: 2.5, MaxInterval: 10}, nil)
+	assert.Equal(t, 10, capped.nextInterval(6, 2.5))
+}
+
+func TestLeitner_Boxes(t *testing.T) {
+	s := NewLeitnerService(nil, LeitnerConfig{Intervals: []int{1, 3, 7}}, nil)
+
+	assert.Equal(t, 0, s.nextBox(2, GradeAgain))
+	assert.Equal(t, 1, s.nextBox(1, GradeHard))
+	assert.Equal(t, 2, s.nextBox(1, GradeGood))
+	assert.Equal(t, 2, s.nextBox(1, GradeEasy), "capped at the last box")
+
+	defaults := NewLeitnerService(nil, LeitnerConfig{}, nil)
+	assert.Equal(t, DefaultLeitnerConfig().Intervals, defaults.config.Intervals)
+}
+
+func TestParseSchedulerKind(t *testing.T) {
+	kind, err := ParseSchedulerKind("")
+	assert.NoError(t, err)
+	assert.Equal(t, SchedulerFSRS, kind)
+
+	kind, err = ParseSchedulerKind("leitner")
+	assert.NoError(t, err)
+	assert.Equal(t, SchedulerLeitner, kind)
+
+	_, err = ParseSchedulerKind("anki")
+	assert.Error(t, err)
+}
+
+func TestImplicitCredit_ApproachesOneReviewAndCaps(t *testing.T) {
+	day := data.DayBoundary{StartHour: 4, Location: time.UTC}
+	fsrs := NewFSRSService(nil, DefaultFSRSConfig(), data.SystemClock()).WithDayBoundary(day)
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	lastReview := now.AddDate(0, 0, -8)
+	theory := func() *ent.FsrsCard {
+		return &ent.FsrsCard{
+			State:         fsrscard.StateReview,
+			Stability:     10,
+			Difficulty:    5,
+			ScheduledDays: 10,
+			LastReview:    &lastReview,
+			Due:           day.AddDays(lastReview, 10),
+		}
+	}
+
+	// What a real Good review now would give
+	r := fsrs.calculateRetrievability(8, 10)
+	good := fsrs.calculateNewStability(5, 10, r, GradeGood)
+
+	// Ten solved problems in one session: each credit gains less than the
+	// last, and together they stay below one real review
+	card := theory()
+	var gains []float64
+	for i := 0; i < 10; i++ {
+		due, stability := implicitCredit(card, 0.25, fsrs, now)
+		assert.False(t, due.Before(card.Due))
+		gains = append(gains, stability-card.Stability)
+		card.Due, card.Stability = due, stability
+	}
+	assert.Greater(t, gains[0], 0.0)
+	assert.Less(t, gains[9], gains[0])
+	assert.Less(t, card.Stability, good)
+	assert.False(t, card.Due.After(day.AddDays(lastReview, int(math.Round(good)))))
+
+	// Never past the maximum interval from the last review
+	fsrs.config.MaxInterval = 12
+	due, _ := implicitCredit(theory(), 1, fsrs, now)
+	assert.Equal(t, day.AddDays(lastReview, 12), due)
+
+	// Without stability the push is a fraction of the last real interval
+	sm2 := &ent.FsrsCard{State: fsrscard.StateReview, ScheduledDays: 8, LastReview: &lastReview, Due: day.AddDays(lastReview, 8)}
+	due, stability := implicitCredit(sm2, 0.25, fsrs, now)
+	assert.Equal(t, day.AddDays(sm2.Due, 2), due)
+	assert.Zero(t, stability)
+
+	// and crediting again does not push it further
+	sm2.Due = due
+	again, _ := implicitCredit(sm2, 0.25, fsrs, now)
+	assert.Equal(t, due, again)
+}
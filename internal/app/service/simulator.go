@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/fsrscard"
+
+	"github.com/google/uuid"
+)
+
+// Fallback review costs when the revlog has no timing data yet
+const (
+	defaultRecallSeconds = 20.0
+	defaultLapseSeconds  = 60.0
+	defaultNewSeconds    = 45.0
+)
+
+// SimulationScenario describes the "what if" to project
+type SimulationScenario struct {
+	DesiredRetention float64 `json:"desired_retention"`
+	NewCardsPerDay   int     `json:"new_cards_per_day"`
+	HorizonDays      int     `json:"horizon_days"`
+}
+
+// SimulationDay is the projected workload of a single day
+type SimulationDay struct {
+	Day             int       `json:"day"`
+	Date            time.Time `json:"date"`
+	DueReviews      int       `json:"due_reviews"`
+	NewCards        int       `json:"new_cards"`
+	ExpectedMinutes float64   `json:"expected_minutes"`
+	ExpectedLapses  float64   `json:"expected_lapses"`
+}
+
+// SimulationResult is the per-day forecast plus totals
+type SimulationResult struct {
+	Scenario         SimulationScenario `json:"scenario"`
+	Days             []SimulationDay    `json:"days"`
+	TotalReviews     int                `json:"total_reviews"`
+	TotalNewCards    int                `json:"total_new_cards"`
+	TotalMinutes     float64            `json:"total_minutes"`
+	TotalLapses      float64            `json:"total_lapses"`
+	RecallSeconds    float64            `json:"recall_seconds"`
+	LapseSeconds     float64            `json:"lapse_seconds"`
+	NewSeconds       float64            `json:"new_seconds"`
+	RemainingNew     int                `json:"remaining_new"`
+	ExpectedRetained float64            `json:"expected_retained"` // Sum of R over the library at the horizon
+}
+
+// reviewCosts are average answer times in seconds
+type reviewCosts struct {
+	recall, lapse, newCard float64
+}
+
+// simCard is the in-memory memory state of one card during a simulation
+type simCard struct {
+	model      *FSRSService // Memory model of the card's preset
+	stability  float64
+	difficulty float64
+	lastReview int // Day index of the last review (may be negative)
+	due        int // Day index of the next review
+}
+
+// SimulatorService projects future workload from the current card population
+type SimulatorService struct {
+	client *ent.Client
+	clock  data.Clock // Day the simulation starts
+}
+
+// NewSimulatorService creates a new simulator
+func NewSimulatorService(client *ent.Client, clock data.Clock) *SimulatorService {
+	return &SimulatorService{client: client, clock: clock}
+}
+
+// Simulate projects the next HorizonDays of reviews under the scenario. Each
+// card follows the weights and maximum interval of its effective preset, or
+// config when it has none; the scenario's retention applies to all of them.
+func (s *SimulatorService) Simulate(
+	ctx context.Context,
+	config FSRSConfig,
+	scenario SimulationScenario,
+) (*SimulationResult, error) {
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+
+	cards, presets, err := s.loadCards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	costs, err := s.averageCosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := data.NewSettingsRepository(s.client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// One model per preset, uuid.Nil for cards without one
+	models := make(map[uuid.UUID]*FSRSService)
+	modelOf := func(card *ent.FsrsCard) *FSRSService {
+		key, presetConfig := uuid.Nil, config
+		if preset := presets[card.NodeID]; preset != nil {
+			key = preset.ID
+			presetConfig, _ = PresetConfigs(preset)
+		}
+		if model, ok := models[key]; ok {
+			return model
+		}
+		presetConfig.DesiredRetention = scenario.DesiredRetention
+		models[key] = NewFSRSService(nil, presetConfig, s.clock).WithDayBoundary(day)
+		return models[key]
+	}
+
+	now := s.clock.Now()
+	population, newQueue := buildPopulation(modelOf, cards, now)
+
+	result := simulate(population, newQueue, scenario, costs, 1)
+	for i := range result.Days {
+		result.Days[i].Date = now.AddDate(0, 0, i)
+	}
+	return result, nil
+}
+
+// loadCards returns the cards to simulate and the effective preset of each
+// card's node (nil when it has none). Suspended cards are never due, so they
+// add no workload.
+func (s *SimulatorService) loadCards(ctx context.Context) ([]*ent.FsrsCard, map[uuid.UUID]*ent.SchedulerPreset, error) {
+	cards, err := s.client.FsrsCard.Query().
+		Where(fsrscard.IsSuspended(false)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching cards: %w", err)
+	}
+	if len(cards) == 0 {
+		return nil, nil, nil
+	}
+
+	nodeIDs := make([]uuid.UUID, len(cards))
+	for i, c := range cards {
+		nodeIDs[i] = c.NodeID
+	}
+	presets, err := NewPresetService(s.client).ResolveForNodes(ctx, nodeIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cards, presets, nil
+}
+
+// averageCosts derives answer times from historical Attempt.duration_ms
+func (s *SimulatorService) averageCosts(ctx context.Context) (reviewCosts, error) {
+	costs := reviewCosts{
+		recall:  defaultRecallSeconds,
+		lapse:   defaultLapseSeconds,
+		newCard: defaultNewSeconds,
+	}
+
+	attempts, err := s.client.Attempt.Query().
+		Where(attempt.DurationMsGT(0)).
+		All(ctx)
+	if err != nil {
+		return costs, fmt.Errorf("fetching attempt durations: %w", err)
+	}
+
+	var recallSum, lapseSum, newSum float64
+	var recallN, lapseN, newN int
+	for _, a := range attempts {
+		seconds := float64(a.DurationMs) / 1000
+		switch {
+		case a.State == attempt.StateNew:
+			newSum += seconds
+			newN++
+		case a.IsCorrect:
+			recallSum += seconds
+			recallN++
+		default:
+			lapseSum += seconds
+			lapseN++
+		}
+	}
+
+	if recallN > 0 {
+		costs.recall = recallSum / float64(recallN)
+	}
+	if lapseN > 0 {
+		costs.lapse = lapseSum / float64(lapseN)
+	}
+	if newN > 0 {
+		costs.newCard = newSum / float64(newN)
+	}
+	return costs, nil
+}
+
+// buildPopulation converts stored cards into simulation state, each under the
+// model modelOf returns for it. Cards that were never graduated are returned
+// as the new-card queue, as the model each will be introduced under. Cards
+// scheduled without stability (SM-2, Leitner) are modelled from their last
+// interval.
+func buildPopulation(
+	modelOf func(card *ent.FsrsCard) *FSRSService,
+	cards []*ent.FsrsCard,
+	now time.Time,
+) ([]*simCard, []*FSRSService) {
+	population := make([]*simCard, 0, len(cards))
+	var newQueue []*FSRSService
+
+	for _, c := range cards {
+		model := modelOf(c)
+		if CardState(c.State) == StateNew || (c.Stability <= 0 && c.ScheduledDays < 1) {
+			newQueue = append(newQueue, model)
+			continue
+		}
+
+		stability := c.Stability
+		if stability <= 0 {
+			stability = model.intervalStability(c.ScheduledDays)
+		}
+
+		due := model.day.DaysBetween(now, c.Due)
+		if due < 0 {
+			due = 0
+		}
+
+		lastReview := due - c.ScheduledDays
+		if c.LastReview != nil {
+			lastReview = -model.day.DaysBetween(*c.LastReview, now)
+		}
+
+		difficulty := c.Difficulty
+		if difficulty <= 0 {
+			difficulty = model.calculateInitialDifficulty(GradeGood)
+		}
+
+		population = append(population, &simCard{
+			model:      model,
+			stability:  stability,
+			difficulty: difficulty,
+			lastReview: lastReview,
+			due:        due,
+		})
+	}
+	return population, newQueue
+}
+
+// simulate advances the population day by day. Outcomes are sampled with a
+// seeded RNG so state evolution is reproducible, while lapses and minutes are
+// reported as expectations from the predicted retrievability.
+func simulate(
+	population []*simCard,
+	newQueue []*FSRSService,
+	scenario SimulationScenario,
+	costs reviewCosts,
+	seed int64,
+) *SimulationResult {
+	rng := rand.New(rand.NewSource(seed))
+
+	result := &SimulationResult{
+		Scenario:      scenario,
+		Days:          make([]SimulationDay, scenario.HorizonDays),
+		RecallSeconds: costs.recall,
+		LapseSeconds:  costs.lapse,
+		NewSeconds:    costs.newCard,
+	}
+
+	for day := 0; day < scenario.HorizonDays; day++ {
+		stats := SimulationDay{Day: day}
+		var seconds float64
+
+		for _, c := range population {
+			if c.due > day {
+				continue
+			}
+
+			model := c.model
+			elapsed := float64(day - c.lastReview)
+			r := model.calculateRetrievability(elapsed, c.stability)
+
+			stats.DueReviews++
+			stats.ExpectedLapses += 1 - r
+			seconds += r*costs.recall + (1-r)*costs.lapse
+
+			grade := GradeGood
+			if rng.Float64() >= r {
+				grade = GradeAgain
+			}
+			c.stability = math.Max(model.calculateNewStability(c.difficulty, c.stability, r, grade), 0.01)
+			c.difficulty = model.calculateNewDifficulty(c.difficulty, grade)
+			c.lastReview = day
+			c.due = day + model.simulatedInterval(c.stability)
+		}
+
+		// Introduce new cards, assuming a first rating of Good
+		introduce := min(scenario.NewCardsPerDay, len(newQueue))
+		for _, model := range newQueue[:introduce] {
+			stability := model.calculateInitialStability(GradeGood)
+			population = append(population, &simCard{
+				model:      model,
+				stability:  stability,
+				difficulty: model.calculateInitialDifficulty(GradeGood),
+				lastReview: day,
+				due:        day + model.simulatedInterval(stability),
+			})
+		}
+		newQueue = newQueue[introduce:]
+		stats.NewCards = introduce
+		seconds += float64(introduce) * costs.newCard
+
+		stats.ExpectedMinutes = seconds / 60
+		result.Days[day] = stats
+
+		result.TotalReviews += stats.DueReviews
+		result.TotalNewCards += stats.NewCards
+		result.TotalMinutes += stats.ExpectedMinutes
+		result.TotalLapses += stats.ExpectedLapses
+	}
+
+	for _, c := range population {
+		result.ExpectedRetained += c.model.calculateRetrievability(float64(scenario.HorizonDays-c.lastReview), c.stability)
+	}
+	result.RemainingNew = len(newQueue)
+	return result
+}
+
+// simulatedInterval is the whole-day interval for a stability, at least one day
+func (s *FSRSService) simulatedInterval(stability float64) int {
+	days := int(math.Round(s.calculateInterval(stability, s.config.DesiredRetention)))
+	if days > s.config.MaxInterval {
+		days = s.config.MaxInterval
+	}
+	if days < 1 {
+		days = 1
+	}
+	return days
+}
+
+// Validate checks the scenario is simulatable
+func (sc SimulationScenario) Validate() error {
+	if sc.DesiredRetention <= 0 || sc.DesiredRetention >= 1 {
+		return fmt.Errorf("desired retention must be between 0 and 1, got %v", sc.DesiredRetention)
+	}
+	if sc.NewCardsPerDay < 0 {
+		return fmt.Errorf("new cards per day must not be negative")
+	}
+	if sc.HorizonDays < 1 || sc.HorizonDays > 3650 {
+		return fmt.Errorf("horizon must be between 1 and 3650 days, got %d", sc.HorizonDays)
+	}
+	return nil
+}
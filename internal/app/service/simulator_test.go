@@ -0,0 +1,142 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCards is a new-card queue of n cards under one model
+func newCards(model *FSRSService, n int) []*FSRSService {
+	queue := make([]*FSRSService, n)
+	for i := range queue {
+		queue[i] = model
+	}
+	return queue
+}
+
+func TestSimulate_IntroducesNewCardsAndSchedulesReviews(t *testing.T) {
+	model := NewFSRSService(nil, DefaultFSRSConfig(), data.SystemClock())
+	scenario := SimulationScenario{DesiredRetention: 0.9, NewCardsPerDay: 10, HorizonDays: 30}
+	costs := reviewCosts{recall: 30, lapse: 90, newCard: 60}
+
+	result := simulate(nil, newCards(model, 25), scenario, costs, 1)
+	require.Len(t, result.Days, 30)
+
+	// 25 new cards at 10/day: 10, 10, 5
+	assert.Equal(t, 10, result.Days[0].NewCards)
+	assert.Equal(t, 10, result.Days[1].NewCards)
+	assert.Equal(t, 5, result.Days[2].NewCards)
+	assert.Equal(t, 0, result.Days[3].NewCards)
+	assert.Equal(t, 25, result.TotalNewCards)
+	assert.Equal(t, 0, result.RemainingNew)
+
+	// Day 0 only costs introductions
+	assert.Equal(t, 0, result.Days[0].DueReviews)
+	assert.InDelta(t, 10.0, result.Days[0].ExpectedMinutes, 1e-9)
+
+	// Introduced cards come back for review within the horizon
+	assert.Greater(t, result.TotalReviews, 0)
+	assert.Greater(t, result.TotalLapses, 0.0)
+	assert.Less(t, result.TotalLapses, float64(result.TotalReviews))
+}
+
+func TestSimulate_HigherRetentionMeansMoreReviews(t *testing.T) {
+	costs := reviewCosts{recall: 20, lapse: 60, newCard: 45}
+
+	run := func(retention float64) *SimulationResult {
+		config := DefaultFSRSConfig()
+		config.DesiredRetention = retention
+		model := NewFSRSService(nil, config, data.SystemClock())
+		scenario := SimulationScenario{DesiredRetention: retention, NewCardsPerDay: 20, HorizonDays: 180}
+		return simulate(nil, newCards(model, 1000), scenario, costs, 7)
+	}
+
+	low := run(0.8)
+	high := run(0.95)
+	assert.Greater(t, high.TotalReviews, low.TotalReviews)
+	assert.Greater(t, high.TotalMinutes, low.TotalMinutes)
+
+	// Same seed, same forecast
+	assert.Equal(t, run(0.9), run(0.9))
+}
+
+func TestSimulate_FollowsEachCardsPreset(t *testing.T) {
+	scenario := SimulationScenario{DesiredRetention: 0.9, NewCardsPerDay: 2, HorizonDays: 10}
+	costs := reviewCosts{recall: 20, lapse: 60, newCard: 45}
+
+	daily := DefaultFSRSConfig()
+	daily.MaxInterval = 1
+	capped := NewFSRSService(nil, daily, data.SystemClock())
+	free := NewFSRSService(nil, DefaultFSRSConfig(), data.SystemClock())
+
+	// A card capped at one day is reviewed on each of days 1-9
+	both := simulate(nil, []*FSRSService{capped, capped}, scenario, costs, 1)
+	assert.Equal(t, 18, both.TotalReviews)
+
+	mixed := simulate(nil, []*FSRSService{capped, free}, scenario, costs, 1)
+	assert.Greater(t, mixed.TotalReviews, 9)
+	assert.Less(t, mixed.TotalReviews, 18)
+}
+
+func TestBuildPopulation_SplitsNewAndReviewCards(t *testing.T) {
+	model := NewFSRSService(nil, DefaultFSRSConfig(), data.SystemClock())
+	now := time.Now()
+	lastReview := now.AddDate(0, 0, -4)
+
+	cards := []*ent.FsrsCard{
+		{ID: uuid.New(), State: fsrscard.StateNew},
+		{ID: uuid.New(), State: fsrscard.StateLearning}, // Never graduated
+		{
+			ID:            uuid.New(),
+			State:         fsrscard.StateReview,
+			Stability:     10,
+			Difficulty:    5,
+			ScheduledDays: 10,
+			LastReview:    &lastReview,
+			Due:           now.AddDate(0, 0, 6),
+		},
+		{
+			ID:         uuid.New(),
+			State:      fsrscard.StateReview,
+			Stability:  3,
+			Difficulty: 6,
+			Due:        now.AddDate(0, 0, -2), // Overdue
+		},
+		{
+			ID:            uuid.New(),
+			State:         fsrscard.StateReview, // SM-2, no stability
+			EaseFactor:    2.5,
+			ScheduledDays: 8,
+			LastReview:    &lastReview,
+			Due:           now.AddDate(0, 0, 4),
+		},
+	}
+
+	population, newQueue := buildPopulation(func(*ent.FsrsCard) *FSRSService { return model }, cards, now)
+	assert.Len(t, newQueue, 2)
+	require.Len(t, population, 3)
+
+	assert.Equal(t, 6, population[0].due)
+	assert.Equal(t, -4, population[0].lastReview)
+	assert.Equal(t, 0, population[1].due, "overdue cards are due on day 0")
+
+	// Stability is recovered from the last interval
+	assert.Equal(t, 4, population[2].due)
+	assert.InDelta(t, 8, model.calculateInterval(population[2].stability, model.config.DesiredRetention), 1e-9)
+	assert.Equal(t, model.calculateInitialDifficulty(GradeGood), population[2].difficulty)
+}
+
+func TestSimulationScenario_Validate(t *testing.T) {
+	assert.NoError(t, SimulationScenario{DesiredRetention: 0.9, NewCardsPerDay: 20, HorizonDays: 365}.Validate())
+	assert.Error(t, SimulationScenario{DesiredRetention: 1, HorizonDays: 30}.Validate())
+	assert.Error(t, SimulationScenario{DesiredRetention: 0.9, NewCardsPerDay: -1, HorizonDays: 30}.Validate())
+	assert.Error(t, SimulationScenario{DesiredRetention: 0.9, HorizonDays: 0}.Validate())
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+)
+
+// SM2Config holds SuperMemo-2 parameters
+type SM2Config struct {
+	InitialEase float64 `json:"initial_ease"` // Ease factor given to graduating cards
+	MaxInterval int     `json:"max_interval"` // Upper bound on intervals in days
+}
+
+// DefaultSM2Config returns the published SM-2 starting ease
+func DefaultSM2Config() SM2Config {
+	return SM2Config{
+		InitialEase: 2.5,
+		MaxInterval: 36500,
+	}
+}
+
+// sm2MinEase is the lowest ease factor SM-2 allows
+const sm2MinEase = 1.3
+
+// SM2Service schedules review cards with the SuperMemo-2 algorithm
+type SM2Service struct {
+	client *ent.Client
+	config SM2Config
+	day    data.DayBoundary
+	clock  data.Clock
+}
+
+// NewSM2Service creates a new SM-2 scheduler
+func NewSM2Service(client *ent.Client, config SM2Config, clock data.Clock) *SM2Service {
+	return &SM2Service{
+		client: client,
+		config: config,
+		day:    data.DefaultDayBoundary(),
+		clock:  clock,
+	}
+}
+
+// WithDayBoundary returns a copy of the service that counts days by the given boundary
+func (s *SM2Service) WithDayBoundary(day data.DayBoundary) *SM2Service {
+	copied := *s
+	copied.day = day
+	return &copied
+}
+
+// Kind identifies SM-2 as a Scheduler
+func (s *SM2Service) Kind() SchedulerKind {
+	return SchedulerSM2
+}
+
+// ReviewCardAt applies an SM-2 review. Grades map to SM-2 quality
+// Again=1, Hard=3, Good=4, Easy=5; Again is a lapse into relearning.
+func (s *SM2Service) ReviewCardAt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	grade FSRSGrade,
+	now time.Time,
+) (*FSRSResult, error) {
+	if CardState(card.State) != StateReview {
+		return nil, fmt.Errorf("card must be in review state to use SM-2")
+	}
+
+	ease := s.nextEase(card.EaseFactor, grade)
+	elapsed := 0
+	if card.LastReview != nil {
+		elapsed = s.day.DaysBetween(*card.LastReview, now)
+	}
+
+	update := card.Update().
+		SetEaseFactor(ease).
+		SetElapsedDays(elapsed).
+		SetLastReview(now).
+		SetReps(card.Reps + 1)
+
+	if grade == GradeAgain {
+		// Relearning steps decide when it comes back
+		_, err := update.
+			SetLapses(card.Lapses + 1).
+			SetState(fsrscard.StateRelearning).
+			SetCurrentStep(0).
+			SetScheduledDays(0).
+			SetDue(now).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &FSRSResult{
+			Stability:       card.Stability,
+			Difficulty:      card.Difficulty,
+			NextReviewAt:    now,
+			IntervalDisplay: "relearn",
+		}, nil
+	}
+
+	intervalDays := s.nextInterval(card.ScheduledDays, ease)
+	nextReview := s.day.AddDays(now, intervalDays)
+	_, err := update.
+		SetScheduledDays(intervalDays).
+		SetDue(nextReview).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FSRSResult{
+		Stability:       card.Stability,
+		Difficulty:      card.Difficulty,
+		NextReviewAt:    nextReview,
+		IntervalDays:    intervalDays,
+		IntervalDisplay: formatDays(intervalDays),
+	}, nil
+}
+
+// GraduateCardAt starts SM-2 for a card leaving learning steps. New cards get
+// the initial ease; relearned cards keep the ease they had.
+func (s *SM2Service) GraduateCardAt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	grade FSRSGrade,
+	graduatingInterval int,
+	now time.Time,
+) (*FSRSResult, error) {
+	ease := card.EaseFactor
+	if card.Lapses == 0 || ease < sm2MinEase {
+		ease = s.config.InitialEase
+	}
+
+	intervalDays := s.capInterval(graduatingInterval)
+	nextReview := s.day.AddDays(now, intervalDays)
+
+	_, err := card.Update().
+		SetState(fsrscard.StateReview).
+		SetEaseFactor(ease).
+		SetScheduledDays(intervalDays).
+		SetElapsedDays(0).
+		SetDue(nextReview).
+		SetLastReview(now).
+		SetReps(card.Reps + 1).
+		SetCurrentStep(-1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FSRSResult{
+		Stability:       card.Stability,
+		Difficulty:      card.Difficulty,
+		NextReviewAt:    nextReview,
+		IntervalDays:    intervalDays,
+		IntervalDisplay: formatDays(intervalDays),
+	}, nil
+}
+
+// GetNextIntervals previews SM-2 intervals for each grade
+func (s *SM2Service) GetNextIntervals(card *ent.FsrsCard) map[int]string {
+	intervals := map[int]string{1: "relearn"}
+	for grade := GradeHard; grade <= GradeEasy; grade++ {
+		ease := s.nextEase(card.EaseFactor, grade)
+		intervals[int(grade)] = formatDays(s.nextInterval(card.ScheduledDays, ease))
+	}
+	return intervals
+}
+
+// nextEase applies EF' = EF + (0.1 - (5-q)(0.08 + (5-q)0.02)), floored at 1.3
+func (s *SM2Service) nextEase(ease float64, grade FSRSGrade) float64 {
+	if ease < sm2MinEase {
+		ease = s.config.InitialEase
+	}
+	q := float64(sm2Quality(grade))
+	ease += 0.1 - (5-q)*(0.08+(5-q)*0.02)
+	return math.Max(ease, sm2MinEase)
+}
+
+// nextInterval follows SM-2's 1, 6, then I*EF progression
+func (s *SM2Service) nextInterval(previous int, ease float64) int {
+	var interval int
+	switch {
+	case previous < 1:
+		interval = 1
+	case previous == 1:
+		interval = 6
+	default:
+		interval = int(math.Round(float64(previous) * ease))
+	}
+	return s.capInterval(interval)
+}
+
+func (s *SM2Service) capInterval(days int) int {
+	if days < 1 {
+		return 1
+	}
+	if s.config.MaxInterval > 0 && days > s.config.MaxInterval {
+		return s.config.MaxInterval
+	}
+	return days
+}
+
+// sm2Quality maps the four grade buttons onto SM-2's 0-5 response quality
+func sm2Quality(grade FSRSGrade) int {
+	switch grade {
+	case GradeAgain:
+		return 1
+	case GradeHard:
+		return 3
+	case GradeGood:
+		return 4
+	default:
+		return 5
+	}
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/errorresolution"
-	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
 
 	"github.com/google/uuid"
@@ -78,14 +78,9 @@ func (s *SnapshotService) ExportSnapshot(ctx context.Context, nodeID uuid.UUID)
 	}
 
 	// 3. Fetch Linked Theory (Context)
-	// Query: Find a node linked via 'tests' (if this is a problem) or 'defined_by'
-	// Usually: Problem -> (tests) -> Theory
-	theory, err := s.client.NodeAssociation.Query().
-		Where(
-			nodeassociation.SourceID(nodeID),
-			nodeassociation.RelTypeEQ(nodeassociation.RelTypeTests),
-		).
-		QueryTarget(). // ✅ Get the target node
+	// Problem -> (tests) -> Theory, or Theory -> (defines) -> Problem
+	theory, err := s.client.Node.Query().
+		Where(data.TestedBy(nodeID)).
 		First(ctx)
 
 	if err == nil && theory != nil {
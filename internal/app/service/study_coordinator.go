@@ -3,25 +3,27 @@ package service
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
 	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/schedulerpreset"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
 // StudyCoordinator manages study session queue generation and card state fetching
 type StudyCoordinator struct {
 	client *ent.Client
+	clock  data.Clock
 }
 
 // NewStudyCoordinator creates a new StudyCoordinator instance
-func NewStudyCoordinator(client *ent.Client) *StudyCoordinator {
-	return &StudyCoordinator{client: client}
+func NewStudyCoordinator(client *ent.Client, clock data.Clock) *StudyCoordinator {
+	return &StudyCoordinator{client: client, clock: clock}
 }
 
 // GetNodeWithCard returns a node with its associated FSRS card data
@@ -59,79 +61,178 @@ func (s *StudyCoordinator) GetNodeWithCard(ctx context.Context, nodeID uuid.UUID
 		"lapses":         card.Lapses,
 		"elapsed_days":   card.ElapsedDays,
 		"scheduled_days": card.ScheduledDays,
+		"is_leech":       card.IsLeech,
+		"is_suspended":   card.IsSuspended,
+		"buried_until":   card.BuriedUntil,
 	}, nil
 }
 
-// GetDueCardsQueue returns IDs of due cards for study session
-// Sorted by due date (oldest first), includes learning cards
+// GetDueCardsQueue returns node IDs for a study session across the library,
+// assembled by each card's preset queue policy (daily limits, learn-ahead, order)
 func (s *StudyCoordinator) GetDueCardsQueue(ctx context.Context, limit int) ([]string, error) {
-	now := time.Now()
-
-	cards, err := s.client.FsrsCard.Query().
-		Where(
-			fsrscard.Or(
-				// Due date is in the past
-				fsrscard.DueLTE(now),
-				// Or card is in learning/relearning state (high priority)
-				fsrscard.StateIn(fsrscard.StateLearning, fsrscard.StateRelearning),
-			),
-		).
-		Order(fsrscard.ByDue()). // Oldest first
-		Limit(limit).
-		All(ctx)
-
+	ids, err := s.buildQueue(ctx, nil, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch due cards: %w", err)
 	}
-
-	// Extract node IDs
-	ids := make([]string, len(cards))
-	for i, card := range cards {
-		ids[i] = card.NodeID.String()
-	}
-
 	return ids, nil
 }
 
-// GetDueCardsFromNode returns due card IDs from descendants of a specific parent node
+// GetDueCardsFromNode returns the study queue for descendants of a specific parent node
 // Uses NodeClosure table to find all descendants efficiently
 func (s *StudyCoordinator) GetDueCardsFromNode(ctx context.Context, parentID uuid.UUID, limit int) ([]string, error) {
-	now := time.Now()
+	ids, err := s.buildQueue(ctx, &parentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due cards from node: %w", err)
+	}
+	return ids, nil
+}
 
-	// Strategy: Query cards that belong to nodes which are descendants of parentID
-	// Use nodeclosure.descendant_id to find all child nodes
-	cards, err := s.client.FsrsCard.Query().
-		Where(
-			// Filter 1: Node must be a descendant of parentID (via closure table)
+// buildQueue loads the due cards (under rootID if set), counts what each
+// preset already studied today and applies the queue policies
+func (s *StudyCoordinator) buildQueue(ctx context.Context, rootID *uuid.UUID, limit int) ([]string, error) {
+	now := s.clock.Now()
+	day, err := data.NewSettingsRepository(s.client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.client.FsrsCard.Query().
+		// Due today, or card is in learning/relearning state (high priority)
+		Where(data.DueCards(now, day))
+	if rootID != nil {
+		query = query.Where(
+			// Node must be a descendant of rootID (via closure table)
 			fsrscard.HasNodeWith(
 				node.HasParentClosuresWith(
-					nodeclosure.AncestorID(parentID),
+					nodeclosure.AncestorID(*rootID),
 				),
-				// Filter 2: Only Problems/Theories (leaf nodes)
+				// Only Problems/Theories (leaf nodes)
 				node.TypeIn(node.TypeProblem, node.TypeTheory),
 			),
-			// Filter 3: Card must be due
-			fsrscard.Or(
-				fsrscard.DueLTE(now),
-				fsrscard.StateIn(fsrscard.StateLearning, fsrscard.StateRelearning),
-			),
+		)
+	}
+	cards, err := query.
+		Order(fsrscard.ByDue()). // Oldest first
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Today's attempts count against the daily limits
+	attempts, err := s.client.Attempt.Query().
+		Where(
+			attempt.CreatedAtGTE(day.StartOf(now)),
+			attempt.KindEQ(attempt.KindReview),
 		).
-		// Sort by due date using SQL
-		Modify(func(s *sql.Selector) {
-			s.OrderBy(sql.Asc(s.C(fsrscard.FieldDue)))
-		}).
-		Limit(limit).
+		WithCard().
 		All(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	nodeIDs := make([]uuid.UUID, 0, len(cards)+len(attempts))
+	for _, card := range cards {
+		nodeIDs = append(nodeIDs, card.NodeID)
+	}
+	for _, a := range attempts {
+		if a.Edges.Card != nil {
+			nodeIDs = append(nodeIDs, a.Edges.Card.NodeID)
+		}
+	}
+	if rootID != nil {
+		nodeIDs = append(nodeIDs, *rootID)
+	}
+
+	presets, err := NewPresetService(s.client).ResolveForNodes(ctx, nodeIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch due cards from node: %w", err)
+		return nil, err
+	}
+	presetOf := func(nodeID uuid.UUID) uuid.UUID {
+		if p := presets[nodeID]; p != nil {
+			return p.ID
+		}
+		return uuid.Nil
 	}
 
-	// Extract node IDs
-	ids := make([]string, len(cards))
-	for i, card := range cards {
-		ids[i] = card.NodeID.String()
+	policies := map[uuid.UUID]QueuePolicy{uuid.Nil: DefaultQueuePolicy()}
+	for _, p := range presets {
+		if p != nil {
+			policies[p.ID] = PresetQueuePolicy(p)
+		}
 	}
 
+	usage := make(map[uuid.UUID]dailyUsage)
+	introduced := make(map[uuid.UUID]bool)
+	for _, a := range attempts {
+		if a.Edges.Card == nil {
+			continue
+		}
+		id := presetOf(a.Edges.Card.NodeID)
+		used := usage[id]
+		switch a.State {
+		case attempt.StateNew:
+			if !introduced[a.CardID] {
+				introduced[a.CardID] = true
+				used.newCards++
+			}
+		case attempt.StateReview:
+			used.reviews++
+		}
+		usage[id] = used
+	}
+
+	// New cards wait until their prerequisites are learned
+	var newNodes []uuid.UUID
+	for _, card := range cards {
+		if card.State == fsrscard.StateNew {
+			newNodes = append(newNodes, card.NodeID)
+		}
+	}
+	locked, err := NewPrerequisiteService(s.client).lockedNodes(ctx, newNodes, func(nodeID uuid.UUID) PrerequisiteGate {
+		if p := presets[nodeID]; p != nil {
+			return PresetPrerequisiteGate(p)
+		}
+		return DefaultPrerequisiteGate()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]queueCard, 0, len(cards))
+	for _, card := range cards {
+		if locked[card.NodeID] {
+			continue
+		}
+		candidates = append(candidates, queueCard{
+			nodeID: card.NodeID,
+			state:  card.State,
+			due:    card.Due,
+			preset: presetOf(card.NodeID),
+		})
+	}
+
+	// The session's interleaving follows the preset of its root (or the default)
+	order := DefaultQueuePolicy().Order
+	if rootID != nil {
+		order = policies[presetOf(*rootID)].order()
+	} else {
+		def, err := s.client.SchedulerPreset.Query().
+			Where(schedulerpreset.IsDefault(true)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if def != nil {
+			order = PresetQueuePolicy(def).order()
+		}
+	}
+
+	queue := assembleQueue(candidates, policies, usage, order, now, limit)
+
+	// Extract node IDs
+	ids := make([]string, len(queue))
+	for i, id := range queue {
+		ids[i] = id.String()
+	}
 	return ids, nil
 }
@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/enttest"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/hooks"
 
 	_ "github.com/lib/pq"
@@ -24,7 +26,7 @@ func setupTestClient(t *testing.T) (*ent.Client, context.Context) {
 
 	// Register hooks
 	client.Node.Use(hooks.NodeClosureHook(client))
-	client.Node.Use(hooks.FsrsCardInitHook(client))
+	client.Node.Use(hooks.FsrsCardInitHook(client, data.SystemClock()))
 
 	ctx := context.Background()
 
@@ -35,6 +37,7 @@ func setupTestClient(t *testing.T) (*ent.Client, context.Context) {
 	client.NodeAssociation.Delete().ExecX(ctx)
 	client.NodeClosure.Delete().ExecX(ctx)
 	client.Node.Delete().ExecX(ctx)
+	client.SchedulerPreset.Delete().ExecX(ctx)
 
 	return client, ctx
 }
@@ -43,7 +46,7 @@ func TestGetNodeWithCard(t *testing.T) {
 	client, ctx := setupTestClient(t)
 	defer client.Close()
 
-	coordinator := NewStudyCoordinator(client)
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
 
 	// Create test node
 	testNode := client.Node.Create().
@@ -75,7 +78,7 @@ func TestGetDueCardsQueue(t *testing.T) {
 	client, ctx := setupTestClient(t)
 	defer client.Close()
 
-	coordinator := NewStudyCoordinator(client)
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
 
 	// Create 3 problems with different due dates
 	now := time.Now()
@@ -128,7 +131,7 @@ func TestGetDueCardsFromNode(t *testing.T) {
 	client, ctx := setupTestClient(t)
 	defer client.Close()
 
-	coordinator := NewStudyCoordinator(client)
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
 
 	// Create hierarchy: Subject -> Topic -> Problem
 	subject := client.Node.Create().
@@ -170,7 +173,7 @@ func TestGetDueCardsFromNode_LearningState(t *testing.T) {
 	client, ctx := setupTestClient(t)
 	defer client.Close()
 
-	coordinator := NewStudyCoordinator(client)
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
 
 	// Create subject and problem
 	subject := client.Node.Create().
@@ -204,7 +207,7 @@ func TestGetDueCardsQueue_Limit(t *testing.T) {
 	client, ctx := setupTestClient(t)
 	defer client.Close()
 
-	coordinator := NewStudyCoordinator(client)
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
 
 	now := time.Now()
 
@@ -232,7 +235,7 @@ func TestGetDueCardsFromNode_OnlyLeafNodes(t *testing.T) {
 	client, ctx := setupTestClient(t)
 	defer client.Close()
 
-	coordinator := NewStudyCoordinator(client)
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
 
 	now := time.Now()
 
@@ -285,3 +288,127 @@ func TestGetDueCardsFromNode_OnlyLeafNodes(t *testing.T) {
 	assert.True(t, idSet[theory.ID.String()])
 	assert.True(t, idSet[problem.ID.String()])
 }
+
+func TestGetDueCardsFromNode_DailyNewLimit(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	presets := NewPresetService(client)
+	settings := PresetSettings{
+		Name:     "Slow Intake",
+		FSRS:     DefaultFSRSConfig(),
+		Learning: DefaultLearningConfig(),
+		Queue:    DefaultQueuePolicy(),
+	}
+	settings.Queue.NewPerDay = 3
+	preset, err := presets.CreatePreset(ctx, settings)
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Import").SaveX(ctx)
+	require.NoError(t, presets.AssignPreset(ctx, subject.ID, &preset.ID))
+
+	var problems []*ent.Node
+	for i := 0; i < 10; i++ {
+		problems = append(problems, client.Node.Create().
+			SetType(node.TypeProblem).
+			SetTitle("Imported").
+			SetParentID(subject.ID).
+			SaveX(ctx))
+	}
+
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
+	ids, err := coordinator.GetDueCardsFromNode(ctx, subject.ID, 50)
+	require.NoError(t, err)
+	assert.Len(t, ids, 3, "bulk import is capped by the new-card limit")
+
+	// A card introduced today uses up one of the slots
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(problems[0].ID)).OnlyX(ctx)
+	client.Attempt.Create().
+		SetCardID(card.ID).
+		SetRating(3).
+		SetIsCorrect(true).
+		SetState("new").
+		SetStability(0).
+		SetDifficulty(0).
+		SaveX(ctx)
+	client.FsrsCard.UpdateOne(card).
+		SetState(fsrscard.StateLearning).
+		SetDue(time.Now().Add(5 * time.Minute)).
+		ExecX(ctx)
+
+	ids, err = coordinator.GetDueCardsFromNode(ctx, subject.ID, 50)
+	require.NoError(t, err)
+	require.Len(t, ids, 3, "1 learning card + 2 remaining new cards")
+	assert.Equal(t, problems[0].ID.String(), ids[0], "learning cards come first")
+}
+
+func TestGetDueCardsQueue_FollowsClock(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Later").SaveX(ctx)
+	client.FsrsCard.Update().
+		Where(fsrscard.NodeID(problem.ID)).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now().AddDate(0, 0, 10)).
+		ExecX(ctx)
+
+	ids, err := NewStudyCoordinator(client, data.SystemClock()).GetDueCardsQueue(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// 30 days from now the card is overdue, without touching the data
+	future := data.FixedClock(time.Now().AddDate(0, 0, 30))
+	ids, err = NewStudyCoordinator(client, future).GetDueCardsQueue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{problem.ID.String()}, ids)
+
+	stats, err := data.NewStatsRepository(client, future).GetDashboardStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.DueCards)
+}
+
+func TestGetDueCardsQueue_PrerequisiteGate(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	limits := client.Node.Create().SetType(node.TypeTheory).SetTitle("Limits").SaveX(ctx)
+	derivatives := client.Node.Create().SetType(node.TypeTheory).SetTitle("Derivatives").SaveX(ctx)
+	chain := client.Node.Create().SetType(node.TypeProblem).SetTitle("Chain rule").SaveX(ctx)
+
+	nodes := data.NewNodeRepository(client)
+	require.NoError(t, nodes.CreateAssociation(ctx, limits.ID, derivatives.ID, nodeassociation.RelTypeComesBefore))
+	require.NoError(t, nodes.CreateAssociation(ctx, derivatives.ID, chain.ID, nodeassociation.RelTypeComesBefore))
+
+	coordinator := NewStudyCoordinator(client, data.SystemClock())
+	ids, err := coordinator.GetDueCardsQueue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{limits.ID.String()}, ids, "only the root of the chain is unlocked")
+
+	// The chain rule is blocked by both prerequisites, transitively
+	status, err := NewPrerequisiteService(client).Explain(ctx, chain.ID)
+	require.NoError(t, err)
+	assert.True(t, status.Locked)
+	require.Len(t, status.Blocking, 2)
+	assert.Equal(t, derivatives.ID, status.Blocking[0].NodeID)
+	assert.Equal(t, 1, status.Blocking[0].Depth)
+	assert.Equal(t, limits.ID, status.Blocking[1].NodeID)
+	assert.Equal(t, 2, status.Blocking[1].Depth)
+
+	// Once Limits is stable, Derivatives unlocks but the chain rule still waits
+	client.FsrsCard.Update().
+		Where(fsrscard.NodeID(limits.ID)).
+		SetState(fsrscard.StateReview).
+		SetStability(5).
+		SetDue(time.Now().AddDate(0, 0, 5)).
+		ExecX(ctx)
+
+	ids, err = coordinator.GetDueCardsQueue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{derivatives.ID.String()}, ids)
+
+	status, err = NewPrerequisiteService(client).Explain(ctx, chain.ID)
+	require.NoError(t, err)
+	require.Len(t, status.Blocking, 1)
+	assert.Equal(t, "not started", status.Blocking[0].Reason)
+}
@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
+	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/predicate"
+
+	"github.com/google/uuid"
+)
+
+// SuggestionStream names where a suggestion came from
+type SuggestionStream string
+
+const (
+	StreamMaintenance SuggestionStream = "maintenance" // Due by the scheduler
+	StreamRemediation SuggestionStream = "remediation" // Unresolved errors and their siblings
+	StreamDiscovery   SuggestionStream = "discovery"   // Unexplored neighbours of studied nodes
+)
+
+// siblingWeight scales the error weight passed to problems testing the same theory
+const siblingWeight = 0.5
+
+// SuggestionRatios sets how many suggestions each stream gets relative to the others
+type SuggestionRatios struct {
+	Maintenance int `json:"maintenance"`
+	Remediation int `json:"remediation"`
+	Discovery   int `json:"discovery"`
+}
+
+// DefaultSuggestionRatios favours due reviews, then errors, then new ground
+func DefaultSuggestionRatios() SuggestionRatios {
+	return SuggestionRatios{
+		Maintenance: 5,
+		Remediation: 3,
+		Discovery:   2,
+	}
+}
+
+// Validate checks the ratios can be blended
+func (r SuggestionRatios) Validate() error {
+	if r.Maintenance < 0 || r.Remediation < 0 || r.Discovery < 0 {
+		return fmt.Errorf("stream ratios must not be negative")
+	}
+	if r.Maintenance+r.Remediation+r.Discovery == 0 {
+		return fmt.Errorf("at least one stream ratio must be positive")
+	}
+	return nil
+}
+
+// Suggestion is one scored, explained entry of the blended queue
+type Suggestion struct {
+	NodeID uuid.UUID        `json:"node_id"`
+	Title  string           `json:"title"`
+	Type   string           `json:"type"`
+	Stream SuggestionStream `json:"stream"`
+	Score  float64          `json:"score"` // Comparable within a stream only
+	Reason string           `json:"reason"`
+}
+
+// SuggestionEngine blends the maintenance, remediation and discovery streams
+type SuggestionEngine struct {
+	client *ent.Client
+	clock  data.Clock
+}
+
+// NewSuggestionEngine creates a new SuggestionEngine
+func NewSuggestionEngine(client *ent.Client, clock data.Clock) *SuggestionEngine {
+	return &SuggestionEngine{client: client, clock: clock}
+}
+
+// Suggest returns up to limit suggestions under rootID (the whole library when
+// nil), taking from each stream in proportion to the ratios. A stream that runs
+// short leaves its share to the others; a node appears at most once.
+func (e *SuggestionEngine) Suggest(
+	ctx context.Context,
+	rootID *uuid.UUID,
+	limit int,
+	ratios SuggestionRatios,
+) ([]Suggestion, error) {
+	if err := ratios.Validate(); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		return []Suggestion{}, nil
+	}
+
+	scope := func(p predicate.Node) predicate.Node { return p }
+	if rootID != nil {
+		scope = func(p predicate.Node) predicate.Node {
+			return node.And(p, node.HasParentClosuresWith(nodeclosure.AncestorID(*rootID)))
+		}
+	}
+
+	maintenance, err := e.maintenance(ctx, scope, limit)
+	if err != nil {
+		return nil, fmt.Errorf("maintenance stream: %w", err)
+	}
+	remediation, err := e.remediation(ctx, scope, limit)
+	if err != nil {
+		return nil, fmt.Errorf("remediation stream: %w", err)
+	}
+	discovery, err := e.discovery(ctx, scope, limit)
+	if err != nil {
+		return nil, fmt.Errorf("discovery stream: %w", err)
+	}
+
+	return blendStreams(
+		[][]Suggestion{maintenance, remediation, discovery},
+		[]int{ratios.Maintenance, ratios.Remediation, ratios.Discovery},
+		limit,
+	), nil
+}
+
+// maintenance lists due cards that were studied before, most overdue first
+func (e *SuggestionEngine) maintenance(ctx context.Context, scope func(predicate.Node) predicate.Node, limit int) ([]Suggestion, error) {
+	day, err := data.NewSettingsRepository(e.client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := e.clock.Now()
+
+	nodes, err := e.client.Node.Query().
+		Where(scope(node.HasFsrsCardWith(
+			data.DueCards(now, day),
+			fsrscard.StateNEQ(fsrscard.StateNew), // New cards belong to discovery
+		))).
+		WithFsrsCard().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Suggestion, 0, len(nodes))
+	for _, n := range nodes {
+		card := n.Edges.FsrsCard
+		overdue := day.DaysBetween(card.Due, now)
+		s := suggestionFor(n, StreamMaintenance)
+		switch {
+		case card.State == fsrscard.StateLearning || card.State == fsrscard.StateRelearning:
+			s.Score = float64(overdue) + 2 // Steps in progress come first
+			s.Reason = fmt.Sprintf("in %s", card.State)
+		case overdue > 0:
+			s.Score = float64(overdue) + 1
+			s.Reason = fmt.Sprintf("overdue by %d days", overdue)
+		default:
+			s.Score = 1
+			s.Reason = "due today"
+		}
+		out = append(out, s)
+	}
+	return topSuggestions(out, limit), nil
+}
+
+// remediation lists nodes by the total weight of their open errors, followed by
+// problems that test the same theory as an erring problem
+func (e *SuggestionEngine) remediation(ctx context.Context, scope func(predicate.Node) predicate.Node, limit int) ([]Suggestion, error) {
+	open, err := e.client.ErrorResolution.Query().
+		Where(
+			errorresolution.IsResolved(false),
+			errorresolution.HasNodeWith(scope(node.HasFsrsCardWith(fsrscard.IsSuspended(false)))),
+		).
+		WithNode().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := make(map[uuid.UUID]float64)
+	counts := make(map[uuid.UUID]int)
+	nodes := make(map[uuid.UUID]*ent.Node)
+	for _, r := range open {
+		weights[r.NodeID] += r.WeightImpact
+		counts[r.NodeID]++
+		nodes[r.NodeID] = r.Edges.Node
+	}
+
+	out := make([]Suggestion, 0, len(weights))
+	for id, weight := range weights {
+		s := suggestionFor(nodes[id], StreamRemediation)
+		s.Score = weight
+		s.Reason = fmt.Sprintf("%d unresolved errors (weight %.1f)", counts[id], weight)
+		out = append(out, s)
+	}
+
+	// Siblings inherit part of the weight of the heaviest erring problem on the theory
+	siblings := make(map[uuid.UUID]Suggestion)
+	for id, weight := range weights {
+		theories, err := e.client.Node.Query().
+			Where(data.TestedBy(id)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, theory := range theories {
+			related, err := e.client.Node.Query().
+				Where(scope(node.And(
+					data.Testing(theory.ID),
+					node.IDNEQ(id),
+					node.HasFsrsCardWith(fsrscard.IsSuspended(false)),
+				))).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, sibling := range related {
+				if _, erring := weights[sibling.ID]; erring {
+					continue
+				}
+				score := weight * siblingWeight
+				if prev, ok := siblings[sibling.ID]; ok && prev.Score >= score {
+					continue
+				}
+				s := suggestionFor(sibling, StreamRemediation)
+				s.Score = score
+				s.Reason = fmt.Sprintf("tests %q like %q, which has unresolved errors", theory.Title, nodes[id].Title)
+				siblings[sibling.ID] = s
+			}
+		}
+	}
+	for _, s := range siblings {
+		out = append(out, s)
+	}
+	return topSuggestions(out, limit), nil
+}
+
+// discovery lists unstudied nodes linked to studied ones, by how many studied
+// neighbours they have. Nodes held back by prerequisites are skipped.
+func (e *SuggestionEngine) discovery(ctx context.Context, scope func(predicate.Node) predicate.Node, limit int) ([]Suggestion, error) {
+	unexplored, err := e.client.Node.Query().
+		Where(scope(node.And(
+			node.HasFsrsCardWith(
+				fsrscard.StateEQ(fsrscard.StateNew),
+				fsrscard.IsSuspended(false),
+			),
+			node.Or(node.HasOutgoingAssociations(), node.HasIncomingAssociations()),
+		))).
+		All(ctx)
+	if err != nil || len(unexplored) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(unexplored))
+	for i, n := range unexplored {
+		ids[i] = n.ID
+	}
+
+	links, err := e.client.NodeAssociation.Query().
+		Where(nodeassociation.Or(
+			nodeassociation.SourceIDIn(ids...),
+			nodeassociation.TargetIDIn(ids...),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbours := make(map[uuid.UUID][]uuid.UUID)
+	var others []uuid.UUID
+	for _, l := range links {
+		neighbours[l.SourceID] = append(neighbours[l.SourceID], l.TargetID)
+		neighbours[l.TargetID] = append(neighbours[l.TargetID], l.SourceID)
+		others = append(others, l.SourceID, l.TargetID)
+	}
+
+	studiedCards, err := e.client.FsrsCard.Query().
+		Where(
+			fsrscard.NodeIDIn(others...),
+			fsrscard.StateNEQ(fsrscard.StateNew),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studied := make(map[uuid.UUID]bool, len(studiedCards))
+	for _, card := range studiedCards {
+		studied[card.NodeID] = true
+	}
+
+	presets, err := NewPresetService(e.client).ResolveForNodes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := NewPrerequisiteService(e.client).lockedNodes(ctx, ids, func(nodeID uuid.UUID) PrerequisiteGate {
+		if p := presets[nodeID]; p != nil {
+			return PresetPrerequisiteGate(p)
+		}
+		return DefaultPrerequisiteGate()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Suggestion, 0, len(unexplored))
+	for _, n := range unexplored {
+		if locked[n.ID] {
+			continue
+		}
+		count := 0
+		for _, other := range neighbours[n.ID] {
+			if studied[other] {
+				count++
+			}
+		}
+		if count == 0 {
+			continue
+		}
+		s := suggestionFor(n, StreamDiscovery)
+		s.Score = float64(count)
+		s.Reason = fmt.Sprintf("linked to %d studied nodes", count)
+		out = append(out, s)
+	}
+	return topSuggestions(out, limit), nil
+}
+
+func suggestionFor(n *ent.Node, stream SuggestionStream) Suggestion {
+	return Suggestion{
+		NodeID: n.ID,
+		Title:  n.Title,
+		Type:   string(n.Type),
+		Stream: stream,
+	}
+}
+
+// topSuggestions sorts by score (ties by title) and keeps the first limit
+func topSuggestions(s []Suggestion, limit int) []Suggestion {
+	sort.SliceStable(s, func(i, j int) bool {
+		if s[i].Score != s[j].Score {
+			return s[i].Score > s[j].Score
+		}
+		return s[i].Title < s[j].Title
+	})
+	if len(s) > limit {
+		s = s[:limit]
+	}
+	return s
+}
+
+// blendStreams interleaves the streams by smooth weighted round robin: each
+// pick goes to the stream furthest behind its share. Exhausted streams drop out.
+func blendStreams(streams [][]Suggestion, ratios []int, limit int) []Suggestion {
+	out := make([]Suggestion, 0, limit)
+	seen := make(map[uuid.UUID]bool)
+	next := make([]int, len(streams))
+	credit := make([]int, len(streams))
+
+	for len(out) < limit {
+		total := 0
+		for i := range streams {
+			if ratios[i] > 0 && next[i] < len(streams[i]) {
+				credit[i] += ratios[i]
+				total += ratios[i]
+			}
+		}
+		if total == 0 {
+			break // Every stream is exhausted
+		}
+
+		pick := -1
+		for i := range streams {
+			if ratios[i] > 0 && next[i] < len(streams[i]) && (pick < 0 || credit[i] > credit[pick]) {
+				pick = i
+			}
+		}
+		credit[pick] -= total
+
+		s := streams[pick][next[pick]]
+		next[pick]++
+		if !seen[s.NodeID] {
+			seen[s.NodeID] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlendStreams_FollowsRatiosAndBackfills(t *testing.T) {
+	stream := func(name SuggestionStream, n int) []Suggestion {
+		out := make([]Suggestion, n)
+		for i := range out {
+			out[i] = Suggestion{NodeID: uuid.New(), Stream: name}
+		}
+		return out
+	}
+	count := func(s []Suggestion) map[SuggestionStream]int {
+		c := make(map[SuggestionStream]int)
+		for _, x := range s {
+			c[x.Stream]++
+		}
+		return c
+	}
+
+	streams := [][]Suggestion{
+		stream(StreamMaintenance, 20),
+		stream(StreamRemediation, 20),
+		stream(StreamDiscovery, 20),
+	}
+	blended := blendStreams(streams, []int{5, 3, 2}, 10)
+	require.Len(t, blended, 10)
+	assert.Equal(t, map[SuggestionStream]int{StreamMaintenance: 5, StreamRemediation: 3, StreamDiscovery: 2}, count(blended))
+	assert.Equal(t, StreamMaintenance, blended[0].Stream)
+
+	// A short stream leaves its share to the others
+	streams[1] = streams[1][:1]
+	blended = blendStreams(streams, []int{5, 3, 2}, 10)
+	require.Len(t, blended, 10)
+	assert.Equal(t, 1, count(blended)[StreamRemediation])
+
+	// A node suggested by two streams appears once
+	shared := Suggestion{NodeID: uuid.New(), Stream: StreamRemediation}
+	blended = blendStreams([][]Suggestion{{shared}, {shared}, nil}, []int{1, 1, 1}, 10)
+	assert.Len(t, blended, 1)
+}
+
+func TestSuggestionEngine_ThreeStreams(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Probability").SaveX(ctx)
+	theory := client.Node.Create().SetType(node.TypeTheory).SetTitle("Bayes").SetParentID(topic.ID).SaveX(ctx)
+	failed := client.Node.Create().SetType(node.TypeProblem).SetTitle("Test positive").SetParentID(topic.ID).SaveX(ctx)
+	sibling := client.Node.Create().SetType(node.TypeProblem).SetTitle("Spam filter").SetParentID(topic.ID).SaveX(ctx)
+	due := client.Node.Create().SetType(node.TypeProblem).SetTitle("Coin flips").SetParentID(topic.ID).SaveX(ctx)
+	fresh := client.Node.Create().SetType(node.TypeTheory).SetTitle("Priors").SetParentID(topic.ID).SaveX(ctx)
+
+	nodes := data.NewNodeRepository(client)
+	require.NoError(t, nodes.CreateAssociation(ctx, failed.ID, theory.ID, nodeassociation.RelTypeTests))
+	require.NoError(t, nodes.CreateAssociation(ctx, sibling.ID, theory.ID, nodeassociation.RelTypeTests))
+	require.NoError(t, nodes.CreateAssociation(ctx, theory.ID, fresh.ID, nodeassociation.RelTypeSimilarTo))
+
+	// Everything but the new theory has been studied; one problem is overdue
+	client.FsrsCard.Update().
+		Where(fsrscard.NodeIDIn(theory.ID, failed.ID, sibling.ID, due.ID)).
+		SetState(fsrscard.StateReview).
+		SetStability(10).
+		SetDue(time.Now().AddDate(0, 0, 5)).
+		ExecX(ctx)
+	client.FsrsCard.Update().
+		Where(fsrscard.NodeID(due.ID)).
+		SetDue(time.Now().AddDate(0, 0, -3)).
+		ExecX(ctx)
+
+	def := client.ErrorDefinition.Create().SetLabel("Concept " + uuid.NewString()).SetBaseWeight(2.5).SaveX(ctx)
+	client.ErrorResolution.Create().SetNodeID(failed.ID).SetErrorTypeID(def.ID).SetWeightImpact(2.5).ExecX(ctx)
+
+	engine := NewSuggestionEngine(client, data.SystemClock())
+	suggestions, err := engine.Suggest(ctx, &topic.ID, 10, DefaultSuggestionRatios())
+	require.NoError(t, err)
+
+	byNode := make(map[uuid.UUID]Suggestion)
+	for _, s := range suggestions {
+		byNode[s.NodeID] = s
+		assert.NotEmpty(t, s.Reason)
+	}
+	require.Len(t, byNode, 4)
+	assert.Equal(t, StreamMaintenance, byNode[due.ID].Stream)
+	assert.Equal(t, StreamRemediation, byNode[failed.ID].Stream)
+	assert.Equal(t, 2.5, byNode[failed.ID].Score)
+	assert.Equal(t, StreamRemediation, byNode[sibling.ID].Stream)
+	assert.Equal(t, 2.5*siblingWeight, byNode[sibling.ID].Score)
+	assert.Equal(t, StreamDiscovery, byNode[fresh.ID].Stream)
+
+	// Turning a stream off removes it from the blend
+	suggestions, err = engine.Suggest(ctx, &topic.ID, 10, SuggestionRatios{Remediation: 1})
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, failed.ID, suggestions[0].NodeID)
+}
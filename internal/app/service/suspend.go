@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
+	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/predicate"
+	"profen/internal/data/ent/schema"
+
+	"github.com/google/uuid"
+)
+
+// SuspendService takes cards out of study rotation and puts them back
+type SuspendService struct {
+	client *ent.Client
+	clock  data.Clock // Decides when tomorrow starts for burying
+}
+
+// NewSuspendService creates a new SuspendService
+func NewSuspendService(client *ent.Client, clock data.Clock) *SuspendService {
+	return &SuspendService{client: client, clock: clock}
+}
+
+// Suspend removes the node's card (or every card under it) from study sessions
+// until it is unsuspended. Returns the number of cards changed.
+func (s *SuspendService) Suspend(ctx context.Context, nodeID uuid.UUID, subtree bool) (int, error) {
+	n, err := s.client.FsrsCard.Update().
+		Where(cardsUnder(nodeID, subtree)).
+		SetIsSuspended(true).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("suspending cards: %w", err)
+	}
+	return n, nil
+}
+
+// Bury hides the node's card (or every card under it) until the next study day
+func (s *SuspendService) Bury(ctx context.Context, nodeID uuid.UUID, subtree bool) (int, error) {
+	until, err := s.tomorrow(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := s.client.FsrsCard.Update().
+		Where(cardsUnder(nodeID, subtree)).
+		SetBuriedUntil(until).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("burying cards: %w", err)
+	}
+	return n, nil
+}
+
+// Unsuspend returns suspended or buried cards to study sessions
+func (s *SuspendService) Unsuspend(ctx context.Context, nodeID uuid.UUID, subtree bool) (int, error) {
+	n, err := s.client.FsrsCard.Update().
+		Where(cardsUnder(nodeID, subtree)).
+		SetIsSuspended(false).
+		ClearBuriedUntil().
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unsuspending cards: %w", err)
+	}
+	return n, nil
+}
+
+// BuryTranslationSiblings buries the cards of terms linked to the node by
+// translation_of/translated_from, so the pair is not reviewed on the same day.
+// Returns the buried cards as they were before.
+func (s *SuspendService) BuryTranslationSiblings(ctx context.Context, nodeID uuid.UUID) ([]schema.BuriedSnapshot, error) {
+	links, err := s.client.NodeAssociation.Query().
+		Where(
+			nodeassociation.Or(
+				nodeassociation.SourceID(nodeID),
+				nodeassociation.TargetID(nodeID),
+			),
+			nodeassociation.RelTypeIn(
+				nodeassociation.RelTypeTranslationOf,
+				nodeassociation.RelTypeTranslatedFrom,
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading translations: %w", err)
+	}
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	siblings := make([]uuid.UUID, 0, len(links))
+	for _, link := range links {
+		if link.SourceID == nodeID {
+			siblings = append(siblings, link.TargetID)
+		} else {
+			siblings = append(siblings, link.SourceID)
+		}
+	}
+
+	until, err := s.tomorrow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := s.client.FsrsCard.Query().
+		Where(fsrscard.NodeIDIn(siblings...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading translations: %w", err)
+	}
+
+	buried := make([]schema.BuriedSnapshot, 0, len(cards))
+	for _, card := range cards {
+		if err := card.Update().SetBuriedUntil(until).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("burying translations: %w", err)
+		}
+		buried = append(buried, schema.BuriedSnapshot{ID: card.ID, BuriedUntil: card.BuriedUntil})
+	}
+	return buried, nil
+}
+
+// tomorrow returns when the next study day starts
+func (s *SuspendService) tomorrow(ctx context.Context) (time.Time, error) {
+	day, err := data.NewSettingsRepository(s.client).DayBoundary(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return day.NextStart(s.clock.Now()), nil
+}
+
+// cardsUnder matches the node's own card, or with subtree every card below it
+func cardsUnder(nodeID uuid.UUID, subtree bool) predicate.FsrsCard {
+	if !subtree {
+		return fsrscard.NodeID(nodeID)
+	}
+	return fsrscard.HasNodeWith(
+		node.HasParentClosuresWith(nodeclosure.AncestorID(nodeID)),
+	)
+}
@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeassociation"
+	"profen/internal/data/hooks"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspendService_SuspendBuryUnsuspend(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Physics").SaveX(ctx)
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Optics").SetParentID(subject.ID).SaveX(ctx)
+	a := client.Node.Create().SetType(node.TypeProblem).SetTitle("Lens").SetParentID(topic.ID).SaveX(ctx)
+	b := client.Node.Create().SetType(node.TypeProblem).SetTitle("Mirror").SetParentID(topic.ID).SaveX(ctx)
+	for _, n := range []uuid.UUID{a.ID, b.ID} {
+		client.FsrsCard.Create().SetNodeID(n).SetDue(time.Now().Add(-time.Hour)).SaveX(ctx)
+	}
+
+	svc := service.NewSuspendService(client, data.SystemClock())
+	study := service.NewStudyCoordinator(client, data.SystemClock())
+	stats := data.NewStatsRepository(client, data.SystemClock())
+
+	dueCount := func() int {
+		queue, err := study.GetDueCardsQueue(ctx, 10)
+		require.NoError(t, err)
+		fromNode, err := study.GetDueCardsFromNode(ctx, subject.ID, 10)
+		require.NoError(t, err)
+		suggested, err := data.NewSuggestionRepository(client, data.SystemClock()).GetDueCards(ctx, 10)
+		require.NoError(t, err)
+		dashboard, err := stats.GetDashboardStats(ctx)
+		require.NoError(t, err)
+
+		assert.Len(t, fromNode, len(queue))
+		assert.Len(t, suggested, len(queue))
+		assert.Equal(t, len(queue), dashboard.DueCards)
+		return len(queue)
+	}
+	require.Equal(t, 2, dueCount())
+
+	// Suspending the subject takes everything under it out of rotation
+	changed, err := svc.Suspend(ctx, subject.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, changed)
+	assert.Equal(t, 0, dueCount())
+
+	dashboard, err := stats.GetDashboardStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, dashboard.Suspended)
+
+	// Unsuspending a single node leaves its sibling suspended
+	_, err = svc.Unsuspend(ctx, a.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dueCount())
+
+	// Burying hides the card until tomorrow
+	_, err = svc.Bury(ctx, a.ID, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, dueCount())
+	card := client.FsrsCard.Query().Where(fsrscard.NodeID(a.ID)).OnlyX(ctx)
+	require.NotNil(t, card.BuriedUntil)
+	assert.True(t, card.BuriedUntil.After(time.Now()))
+
+	dashboard, err = stats.GetDashboardStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dashboard.Buried)
+
+	// Tomorrow follows the injected clock
+	future := time.Now().AddDate(0, 0, 10)
+	_, err = service.NewSuspendService(client, data.FixedClock(future)).Bury(ctx, b.ID, false)
+	require.NoError(t, err)
+	buried := client.FsrsCard.Query().Where(fsrscard.NodeID(b.ID)).OnlyX(ctx)
+	require.NotNil(t, buried.BuriedUntil)
+	assert.True(t, buried.BuriedUntil.After(future))
+
+	// Unsuspending the subtree clears both
+	_, err = svc.Unsuspend(ctx, subject.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, dueCount())
+}
+
+func TestReviewCoordinator_BuriesTranslationSiblings(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	presets := service.NewPresetService(client)
+	settings := service.PresetSettings{
+		Name:             "Vocab",
+		FSRS:             service.DefaultFSRSConfig(),
+		Learning:         service.DefaultLearningConfig(),
+		Leech:            service.DefaultLeechConfig(),
+		BuryTranslations: true,
+	}
+	preset, err := presets.CreatePreset(ctx, settings)
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("German").SaveX(ctx)
+	require.NoError(t, presets.AssignPreset(ctx, subject.ID, &preset.ID))
+	hund := client.Node.Create().SetType(node.TypeTerm).SetTitle("Hund").SetParentID(subject.ID).SaveX(ctx)
+	dog := client.Node.Create().SetType(node.TypeTerm).SetTitle("dog").SetParentID(subject.ID).SaveX(ctx)
+	for _, n := range []uuid.UUID{hund.ID, dog.ID} {
+		client.FsrsCard.Create().SetNodeID(n).SetDue(time.Now().Add(-time.Hour)).SaveX(ctx)
+	}
+	require.NoError(t, data.NewNodeRepository(client).
+		CreateAssociation(ctx, hund.ID, dog.ID, nodeassociation.RelTypeTranslationOf))
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+	_, _, err = coordinator.SubmitReview(ctx, service.ReviewSubmission{NodeID: hund.ID, Grade: 3})
+	require.NoError(t, err)
+
+	sibling := client.FsrsCard.Query().Where(fsrscard.NodeID(dog.ID)).OnlyX(ctx)
+	require.NotNil(t, sibling.BuriedUntil)
+	assert.True(t, sibling.BuriedUntil.After(time.Now()))
+
+	reviewed := client.FsrsCard.Query().Where(fsrscard.NodeID(hund.ID)).OnlyX(ctx)
+	assert.Nil(t, reviewed.BuriedUntil)
+
+	// Undoing the review unburies the sibling
+	_, err = service.NewUndoService(client).UndoLast(ctx)
+	require.NoError(t, err)
+	sibling = client.FsrsCard.GetX(ctx, sibling.ID)
+	assert.Nil(t, sibling.BuriedUntil)
+}
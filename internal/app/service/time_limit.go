@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/nodeclosure"
+
+	"github.com/google/uuid"
+)
+
+// TimeLimitKey is the node metadata key holding the answer time limit in seconds
+const TimeLimitKey = "time_limit_seconds"
+
+// TimeLimit is the effective answer time limit of a node
+type TimeLimit struct {
+	Seconds   int       `json:"seconds"`
+	SourceID  uuid.UUID `json:"source_id"` // Node the limit is set on
+	Inherited bool      `json:"inherited"` // Set on a topic or subject above the node
+}
+
+// TopicTimeStats summarizes answer times under one topic
+type TopicTimeStats struct {
+	TopicID      uuid.UUID  `json:"topic_id"`
+	Title        string     `json:"title"`
+	Reviews      int        `json:"reviews"`
+	Correct      int        `json:"correct"`
+	MedianMs     int        `json:"median_ms"` // Percentiles of correct answers
+	P75Ms        int        `json:"p75_ms"`
+	P90Ms        int        `json:"p90_ms"`
+	Limit        *TimeLimit `json:"limit,omitempty"`
+	OverLimit    int        `json:"over_limit"`    // Correct answers slower than the limit
+	SuggestedSec int        `json:"suggested_sec"` // p75 of correct answers, rounded up to 5 seconds
+}
+
+// TimeLimitService reads and writes answer time limits and reports answer times
+type TimeLimitService struct {
+	client *ent.Client
+}
+
+// NewTimeLimitService creates a new TimeLimitService
+func NewTimeLimitService(client *ent.Client) *TimeLimitService {
+	return &TimeLimitService{client: client}
+}
+
+// SetTimeLimit stores the limit in the node's metadata; 0 removes it so the
+// node inherits again
+func (s *TimeLimitService) SetTimeLimit(ctx context.Context, nodeID uuid.UUID, seconds int) (*ent.Node, error) {
+	if seconds < 0 {
+		return nil, fmt.Errorf("time limit must not be negative")
+	}
+	n, err := s.client.Node.Get(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]interface{}, len(n.Metadata)+1)
+	for k, v := range n.Metadata {
+		metadata[k] = v
+	}
+	if seconds == 0 {
+		delete(metadata, TimeLimitKey)
+	} else {
+		metadata[TimeLimitKey] = seconds
+	}
+	return n.Update().SetMetadata(metadata).Save(ctx)
+}
+
+// Resolve returns the limit on the node itself or its nearest ancestor, or nil
+// when none is set
+func (s *TimeLimitService) Resolve(ctx context.Context, nodeID uuid.UUID) (*TimeLimit, error) {
+	closures, err := s.client.NodeClosure.Query().
+		Where(nodeclosure.DescendantID(nodeID)).
+		Order(ent.Asc(nodeclosure.FieldDepth)). // The node itself first
+		WithAncestor().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resolving time limit: %w", err)
+	}
+
+	for _, c := range closures {
+		if c.Edges.Ancestor == nil {
+			continue
+		}
+		if seconds := timeLimitOf(c.Edges.Ancestor); seconds > 0 {
+			return &TimeLimit{Seconds: seconds, SourceID: c.AncestorID, Inherited: c.Depth > 0}, nil
+		}
+	}
+	return nil, nil
+}
+
+// TopicStats reports answer times for every topic under rootID (all topics
+// when nil), to help choose limits
+func (s *TimeLimitService) TopicStats(ctx context.Context, rootID *uuid.UUID) ([]TopicTimeStats, error) {
+	q := s.client.Node.Query().Where(node.TypeEQ(node.TypeTopic))
+	if rootID != nil {
+		q = q.Where(node.HasParentClosuresWith(nodeclosure.AncestorID(*rootID)))
+	}
+	topics, err := q.Order(ent.Asc(node.FieldTitle)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading topics: %w", err)
+	}
+
+	stats := make([]TopicTimeStats, 0, len(topics))
+	for _, topic := range topics {
+		attempts, err := s.client.Attempt.Query().
+			Where(
+				attempt.KindEQ(attempt.KindReview),
+				attempt.DurationMsGT(0),
+				attempt.HasCardWith(cardsUnder(topic.ID, true)),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("loading attempts for %q: %w", topic.Title, err)
+		}
+
+		limit, err := s.Resolve(ctx, topic.ID)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, topicTimeStats(topic, attempts, limit))
+	}
+	return stats, nil
+}
+
+// topicTimeStats computes the percentiles of correct answer times
+func topicTimeStats(topic *ent.Node, attempts []*ent.Attempt, limit *TimeLimit) TopicTimeStats {
+	st := TopicTimeStats{
+		TopicID: topic.ID,
+		Title:   topic.Title,
+		Reviews: len(attempts),
+		Limit:   limit,
+	}
+
+	var durations []int
+	for _, a := range attempts {
+		if !a.IsCorrect {
+			continue
+		}
+		durations = append(durations, a.DurationMs)
+		if limit != nil && a.DurationMs > limit.Seconds*1000 {
+			st.OverLimit++
+		}
+	}
+	st.Correct = len(durations)
+	if len(durations) == 0 {
+		return st
+	}
+
+	sort.Ints(durations)
+	st.MedianMs = percentile(durations, 0.5)
+	st.P75Ms = percentile(durations, 0.75)
+	st.P90Ms = percentile(durations, 0.9)
+	st.SuggestedSec = int(math.Ceil(float64(st.P75Ms)/5000)) * 5
+	return st
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sorted []int, p float64) int {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// timeLimitOf reads the limit from a node's metadata; JSON numbers decode as float64
+func timeLimitOf(n *ent.Node) int {
+	switch v := n.Metadata[TimeLimitKey].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"testing"
+
+	"profen/internal/data"
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/node"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicTimeStats_Percentiles(t *testing.T) {
+	topic := &ent.Node{ID: uuid.New(), Title: "Derivatives"}
+	var attempts []*ent.Attempt
+	for i := 1; i <= 10; i++ {
+		attempts = append(attempts, &ent.Attempt{DurationMs: i * 4000, IsCorrect: true})
+	}
+	attempts = append(attempts, &ent.Attempt{DurationMs: 90000, IsCorrect: false}) // Wrong answers are ignored
+
+	st := topicTimeStats(topic, attempts, &TimeLimit{Seconds: 30})
+	assert.Equal(t, 11, st.Reviews)
+	assert.Equal(t, 10, st.Correct)
+	assert.Equal(t, 20000, st.MedianMs)
+	assert.Equal(t, 32000, st.P75Ms)
+	assert.Equal(t, 36000, st.P90Ms)
+	assert.Equal(t, 35, st.SuggestedSec)
+	assert.Equal(t, 3, st.OverLimit) // 32s, 36s and 40s
+
+	empty := topicTimeStats(topic, nil, nil)
+	assert.Zero(t, empty.SuggestedSec)
+}
+
+func TestReviewCoordinator_SlowCorrectAnswerIsFluencyError(t *testing.T) {
+	client, ctx := setupTestClient(t)
+	defer client.Close()
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Calculus").SaveX(ctx)
+	topic := client.Node.Create().SetType(node.TypeTopic).SetTitle("Derivatives").SetParentID(subject.ID).SaveX(ctx)
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("d/dx sin x").SetParentID(topic.ID).SaveX(ctx)
+
+	limits := NewTimeLimitService(client)
+	_, err := limits.SetTimeLimit(ctx, subject.ID, 30)
+	require.NoError(t, err)
+
+	limit, err := limits.Resolve(ctx, problem.ID)
+	require.NoError(t, err)
+	require.NotNil(t, limit)
+	assert.Equal(t, 30, limit.Seconds)
+	assert.Equal(t, subject.ID, limit.SourceID)
+	assert.True(t, limit.Inherited)
+
+	// The topic overrides the subject
+	_, err = limits.SetTimeLimit(ctx, topic.ID, 20)
+	require.NoError(t, err)
+	limit, err = limits.Resolve(ctx, problem.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 20, limit.Seconds)
+
+	coordinator := NewReviewCoordinator(
+		NewLearningStepsService(client, DefaultLearningConfig(), data.SystemClock()),
+		NewFSRSService(client, DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+	review := func(grade, durationMs int) *ReviewResult {
+		result, _, err := coordinator.SubmitReview(ctx, ReviewSubmission{
+			NodeID:     problem.ID,
+			Grade:      grade,
+			DurationMs: durationMs,
+		})
+		require.NoError(t, err)
+		return result
+	}
+	fluency, err := systemErrorType(ctx, client, data.ErrorCodeFluency)
+	require.NoError(t, err)
+	fluencyErrors := func() []*ent.ErrorResolution {
+		return client.ErrorResolution.Query().
+			Where(
+				errorresolution.NodeID(problem.ID),
+				errorresolution.ErrorTypeID(fluency.ID),
+			).
+			AllX(ctx)
+	}
+
+	assert.False(t, review(3, 15000).OverTimeLimit)
+	assert.False(t, review(1, 60000).OverTimeLimit) // Wrong answers are not fluency errors
+	assert.Empty(t, fluencyErrors())
+
+	assert.True(t, review(3, 25000).OverTimeLimit)
+	open := fluencyErrors()
+	require.Len(t, open, 1)
+	assert.False(t, open[0].IsResolved)
+
+	stats, err := limits.TopicStats(ctx, &subject.ID)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, 3, stats[0].Reviews)
+	assert.Equal(t, 2, stats[0].Correct)
+	assert.Equal(t, 1, stats[0].OverLimit)
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/schema"
+
+	"github.com/google/uuid"
+)
+
+// UndoResult describes a review that was undone
+type UndoResult struct {
+	AttemptID uuid.UUID `json:"attempt_id"`
+	NodeID    uuid.UUID `json:"node_id"` // Goes back to the front of the study queue
+	Rating    int       `json:"rating"`
+	CardState string    `json:"card_state"` // Restored state
+}
+
+// UndoService keeps a stack of the reviews made in this session and reverts them.
+// The stack lives in memory; after a restart the most recent review in the
+// database is undone instead.
+type UndoService struct {
+	client *ent.Client
+
+	mu    sync.Mutex
+	stack []uuid.UUID // Attempt IDs, most recent last
+}
+
+// NewUndoService creates a new UndoService
+func NewUndoService(client *ent.Client) *UndoService {
+	return &UndoService{client: client}
+}
+
+// Push records a review so it can be undone
+func (s *UndoService) Push(attemptID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack = append(s.stack, attemptID)
+}
+
+// UndoLast reverts the most recent review: the card gets its exact prior
+// fields back, errors the review opened, bumped or closed are reverted and the
+// attempt is deleted, in one transaction.
+func (s *UndoService) UndoLast(ctx context.Context) (*UndoResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attemptID, err := s.nextTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	result, err := s.undo(ctx, tx, attemptID)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("rolling back transaction: %v (original error: %w)", rerr, err)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	if n := len(s.stack); n > 0 && s.stack[n-1] == attemptID {
+		s.stack = s.stack[:n-1]
+	}
+	return result, nil
+}
+
+// nextTarget returns the attempt to undo: the top of the session stack
+// (skipping entries already removed elsewhere), else the latest graded review.
+func (s *UndoService) nextTarget(ctx context.Context) (uuid.UUID, error) {
+	for len(s.stack) > 0 {
+		top := s.stack[len(s.stack)-1]
+		exists, err := s.client.Attempt.Query().Where(attempt.ID(top)).Exist(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if exists {
+			return top, nil
+		}
+		s.stack = s.stack[:len(s.stack)-1]
+	}
+
+	latest, err := s.client.Attempt.Query().
+		Where(attempt.KindEQ(attempt.KindReview)). // Implicit credit is undone with its review
+		Order(ent.Desc(attempt.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return uuid.Nil, fmt.Errorf("nothing to undo")
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return latest.ID, nil
+}
+
+func (s *UndoService) undo(ctx context.Context, tx *ent.Tx, attemptID uuid.UUID) (*UndoResult, error) {
+	a, err := tx.Attempt.Get(ctx, attemptID)
+	if err != nil {
+		return nil, fmt.Errorf("attempt not found: %w", err)
+	}
+	if a.Kind == attempt.KindImplicit {
+		return nil, fmt.Errorf("implicit credit is undone with the review that gave it")
+	}
+	if a.CardBefore == nil {
+		return nil, fmt.Errorf("attempt %s was recorded without a card snapshot and cannot be undone", attemptID)
+	}
+
+	// Restoring an older snapshot would silently drop the reviews after it
+	newer, err := hasNewerAttempt(ctx, tx.Client(), a)
+	if err != nil {
+		return nil, err
+	}
+	if newer {
+		return nil, fmt.Errorf("only the most recent review of a card can be undone")
+	}
+
+	card, err := restoreCard(ctx, tx.Client(), a.CardID, a.CardBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := revertEffects(ctx, tx.Client(), a.Effects); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Attempt.DeleteOneID(a.ID).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("deleting attempt: %w", err)
+	}
+
+	return &UndoResult{
+		AttemptID: a.ID,
+		NodeID:    card.NodeID,
+		Rating:    a.Rating,
+		CardState: string(card.State),
+	}, nil
+}
+
+// hasNewerAttempt reports whether the attempt's card was reviewed or credited after it
+func hasNewerAttempt(ctx context.Context, client *ent.Client, a *ent.Attempt) (bool, error) {
+	return client.Attempt.Query().
+		Where(
+			attempt.CardID(a.CardID),
+			attempt.IDNEQ(a.ID),
+			attempt.CreatedAtGT(a.CreatedAt),
+		).
+		Exist(ctx)
+}
+
+// restoreCard puts every scheduling field of the card back to the snapshot
+func restoreCard(ctx context.Context, client *ent.Client, cardID uuid.UUID, snap *schema.CardSnapshot) (*ent.FsrsCard, error) {
+	update := client.FsrsCard.UpdateOneID(cardID).
+		SetState(fsrscard.State(snap.State)).
+		SetCurrentStep(snap.CurrentStep).
+		SetStability(snap.Stability).
+		SetDifficulty(snap.Difficulty).
+		SetElapsedDays(snap.ElapsedDays).
+		SetScheduledDays(snap.ScheduledDays).
+		SetReps(snap.Reps).
+		SetLapses(snap.Lapses).
+		SetLeitnerBox(snap.LeitnerBox).
+		SetDue(snap.Due).
+		AddVersion(1)
+	if snap.EaseFactor > 0 { // Snapshots from before SM-2 have no ease
+		update = update.SetEaseFactor(snap.EaseFactor)
+	}
+	if snap.LastReview != nil {
+		update = update.SetLastReview(*snap.LastReview)
+	} else {
+		update = update.ClearLastReview()
+	}
+	if snap.IsLeech != nil {
+		update = update.SetIsLeech(*snap.IsLeech)
+	}
+	if snap.IsSuspended != nil {
+		update = update.SetIsSuspended(*snap.IsSuspended)
+	}
+
+	card, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("restoring card: %w", err)
+	}
+	return card, nil
+}
+
+// revertEffects undoes what a review changed beyond its own card: implicit
+// credit on linked cards is taken back, errors it opened (e.g. the Memory Lapse
+// of a new leech) are deleted, errors it bumped or closed get their prior fields back
+func revertEffects(ctx context.Context, client *ent.Client, effects *schema.ReviewEffects) error {
+	if effects == nil { // Recorded before effects were tracked
+		return nil
+	}
+
+	// Newest first, so a card credited twice ends at its oldest snapshot
+	for i := len(effects.ImplicitAttempts) - 1; i >= 0; i-- {
+		if err := revertImplicit(ctx, client, effects.ImplicitAttempts[i]); err != nil {
+			return err
+		}
+	}
+
+	if len(effects.CreatedResolutions) > 0 {
+		_, err := client.ErrorResolution.Delete().
+			Where(errorresolution.IDIn(effects.CreatedResolutions...)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting errors opened by the review: %w", err)
+		}
+	}
+
+	for _, r := range effects.ChangedResolutions {
+		update := client.ErrorResolution.UpdateOneID(r.ID).
+			SetWeightImpact(r.WeightImpact).
+			SetOccurrences(r.Occurrences).
+			SetIsResolved(r.IsResolved).
+			SetResolutionNotes(r.ResolutionNotes)
+		if r.LastSeenAt != nil {
+			update = update.SetLastSeenAt(*r.LastSeenAt)
+		} else {
+			update = update.ClearLastSeenAt()
+		}
+		if r.ResolvedAt != nil {
+			update = update.SetResolvedAt(*r.ResolvedAt)
+		} else {
+			update = update.ClearResolvedAt()
+		}
+		err := update.Exec(ctx)
+		if ent.IsNotFound(err) {
+			continue // Deleted or merged away since
+		}
+		if err != nil {
+			return fmt.Errorf("restoring error changed by the review: %w", err)
+		}
+	}
+
+	for _, b := range effects.BuriedCards {
+		update := client.FsrsCard.UpdateOneID(b.ID)
+		if b.BuriedUntil != nil {
+			update = update.SetBuriedUntil(*b.BuriedUntil)
+		} else {
+			update = update.ClearBuriedUntil()
+		}
+		err := update.Exec(ctx)
+		if ent.IsNotFound(err) {
+			continue // Deleted since
+		}
+		if err != nil {
+			return fmt.Errorf("unburying translation: %w", err)
+		}
+	}
+	return nil
+}
+
+// revertImplicit restores the linked card credited by an implicit attempt and
+// deletes the attempt. A card reviewed since keeps its schedule.
+func revertImplicit(ctx context.Context, client *ent.Client, attemptID uuid.UUID) error {
+	a, err := client.Attempt.Get(ctx, attemptID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("loading implicit attempt: %w", err)
+	}
+
+	newer, err := hasNewerAttempt(ctx, client, a)
+	if err != nil {
+		return err
+	}
+	if newer || a.CardBefore == nil {
+		return nil
+	}
+
+	if _, err := restoreCard(ctx, client, a.CardID, a.CardBefore); err != nil {
+		return err
+	}
+	if err := client.Attempt.DeleteOneID(a.ID).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting implicit attempt: %w", err)
+	}
+	return nil
+}
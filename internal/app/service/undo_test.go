@@ -0,0 +1,130 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/app/service"
+	"profen/internal/data"
+	"profen/internal/data/ent/errorresolution"
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/hooks"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndoService_RestoresCardAndDeletesAttempt(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+	undo := service.NewUndoService(client)
+
+	n := client.Node.Create().SetType(node.TypeProblem).SetTitle("Undo").SaveX(ctx)
+	lastReview := time.Now().AddDate(0, 0, -5).Truncate(time.Second)
+	card := client.FsrsCard.Create().
+		SetNodeID(n.ID).
+		SetState(fsrscard.StateReview).
+		SetState(fsrscard.StateReview).
+		SetStability(5).
+		SetDifficulty(5).
+		SetReps(3).
+		SetLastReview(lastReview).
+		SetDue(time.Now().Add(-time.Hour).Truncate(time.Second)).
+		SaveX(ctx)
+
+	review := func(grade int) {
+		_, a, err := coordinator.SubmitReview(ctx, service.ReviewSubmission{NodeID: n.ID, Grade: grade, DurationMs: 1000})
+		require.NoError(t, err)
+		undo.Push(a.ID)
+	}
+
+	// Misclick Again
+	review(1)
+	lapsed := client.FsrsCard.GetX(ctx, card.ID)
+	assert.Equal(t, fsrscard.StateRelearning, lapsed.State)
+	assert.Equal(t, 1, lapsed.Lapses)
+
+	result, err := undo.UndoLast(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, n.ID, result.NodeID)
+	assert.Equal(t, 1, result.Rating)
+
+	restored := client.FsrsCard.GetX(ctx, card.ID)
+	assert.Equal(t, fsrscard.StateReview, restored.State)
+	assert.Equal(t, 0, restored.Lapses)
+	assert.Equal(t, 3, restored.Reps)
+	assert.Equal(t, 5.0, restored.Stability)
+	assert.WithinDuration(t, card.Due, restored.Due, time.Second)
+	require.NotNil(t, restored.LastReview)
+	assert.WithinDuration(t, lastReview, *restored.LastReview, time.Second)
+	assert.Equal(t, 0, client.Attempt.Query().CountX(ctx))
+
+	// After a restart the stack is empty, but the latest review can still be undone
+	review(3)
+	restarted := service.NewUndoService(client)
+	_, err = restarted.UndoLast(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, client.FsrsCard.GetX(ctx, card.ID).Reps)
+
+	_, err = restarted.UndoLast(ctx)
+	assert.Error(t, err, "nothing left to undo")
+}
+
+func TestUndoService_RevertsLeech(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	client.Node.Use(hooks.NodeClosureHook(client))
+
+	presets := service.NewPresetService(client)
+	preset, err := presets.CreatePreset(ctx, service.PresetSettings{
+		Name:     "Strict",
+		FSRS:     service.DefaultFSRSConfig(),
+		Learning: service.DefaultLearningConfig(),
+		Leech:    service.LeechConfig{Threshold: 2, Suspend: true},
+	})
+	require.NoError(t, err)
+
+	subject := client.Node.Create().SetType(node.TypeSubject).SetTitle("Chem").SaveX(ctx)
+	require.NoError(t, presets.AssignPreset(ctx, subject.ID, &preset.ID))
+	problem := client.Node.Create().SetType(node.TypeProblem).SetTitle("Ionic").SetParentID(subject.ID).SaveX(ctx)
+
+	card := client.FsrsCard.Create().
+		SetNodeID(problem.ID).
+		SetState(fsrscard.StateReview).
+		SetStability(3).
+		SetDifficulty(7).
+		SetReps(6).
+		SetLapses(1).
+		SetLastReview(time.Now().AddDate(0, 0, -3)).
+		SetDue(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	coordinator := service.NewReviewCoordinator(
+		service.NewLearningStepsService(client, service.DefaultLearningConfig(), data.SystemClock()),
+		service.NewFSRSService(client, service.DefaultFSRSConfig(), data.SystemClock()),
+		client,
+	)
+
+	// The misclicked Again makes the card a suspended leech with an open Memory Lapse
+	result, _, err := coordinator.SubmitReview(ctx, service.ReviewSubmission{NodeID: problem.ID, Grade: 1})
+	require.NoError(t, err)
+	require.True(t, result.BecameLeech)
+	assert.Equal(t, 1, client.ErrorResolution.Query().Where(errorresolution.NodeID(problem.ID)).CountX(ctx))
+
+	_, err = service.NewUndoService(client).UndoLast(ctx)
+	require.NoError(t, err)
+
+	restored := client.FsrsCard.GetX(ctx, card.ID)
+	assert.False(t, restored.IsLeech)
+	assert.False(t, restored.IsSuspended)
+	assert.Equal(t, 1, restored.Lapses)
+	assert.Zero(t, client.ErrorResolution.Query().Where(errorresolution.NodeID(problem.ID)).CountX(ctx))
+}
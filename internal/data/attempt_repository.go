@@ -8,6 +8,7 @@ import (
 	"profen/internal/data/ent/attempt"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/schema"
 
 	"github.com/google/uuid"
 )
@@ -28,10 +29,57 @@ func (r *AttemptRepository) CreateAttempt(
 	durationMs int64,
 	userAnswer string,
 	metadata map[string]interface{},
-) error {
+) (*ent.Attempt, error) {
+	builder, err := r.newAttempt(card, rating, durationMs, userAnswer, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return builder.Save(ctx)
+}
+
+// CreateAttemptWithReviewID records an attempt tagged with a client-supplied review ID.
+// The unique review_id rejects a second insert of the same review.
+func (r *AttemptRepository) CreateAttemptWithReviewID(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	reviewID uuid.UUID,
+	rating int,
+	durationMs int64,
+	userAnswer string,
+	metadata map[string]interface{},
+) (*ent.Attempt, error) {
+	builder, err := r.newAttempt(card, rating, durationMs, userAnswer, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return builder.SetReviewID(reviewID).Save(ctx)
+}
+
+// CreateImplicitAttempt logs credit or a lapse propagated to a card from a
+// linked node. It has no duration and is excluded from grading statistics.
+func (r *AttemptRepository) CreateImplicitAttempt(
+	ctx context.Context,
+	card *ent.FsrsCard,
+	rating int,
+	metadata map[string]interface{},
+) (*ent.Attempt, error) {
+	builder, err := r.newAttempt(card, rating, 0, "", metadata)
+	if err != nil {
+		return nil, err
+	}
+	return builder.SetKind(attempt.KindImplicit).Save(ctx)
+}
+
+func (r *AttemptRepository) newAttempt(
+	card *ent.FsrsCard,
+	rating int,
+	durationMs int64,
+	userAnswer string,
+	metadata map[string]interface{},
+) (*ent.AttemptCreate, error) {
 	// Validate rating
 	if rating < 1 || rating > 4 {
-		return fmt.Errorf("rating must be between 1 and 4, got %d", rating)
+		return nil, fmt.Errorf("rating must be between 1 and 4, got %d", rating)
 	}
 
 	// Determine if correct (Good or Easy = correct)
@@ -43,9 +91,10 @@ func (r *AttemptRepository) CreateAttempt(
 		SetRating(rating).
 		SetDurationMs(int(durationMs)).
 		SetIsCorrect(isCorrect).
-		SetState(attempt.State(card.CardState)).
+		SetState(attempt.State(card.State)).
 		SetStability(card.Stability).
-		SetDifficulty(card.Difficulty)
+		SetDifficulty(card.Difficulty).
+		SetCardBefore(NewCardSnapshot(card))
 
 	// Add optional user answer
 	if userAnswer != "" {
@@ -58,9 +107,28 @@ func (r *AttemptRepository) CreateAttempt(
 		builder = builder.SetMetadata(metadata)
 	}
 
-	// Save to database
-	_, err := builder.Save(ctx)
-	return err
+	return builder, nil
+}
+
+// NewCardSnapshot copies every scheduling field of a card so it can be restored later
+func NewCardSnapshot(card *ent.FsrsCard) *schema.CardSnapshot {
+	isLeech, isSuspended := card.IsLeech, card.IsSuspended
+	return &schema.CardSnapshot{
+		State:         string(card.State),
+		CurrentStep:   card.CurrentStep,
+		Stability:     card.Stability,
+		Difficulty:    card.Difficulty,
+		ElapsedDays:   card.ElapsedDays,
+		ScheduledDays: card.ScheduledDays,
+		Reps:          card.Reps,
+		Lapses:        card.Lapses,
+		EaseFactor:    card.EaseFactor,
+		LeitnerBox:    card.LeitnerBox,
+		Due:           card.Due,
+		LastReview:    card.LastReview,
+		IsLeech:       &isLeech,
+		IsSuspended:   &isSuspended,
+	}
 }
 
 // GetAttemptsByNode retrieves all attempts for a specific node (sorted by newest first)
@@ -77,7 +145,12 @@ func (r *AttemptRepository) GetAttemptsByNode(ctx context.Context, nodeID uuid.U
 
 // GetAttempt retrieves a single attempt by ID with all fields
 func (r *AttemptRepository) GetAttempt(ctx context.Context, id uuid.UUID) (*ent.Attempt, error) {
-	return r.client.Attempt.Get(ctx, id)
+	return r.client.Attempt.Query().
+		Where(attempt.ID(id)).
+		WithErrors(func(q *ent.AttemptErrorQuery) {
+			q.WithErrorDefinition()
+		}).
+		Only(ctx)
 }
 
 // GetAttemptsByCard retrieves all attempts for a specific card
@@ -96,16 +169,24 @@ func (r *AttemptRepository) GetRecentAttempts(ctx context.Context, limit int) ([
 		All(ctx)
 }
 
-// GetAllAttempts retrieves all attempts (for activity heatmap)
+// GetAllAttempts retrieves all graded attempts (for activity heatmap)
 func (r *AttemptRepository) GetAllAttempts(ctx context.Context) ([]*ent.Attempt, error) {
 	return r.client.Attempt.Query().
+		Where(attempt.KindEQ(attempt.KindReview)).
 		Order(ent.Desc(attempt.FieldCreatedAt)).
 		All(ctx)
 }
 
 // GetAttemptStats returns aggregate statistics for a node
 func (r *AttemptRepository) GetAttemptStats(ctx context.Context, nodeID uuid.UUID) (map[string]interface{}, error) {
-	attempts, err := r.GetAttemptsByNode(ctx, nodeID)
+	attempts, err := r.client.Attempt.Query().
+		Where(
+			attempt.HasCardWith(
+				fsrscard.HasNodeWith(node.ID(nodeID)),
+			),
+			attempt.KindEQ(attempt.KindReview),
+		).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
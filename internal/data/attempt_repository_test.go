@@ -9,6 +9,7 @@ import (
 	"profen/internal/data"
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/enttest"
+	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
 
 	_ "github.com/lib/pq"
@@ -41,8 +42,8 @@ func TestAttemptRepository_CreateAttempt(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now()).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now()).
 		SetStability(3.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -52,7 +53,7 @@ func TestAttemptRepository_CreateAttempt(t *testing.T) {
 		"userDifficultyRating": 3,
 	}
 
-	err := repo.CreateAttempt(ctx, card, 3, 5000, "My answer", metadata)
+	_, err := repo.CreateAttempt(ctx, card, 3, 5000, "My answer", metadata)
 	require.NoError(t, err)
 
 	// Verify attempt was created
@@ -66,6 +67,11 @@ func TestAttemptRepository_CreateAttempt(t *testing.T) {
 	assert.True(t, attempt.IsCorrect) // Grade >= 3
 	assert.Equal(t, "My answer", attempt.UserAnswer)
 	assert.Equal(t, "Forgot formula", attempt.Metadata["errorLog"])
+
+	// Pre-review card state is kept for undo
+	require.NotNil(t, attempt.CardBefore)
+	assert.Equal(t, "review", attempt.CardBefore.State)
+	assert.Equal(t, 3.0, attempt.CardBefore.Stability)
 }
 
 func TestAttemptRepository_IsCorrect_Calculation(t *testing.T) {
@@ -80,8 +86,8 @@ func TestAttemptRepository_IsCorrect_Calculation(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now()).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now()).
 		SetStability(3.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -114,8 +120,8 @@ func TestAttemptRepository_GetAttemptStats(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now()).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now()).
 		SetStability(3.0).
 		SetDifficulty(5.0).
 		Save(ctx)
@@ -149,8 +155,8 @@ func TestAttemptRepository_GetRecentAttempts(t *testing.T) {
 
 	card, _ := client.FsrsCard.Create().
 		SetNodeID(testNode.ID).
-		SetCardState("review").
-		SetNextReview(time.Now()).
+		SetState(fsrscard.StateReview).
+		SetDue(time.Now()).
 		SetStability(3.0).
 		SetDifficulty(5.0).
 		Save(ctx)
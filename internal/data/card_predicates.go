@@ -0,0 +1,26 @@
+package data
+
+import (
+	"time"
+
+	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/predicate"
+)
+
+// DueCards matches cards that belong in a study session at the given time:
+// scheduled before the next study day starts, or still in (re)learning
+// steps, and neither suspended nor buried.
+// The study queue, suggestions and dashboard stats share it so they agree.
+func DueCards(now time.Time, day DayBoundary) predicate.FsrsCard {
+	return fsrscard.And(
+		fsrscard.IsSuspended(false),
+		fsrscard.Or(
+			fsrscard.BuriedUntilIsNil(),
+			fsrscard.BuriedUntilLTE(now),
+		),
+		fsrscard.Or(
+			fsrscard.DueLT(day.NextStart(now)),
+			fsrscard.StateIn(fsrscard.StateLearning, fsrscard.StateRelearning),
+		),
+	)
+}
@@ -0,0 +1,68 @@
+package data
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells scheduling code what time it is, so scenarios can run at any instant
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock returns the wall clock
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// FixedClock always reports the same instant
+type FixedClock time.Time
+
+func (c FixedClock) Now() time.Time { return time.Time(c) }
+
+// TravelClock follows the wall clock until it is sent to another instant.
+// It backs the developer time-travel mode.
+type TravelClock struct {
+	mu sync.RWMutex
+	at *time.Time
+}
+
+// NewTravelClock creates a TravelClock at the present
+func NewTravelClock() *TravelClock {
+	return &TravelClock{}
+}
+
+// Now returns the travelled-to instant, or the wall clock when at the present
+func (c *TravelClock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.at != nil {
+		return *c.at
+	}
+	return time.Now()
+}
+
+// TravelTo freezes the clock at t
+func (c *TravelClock) TravelTo(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.at = &t
+}
+
+// Return goes back to the wall clock
+func (c *TravelClock) Return() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.at = nil
+}
+
+// Travelling reports whether the clock is away from the present
+func (c *TravelClock) Travelling() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.at != nil
+}
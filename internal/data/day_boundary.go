@@ -0,0 +1,53 @@
+package data
+
+import "time"
+
+// DayBoundary defines study days: they start at StartHour in Location
+// rather than at midnight, so a late-night session counts as the same day.
+type DayBoundary struct {
+	StartHour int
+	Location  *time.Location
+}
+
+// DefaultDayBoundary starts days at 4am in the system timezone
+func DefaultDayBoundary() DayBoundary {
+	return DayBoundary{StartHour: 4, Location: time.Local}
+}
+
+func (b DayBoundary) location() *time.Location {
+	if b.Location == nil {
+		return time.Local
+	}
+	return b.Location
+}
+
+// StartOf returns when the study day containing t began
+func (b DayBoundary) StartOf(t time.Time) time.Time {
+	t = t.In(b.location())
+	start := time.Date(t.Year(), t.Month(), t.Day(), b.StartHour, 0, 0, 0, t.Location())
+	if t.Before(start) {
+		start = time.Date(t.Year(), t.Month(), t.Day()-1, b.StartHour, 0, 0, 0, t.Location())
+	}
+	return start
+}
+
+// AddDays returns the start of the study day that is days after t's
+func (b DayBoundary) AddDays(t time.Time, days int) time.Time {
+	start := b.StartOf(t)
+	return time.Date(start.Year(), start.Month(), start.Day()+days, b.StartHour, 0, 0, 0, start.Location())
+}
+
+// NextStart returns when the study day after t's begins
+func (b DayBoundary) NextStart(t time.Time) time.Time {
+	return b.AddDays(t, 1)
+}
+
+// DaysBetween counts study-day boundaries crossed from one time to another
+func (b DayBoundary) DaysBetween(from, to time.Time) int {
+	return civilDay(b.StartOf(to)) - civilDay(b.StartOf(from))
+}
+
+// civilDay numbers calendar dates, ignoring DST and offsets
+func civilDay(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
@@ -0,0 +1,48 @@
+package data_test
+
+import (
+	"testing"
+	"time"
+
+	"profen/internal/data"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayBoundary_LateNightCountsAsSameDay(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+	day := data.DayBoundary{StartHour: 4, Location: berlin}
+
+	evening := time.Date(2025, 3, 10, 23, 0, 0, 0, berlin)
+	afterMidnight := time.Date(2025, 3, 11, 0, 30, 0, 0, berlin)
+	morning := time.Date(2025, 3, 11, 9, 0, 0, 0, berlin)
+
+	assert.Equal(t, time.Date(2025, 3, 10, 4, 0, 0, 0, berlin), day.StartOf(afterMidnight))
+	assert.Equal(t, 0, day.DaysBetween(evening, afterMidnight))
+	assert.Equal(t, 1, day.DaysBetween(afterMidnight, morning))
+
+	// "1d" at 23:00 is due when the next study day starts, not 24h later
+	assert.Equal(t, time.Date(2025, 3, 11, 4, 0, 0, 0, berlin), day.AddDays(evening, 1))
+	assert.Equal(t, day.AddDays(evening, 1), day.NextStart(evening))
+
+	// Boundaries follow the user's timezone, not the instant's
+	utcEvening := time.Date(2025, 3, 11, 2, 30, 0, 0, time.UTC) // 03:30 in Berlin
+	assert.Equal(t, 0, day.DaysBetween(evening, utcEvening))
+}
+
+func TestDayBoundary_DaysBetweenAcrossDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+	day := data.DayBoundary{StartHour: 0, Location: berlin}
+
+	// Clocks go forward on 30 March 2025; that day is only 23 hours long
+	before := time.Date(2025, 3, 29, 12, 0, 0, 0, berlin)
+	after := time.Date(2025, 3, 31, 12, 0, 0, 0, berlin)
+	assert.Equal(t, 2, day.DaysBetween(before, after))
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, berlin), day.AddDays(before, 2))
+}
@@ -21,7 +21,7 @@ func TestDictionary_DualCreation(t *testing.T) {
 
 	// ✅ Register Hooks BEFORE any operations
 	client.Node.Use(hooks.NodeClosureHook(client))
-	client.Node.Use(hooks.FsrsCardInitHook(client))
+	client.Node.Use(hooks.FsrsCardInitHook(client, data.SystemClock()))
 
 	ctx := context.Background()
 	// Clean in correct order (foreign key dependencies)
@@ -8,6 +8,7 @@ import (
 	"profen/internal/data/ent/attempt"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/schema"
 	"strings"
 	"time"
 
@@ -39,10 +40,22 @@ type Attempt struct {
 	IsCorrect bool `json:"is_correct,omitempty"`
 	// ErrorTypeID holds the value of the "error_type_id" field.
 	ErrorTypeID *uuid.UUID `json:"error_type_id,omitempty"`
+	// What went wrong, in the user's words
+	ErrorNote string `json:"error_note,omitempty"`
 	// Snapshot of what the user typed
 	UserAnswer string `json:"user_answer,omitempty"`
 	// Error logs, difficulty rating, and other attempt metadata
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Client-supplied ID; a repeated submission with the same ID is ignored
+	ReviewID *uuid.UUID `json:"review_id,omitempty"`
+	// Scheduler that decided the next review; empty for attempts recorded before schedulers were pluggable
+	Scheduler *attempt.Scheduler `json:"scheduler,omitempty"`
+	// review = graded by the user; implicit = credit or lapse propagated from a linked node
+	Kind attempt.Kind `json:"kind,omitempty"`
+	// Full card state before this attempt, restored on undo
+	CardBefore *schema.CardSnapshot `json:"card_before,omitempty"`
+	// Changes the review made beyond its own card, reverted on undo
+	Effects *schema.ReviewEffects `json:"effects,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AttemptQuery when eager-loading is set.
 	Edges        AttemptEdges `json:"edges"`
@@ -55,9 +68,11 @@ type AttemptEdges struct {
 	Card *FsrsCard `json:"card,omitempty"`
 	// ErrorDefinition holds the value of the error_definition edge.
 	ErrorDefinition *ErrorDefinition `json:"error_definition,omitempty"`
+	// Errors holds the value of the errors edge.
+	Errors []*AttemptError `json:"errors,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // CardOrErr returns the Card value or an error if the edge
@@ -82,14 +97,23 @@ func (e AttemptEdges) ErrorDefinitionOrErr() (*ErrorDefinition, error) {
 	return nil, &NotLoadedError{edge: "error_definition"}
 }
 
+// ErrorsOrErr returns the Errors value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttemptEdges) ErrorsOrErr() ([]*AttemptError, error) {
+	if e.loadedTypes[2] {
+		return e.Errors, nil
+	}
+	return nil, &NotLoadedError{edge: "errors"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Attempt) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case attempt.FieldErrorTypeID:
+		case attempt.FieldErrorTypeID, attempt.FieldReviewID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case attempt.FieldMetadata:
+		case attempt.FieldMetadata, attempt.FieldCardBefore, attempt.FieldEffects:
 			values[i] = new([]byte)
 		case attempt.FieldIsCorrect:
 			values[i] = new(sql.NullBool)
@@ -97,7 +121,7 @@ func (*Attempt) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case attempt.FieldRating, attempt.FieldDurationMs:
 			values[i] = new(sql.NullInt64)
-		case attempt.FieldState, attempt.FieldUserAnswer:
+		case attempt.FieldState, attempt.FieldErrorNote, attempt.FieldUserAnswer, attempt.FieldScheduler, attempt.FieldKind:
 			values[i] = new(sql.NullString)
 		case attempt.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -179,6 +203,12 @@ func (_m *Attempt) assignValues(columns []string, values []any) error {
 				_m.ErrorTypeID = new(uuid.UUID)
 				*_m.ErrorTypeID = *value.S.(*uuid.UUID)
 			}
+		case attempt.FieldErrorNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_note", values[i])
+			} else if value.Valid {
+				_m.ErrorNote = value.String
+			}
 		case attempt.FieldUserAnswer:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_answer", values[i])
@@ -193,6 +223,42 @@ func (_m *Attempt) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case attempt.FieldReviewID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field review_id", values[i])
+			} else if value.Valid {
+				_m.ReviewID = new(uuid.UUID)
+				*_m.ReviewID = *value.S.(*uuid.UUID)
+			}
+		case attempt.FieldScheduler:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduler", values[i])
+			} else if value.Valid {
+				_m.Scheduler = new(attempt.Scheduler)
+				*_m.Scheduler = attempt.Scheduler(value.String)
+			}
+		case attempt.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = attempt.Kind(value.String)
+			}
+		case attempt.FieldCardBefore:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field card_before", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CardBefore); err != nil {
+					return fmt.Errorf("unmarshal field card_before: %w", err)
+				}
+			}
+		case attempt.FieldEffects:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field effects", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Effects); err != nil {
+					return fmt.Errorf("unmarshal field effects: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -216,6 +282,11 @@ func (_m *Attempt) QueryErrorDefinition() *ErrorDefinitionQuery {
 	return NewAttemptClient(_m.config).QueryErrorDefinition(_m)
 }
 
+// QueryErrors queries the "errors" edge of the Attempt entity.
+func (_m *Attempt) QueryErrors() *AttemptErrorQuery {
+	return NewAttemptClient(_m.config).QueryErrors(_m)
+}
+
 // Update returns a builder for updating this Attempt.
 // Note that you need to call Attempt.Unwrap() before calling this method if this Attempt
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -268,11 +339,33 @@ func (_m *Attempt) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("error_note=")
+	builder.WriteString(_m.ErrorNote)
+	builder.WriteString(", ")
 	builder.WriteString("user_answer=")
 	builder.WriteString(_m.UserAnswer)
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	if v := _m.ReviewID; v != nil {
+		builder.WriteString("review_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Scheduler; v != nil {
+		builder.WriteString("scheduler=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("card_before=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CardBefore))
+	builder.WriteString(", ")
+	builder.WriteString("effects=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Effects))
 	builder.WriteByte(')')
 	return builder.String()
 }
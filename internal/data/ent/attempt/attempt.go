@@ -34,18 +34,34 @@ const (
 	FieldIsCorrect = "is_correct"
 	// FieldErrorTypeID holds the string denoting the error_type_id field in the database.
 	FieldErrorTypeID = "error_type_id"
+	// FieldErrorNote holds the string denoting the error_note field in the database.
+	FieldErrorNote = "error_note"
 	// FieldUserAnswer holds the string denoting the user_answer field in the database.
 	FieldUserAnswer = "user_answer"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldReviewID holds the string denoting the review_id field in the database.
+	FieldReviewID = "review_id"
+	// FieldScheduler holds the string denoting the scheduler field in the database.
+	FieldScheduler = "scheduler"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCardBefore holds the string denoting the card_before field in the database.
+	FieldCardBefore = "card_before"
+	// FieldEffects holds the string denoting the effects field in the database.
+	FieldEffects = "effects"
 	// EdgeCard holds the string denoting the card edge name in mutations.
 	EdgeCard = "card"
 	// EdgeErrorDefinition holds the string denoting the error_definition edge name in mutations.
 	EdgeErrorDefinition = "error_definition"
+	// EdgeErrors holds the string denoting the errors edge name in mutations.
+	EdgeErrors = "errors"
 	// FsrsCardFieldID holds the string denoting the ID field of the FsrsCard.
 	FsrsCardFieldID = "card_id"
 	// ErrorDefinitionFieldID holds the string denoting the ID field of the ErrorDefinition.
 	ErrorDefinitionFieldID = "error_type_id"
+	// AttemptErrorFieldID holds the string denoting the ID field of the AttemptError.
+	AttemptErrorFieldID = "attempt_error_id"
 	// Table holds the table name of the attempt in the database.
 	Table = "attempts"
 	// CardTable is the table that holds the card relation/edge.
@@ -62,6 +78,13 @@ const (
 	ErrorDefinitionInverseTable = "error_definitions"
 	// ErrorDefinitionColumn is the table column denoting the error_definition relation/edge.
 	ErrorDefinitionColumn = "error_type_id"
+	// ErrorsTable is the table that holds the errors relation/edge.
+	ErrorsTable = "attempt_errors"
+	// ErrorsInverseTable is the table name for the AttemptError entity.
+	// It exists in this package in order to avoid circular dependency with the "attempterror" package.
+	ErrorsInverseTable = "attempt_errors"
+	// ErrorsColumn is the table column denoting the errors relation/edge.
+	ErrorsColumn = "attempt_id"
 )
 
 // Columns holds all SQL columns for attempt fields.
@@ -76,8 +99,14 @@ var Columns = []string{
 	FieldCardID,
 	FieldIsCorrect,
 	FieldErrorTypeID,
+	FieldErrorNote,
 	FieldUserAnswer,
 	FieldMetadata,
+	FieldReviewID,
+	FieldScheduler,
+	FieldKind,
+	FieldCardBefore,
+	FieldEffects,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -124,6 +153,57 @@ func StateValidator(s State) error {
 	}
 }
 
+// Scheduler defines the type for the "scheduler" enum field.
+type Scheduler string
+
+// Scheduler values.
+const (
+	SchedulerLearningSteps Scheduler = "learning_steps"
+	SchedulerFsrs          Scheduler = "fsrs"
+	SchedulerSm2           Scheduler = "sm2"
+	SchedulerLeitner       Scheduler = "leitner"
+)
+
+func (s Scheduler) String() string {
+	return string(s)
+}
+
+// SchedulerValidator is a validator for the "scheduler" field enum values. It is called by the builders before save.
+func SchedulerValidator(s Scheduler) error {
+	switch s {
+	case SchedulerLearningSteps, SchedulerFsrs, SchedulerSm2, SchedulerLeitner:
+		return nil
+	default:
+		return fmt.Errorf("attempt: invalid enum value for scheduler field: %q", s)
+	}
+}
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindReview is the default value of the Kind enum.
+const DefaultKind = KindReview
+
+// Kind values.
+const (
+	KindReview   Kind = "review"
+	KindImplicit Kind = "implicit"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindReview, KindImplicit:
+		return nil
+	default:
+		return fmt.Errorf("attempt: invalid enum value for kind field: %q", k)
+	}
+}
+
 // OrderOption defines the ordering options for the Attempt queries.
 type OrderOption func(*sql.Selector)
 
@@ -177,11 +257,31 @@ func ByErrorTypeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorTypeID, opts...).ToFunc()
 }
 
+// ByErrorNote orders the results by the error_note field.
+func ByErrorNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorNote, opts...).ToFunc()
+}
+
 // ByUserAnswer orders the results by the user_answer field.
 func ByUserAnswer(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserAnswer, opts...).ToFunc()
 }
 
+// ByReviewID orders the results by the review_id field.
+func ByReviewID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewID, opts...).ToFunc()
+}
+
+// ByScheduler orders the results by the scheduler field.
+func ByScheduler(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduler, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
 // ByCardField orders the results by card field.
 func ByCardField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -195,6 +295,20 @@ func ByErrorDefinitionField(field string, opts ...sql.OrderTermOption) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newErrorDefinitionStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByErrorsCount orders the results by errors count.
+func ByErrorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newErrorsStep(), opts...)
+	}
+}
+
+// ByErrors orders the results by errors terms.
+func ByErrors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newErrorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCardStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -209,3 +323,10 @@ func newErrorDefinitionStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ErrorDefinitionTable, ErrorDefinitionColumn),
 	)
 }
+func newErrorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ErrorsInverseTable, AttemptErrorFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ErrorsTable, ErrorsColumn),
+	)
+}
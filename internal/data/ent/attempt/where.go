@@ -96,11 +96,21 @@ func ErrorTypeID(v uuid.UUID) predicate.Attempt {
 	return predicate.Attempt(sql.FieldEQ(FieldErrorTypeID, v))
 }
 
+// ErrorNote applies equality check predicate on the "error_note" field. It's identical to ErrorNoteEQ.
+func ErrorNote(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldErrorNote, v))
+}
+
 // UserAnswer applies equality check predicate on the "user_answer" field. It's identical to UserAnswerEQ.
 func UserAnswer(v string) predicate.Attempt {
 	return predicate.Attempt(sql.FieldEQ(FieldUserAnswer, v))
 }
 
+// ReviewID applies equality check predicate on the "review_id" field. It's identical to ReviewIDEQ.
+func ReviewID(v uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldReviewID, v))
+}
+
 // RatingEQ applies the EQ predicate on the "rating" field.
 func RatingEQ(v int) predicate.Attempt {
 	return predicate.Attempt(sql.FieldEQ(FieldRating, v))
@@ -381,6 +391,81 @@ func ErrorTypeIDNotNil() predicate.Attempt {
 	return predicate.Attempt(sql.FieldNotNull(FieldErrorTypeID))
 }
 
+// ErrorNoteEQ applies the EQ predicate on the "error_note" field.
+func ErrorNoteEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldErrorNote, v))
+}
+
+// ErrorNoteNEQ applies the NEQ predicate on the "error_note" field.
+func ErrorNoteNEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldErrorNote, v))
+}
+
+// ErrorNoteIn applies the In predicate on the "error_note" field.
+func ErrorNoteIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldErrorNote, vs...))
+}
+
+// ErrorNoteNotIn applies the NotIn predicate on the "error_note" field.
+func ErrorNoteNotIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldErrorNote, vs...))
+}
+
+// ErrorNoteGT applies the GT predicate on the "error_note" field.
+func ErrorNoteGT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldErrorNote, v))
+}
+
+// ErrorNoteGTE applies the GTE predicate on the "error_note" field.
+func ErrorNoteGTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldErrorNote, v))
+}
+
+// ErrorNoteLT applies the LT predicate on the "error_note" field.
+func ErrorNoteLT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldErrorNote, v))
+}
+
+// ErrorNoteLTE applies the LTE predicate on the "error_note" field.
+func ErrorNoteLTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldErrorNote, v))
+}
+
+// ErrorNoteContains applies the Contains predicate on the "error_note" field.
+func ErrorNoteContains(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContains(FieldErrorNote, v))
+}
+
+// ErrorNoteHasPrefix applies the HasPrefix predicate on the "error_note" field.
+func ErrorNoteHasPrefix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasPrefix(FieldErrorNote, v))
+}
+
+// ErrorNoteHasSuffix applies the HasSuffix predicate on the "error_note" field.
+func ErrorNoteHasSuffix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasSuffix(FieldErrorNote, v))
+}
+
+// ErrorNoteIsNil applies the IsNil predicate on the "error_note" field.
+func ErrorNoteIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldErrorNote))
+}
+
+// ErrorNoteNotNil applies the NotNil predicate on the "error_note" field.
+func ErrorNoteNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldErrorNote))
+}
+
+// ErrorNoteEqualFold applies the EqualFold predicate on the "error_note" field.
+func ErrorNoteEqualFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEqualFold(FieldErrorNote, v))
+}
+
+// ErrorNoteContainsFold applies the ContainsFold predicate on the "error_note" field.
+func ErrorNoteContainsFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContainsFold(FieldErrorNote, v))
+}
+
 // UserAnswerEQ applies the EQ predicate on the "user_answer" field.
 func UserAnswerEQ(v string) predicate.Attempt {
 	return predicate.Attempt(sql.FieldEQ(FieldUserAnswer, v))
@@ -466,6 +551,126 @@ func MetadataNotNil() predicate.Attempt {
 	return predicate.Attempt(sql.FieldNotNull(FieldMetadata))
 }
 
+// ReviewIDEQ applies the EQ predicate on the "review_id" field.
+func ReviewIDEQ(v uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldReviewID, v))
+}
+
+// ReviewIDNEQ applies the NEQ predicate on the "review_id" field.
+func ReviewIDNEQ(v uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldReviewID, v))
+}
+
+// ReviewIDIn applies the In predicate on the "review_id" field.
+func ReviewIDIn(vs ...uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldReviewID, vs...))
+}
+
+// ReviewIDNotIn applies the NotIn predicate on the "review_id" field.
+func ReviewIDNotIn(vs ...uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldReviewID, vs...))
+}
+
+// ReviewIDGT applies the GT predicate on the "review_id" field.
+func ReviewIDGT(v uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldReviewID, v))
+}
+
+// ReviewIDGTE applies the GTE predicate on the "review_id" field.
+func ReviewIDGTE(v uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldReviewID, v))
+}
+
+// ReviewIDLT applies the LT predicate on the "review_id" field.
+func ReviewIDLT(v uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldReviewID, v))
+}
+
+// ReviewIDLTE applies the LTE predicate on the "review_id" field.
+func ReviewIDLTE(v uuid.UUID) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldReviewID, v))
+}
+
+// ReviewIDIsNil applies the IsNil predicate on the "review_id" field.
+func ReviewIDIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldReviewID))
+}
+
+// ReviewIDNotNil applies the NotNil predicate on the "review_id" field.
+func ReviewIDNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldReviewID))
+}
+
+// SchedulerEQ applies the EQ predicate on the "scheduler" field.
+func SchedulerEQ(v Scheduler) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldScheduler, v))
+}
+
+// SchedulerNEQ applies the NEQ predicate on the "scheduler" field.
+func SchedulerNEQ(v Scheduler) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldScheduler, v))
+}
+
+// SchedulerIn applies the In predicate on the "scheduler" field.
+func SchedulerIn(vs ...Scheduler) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldScheduler, vs...))
+}
+
+// SchedulerNotIn applies the NotIn predicate on the "scheduler" field.
+func SchedulerNotIn(vs ...Scheduler) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldScheduler, vs...))
+}
+
+// SchedulerIsNil applies the IsNil predicate on the "scheduler" field.
+func SchedulerIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldScheduler))
+}
+
+// SchedulerNotNil applies the NotNil predicate on the "scheduler" field.
+func SchedulerNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldScheduler))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// CardBeforeIsNil applies the IsNil predicate on the "card_before" field.
+func CardBeforeIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldCardBefore))
+}
+
+// CardBeforeNotNil applies the NotNil predicate on the "card_before" field.
+func CardBeforeNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldCardBefore))
+}
+
+// EffectsIsNil applies the IsNil predicate on the "effects" field.
+func EffectsIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldEffects))
+}
+
+// EffectsNotNil applies the NotNil predicate on the "effects" field.
+func EffectsNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldEffects))
+}
+
 // HasCard applies the HasEdge predicate on the "card" edge.
 func HasCard() predicate.Attempt {
 	return predicate.Attempt(func(s *sql.Selector) {
@@ -512,6 +717,29 @@ func HasErrorDefinitionWith(preds ...predicate.ErrorDefinition) predicate.Attemp
 	})
 }
 
+// HasErrors applies the HasEdge predicate on the "errors" edge.
+func HasErrors() predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ErrorsTable, ErrorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasErrorsWith applies the HasEdge predicate on the "errors" edge with a given conditions (other predicates).
+func HasErrorsWith(preds ...predicate.AttemptError) predicate.Attempt {
+	return predicate.Attempt(func(s *sql.Selector) {
+		step := newErrorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Attempt) predicate.Attempt {
 	return predicate.Attempt(sql.AndPredicates(predicates...))
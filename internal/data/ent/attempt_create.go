@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/fsrscard"
+	"profen/internal/data/ent/schema"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -101,6 +103,20 @@ func (_c *AttemptCreate) SetNillableErrorTypeID(v *uuid.UUID) *AttemptCreate {
 	return _c
 }
 
+// SetErrorNote sets the "error_note" field.
+func (_c *AttemptCreate) SetErrorNote(v string) *AttemptCreate {
+	_c.mutation.SetErrorNote(v)
+	return _c
+}
+
+// SetNillableErrorNote sets the "error_note" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableErrorNote(v *string) *AttemptCreate {
+	if v != nil {
+		_c.SetErrorNote(*v)
+	}
+	return _c
+}
+
 // SetUserAnswer sets the "user_answer" field.
 func (_c *AttemptCreate) SetUserAnswer(v string) *AttemptCreate {
 	_c.mutation.SetUserAnswer(v)
@@ -121,6 +137,60 @@ func (_c *AttemptCreate) SetMetadata(v map[string]interface{}) *AttemptCreate {
 	return _c
 }
 
+// SetReviewID sets the "review_id" field.
+func (_c *AttemptCreate) SetReviewID(v uuid.UUID) *AttemptCreate {
+	_c.mutation.SetReviewID(v)
+	return _c
+}
+
+// SetNillableReviewID sets the "review_id" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableReviewID(v *uuid.UUID) *AttemptCreate {
+	if v != nil {
+		_c.SetReviewID(*v)
+	}
+	return _c
+}
+
+// SetScheduler sets the "scheduler" field.
+func (_c *AttemptCreate) SetScheduler(v attempt.Scheduler) *AttemptCreate {
+	_c.mutation.SetScheduler(v)
+	return _c
+}
+
+// SetNillableScheduler sets the "scheduler" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableScheduler(v *attempt.Scheduler) *AttemptCreate {
+	if v != nil {
+		_c.SetScheduler(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *AttemptCreate) SetKind(v attempt.Kind) *AttemptCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableKind(v *attempt.Kind) *AttemptCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetCardBefore sets the "card_before" field.
+func (_c *AttemptCreate) SetCardBefore(v *schema.CardSnapshot) *AttemptCreate {
+	_c.mutation.SetCardBefore(v)
+	return _c
+}
+
+// SetEffects sets the "effects" field.
+func (_c *AttemptCreate) SetEffects(v *schema.ReviewEffects) *AttemptCreate {
+	_c.mutation.SetEffects(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AttemptCreate) SetID(v uuid.UUID) *AttemptCreate {
 	_c.mutation.SetID(v)
@@ -159,6 +229,21 @@ func (_c *AttemptCreate) SetErrorDefinition(v *ErrorDefinition) *AttemptCreate {
 	return _c.SetErrorDefinitionID(v.ID)
 }
 
+// AddErrorIDs adds the "errors" edge to the AttemptError entity by IDs.
+func (_c *AttemptCreate) AddErrorIDs(ids ...uuid.UUID) *AttemptCreate {
+	_c.mutation.AddErrorIDs(ids...)
+	return _c
+}
+
+// AddErrors adds the "errors" edges to the AttemptError entity.
+func (_c *AttemptCreate) AddErrors(v ...*AttemptError) *AttemptCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddErrorIDs(ids...)
+}
+
 // Mutation returns the AttemptMutation object of the builder.
 func (_c *AttemptCreate) Mutation() *AttemptMutation {
 	return _c.mutation
@@ -202,6 +287,10 @@ func (_c *AttemptCreate) defaults() {
 		v := attempt.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := attempt.DefaultKind
+		_c.mutation.SetKind(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := attempt.DefaultID()
 		_c.mutation.SetID(v)
@@ -239,6 +328,19 @@ func (_c *AttemptCreate) check() error {
 	if _, ok := _c.mutation.IsCorrect(); !ok {
 		return &ValidationError{Name: "is_correct", err: errors.New(`ent: missing required field "Attempt.is_correct"`)}
 	}
+	if v, ok := _c.mutation.Scheduler(); ok {
+		if err := attempt.SchedulerValidator(v); err != nil {
+			return &ValidationError{Name: "scheduler", err: fmt.Errorf(`ent: validator failed for field "Attempt.scheduler": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Attempt.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := attempt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Attempt.kind": %w`, err)}
+		}
+	}
 	if len(_c.mutation.CardIDs()) == 0 {
 		return &ValidationError{Name: "card", err: errors.New(`ent: missing required edge "Attempt.card"`)}
 	}
@@ -305,6 +407,10 @@ func (_c *AttemptCreate) createSpec() (*Attempt, *sqlgraph.CreateSpec) {
 		_spec.SetField(attempt.FieldIsCorrect, field.TypeBool, value)
 		_node.IsCorrect = value
 	}
+	if value, ok := _c.mutation.ErrorNote(); ok {
+		_spec.SetField(attempt.FieldErrorNote, field.TypeString, value)
+		_node.ErrorNote = value
+	}
 	if value, ok := _c.mutation.UserAnswer(); ok {
 		_spec.SetField(attempt.FieldUserAnswer, field.TypeString, value)
 		_node.UserAnswer = value
@@ -313,6 +419,26 @@ func (_c *AttemptCreate) createSpec() (*Attempt, *sqlgraph.CreateSpec) {
 		_spec.SetField(attempt.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.ReviewID(); ok {
+		_spec.SetField(attempt.FieldReviewID, field.TypeUUID, value)
+		_node.ReviewID = &value
+	}
+	if value, ok := _c.mutation.Scheduler(); ok {
+		_spec.SetField(attempt.FieldScheduler, field.TypeEnum, value)
+		_node.Scheduler = &value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(attempt.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.CardBefore(); ok {
+		_spec.SetField(attempt.FieldCardBefore, field.TypeJSON, value)
+		_node.CardBefore = value
+	}
+	if value, ok := _c.mutation.Effects(); ok {
+		_spec.SetField(attempt.FieldEffects, field.TypeJSON, value)
+		_node.Effects = value
+	}
 	if nodes := _c.mutation.CardIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -347,6 +473,22 @@ func (_c *AttemptCreate) createSpec() (*Attempt, *sqlgraph.CreateSpec) {
 		_node.ErrorTypeID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ErrorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ErrorsTable,
+			Columns: []string{attempt.ErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,9 +4,11 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/predicate"
@@ -27,6 +29,7 @@ type AttemptQuery struct {
 	predicates          []predicate.Attempt
 	withCard            *FsrsCardQuery
 	withErrorDefinition *ErrorDefinitionQuery
+	withErrors          *AttemptErrorQuery
 	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -108,6 +111,28 @@ func (_q *AttemptQuery) QueryErrorDefinition() *ErrorDefinitionQuery {
 	return query
 }
 
+// QueryErrors chains the current query on the "errors" edge.
+func (_q *AttemptQuery) QueryErrors() *AttemptErrorQuery {
+	query := (&AttemptErrorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attempt.Table, attempt.FieldID, selector),
+			sqlgraph.To(attempterror.Table, attempterror.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attempt.ErrorsTable, attempt.ErrorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Attempt entity from the query.
 // Returns a *NotFoundError when no Attempt was found.
 func (_q *AttemptQuery) First(ctx context.Context) (*Attempt, error) {
@@ -302,6 +327,7 @@ func (_q *AttemptQuery) Clone() *AttemptQuery {
 		predicates:          append([]predicate.Attempt{}, _q.predicates...),
 		withCard:            _q.withCard.Clone(),
 		withErrorDefinition: _q.withErrorDefinition.Clone(),
+		withErrors:          _q.withErrors.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +357,17 @@ func (_q *AttemptQuery) WithErrorDefinition(opts ...func(*ErrorDefinitionQuery))
 	return _q
 }
 
+// WithErrors tells the query-builder to eager-load the nodes that are connected to
+// the "errors" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttemptQuery) WithErrors(opts ...func(*AttemptErrorQuery)) *AttemptQuery {
+	query := (&AttemptErrorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withErrors = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +446,10 @@ func (_q *AttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Atte
 	var (
 		nodes       = []*Attempt{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withCard != nil,
 			_q.withErrorDefinition != nil,
+			_q.withErrors != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -447,6 +485,13 @@ func (_q *AttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Atte
 			return nil, err
 		}
 	}
+	if query := _q.withErrors; query != nil {
+		if err := _q.loadErrors(ctx, query, nodes,
+			func(n *Attempt) { n.Edges.Errors = []*AttemptError{} },
+			func(n *Attempt, e *AttemptError) { n.Edges.Errors = append(n.Edges.Errors, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -511,6 +556,36 @@ func (_q *AttemptQuery) loadErrorDefinition(ctx context.Context, query *ErrorDef
 	}
 	return nil
 }
+func (_q *AttemptQuery) loadErrors(ctx context.Context, query *AttemptErrorQuery, nodes []*Attempt, init func(*Attempt), assign func(*Attempt, *AttemptError)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attempt)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attempterror.FieldAttemptID)
+	}
+	query.Where(predicate.AttemptError(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attempt.ErrorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttemptID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attempt_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AttemptQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/predicate"
+	"profen/internal/data/ent/schema"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -177,6 +179,26 @@ func (_u *AttemptUpdate) ClearErrorTypeID() *AttemptUpdate {
 	return _u
 }
 
+// SetErrorNote sets the "error_note" field.
+func (_u *AttemptUpdate) SetErrorNote(v string) *AttemptUpdate {
+	_u.mutation.SetErrorNote(v)
+	return _u
+}
+
+// SetNillableErrorNote sets the "error_note" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableErrorNote(v *string) *AttemptUpdate {
+	if v != nil {
+		_u.SetErrorNote(*v)
+	}
+	return _u
+}
+
+// ClearErrorNote clears the value of the "error_note" field.
+func (_u *AttemptUpdate) ClearErrorNote() *AttemptUpdate {
+	_u.mutation.ClearErrorNote()
+	return _u
+}
+
 // SetUserAnswer sets the "user_answer" field.
 func (_u *AttemptUpdate) SetUserAnswer(v string) *AttemptUpdate {
 	_u.mutation.SetUserAnswer(v)
@@ -209,6 +231,84 @@ func (_u *AttemptUpdate) ClearMetadata() *AttemptUpdate {
 	return _u
 }
 
+// SetReviewID sets the "review_id" field.
+func (_u *AttemptUpdate) SetReviewID(v uuid.UUID) *AttemptUpdate {
+	_u.mutation.SetReviewID(v)
+	return _u
+}
+
+// SetNillableReviewID sets the "review_id" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableReviewID(v *uuid.UUID) *AttemptUpdate {
+	if v != nil {
+		_u.SetReviewID(*v)
+	}
+	return _u
+}
+
+// ClearReviewID clears the value of the "review_id" field.
+func (_u *AttemptUpdate) ClearReviewID() *AttemptUpdate {
+	_u.mutation.ClearReviewID()
+	return _u
+}
+
+// SetScheduler sets the "scheduler" field.
+func (_u *AttemptUpdate) SetScheduler(v attempt.Scheduler) *AttemptUpdate {
+	_u.mutation.SetScheduler(v)
+	return _u
+}
+
+// SetNillableScheduler sets the "scheduler" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableScheduler(v *attempt.Scheduler) *AttemptUpdate {
+	if v != nil {
+		_u.SetScheduler(*v)
+	}
+	return _u
+}
+
+// ClearScheduler clears the value of the "scheduler" field.
+func (_u *AttemptUpdate) ClearScheduler() *AttemptUpdate {
+	_u.mutation.ClearScheduler()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AttemptUpdate) SetKind(v attempt.Kind) *AttemptUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableKind(v *attempt.Kind) *AttemptUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCardBefore sets the "card_before" field.
+func (_u *AttemptUpdate) SetCardBefore(v *schema.CardSnapshot) *AttemptUpdate {
+	_u.mutation.SetCardBefore(v)
+	return _u
+}
+
+// ClearCardBefore clears the value of the "card_before" field.
+func (_u *AttemptUpdate) ClearCardBefore() *AttemptUpdate {
+	_u.mutation.ClearCardBefore()
+	return _u
+}
+
+// SetEffects sets the "effects" field.
+func (_u *AttemptUpdate) SetEffects(v *schema.ReviewEffects) *AttemptUpdate {
+	_u.mutation.SetEffects(v)
+	return _u
+}
+
+// ClearEffects clears the value of the "effects" field.
+func (_u *AttemptUpdate) ClearEffects() *AttemptUpdate {
+	_u.mutation.ClearEffects()
+	return _u
+}
+
 // SetCard sets the "card" edge to the FsrsCard entity.
 func (_u *AttemptUpdate) SetCard(v *FsrsCard) *AttemptUpdate {
 	return _u.SetCardID(v.ID)
@@ -233,6 +333,21 @@ func (_u *AttemptUpdate) SetErrorDefinition(v *ErrorDefinition) *AttemptUpdate {
 	return _u.SetErrorDefinitionID(v.ID)
 }
 
+// AddErrorIDs adds the "errors" edge to the AttemptError entity by IDs.
+func (_u *AttemptUpdate) AddErrorIDs(ids ...uuid.UUID) *AttemptUpdate {
+	_u.mutation.AddErrorIDs(ids...)
+	return _u
+}
+
+// AddErrors adds the "errors" edges to the AttemptError entity.
+func (_u *AttemptUpdate) AddErrors(v ...*AttemptError) *AttemptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddErrorIDs(ids...)
+}
+
 // Mutation returns the AttemptMutation object of the builder.
 func (_u *AttemptUpdate) Mutation() *AttemptMutation {
 	return _u.mutation
@@ -250,6 +365,27 @@ func (_u *AttemptUpdate) ClearErrorDefinition() *AttemptUpdate {
 	return _u
 }
 
+// ClearErrors clears all "errors" edges to the AttemptError entity.
+func (_u *AttemptUpdate) ClearErrors() *AttemptUpdate {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// RemoveErrorIDs removes the "errors" edge to AttemptError entities by IDs.
+func (_u *AttemptUpdate) RemoveErrorIDs(ids ...uuid.UUID) *AttemptUpdate {
+	_u.mutation.RemoveErrorIDs(ids...)
+	return _u
+}
+
+// RemoveErrors removes "errors" edges to AttemptError entities.
+func (_u *AttemptUpdate) RemoveErrors(v ...*AttemptError) *AttemptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveErrorIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AttemptUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -284,6 +420,16 @@ func (_u *AttemptUpdate) check() error {
 			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Attempt.state": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Scheduler(); ok {
+		if err := attempt.SchedulerValidator(v); err != nil {
+			return &ValidationError{Name: "scheduler", err: fmt.Errorf(`ent: validator failed for field "Attempt.scheduler": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := attempt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Attempt.kind": %w`, err)}
+		}
+	}
 	if _u.mutation.CardCleared() && len(_u.mutation.CardIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Attempt.card"`)
 	}
@@ -338,6 +484,12 @@ func (_u *AttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsCorrect(); ok {
 		_spec.SetField(attempt.FieldIsCorrect, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ErrorNote(); ok {
+		_spec.SetField(attempt.FieldErrorNote, field.TypeString, value)
+	}
+	if _u.mutation.ErrorNoteCleared() {
+		_spec.ClearField(attempt.FieldErrorNote, field.TypeString)
+	}
 	if value, ok := _u.mutation.UserAnswer(); ok {
 		_spec.SetField(attempt.FieldUserAnswer, field.TypeString, value)
 	}
@@ -350,6 +502,33 @@ func (_u *AttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(attempt.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ReviewID(); ok {
+		_spec.SetField(attempt.FieldReviewID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewIDCleared() {
+		_spec.ClearField(attempt.FieldReviewID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Scheduler(); ok {
+		_spec.SetField(attempt.FieldScheduler, field.TypeEnum, value)
+	}
+	if _u.mutation.SchedulerCleared() {
+		_spec.ClearField(attempt.FieldScheduler, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(attempt.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CardBefore(); ok {
+		_spec.SetField(attempt.FieldCardBefore, field.TypeJSON, value)
+	}
+	if _u.mutation.CardBeforeCleared() {
+		_spec.ClearField(attempt.FieldCardBefore, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Effects(); ok {
+		_spec.SetField(attempt.FieldEffects, field.TypeJSON, value)
+	}
+	if _u.mutation.EffectsCleared() {
+		_spec.ClearField(attempt.FieldEffects, field.TypeJSON)
+	}
 	if _u.mutation.CardCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -408,6 +587,51 @@ func (_u *AttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ErrorsTable,
+			Columns: []string{attempt.ErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedErrorsIDs(); len(nodes) > 0 && !_u.mutation.ErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ErrorsTable,
+			Columns: []string{attempt.ErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ErrorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ErrorsTable,
+			Columns: []string{attempt.ErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -576,6 +800,26 @@ func (_u *AttemptUpdateOne) ClearErrorTypeID() *AttemptUpdateOne {
 	return _u
 }
 
+// SetErrorNote sets the "error_note" field.
+func (_u *AttemptUpdateOne) SetErrorNote(v string) *AttemptUpdateOne {
+	_u.mutation.SetErrorNote(v)
+	return _u
+}
+
+// SetNillableErrorNote sets the "error_note" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableErrorNote(v *string) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetErrorNote(*v)
+	}
+	return _u
+}
+
+// ClearErrorNote clears the value of the "error_note" field.
+func (_u *AttemptUpdateOne) ClearErrorNote() *AttemptUpdateOne {
+	_u.mutation.ClearErrorNote()
+	return _u
+}
+
 // SetUserAnswer sets the "user_answer" field.
 func (_u *AttemptUpdateOne) SetUserAnswer(v string) *AttemptUpdateOne {
 	_u.mutation.SetUserAnswer(v)
@@ -608,6 +852,84 @@ func (_u *AttemptUpdateOne) ClearMetadata() *AttemptUpdateOne {
 	return _u
 }
 
+// SetReviewID sets the "review_id" field.
+func (_u *AttemptUpdateOne) SetReviewID(v uuid.UUID) *AttemptUpdateOne {
+	_u.mutation.SetReviewID(v)
+	return _u
+}
+
+// SetNillableReviewID sets the "review_id" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableReviewID(v *uuid.UUID) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetReviewID(*v)
+	}
+	return _u
+}
+
+// ClearReviewID clears the value of the "review_id" field.
+func (_u *AttemptUpdateOne) ClearReviewID() *AttemptUpdateOne {
+	_u.mutation.ClearReviewID()
+	return _u
+}
+
+// SetScheduler sets the "scheduler" field.
+func (_u *AttemptUpdateOne) SetScheduler(v attempt.Scheduler) *AttemptUpdateOne {
+	_u.mutation.SetScheduler(v)
+	return _u
+}
+
+// SetNillableScheduler sets the "scheduler" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableScheduler(v *attempt.Scheduler) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetScheduler(*v)
+	}
+	return _u
+}
+
+// ClearScheduler clears the value of the "scheduler" field.
+func (_u *AttemptUpdateOne) ClearScheduler() *AttemptUpdateOne {
+	_u.mutation.ClearScheduler()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AttemptUpdateOne) SetKind(v attempt.Kind) *AttemptUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableKind(v *attempt.Kind) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCardBefore sets the "card_before" field.
+func (_u *AttemptUpdateOne) SetCardBefore(v *schema.CardSnapshot) *AttemptUpdateOne {
+	_u.mutation.SetCardBefore(v)
+	return _u
+}
+
+// ClearCardBefore clears the value of the "card_before" field.
+func (_u *AttemptUpdateOne) ClearCardBefore() *AttemptUpdateOne {
+	_u.mutation.ClearCardBefore()
+	return _u
+}
+
+// SetEffects sets the "effects" field.
+func (_u *AttemptUpdateOne) SetEffects(v *schema.ReviewEffects) *AttemptUpdateOne {
+	_u.mutation.SetEffects(v)
+	return _u
+}
+
+// ClearEffects clears the value of the "effects" field.
+func (_u *AttemptUpdateOne) ClearEffects() *AttemptUpdateOne {
+	_u.mutation.ClearEffects()
+	return _u
+}
+
 // SetCard sets the "card" edge to the FsrsCard entity.
 func (_u *AttemptUpdateOne) SetCard(v *FsrsCard) *AttemptUpdateOne {
 	return _u.SetCardID(v.ID)
@@ -632,6 +954,21 @@ func (_u *AttemptUpdateOne) SetErrorDefinition(v *ErrorDefinition) *AttemptUpdat
 	return _u.SetErrorDefinitionID(v.ID)
 }
 
+// AddErrorIDs adds the "errors" edge to the AttemptError entity by IDs.
+func (_u *AttemptUpdateOne) AddErrorIDs(ids ...uuid.UUID) *AttemptUpdateOne {
+	_u.mutation.AddErrorIDs(ids...)
+	return _u
+}
+
+// AddErrors adds the "errors" edges to the AttemptError entity.
+func (_u *AttemptUpdateOne) AddErrors(v ...*AttemptError) *AttemptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddErrorIDs(ids...)
+}
+
 // Mutation returns the AttemptMutation object of the builder.
 func (_u *AttemptUpdateOne) Mutation() *AttemptMutation {
 	return _u.mutation
@@ -649,6 +986,27 @@ func (_u *AttemptUpdateOne) ClearErrorDefinition() *AttemptUpdateOne {
 	return _u
 }
 
+// ClearErrors clears all "errors" edges to the AttemptError entity.
+func (_u *AttemptUpdateOne) ClearErrors() *AttemptUpdateOne {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// RemoveErrorIDs removes the "errors" edge to AttemptError entities by IDs.
+func (_u *AttemptUpdateOne) RemoveErrorIDs(ids ...uuid.UUID) *AttemptUpdateOne {
+	_u.mutation.RemoveErrorIDs(ids...)
+	return _u
+}
+
+// RemoveErrors removes "errors" edges to AttemptError entities.
+func (_u *AttemptUpdateOne) RemoveErrors(v ...*AttemptError) *AttemptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveErrorIDs(ids...)
+}
+
 // Where appends a list predicates to the AttemptUpdate builder.
 func (_u *AttemptUpdateOne) Where(ps ...predicate.Attempt) *AttemptUpdateOne {
 	_u.mutation.Where(ps...)
@@ -696,6 +1054,16 @@ func (_u *AttemptUpdateOne) check() error {
 			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Attempt.state": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Scheduler(); ok {
+		if err := attempt.SchedulerValidator(v); err != nil {
+			return &ValidationError{Name: "scheduler", err: fmt.Errorf(`ent: validator failed for field "Attempt.scheduler": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := attempt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Attempt.kind": %w`, err)}
+		}
+	}
 	if _u.mutation.CardCleared() && len(_u.mutation.CardIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Attempt.card"`)
 	}
@@ -767,6 +1135,12 @@ func (_u *AttemptUpdateOne) sqlSave(ctx context.Context) (_node *Attempt, err er
 	if value, ok := _u.mutation.IsCorrect(); ok {
 		_spec.SetField(attempt.FieldIsCorrect, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ErrorNote(); ok {
+		_spec.SetField(attempt.FieldErrorNote, field.TypeString, value)
+	}
+	if _u.mutation.ErrorNoteCleared() {
+		_spec.ClearField(attempt.FieldErrorNote, field.TypeString)
+	}
 	if value, ok := _u.mutation.UserAnswer(); ok {
 		_spec.SetField(attempt.FieldUserAnswer, field.TypeString, value)
 	}
@@ -779,6 +1153,33 @@ func (_u *AttemptUpdateOne) sqlSave(ctx context.Context) (_node *Attempt, err er
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(attempt.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ReviewID(); ok {
+		_spec.SetField(attempt.FieldReviewID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewIDCleared() {
+		_spec.ClearField(attempt.FieldReviewID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Scheduler(); ok {
+		_spec.SetField(attempt.FieldScheduler, field.TypeEnum, value)
+	}
+	if _u.mutation.SchedulerCleared() {
+		_spec.ClearField(attempt.FieldScheduler, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(attempt.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CardBefore(); ok {
+		_spec.SetField(attempt.FieldCardBefore, field.TypeJSON, value)
+	}
+	if _u.mutation.CardBeforeCleared() {
+		_spec.ClearField(attempt.FieldCardBefore, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Effects(); ok {
+		_spec.SetField(attempt.FieldEffects, field.TypeJSON, value)
+	}
+	if _u.mutation.EffectsCleared() {
+		_spec.ClearField(attempt.FieldEffects, field.TypeJSON)
+	}
 	if _u.mutation.CardCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -837,6 +1238,51 @@ func (_u *AttemptUpdateOne) sqlSave(ctx context.Context) (_node *Attempt, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ErrorsTable,
+			Columns: []string{attempt.ErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedErrorsIDs(); len(nodes) > 0 && !_u.mutation.ErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ErrorsTable,
+			Columns: []string{attempt.ErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ErrorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attempt.ErrorsTable,
+			Columns: []string{attempt.ErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Attempt{config: _u.config}
 	_spec.Assign = _node.assignValues
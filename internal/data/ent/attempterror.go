@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
+	"profen/internal/data/ent/errordefinition"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// AttemptError is the model entity for the AttemptError schema.
+type AttemptError struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AttemptID holds the value of the "attempt_id" field.
+	AttemptID uuid.UUID `json:"attempt_id,omitempty"`
+	// ErrorTypeID holds the value of the "error_type_id" field.
+	ErrorTypeID uuid.UUID `json:"error_type_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttemptErrorQuery when eager-loading is set.
+	Edges        AttemptErrorEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttemptErrorEdges holds the relations/edges for other nodes in the graph.
+type AttemptErrorEdges struct {
+	// Attempt holds the value of the attempt edge.
+	Attempt *Attempt `json:"attempt,omitempty"`
+	// ErrorDefinition holds the value of the error_definition edge.
+	ErrorDefinition *ErrorDefinition `json:"error_definition,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttemptOrErr returns the Attempt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttemptErrorEdges) AttemptOrErr() (*Attempt, error) {
+	if e.Attempt != nil {
+		return e.Attempt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attempt.Label}
+	}
+	return nil, &NotLoadedError{edge: "attempt"}
+}
+
+// ErrorDefinitionOrErr returns the ErrorDefinition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttemptErrorEdges) ErrorDefinitionOrErr() (*ErrorDefinition, error) {
+	if e.ErrorDefinition != nil {
+		return e.ErrorDefinition, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: errordefinition.Label}
+	}
+	return nil, &NotLoadedError{edge: "error_definition"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttemptError) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attempterror.FieldID, attempterror.FieldAttemptID, attempterror.FieldErrorTypeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttemptError fields.
+func (_m *AttemptError) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attempterror.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case attempterror.FieldAttemptID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_id", values[i])
+			} else if value != nil {
+				_m.AttemptID = *value
+			}
+		case attempterror.FieldErrorTypeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field error_type_id", values[i])
+			} else if value != nil {
+				_m.ErrorTypeID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttemptError.
+// This includes values selected through modifiers, order, etc.
+func (_m *AttemptError) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttempt queries the "attempt" edge of the AttemptError entity.
+func (_m *AttemptError) QueryAttempt() *AttemptQuery {
+	return NewAttemptErrorClient(_m.config).QueryAttempt(_m)
+}
+
+// QueryErrorDefinition queries the "error_definition" edge of the AttemptError entity.
+func (_m *AttemptError) QueryErrorDefinition() *ErrorDefinitionQuery {
+	return NewAttemptErrorClient(_m.config).QueryErrorDefinition(_m)
+}
+
+// Update returns a builder for updating this AttemptError.
+// Note that you need to call AttemptError.Unwrap() before calling this method if this AttemptError
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AttemptError) Update() *AttemptErrorUpdateOne {
+	return NewAttemptErrorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AttemptError entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AttemptError) Unwrap() *AttemptError {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttemptError is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AttemptError) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttemptError(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("attempt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptID))
+	builder.WriteString(", ")
+	builder.WriteString("error_type_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ErrorTypeID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttemptErrors is a parsable slice of AttemptError.
+type AttemptErrors []*AttemptError
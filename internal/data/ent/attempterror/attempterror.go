@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attempterror
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the attempterror type in the database.
+	Label = "attempt_error"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "attempt_error_id"
+	// FieldAttemptID holds the string denoting the attempt_id field in the database.
+	FieldAttemptID = "attempt_id"
+	// FieldErrorTypeID holds the string denoting the error_type_id field in the database.
+	FieldErrorTypeID = "error_type_id"
+	// EdgeAttempt holds the string denoting the attempt edge name in mutations.
+	EdgeAttempt = "attempt"
+	// EdgeErrorDefinition holds the string denoting the error_definition edge name in mutations.
+	EdgeErrorDefinition = "error_definition"
+	// AttemptFieldID holds the string denoting the ID field of the Attempt.
+	AttemptFieldID = "attempt_id"
+	// ErrorDefinitionFieldID holds the string denoting the ID field of the ErrorDefinition.
+	ErrorDefinitionFieldID = "error_type_id"
+	// Table holds the table name of the attempterror in the database.
+	Table = "attempt_errors"
+	// AttemptTable is the table that holds the attempt relation/edge.
+	AttemptTable = "attempt_errors"
+	// AttemptInverseTable is the table name for the Attempt entity.
+	// It exists in this package in order to avoid circular dependency with the "attempt" package.
+	AttemptInverseTable = "attempts"
+	// AttemptColumn is the table column denoting the attempt relation/edge.
+	AttemptColumn = "attempt_id"
+	// ErrorDefinitionTable is the table that holds the error_definition relation/edge.
+	ErrorDefinitionTable = "attempt_errors"
+	// ErrorDefinitionInverseTable is the table name for the ErrorDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "errordefinition" package.
+	ErrorDefinitionInverseTable = "error_definitions"
+	// ErrorDefinitionColumn is the table column denoting the error_definition relation/edge.
+	ErrorDefinitionColumn = "error_type_id"
+)
+
+// Columns holds all SQL columns for attempterror fields.
+var Columns = []string{
+	FieldID,
+	FieldAttemptID,
+	FieldErrorTypeID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AttemptError queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAttemptID orders the results by the attempt_id field.
+func ByAttemptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptID, opts...).ToFunc()
+}
+
+// ByErrorTypeID orders the results by the error_type_id field.
+func ByErrorTypeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorTypeID, opts...).ToFunc()
+}
+
+// ByAttemptField orders the results by attempt field.
+func ByAttemptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByErrorDefinitionField orders the results by error_definition field.
+func ByErrorDefinitionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newErrorDefinitionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttemptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptInverseTable, AttemptFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+	)
+}
+func newErrorDefinitionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ErrorDefinitionInverseTable, ErrorDefinitionFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ErrorDefinitionTable, ErrorDefinitionColumn),
+	)
+}
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attempterror
+
+import (
+	"profen/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldLTE(FieldID, id))
+}
+
+// AttemptID applies equality check predicate on the "attempt_id" field. It's identical to AttemptIDEQ.
+func AttemptID(v uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// ErrorTypeID applies equality check predicate on the "error_type_id" field. It's identical to ErrorTypeIDEQ.
+func ErrorTypeID(v uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldEQ(FieldErrorTypeID, v))
+}
+
+// AttemptIDEQ applies the EQ predicate on the "attempt_id" field.
+func AttemptIDEQ(v uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// AttemptIDNEQ applies the NEQ predicate on the "attempt_id" field.
+func AttemptIDNEQ(v uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldNEQ(FieldAttemptID, v))
+}
+
+// AttemptIDIn applies the In predicate on the "attempt_id" field.
+func AttemptIDIn(vs ...uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldIn(FieldAttemptID, vs...))
+}
+
+// AttemptIDNotIn applies the NotIn predicate on the "attempt_id" field.
+func AttemptIDNotIn(vs ...uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldNotIn(FieldAttemptID, vs...))
+}
+
+// ErrorTypeIDEQ applies the EQ predicate on the "error_type_id" field.
+func ErrorTypeIDEQ(v uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldEQ(FieldErrorTypeID, v))
+}
+
+// ErrorTypeIDNEQ applies the NEQ predicate on the "error_type_id" field.
+func ErrorTypeIDNEQ(v uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldNEQ(FieldErrorTypeID, v))
+}
+
+// ErrorTypeIDIn applies the In predicate on the "error_type_id" field.
+func ErrorTypeIDIn(vs ...uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldIn(FieldErrorTypeID, vs...))
+}
+
+// ErrorTypeIDNotIn applies the NotIn predicate on the "error_type_id" field.
+func ErrorTypeIDNotIn(vs ...uuid.UUID) predicate.AttemptError {
+	return predicate.AttemptError(sql.FieldNotIn(FieldErrorTypeID, vs...))
+}
+
+// HasAttempt applies the HasEdge predicate on the "attempt" edge.
+func HasAttempt() predicate.AttemptError {
+	return predicate.AttemptError(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptWith applies the HasEdge predicate on the "attempt" edge with a given conditions (other predicates).
+func HasAttemptWith(preds ...predicate.Attempt) predicate.AttemptError {
+	return predicate.AttemptError(func(s *sql.Selector) {
+		step := newAttemptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasErrorDefinition applies the HasEdge predicate on the "error_definition" edge.
+func HasErrorDefinition() predicate.AttemptError {
+	return predicate.AttemptError(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ErrorDefinitionTable, ErrorDefinitionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasErrorDefinitionWith applies the HasEdge predicate on the "error_definition" edge with a given conditions (other predicates).
+func HasErrorDefinitionWith(preds ...predicate.ErrorDefinition) predicate.AttemptError {
+	return predicate.AttemptError(func(s *sql.Selector) {
+		step := newErrorDefinitionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttemptError) predicate.AttemptError {
+	return predicate.AttemptError(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttemptError) predicate.AttemptError {
+	return predicate.AttemptError(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttemptError) predicate.AttemptError {
+	return predicate.AttemptError(sql.NotPredicates(p))
+}
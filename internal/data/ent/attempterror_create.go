@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
+	"profen/internal/data/ent/errordefinition"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AttemptErrorCreate is the builder for creating a AttemptError entity.
+type AttemptErrorCreate struct {
+	config
+	mutation *AttemptErrorMutation
+	hooks    []Hook
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_c *AttemptErrorCreate) SetAttemptID(v uuid.UUID) *AttemptErrorCreate {
+	_c.mutation.SetAttemptID(v)
+	return _c
+}
+
+// SetErrorTypeID sets the "error_type_id" field.
+func (_c *AttemptErrorCreate) SetErrorTypeID(v uuid.UUID) *AttemptErrorCreate {
+	_c.mutation.SetErrorTypeID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AttemptErrorCreate) SetID(v uuid.UUID) *AttemptErrorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AttemptErrorCreate) SetNillableID(v *uuid.UUID) *AttemptErrorCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_c *AttemptErrorCreate) SetAttempt(v *Attempt) *AttemptErrorCreate {
+	return _c.SetAttemptID(v.ID)
+}
+
+// SetErrorDefinitionID sets the "error_definition" edge to the ErrorDefinition entity by ID.
+func (_c *AttemptErrorCreate) SetErrorDefinitionID(id uuid.UUID) *AttemptErrorCreate {
+	_c.mutation.SetErrorDefinitionID(id)
+	return _c
+}
+
+// SetErrorDefinition sets the "error_definition" edge to the ErrorDefinition entity.
+func (_c *AttemptErrorCreate) SetErrorDefinition(v *ErrorDefinition) *AttemptErrorCreate {
+	return _c.SetErrorDefinitionID(v.ID)
+}
+
+// Mutation returns the AttemptErrorMutation object of the builder.
+func (_c *AttemptErrorCreate) Mutation() *AttemptErrorMutation {
+	return _c.mutation
+}
+
+// Save creates the AttemptError in the database.
+func (_c *AttemptErrorCreate) Save(ctx context.Context) (*AttemptError, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AttemptErrorCreate) SaveX(ctx context.Context) *AttemptError {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttemptErrorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttemptErrorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AttemptErrorCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := attempterror.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AttemptErrorCreate) check() error {
+	if _, ok := _c.mutation.AttemptID(); !ok {
+		return &ValidationError{Name: "attempt_id", err: errors.New(`ent: missing required field "AttemptError.attempt_id"`)}
+	}
+	if _, ok := _c.mutation.ErrorTypeID(); !ok {
+		return &ValidationError{Name: "error_type_id", err: errors.New(`ent: missing required field "AttemptError.error_type_id"`)}
+	}
+	if len(_c.mutation.AttemptIDs()) == 0 {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required edge "AttemptError.attempt"`)}
+	}
+	if len(_c.mutation.ErrorDefinitionIDs()) == 0 {
+		return &ValidationError{Name: "error_definition", err: errors.New(`ent: missing required edge "AttemptError.error_definition"`)}
+	}
+	return nil
+}
+
+func (_c *AttemptErrorCreate) sqlSave(ctx context.Context) (*AttemptError, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AttemptErrorCreate) createSpec() (*AttemptError, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttemptError{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(attempterror.Table, sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if nodes := _c.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.AttemptTable,
+			Columns: []string{attempterror.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttemptID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ErrorDefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.ErrorDefinitionTable,
+			Columns: []string{attempterror.ErrorDefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(errordefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ErrorTypeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttemptErrorCreateBulk is the builder for creating many AttemptError entities in bulk.
+type AttemptErrorCreateBulk struct {
+	config
+	err      error
+	builders []*AttemptErrorCreate
+}
+
+// Save creates the AttemptError entities in the database.
+func (_c *AttemptErrorCreateBulk) Save(ctx context.Context) ([]*AttemptError, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AttemptError, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttemptErrorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AttemptErrorCreateBulk) SaveX(ctx context.Context) []*AttemptError {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttemptErrorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttemptErrorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
+	"profen/internal/data/ent/errordefinition"
+	"profen/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AttemptErrorUpdate is the builder for updating AttemptError entities.
+type AttemptErrorUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttemptErrorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttemptErrorUpdate builder.
+func (_u *AttemptErrorUpdate) Where(ps ...predicate.AttemptError) *AttemptErrorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *AttemptErrorUpdate) SetAttemptID(v uuid.UUID) *AttemptErrorUpdate {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *AttemptErrorUpdate) SetNillableAttemptID(v *uuid.UUID) *AttemptErrorUpdate {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetErrorTypeID sets the "error_type_id" field.
+func (_u *AttemptErrorUpdate) SetErrorTypeID(v uuid.UUID) *AttemptErrorUpdate {
+	_u.mutation.SetErrorTypeID(v)
+	return _u
+}
+
+// SetNillableErrorTypeID sets the "error_type_id" field if the given value is not nil.
+func (_u *AttemptErrorUpdate) SetNillableErrorTypeID(v *uuid.UUID) *AttemptErrorUpdate {
+	if v != nil {
+		_u.SetErrorTypeID(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_u *AttemptErrorUpdate) SetAttempt(v *Attempt) *AttemptErrorUpdate {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetErrorDefinitionID sets the "error_definition" edge to the ErrorDefinition entity by ID.
+func (_u *AttemptErrorUpdate) SetErrorDefinitionID(id uuid.UUID) *AttemptErrorUpdate {
+	_u.mutation.SetErrorDefinitionID(id)
+	return _u
+}
+
+// SetErrorDefinition sets the "error_definition" edge to the ErrorDefinition entity.
+func (_u *AttemptErrorUpdate) SetErrorDefinition(v *ErrorDefinition) *AttemptErrorUpdate {
+	return _u.SetErrorDefinitionID(v.ID)
+}
+
+// Mutation returns the AttemptErrorMutation object of the builder.
+func (_u *AttemptErrorUpdate) Mutation() *AttemptErrorMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (_u *AttemptErrorUpdate) ClearAttempt() *AttemptErrorUpdate {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearErrorDefinition clears the "error_definition" edge to the ErrorDefinition entity.
+func (_u *AttemptErrorUpdate) ClearErrorDefinition() *AttemptErrorUpdate {
+	_u.mutation.ClearErrorDefinition()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttemptErrorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttemptErrorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttemptErrorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttemptErrorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttemptErrorUpdate) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptError.attempt"`)
+	}
+	if _u.mutation.ErrorDefinitionCleared() && len(_u.mutation.ErrorDefinitionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptError.error_definition"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AttemptErrorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttemptErrorUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AttemptErrorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attempterror.Table, attempterror.Columns, sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.AttemptTable,
+			Columns: []string{attempterror.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.AttemptTable,
+			Columns: []string{attempterror.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ErrorDefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.ErrorDefinitionTable,
+			Columns: []string{attempterror.ErrorDefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(errordefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ErrorDefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.ErrorDefinitionTable,
+			Columns: []string{attempterror.ErrorDefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(errordefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attempterror.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttemptErrorUpdateOne is the builder for updating a single AttemptError entity.
+type AttemptErrorUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttemptErrorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *AttemptErrorUpdateOne) SetAttemptID(v uuid.UUID) *AttemptErrorUpdateOne {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *AttemptErrorUpdateOne) SetNillableAttemptID(v *uuid.UUID) *AttemptErrorUpdateOne {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetErrorTypeID sets the "error_type_id" field.
+func (_u *AttemptErrorUpdateOne) SetErrorTypeID(v uuid.UUID) *AttemptErrorUpdateOne {
+	_u.mutation.SetErrorTypeID(v)
+	return _u
+}
+
+// SetNillableErrorTypeID sets the "error_type_id" field if the given value is not nil.
+func (_u *AttemptErrorUpdateOne) SetNillableErrorTypeID(v *uuid.UUID) *AttemptErrorUpdateOne {
+	if v != nil {
+		_u.SetErrorTypeID(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the Attempt entity.
+func (_u *AttemptErrorUpdateOne) SetAttempt(v *Attempt) *AttemptErrorUpdateOne {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetErrorDefinitionID sets the "error_definition" edge to the ErrorDefinition entity by ID.
+func (_u *AttemptErrorUpdateOne) SetErrorDefinitionID(id uuid.UUID) *AttemptErrorUpdateOne {
+	_u.mutation.SetErrorDefinitionID(id)
+	return _u
+}
+
+// SetErrorDefinition sets the "error_definition" edge to the ErrorDefinition entity.
+func (_u *AttemptErrorUpdateOne) SetErrorDefinition(v *ErrorDefinition) *AttemptErrorUpdateOne {
+	return _u.SetErrorDefinitionID(v.ID)
+}
+
+// Mutation returns the AttemptErrorMutation object of the builder.
+func (_u *AttemptErrorUpdateOne) Mutation() *AttemptErrorMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (_u *AttemptErrorUpdateOne) ClearAttempt() *AttemptErrorUpdateOne {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearErrorDefinition clears the "error_definition" edge to the ErrorDefinition entity.
+func (_u *AttemptErrorUpdateOne) ClearErrorDefinition() *AttemptErrorUpdateOne {
+	_u.mutation.ClearErrorDefinition()
+	return _u
+}
+
+// Where appends a list predicates to the AttemptErrorUpdate builder.
+func (_u *AttemptErrorUpdateOne) Where(ps ...predicate.AttemptError) *AttemptErrorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttemptErrorUpdateOne) Select(field string, fields ...string) *AttemptErrorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AttemptError entity.
+func (_u *AttemptErrorUpdateOne) Save(ctx context.Context) (*AttemptError, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttemptErrorUpdateOne) SaveX(ctx context.Context) *AttemptError {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttemptErrorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttemptErrorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttemptErrorUpdateOne) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptError.attempt"`)
+	}
+	if _u.mutation.ErrorDefinitionCleared() && len(_u.mutation.ErrorDefinitionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttemptError.error_definition"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AttemptErrorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttemptErrorUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AttemptErrorUpdateOne) sqlSave(ctx context.Context) (_node *AttemptError, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attempterror.Table, attempterror.Columns, sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttemptError.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attempterror.FieldID)
+		for _, f := range fields {
+			if !attempterror.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attempterror.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.AttemptTable,
+			Columns: []string{attempterror.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.AttemptTable,
+			Columns: []string{attempterror.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ErrorDefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.ErrorDefinitionTable,
+			Columns: []string{attempterror.ErrorDefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(errordefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ErrorDefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attempterror.ErrorDefinitionTable,
+			Columns: []string{attempterror.ErrorDefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(errordefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AttemptError{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attempterror.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,12 +12,15 @@ import (
 	"profen/internal/data/ent/migrate"
 
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/errorresolution"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
 	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/schedulerpreset"
+	"profen/internal/data/ent/settings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -33,6 +36,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// Attempt is the client for interacting with the Attempt builders.
 	Attempt *AttemptClient
+	// AttemptError is the client for interacting with the AttemptError builders.
+	AttemptError *AttemptErrorClient
 	// ErrorDefinition is the client for interacting with the ErrorDefinition builders.
 	ErrorDefinition *ErrorDefinitionClient
 	// ErrorResolution is the client for interacting with the ErrorResolution builders.
@@ -45,6 +50,10 @@ type Client struct {
 	NodeAssociation *NodeAssociationClient
 	// NodeClosure is the client for interacting with the NodeClosure builders.
 	NodeClosure *NodeClosureClient
+	// SchedulerPreset is the client for interacting with the SchedulerPreset builders.
+	SchedulerPreset *SchedulerPresetClient
+	// Settings is the client for interacting with the Settings builders.
+	Settings *SettingsClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -57,12 +66,15 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Attempt = NewAttemptClient(c.config)
+	c.AttemptError = NewAttemptErrorClient(c.config)
 	c.ErrorDefinition = NewErrorDefinitionClient(c.config)
 	c.ErrorResolution = NewErrorResolutionClient(c.config)
 	c.FsrsCard = NewFsrsCardClient(c.config)
 	c.Node = NewNodeClient(c.config)
 	c.NodeAssociation = NewNodeAssociationClient(c.config)
 	c.NodeClosure = NewNodeClosureClient(c.config)
+	c.SchedulerPreset = NewSchedulerPresetClient(c.config)
+	c.Settings = NewSettingsClient(c.config)
 }
 
 type (
@@ -156,12 +168,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:             ctx,
 		config:          cfg,
 		Attempt:         NewAttemptClient(cfg),
+		AttemptError:    NewAttemptErrorClient(cfg),
 		ErrorDefinition: NewErrorDefinitionClient(cfg),
 		ErrorResolution: NewErrorResolutionClient(cfg),
 		FsrsCard:        NewFsrsCardClient(cfg),
 		Node:            NewNodeClient(cfg),
 		NodeAssociation: NewNodeAssociationClient(cfg),
 		NodeClosure:     NewNodeClosureClient(cfg),
+		SchedulerPreset: NewSchedulerPresetClient(cfg),
+		Settings:        NewSettingsClient(cfg),
 	}, nil
 }
 
@@ -182,12 +197,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:             ctx,
 		config:          cfg,
 		Attempt:         NewAttemptClient(cfg),
+		AttemptError:    NewAttemptErrorClient(cfg),
 		ErrorDefinition: NewErrorDefinitionClient(cfg),
 		ErrorResolution: NewErrorResolutionClient(cfg),
 		FsrsCard:        NewFsrsCardClient(cfg),
 		Node:            NewNodeClient(cfg),
 		NodeAssociation: NewNodeAssociationClient(cfg),
 		NodeClosure:     NewNodeClosureClient(cfg),
+		SchedulerPreset: NewSchedulerPresetClient(cfg),
+		Settings:        NewSettingsClient(cfg),
 	}, nil
 }
 
@@ -217,8 +235,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attempt, c.ErrorDefinition, c.ErrorResolution, c.FsrsCard, c.Node,
-		c.NodeAssociation, c.NodeClosure,
+		c.Attempt, c.AttemptError, c.ErrorDefinition, c.ErrorResolution, c.FsrsCard,
+		c.Node, c.NodeAssociation, c.NodeClosure, c.SchedulerPreset, c.Settings,
 	} {
 		n.Use(hooks...)
 	}
@@ -228,8 +246,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attempt, c.ErrorDefinition, c.ErrorResolution, c.FsrsCard, c.Node,
-		c.NodeAssociation, c.NodeClosure,
+		c.Attempt, c.AttemptError, c.ErrorDefinition, c.ErrorResolution, c.FsrsCard,
+		c.Node, c.NodeAssociation, c.NodeClosure, c.SchedulerPreset, c.Settings,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -240,6 +258,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AttemptMutation:
 		return c.Attempt.mutate(ctx, m)
+	case *AttemptErrorMutation:
+		return c.AttemptError.mutate(ctx, m)
 	case *ErrorDefinitionMutation:
 		return c.ErrorDefinition.mutate(ctx, m)
 	case *ErrorResolutionMutation:
@@ -252,6 +272,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.NodeAssociation.mutate(ctx, m)
 	case *NodeClosureMutation:
 		return c.NodeClosure.mutate(ctx, m)
+	case *SchedulerPresetMutation:
+		return c.SchedulerPreset.mutate(ctx, m)
+	case *SettingsMutation:
+		return c.Settings.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -397,6 +421,22 @@ func (c *AttemptClient) QueryErrorDefinition(_m *Attempt) *ErrorDefinitionQuery
 	return query
 }
 
+// QueryErrors queries the errors edge of a Attempt.
+func (c *AttemptClient) QueryErrors(_m *Attempt) *AttemptErrorQuery {
+	query := (&AttemptErrorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attempt.Table, attempt.FieldID, id),
+			sqlgraph.To(attempterror.Table, attempterror.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attempt.ErrorsTable, attempt.ErrorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AttemptClient) Hooks() []Hook {
 	return c.hooks.Attempt
@@ -422,6 +462,171 @@ func (c *AttemptClient) mutate(ctx context.Context, m *AttemptMutation) (Value,
 	}
 }
 
+// AttemptErrorClient is a client for the AttemptError schema.
+type AttemptErrorClient struct {
+	config
+}
+
+// NewAttemptErrorClient returns a client for the AttemptError from the given config.
+func NewAttemptErrorClient(c config) *AttemptErrorClient {
+	return &AttemptErrorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attempterror.Hooks(f(g(h())))`.
+func (c *AttemptErrorClient) Use(hooks ...Hook) {
+	c.hooks.AttemptError = append(c.hooks.AttemptError, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attempterror.Intercept(f(g(h())))`.
+func (c *AttemptErrorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttemptError = append(c.inters.AttemptError, interceptors...)
+}
+
+// Create returns a builder for creating a AttemptError entity.
+func (c *AttemptErrorClient) Create() *AttemptErrorCreate {
+	mutation := newAttemptErrorMutation(c.config, OpCreate)
+	return &AttemptErrorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AttemptError entities.
+func (c *AttemptErrorClient) CreateBulk(builders ...*AttemptErrorCreate) *AttemptErrorCreateBulk {
+	return &AttemptErrorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AttemptErrorClient) MapCreateBulk(slice any, setFunc func(*AttemptErrorCreate, int)) *AttemptErrorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AttemptErrorCreateBulk{err: fmt.Errorf("calling to AttemptErrorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AttemptErrorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AttemptErrorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AttemptError.
+func (c *AttemptErrorClient) Update() *AttemptErrorUpdate {
+	mutation := newAttemptErrorMutation(c.config, OpUpdate)
+	return &AttemptErrorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttemptErrorClient) UpdateOne(_m *AttemptError) *AttemptErrorUpdateOne {
+	mutation := newAttemptErrorMutation(c.config, OpUpdateOne, withAttemptError(_m))
+	return &AttemptErrorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttemptErrorClient) UpdateOneID(id uuid.UUID) *AttemptErrorUpdateOne {
+	mutation := newAttemptErrorMutation(c.config, OpUpdateOne, withAttemptErrorID(id))
+	return &AttemptErrorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AttemptError.
+func (c *AttemptErrorClient) Delete() *AttemptErrorDelete {
+	mutation := newAttemptErrorMutation(c.config, OpDelete)
+	return &AttemptErrorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttemptErrorClient) DeleteOne(_m *AttemptError) *AttemptErrorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttemptErrorClient) DeleteOneID(id uuid.UUID) *AttemptErrorDeleteOne {
+	builder := c.Delete().Where(attempterror.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttemptErrorDeleteOne{builder}
+}
+
+// Query returns a query builder for AttemptError.
+func (c *AttemptErrorClient) Query() *AttemptErrorQuery {
+	return &AttemptErrorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttemptError},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AttemptError entity by its id.
+func (c *AttemptErrorClient) Get(ctx context.Context, id uuid.UUID) (*AttemptError, error) {
+	return c.Query().Where(attempterror.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttemptErrorClient) GetX(ctx context.Context, id uuid.UUID) *AttemptError {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttempt queries the attempt edge of a AttemptError.
+func (c *AttemptErrorClient) QueryAttempt(_m *AttemptError) *AttemptQuery {
+	query := (&AttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attempterror.Table, attempterror.FieldID, id),
+			sqlgraph.To(attempt.Table, attempt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attempterror.AttemptTable, attempterror.AttemptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryErrorDefinition queries the error_definition edge of a AttemptError.
+func (c *AttemptErrorClient) QueryErrorDefinition(_m *AttemptError) *ErrorDefinitionQuery {
+	query := (&ErrorDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attempterror.Table, attempterror.FieldID, id),
+			sqlgraph.To(errordefinition.Table, errordefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attempterror.ErrorDefinitionTable, attempterror.ErrorDefinitionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttemptErrorClient) Hooks() []Hook {
+	return c.hooks.AttemptError
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttemptErrorClient) Interceptors() []Interceptor {
+	return c.inters.AttemptError
+}
+
+func (c *AttemptErrorClient) mutate(ctx context.Context, m *AttemptErrorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttemptErrorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttemptErrorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttemptErrorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttemptErrorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AttemptError mutation op: %q", m.Op())
+	}
+}
+
 // ErrorDefinitionClient is a client for the ErrorDefinition schema.
 type ErrorDefinitionClient struct {
 	config
@@ -546,6 +751,22 @@ func (c *ErrorDefinitionClient) QueryAttempts(_m *ErrorDefinition) *AttemptQuery
 	return query
 }
 
+// QueryAttemptErrors queries the attempt_errors edge of a ErrorDefinition.
+func (c *ErrorDefinitionClient) QueryAttemptErrors(_m *ErrorDefinition) *AttemptErrorQuery {
+	query := (&AttemptErrorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(errordefinition.Table, errordefinition.FieldID, id),
+			sqlgraph.To(attempterror.Table, attempterror.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, errordefinition.AttemptErrorsTable, errordefinition.AttemptErrorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ErrorDefinitionClient) Hooks() []Hook {
 	return c.hooks.ErrorDefinition
@@ -1121,6 +1342,22 @@ func (c *NodeClient) QueryErrorResolutions(_m *Node) *ErrorResolutionQuery {
 	return query
 }
 
+// QueryPreset queries the preset edge of a Node.
+func (c *NodeClient) QueryPreset(_m *Node) *SchedulerPresetQuery {
+	query := (&SchedulerPresetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(schedulerpreset.Table, schedulerpreset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, node.PresetTable, node.PresetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *NodeClient) Hooks() []Hook {
 	return c.hooks.Node
@@ -1476,14 +1713,296 @@ func (c *NodeClosureClient) mutate(ctx context.Context, m *NodeClosureMutation)
 	}
 }
 
+// SchedulerPresetClient is a client for the SchedulerPreset schema.
+type SchedulerPresetClient struct {
+	config
+}
+
+// NewSchedulerPresetClient returns a client for the SchedulerPreset from the given config.
+func NewSchedulerPresetClient(c config) *SchedulerPresetClient {
+	return &SchedulerPresetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schedulerpreset.Hooks(f(g(h())))`.
+func (c *SchedulerPresetClient) Use(hooks ...Hook) {
+	c.hooks.SchedulerPreset = append(c.hooks.SchedulerPreset, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schedulerpreset.Intercept(f(g(h())))`.
+func (c *SchedulerPresetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SchedulerPreset = append(c.inters.SchedulerPreset, interceptors...)
+}
+
+// Create returns a builder for creating a SchedulerPreset entity.
+func (c *SchedulerPresetClient) Create() *SchedulerPresetCreate {
+	mutation := newSchedulerPresetMutation(c.config, OpCreate)
+	return &SchedulerPresetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SchedulerPreset entities.
+func (c *SchedulerPresetClient) CreateBulk(builders ...*SchedulerPresetCreate) *SchedulerPresetCreateBulk {
+	return &SchedulerPresetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SchedulerPresetClient) MapCreateBulk(slice any, setFunc func(*SchedulerPresetCreate, int)) *SchedulerPresetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SchedulerPresetCreateBulk{err: fmt.Errorf("calling to SchedulerPresetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SchedulerPresetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SchedulerPresetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SchedulerPreset.
+func (c *SchedulerPresetClient) Update() *SchedulerPresetUpdate {
+	mutation := newSchedulerPresetMutation(c.config, OpUpdate)
+	return &SchedulerPresetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SchedulerPresetClient) UpdateOne(_m *SchedulerPreset) *SchedulerPresetUpdateOne {
+	mutation := newSchedulerPresetMutation(c.config, OpUpdateOne, withSchedulerPreset(_m))
+	return &SchedulerPresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SchedulerPresetClient) UpdateOneID(id uuid.UUID) *SchedulerPresetUpdateOne {
+	mutation := newSchedulerPresetMutation(c.config, OpUpdateOne, withSchedulerPresetID(id))
+	return &SchedulerPresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SchedulerPreset.
+func (c *SchedulerPresetClient) Delete() *SchedulerPresetDelete {
+	mutation := newSchedulerPresetMutation(c.config, OpDelete)
+	return &SchedulerPresetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SchedulerPresetClient) DeleteOne(_m *SchedulerPreset) *SchedulerPresetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SchedulerPresetClient) DeleteOneID(id uuid.UUID) *SchedulerPresetDeleteOne {
+	builder := c.Delete().Where(schedulerpreset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SchedulerPresetDeleteOne{builder}
+}
+
+// Query returns a query builder for SchedulerPreset.
+func (c *SchedulerPresetClient) Query() *SchedulerPresetQuery {
+	return &SchedulerPresetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchedulerPreset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SchedulerPreset entity by its id.
+func (c *SchedulerPresetClient) Get(ctx context.Context, id uuid.UUID) (*SchedulerPreset, error) {
+	return c.Query().Where(schedulerpreset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SchedulerPresetClient) GetX(ctx context.Context, id uuid.UUID) *SchedulerPreset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNodes queries the nodes edge of a SchedulerPreset.
+func (c *SchedulerPresetClient) QueryNodes(_m *SchedulerPreset) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schedulerpreset.Table, schedulerpreset.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, schedulerpreset.NodesTable, schedulerpreset.NodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SchedulerPresetClient) Hooks() []Hook {
+	return c.hooks.SchedulerPreset
+}
+
+// Interceptors returns the client interceptors.
+func (c *SchedulerPresetClient) Interceptors() []Interceptor {
+	return c.inters.SchedulerPreset
+}
+
+func (c *SchedulerPresetClient) mutate(ctx context.Context, m *SchedulerPresetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SchedulerPresetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SchedulerPresetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SchedulerPresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SchedulerPresetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SchedulerPreset mutation op: %q", m.Op())
+	}
+}
+
+// SettingsClient is a client for the Settings schema.
+type SettingsClient struct {
+	config
+}
+
+// NewSettingsClient returns a client for the Settings from the given config.
+func NewSettingsClient(c config) *SettingsClient {
+	return &SettingsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settings.Hooks(f(g(h())))`.
+func (c *SettingsClient) Use(hooks ...Hook) {
+	c.hooks.Settings = append(c.hooks.Settings, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settings.Intercept(f(g(h())))`.
+func (c *SettingsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Settings = append(c.inters.Settings, interceptors...)
+}
+
+// Create returns a builder for creating a Settings entity.
+func (c *SettingsClient) Create() *SettingsCreate {
+	mutation := newSettingsMutation(c.config, OpCreate)
+	return &SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Settings entities.
+func (c *SettingsClient) CreateBulk(builders ...*SettingsCreate) *SettingsCreateBulk {
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingsClient) MapCreateBulk(slice any, setFunc func(*SettingsCreate, int)) *SettingsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingsCreateBulk{err: fmt.Errorf("calling to SettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Settings.
+func (c *SettingsClient) Update() *SettingsUpdate {
+	mutation := newSettingsMutation(c.config, OpUpdate)
+	return &SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingsClient) UpdateOne(_m *Settings) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettings(_m))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingsClient) UpdateOneID(id int) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettingsID(id))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Settings.
+func (c *SettingsClient) Delete() *SettingsDelete {
+	mutation := newSettingsMutation(c.config, OpDelete)
+	return &SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingsClient) DeleteOne(_m *Settings) *SettingsDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingsClient) DeleteOneID(id int) *SettingsDeleteOne {
+	builder := c.Delete().Where(settings.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingsDeleteOne{builder}
+}
+
+// Query returns a query builder for Settings.
+func (c *SettingsClient) Query() *SettingsQuery {
+	return &SettingsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettings},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Settings entity by its id.
+func (c *SettingsClient) Get(ctx context.Context, id int) (*Settings, error) {
+	return c.Query().Where(settings.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettingsClient) Hooks() []Hook {
+	return c.hooks.Settings
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettingsClient) Interceptors() []Interceptor {
+	return c.inters.Settings
+}
+
+func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Settings mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attempt, ErrorDefinition, ErrorResolution, FsrsCard, Node, NodeAssociation,
-		NodeClosure []ent.Hook
+		Attempt, AttemptError, ErrorDefinition, ErrorResolution, FsrsCard, Node,
+		NodeAssociation, NodeClosure, SchedulerPreset, Settings []ent.Hook
 	}
 	inters struct {
-		Attempt, ErrorDefinition, ErrorResolution, FsrsCard, Node, NodeAssociation,
-		NodeClosure []ent.Interceptor
+		Attempt, AttemptError, ErrorDefinition, ErrorResolution, FsrsCard, Node,
+		NodeAssociation, NodeClosure, SchedulerPreset, Settings []ent.Interceptor
 	}
 )
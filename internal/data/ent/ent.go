@@ -7,12 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/errorresolution"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
 	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/schedulerpreset"
+	"profen/internal/data/ent/settings"
 	"reflect"
 	"sync"
 
@@ -80,12 +83,15 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			attempt.Table:         attempt.ValidColumn,
+			attempterror.Table:    attempterror.ValidColumn,
 			errordefinition.Table: errordefinition.ValidColumn,
 			errorresolution.Table: errorresolution.ValidColumn,
 			fsrscard.Table:        fsrscard.ValidColumn,
 			node.Table:            node.ValidColumn,
 			nodeassociation.Table: nodeassociation.ValidColumn,
 			nodeclosure.Table:     nodeclosure.ValidColumn,
+			schedulerpreset.Table: schedulerpreset.ValidColumn,
+			settings.Table:        settings.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
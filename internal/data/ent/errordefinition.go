@@ -17,12 +17,20 @@ type ErrorDefinition struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Stable identifier such as ERR_LAPSE; labels may be renamed, codes may not
+	Code *string `json:"code,omitempty"`
 	// Label holds the value of the "label" field.
 	Label string `json:"label,omitempty"`
+	// Parent category used to group related error types
+	Category string `json:"category,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
 	// BaseWeight holds the value of the "base_weight" field.
 	BaseWeight float64 `json:"base_weight,omitempty"`
 	// IsSystem holds the value of the "is_system" field.
 	IsSystem bool `json:"is_system,omitempty"`
+	// Archived types keep their history but can no longer be recorded
+	IsArchived bool `json:"is_archived,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ErrorDefinitionQuery when eager-loading is set.
 	Edges        ErrorDefinitionEdges `json:"edges"`
@@ -33,9 +41,11 @@ type ErrorDefinition struct {
 type ErrorDefinitionEdges struct {
 	// Attempts holds the value of the attempts edge.
 	Attempts []*Attempt `json:"attempts,omitempty"`
+	// AttemptErrors holds the value of the attempt_errors edge.
+	AttemptErrors []*AttemptError `json:"attempt_errors,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // AttemptsOrErr returns the Attempts value or an error if the edge
@@ -47,16 +57,25 @@ func (e ErrorDefinitionEdges) AttemptsOrErr() ([]*Attempt, error) {
 	return nil, &NotLoadedError{edge: "attempts"}
 }
 
+// AttemptErrorsOrErr returns the AttemptErrors value or an error if the edge
+// was not loaded in eager-loading.
+func (e ErrorDefinitionEdges) AttemptErrorsOrErr() ([]*AttemptError, error) {
+	if e.loadedTypes[1] {
+		return e.AttemptErrors, nil
+	}
+	return nil, &NotLoadedError{edge: "attempt_errors"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ErrorDefinition) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case errordefinition.FieldIsSystem:
+		case errordefinition.FieldIsSystem, errordefinition.FieldIsArchived:
 			values[i] = new(sql.NullBool)
 		case errordefinition.FieldBaseWeight:
 			values[i] = new(sql.NullFloat64)
-		case errordefinition.FieldLabel:
+		case errordefinition.FieldCode, errordefinition.FieldLabel, errordefinition.FieldCategory, errordefinition.FieldDescription:
 			values[i] = new(sql.NullString)
 		case errordefinition.FieldID:
 			values[i] = new(uuid.UUID)
@@ -81,12 +100,31 @@ func (_m *ErrorDefinition) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case errordefinition.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = new(string)
+				*_m.Code = value.String
+			}
 		case errordefinition.FieldLabel:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field label", values[i])
 			} else if value.Valid {
 				_m.Label = value.String
 			}
+		case errordefinition.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case errordefinition.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
 		case errordefinition.FieldBaseWeight:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field base_weight", values[i])
@@ -99,6 +137,12 @@ func (_m *ErrorDefinition) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsSystem = value.Bool
 			}
+		case errordefinition.FieldIsArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_archived", values[i])
+			} else if value.Valid {
+				_m.IsArchived = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -117,6 +161,11 @@ func (_m *ErrorDefinition) QueryAttempts() *AttemptQuery {
 	return NewErrorDefinitionClient(_m.config).QueryAttempts(_m)
 }
 
+// QueryAttemptErrors queries the "attempt_errors" edge of the ErrorDefinition entity.
+func (_m *ErrorDefinition) QueryAttemptErrors() *AttemptErrorQuery {
+	return NewErrorDefinitionClient(_m.config).QueryAttemptErrors(_m)
+}
+
 // Update returns a builder for updating this ErrorDefinition.
 // Note that you need to call ErrorDefinition.Unwrap() before calling this method if this ErrorDefinition
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -140,14 +189,28 @@ func (_m *ErrorDefinition) String() string {
 	var builder strings.Builder
 	builder.WriteString("ErrorDefinition(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.Code; v != nil {
+		builder.WriteString("code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("label=")
 	builder.WriteString(_m.Label)
 	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
 	builder.WriteString("base_weight=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BaseWeight))
 	builder.WriteString(", ")
 	builder.WriteString("is_system=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsSystem))
+	builder.WriteString(", ")
+	builder.WriteString("is_archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsArchived))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -13,16 +13,28 @@ const (
 	Label = "error_definition"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "error_type_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
 	// FieldLabel holds the string denoting the label field in the database.
 	FieldLabel = "label"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
 	// FieldBaseWeight holds the string denoting the base_weight field in the database.
 	FieldBaseWeight = "base_weight"
 	// FieldIsSystem holds the string denoting the is_system field in the database.
 	FieldIsSystem = "is_system"
+	// FieldIsArchived holds the string denoting the is_archived field in the database.
+	FieldIsArchived = "is_archived"
 	// EdgeAttempts holds the string denoting the attempts edge name in mutations.
 	EdgeAttempts = "attempts"
+	// EdgeAttemptErrors holds the string denoting the attempt_errors edge name in mutations.
+	EdgeAttemptErrors = "attempt_errors"
 	// AttemptFieldID holds the string denoting the ID field of the Attempt.
 	AttemptFieldID = "attempt_id"
+	// AttemptErrorFieldID holds the string denoting the ID field of the AttemptError.
+	AttemptErrorFieldID = "attempt_error_id"
 	// Table holds the table name of the errordefinition in the database.
 	Table = "error_definitions"
 	// AttemptsTable is the table that holds the attempts relation/edge.
@@ -32,14 +44,25 @@ const (
 	AttemptsInverseTable = "attempts"
 	// AttemptsColumn is the table column denoting the attempts relation/edge.
 	AttemptsColumn = "error_type_id"
+	// AttemptErrorsTable is the table that holds the attempt_errors relation/edge.
+	AttemptErrorsTable = "attempt_errors"
+	// AttemptErrorsInverseTable is the table name for the AttemptError entity.
+	// It exists in this package in order to avoid circular dependency with the "attempterror" package.
+	AttemptErrorsInverseTable = "attempt_errors"
+	// AttemptErrorsColumn is the table column denoting the attempt_errors relation/edge.
+	AttemptErrorsColumn = "error_type_id"
 )
 
 // Columns holds all SQL columns for errordefinition fields.
 var Columns = []string{
 	FieldID,
+	FieldCode,
 	FieldLabel,
+	FieldCategory,
+	FieldDescription,
 	FieldBaseWeight,
 	FieldIsSystem,
+	FieldIsArchived,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -59,6 +82,8 @@ var (
 	DefaultBaseWeight float64
 	// DefaultIsSystem holds the default value on creation for the "is_system" field.
 	DefaultIsSystem bool
+	// DefaultIsArchived holds the default value on creation for the "is_archived" field.
+	DefaultIsArchived bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -71,11 +96,26 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
 // ByLabel orders the results by the label field.
 func ByLabel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLabel, opts...).ToFunc()
 }
 
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
 // ByBaseWeight orders the results by the base_weight field.
 func ByBaseWeight(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBaseWeight, opts...).ToFunc()
@@ -86,6 +126,11 @@ func ByIsSystem(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsSystem, opts...).ToFunc()
 }
 
+// ByIsArchived orders the results by the is_archived field.
+func ByIsArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsArchived, opts...).ToFunc()
+}
+
 // ByAttemptsCount orders the results by attempts count.
 func ByAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -99,6 +144,20 @@ func ByAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAttemptErrorsCount orders the results by attempt_errors count.
+func ByAttemptErrorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttemptErrorsStep(), opts...)
+	}
+}
+
+// ByAttemptErrors orders the results by attempt_errors terms.
+func ByAttemptErrors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptErrorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAttemptsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -106,3 +165,10 @@ func newAttemptsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
 	)
 }
+func newAttemptErrorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptErrorsInverseTable, AttemptErrorFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttemptErrorsTable, AttemptErrorsColumn),
+	)
+}
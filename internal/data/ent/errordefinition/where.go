@@ -55,6 +55,21 @@ func IDLTE(id uuid.UUID) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.FieldLTE(FieldID, id))
 }
 
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldCode, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldCategory, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
 // BaseWeight applies equality check predicate on the "base_weight" field. It's identical to BaseWeightEQ.
 func BaseWeight(v float64) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.FieldEQ(FieldBaseWeight, v))
@@ -65,6 +80,86 @@ func IsSystem(v bool) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.FieldEQ(FieldIsSystem, v))
 }
 
+// IsArchived applies equality check predicate on the "is_archived" field. It's identical to IsArchivedEQ.
+func IsArchived(v bool) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldIsArchived, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldIsNull(FieldCode))
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNotNull(FieldCode))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldContainsFold(FieldCode, v))
+}
+
 // LabelEQ applies the EQ predicate on the "label" field.
 func LabelEQ(v string) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.FieldEQ(FieldLabel, v))
@@ -130,6 +225,156 @@ func LabelContainsFold(v string) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.FieldContainsFold(FieldLabel, v))
 }
 
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldContainsFold(FieldDescription, v))
+}
+
 // BaseWeightEQ applies the EQ predicate on the "base_weight" field.
 func BaseWeightEQ(v float64) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.FieldEQ(FieldBaseWeight, v))
@@ -180,6 +425,16 @@ func IsSystemNEQ(v bool) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.FieldNEQ(FieldIsSystem, v))
 }
 
+// IsArchivedEQ applies the EQ predicate on the "is_archived" field.
+func IsArchivedEQ(v bool) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldEQ(FieldIsArchived, v))
+}
+
+// IsArchivedNEQ applies the NEQ predicate on the "is_archived" field.
+func IsArchivedNEQ(v bool) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(sql.FieldNEQ(FieldIsArchived, v))
+}
+
 // HasAttempts applies the HasEdge predicate on the "attempts" edge.
 func HasAttempts() predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(func(s *sql.Selector) {
@@ -203,6 +458,29 @@ func HasAttemptsWith(preds ...predicate.Attempt) predicate.ErrorDefinition {
 	})
 }
 
+// HasAttemptErrors applies the HasEdge predicate on the "attempt_errors" edge.
+func HasAttemptErrors() predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttemptErrorsTable, AttemptErrorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptErrorsWith applies the HasEdge predicate on the "attempt_errors" edge with a given conditions (other predicates).
+func HasAttemptErrorsWith(preds ...predicate.AttemptError) predicate.ErrorDefinition {
+	return predicate.ErrorDefinition(func(s *sql.Selector) {
+		step := newAttemptErrorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ErrorDefinition) predicate.ErrorDefinition {
 	return predicate.ErrorDefinition(sql.AndPredicates(predicates...))
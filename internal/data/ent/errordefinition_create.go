@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -21,12 +22,54 @@ type ErrorDefinitionCreate struct {
 	hooks    []Hook
 }
 
+// SetCode sets the "code" field.
+func (_c *ErrorDefinitionCreate) SetCode(v string) *ErrorDefinitionCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *ErrorDefinitionCreate) SetNillableCode(v *string) *ErrorDefinitionCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
 // SetLabel sets the "label" field.
 func (_c *ErrorDefinitionCreate) SetLabel(v string) *ErrorDefinitionCreate {
 	_c.mutation.SetLabel(v)
 	return _c
 }
 
+// SetCategory sets the "category" field.
+func (_c *ErrorDefinitionCreate) SetCategory(v string) *ErrorDefinitionCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *ErrorDefinitionCreate) SetNillableCategory(v *string) *ErrorDefinitionCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ErrorDefinitionCreate) SetDescription(v string) *ErrorDefinitionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ErrorDefinitionCreate) SetNillableDescription(v *string) *ErrorDefinitionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
 // SetBaseWeight sets the "base_weight" field.
 func (_c *ErrorDefinitionCreate) SetBaseWeight(v float64) *ErrorDefinitionCreate {
 	_c.mutation.SetBaseWeight(v)
@@ -55,6 +98,20 @@ func (_c *ErrorDefinitionCreate) SetNillableIsSystem(v *bool) *ErrorDefinitionCr
 	return _c
 }
 
+// SetIsArchived sets the "is_archived" field.
+func (_c *ErrorDefinitionCreate) SetIsArchived(v bool) *ErrorDefinitionCreate {
+	_c.mutation.SetIsArchived(v)
+	return _c
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (_c *ErrorDefinitionCreate) SetNillableIsArchived(v *bool) *ErrorDefinitionCreate {
+	if v != nil {
+		_c.SetIsArchived(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ErrorDefinitionCreate) SetID(v uuid.UUID) *ErrorDefinitionCreate {
 	_c.mutation.SetID(v)
@@ -84,6 +141,21 @@ func (_c *ErrorDefinitionCreate) AddAttempts(v ...*Attempt) *ErrorDefinitionCrea
 	return _c.AddAttemptIDs(ids...)
 }
 
+// AddAttemptErrorIDs adds the "attempt_errors" edge to the AttemptError entity by IDs.
+func (_c *ErrorDefinitionCreate) AddAttemptErrorIDs(ids ...uuid.UUID) *ErrorDefinitionCreate {
+	_c.mutation.AddAttemptErrorIDs(ids...)
+	return _c
+}
+
+// AddAttemptErrors adds the "attempt_errors" edges to the AttemptError entity.
+func (_c *ErrorDefinitionCreate) AddAttemptErrors(v ...*AttemptError) *ErrorDefinitionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttemptErrorIDs(ids...)
+}
+
 // Mutation returns the ErrorDefinitionMutation object of the builder.
 func (_c *ErrorDefinitionCreate) Mutation() *ErrorDefinitionMutation {
 	return _c.mutation
@@ -127,6 +199,10 @@ func (_c *ErrorDefinitionCreate) defaults() {
 		v := errordefinition.DefaultIsSystem
 		_c.mutation.SetIsSystem(v)
 	}
+	if _, ok := _c.mutation.IsArchived(); !ok {
+		v := errordefinition.DefaultIsArchived
+		_c.mutation.SetIsArchived(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := errordefinition.DefaultID()
 		_c.mutation.SetID(v)
@@ -149,6 +225,9 @@ func (_c *ErrorDefinitionCreate) check() error {
 	if _, ok := _c.mutation.IsSystem(); !ok {
 		return &ValidationError{Name: "is_system", err: errors.New(`ent: missing required field "ErrorDefinition.is_system"`)}
 	}
+	if _, ok := _c.mutation.IsArchived(); !ok {
+		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "ErrorDefinition.is_archived"`)}
+	}
 	return nil
 }
 
@@ -184,10 +263,22 @@ func (_c *ErrorDefinitionCreate) createSpec() (*ErrorDefinition, *sqlgraph.Creat
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(errordefinition.FieldCode, field.TypeString, value)
+		_node.Code = &value
+	}
 	if value, ok := _c.mutation.Label(); ok {
 		_spec.SetField(errordefinition.FieldLabel, field.TypeString, value)
 		_node.Label = value
 	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(errordefinition.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(errordefinition.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
 	if value, ok := _c.mutation.BaseWeight(); ok {
 		_spec.SetField(errordefinition.FieldBaseWeight, field.TypeFloat64, value)
 		_node.BaseWeight = value
@@ -196,6 +287,10 @@ func (_c *ErrorDefinitionCreate) createSpec() (*ErrorDefinition, *sqlgraph.Creat
 		_spec.SetField(errordefinition.FieldIsSystem, field.TypeBool, value)
 		_node.IsSystem = value
 	}
+	if value, ok := _c.mutation.IsArchived(); ok {
+		_spec.SetField(errordefinition.FieldIsArchived, field.TypeBool, value)
+		_node.IsArchived = value
+	}
 	if nodes := _c.mutation.AttemptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -212,6 +307,22 @@ func (_c *ErrorDefinitionCreate) createSpec() (*ErrorDefinition, *sqlgraph.Creat
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AttemptErrorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   errordefinition.AttemptErrorsTable,
+			Columns: []string{errordefinition.AttemptErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
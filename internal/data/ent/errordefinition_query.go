@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/predicate"
 
@@ -21,12 +22,13 @@ import (
 // ErrorDefinitionQuery is the builder for querying ErrorDefinition entities.
 type ErrorDefinitionQuery struct {
 	config
-	ctx          *QueryContext
-	order        []errordefinition.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.ErrorDefinition
-	withAttempts *AttemptQuery
-	modifiers    []func(*sql.Selector)
+	ctx               *QueryContext
+	order             []errordefinition.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.ErrorDefinition
+	withAttempts      *AttemptQuery
+	withAttemptErrors *AttemptErrorQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +87,28 @@ func (_q *ErrorDefinitionQuery) QueryAttempts() *AttemptQuery {
 	return query
 }
 
+// QueryAttemptErrors chains the current query on the "attempt_errors" edge.
+func (_q *ErrorDefinitionQuery) QueryAttemptErrors() *AttemptErrorQuery {
+	query := (&AttemptErrorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(errordefinition.Table, errordefinition.FieldID, selector),
+			sqlgraph.To(attempterror.Table, attempterror.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, errordefinition.AttemptErrorsTable, errordefinition.AttemptErrorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ErrorDefinition entity from the query.
 // Returns a *NotFoundError when no ErrorDefinition was found.
 func (_q *ErrorDefinitionQuery) First(ctx context.Context) (*ErrorDefinition, error) {
@@ -272,12 +296,13 @@ func (_q *ErrorDefinitionQuery) Clone() *ErrorDefinitionQuery {
 		return nil
 	}
 	return &ErrorDefinitionQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]errordefinition.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.ErrorDefinition{}, _q.predicates...),
-		withAttempts: _q.withAttempts.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]errordefinition.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.ErrorDefinition{}, _q.predicates...),
+		withAttempts:      _q.withAttempts.Clone(),
+		withAttemptErrors: _q.withAttemptErrors.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -296,18 +321,29 @@ func (_q *ErrorDefinitionQuery) WithAttempts(opts ...func(*AttemptQuery)) *Error
 	return _q
 }
 
+// WithAttemptErrors tells the query-builder to eager-load the nodes that are connected to
+// the "attempt_errors" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ErrorDefinitionQuery) WithAttemptErrors(opts ...func(*AttemptErrorQuery)) *ErrorDefinitionQuery {
+	query := (&AttemptErrorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAttemptErrors = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Label string `json:"label,omitempty"`
+//		Code string `json:"code,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.ErrorDefinition.Query().
-//		GroupBy(errordefinition.FieldLabel).
+//		GroupBy(errordefinition.FieldCode).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ErrorDefinitionQuery) GroupBy(field string, fields ...string) *ErrorDefinitionGroupBy {
@@ -325,11 +361,11 @@ func (_q *ErrorDefinitionQuery) GroupBy(field string, fields ...string) *ErrorDe
 // Example:
 //
 //	var v []struct {
-//		Label string `json:"label,omitempty"`
+//		Code string `json:"code,omitempty"`
 //	}
 //
 //	client.ErrorDefinition.Query().
-//		Select(errordefinition.FieldLabel).
+//		Select(errordefinition.FieldCode).
 //		Scan(ctx, &v)
 func (_q *ErrorDefinitionQuery) Select(fields ...string) *ErrorDefinitionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -374,8 +410,9 @@ func (_q *ErrorDefinitionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*ErrorDefinition{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withAttempts != nil,
+			_q.withAttemptErrors != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -406,6 +443,13 @@ func (_q *ErrorDefinitionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withAttemptErrors; query != nil {
+		if err := _q.loadAttemptErrors(ctx, query, nodes,
+			func(n *ErrorDefinition) { n.Edges.AttemptErrors = []*AttemptError{} },
+			func(n *ErrorDefinition, e *AttemptError) { n.Edges.AttemptErrors = append(n.Edges.AttemptErrors, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,6 +486,36 @@ func (_q *ErrorDefinitionQuery) loadAttempts(ctx context.Context, query *Attempt
 	}
 	return nil
 }
+func (_q *ErrorDefinitionQuery) loadAttemptErrors(ctx context.Context, query *AttemptErrorQuery, nodes []*ErrorDefinition, init func(*ErrorDefinition), assign func(*ErrorDefinition, *AttemptError)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ErrorDefinition)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attempterror.FieldErrorTypeID)
+	}
+	query.Where(predicate.AttemptError(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(errordefinition.AttemptErrorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ErrorTypeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "error_type_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ErrorDefinitionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/predicate"
 
@@ -30,6 +31,26 @@ func (_u *ErrorDefinitionUpdate) Where(ps ...predicate.ErrorDefinition) *ErrorDe
 	return _u
 }
 
+// SetCode sets the "code" field.
+func (_u *ErrorDefinitionUpdate) SetCode(v string) *ErrorDefinitionUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdate) SetNillableCode(v *string) *ErrorDefinitionUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *ErrorDefinitionUpdate) ClearCode() *ErrorDefinitionUpdate {
+	_u.mutation.ClearCode()
+	return _u
+}
+
 // SetLabel sets the "label" field.
 func (_u *ErrorDefinitionUpdate) SetLabel(v string) *ErrorDefinitionUpdate {
 	_u.mutation.SetLabel(v)
@@ -44,6 +65,46 @@ func (_u *ErrorDefinitionUpdate) SetNillableLabel(v *string) *ErrorDefinitionUpd
 	return _u
 }
 
+// SetCategory sets the "category" field.
+func (_u *ErrorDefinitionUpdate) SetCategory(v string) *ErrorDefinitionUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdate) SetNillableCategory(v *string) *ErrorDefinitionUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *ErrorDefinitionUpdate) ClearCategory() *ErrorDefinitionUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ErrorDefinitionUpdate) SetDescription(v string) *ErrorDefinitionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdate) SetNillableDescription(v *string) *ErrorDefinitionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ErrorDefinitionUpdate) ClearDescription() *ErrorDefinitionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
 // SetBaseWeight sets the "base_weight" field.
 func (_u *ErrorDefinitionUpdate) SetBaseWeight(v float64) *ErrorDefinitionUpdate {
 	_u.mutation.ResetBaseWeight()
@@ -79,6 +140,20 @@ func (_u *ErrorDefinitionUpdate) SetNillableIsSystem(v *bool) *ErrorDefinitionUp
 	return _u
 }
 
+// SetIsArchived sets the "is_archived" field.
+func (_u *ErrorDefinitionUpdate) SetIsArchived(v bool) *ErrorDefinitionUpdate {
+	_u.mutation.SetIsArchived(v)
+	return _u
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdate) SetNillableIsArchived(v *bool) *ErrorDefinitionUpdate {
+	if v != nil {
+		_u.SetIsArchived(*v)
+	}
+	return _u
+}
+
 // AddAttemptIDs adds the "attempts" edge to the Attempt entity by IDs.
 func (_u *ErrorDefinitionUpdate) AddAttemptIDs(ids ...uuid.UUID) *ErrorDefinitionUpdate {
 	_u.mutation.AddAttemptIDs(ids...)
@@ -94,6 +169,21 @@ func (_u *ErrorDefinitionUpdate) AddAttempts(v ...*Attempt) *ErrorDefinitionUpda
 	return _u.AddAttemptIDs(ids...)
 }
 
+// AddAttemptErrorIDs adds the "attempt_errors" edge to the AttemptError entity by IDs.
+func (_u *ErrorDefinitionUpdate) AddAttemptErrorIDs(ids ...uuid.UUID) *ErrorDefinitionUpdate {
+	_u.mutation.AddAttemptErrorIDs(ids...)
+	return _u
+}
+
+// AddAttemptErrors adds the "attempt_errors" edges to the AttemptError entity.
+func (_u *ErrorDefinitionUpdate) AddAttemptErrors(v ...*AttemptError) *ErrorDefinitionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptErrorIDs(ids...)
+}
+
 // Mutation returns the ErrorDefinitionMutation object of the builder.
 func (_u *ErrorDefinitionUpdate) Mutation() *ErrorDefinitionMutation {
 	return _u.mutation
@@ -120,6 +210,27 @@ func (_u *ErrorDefinitionUpdate) RemoveAttempts(v ...*Attempt) *ErrorDefinitionU
 	return _u.RemoveAttemptIDs(ids...)
 }
 
+// ClearAttemptErrors clears all "attempt_errors" edges to the AttemptError entity.
+func (_u *ErrorDefinitionUpdate) ClearAttemptErrors() *ErrorDefinitionUpdate {
+	_u.mutation.ClearAttemptErrors()
+	return _u
+}
+
+// RemoveAttemptErrorIDs removes the "attempt_errors" edge to AttemptError entities by IDs.
+func (_u *ErrorDefinitionUpdate) RemoveAttemptErrorIDs(ids ...uuid.UUID) *ErrorDefinitionUpdate {
+	_u.mutation.RemoveAttemptErrorIDs(ids...)
+	return _u
+}
+
+// RemoveAttemptErrors removes "attempt_errors" edges to AttemptError entities.
+func (_u *ErrorDefinitionUpdate) RemoveAttemptErrors(v ...*AttemptError) *ErrorDefinitionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptErrorIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ErrorDefinitionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -175,9 +286,27 @@ func (_u *ErrorDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err er
 			}
 		}
 	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(errordefinition.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(errordefinition.FieldCode, field.TypeString)
+	}
 	if value, ok := _u.mutation.Label(); ok {
 		_spec.SetField(errordefinition.FieldLabel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(errordefinition.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(errordefinition.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(errordefinition.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(errordefinition.FieldDescription, field.TypeString)
+	}
 	if value, ok := _u.mutation.BaseWeight(); ok {
 		_spec.SetField(errordefinition.FieldBaseWeight, field.TypeFloat64, value)
 	}
@@ -187,6 +316,9 @@ func (_u *ErrorDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.IsSystem(); ok {
 		_spec.SetField(errordefinition.FieldIsSystem, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsArchived(); ok {
+		_spec.SetField(errordefinition.FieldIsArchived, field.TypeBool, value)
+	}
 	if _u.mutation.AttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -232,6 +364,51 @@ func (_u *ErrorDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttemptErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   errordefinition.AttemptErrorsTable,
+			Columns: []string{errordefinition.AttemptErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptErrorsIDs(); len(nodes) > 0 && !_u.mutation.AttemptErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   errordefinition.AttemptErrorsTable,
+			Columns: []string{errordefinition.AttemptErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptErrorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   errordefinition.AttemptErrorsTable,
+			Columns: []string{errordefinition.AttemptErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -254,6 +431,26 @@ type ErrorDefinitionUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
+// SetCode sets the "code" field.
+func (_u *ErrorDefinitionUpdateOne) SetCode(v string) *ErrorDefinitionUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdateOne) SetNillableCode(v *string) *ErrorDefinitionUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *ErrorDefinitionUpdateOne) ClearCode() *ErrorDefinitionUpdateOne {
+	_u.mutation.ClearCode()
+	return _u
+}
+
 // SetLabel sets the "label" field.
 func (_u *ErrorDefinitionUpdateOne) SetLabel(v string) *ErrorDefinitionUpdateOne {
 	_u.mutation.SetLabel(v)
@@ -268,6 +465,46 @@ func (_u *ErrorDefinitionUpdateOne) SetNillableLabel(v *string) *ErrorDefinition
 	return _u
 }
 
+// SetCategory sets the "category" field.
+func (_u *ErrorDefinitionUpdateOne) SetCategory(v string) *ErrorDefinitionUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdateOne) SetNillableCategory(v *string) *ErrorDefinitionUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *ErrorDefinitionUpdateOne) ClearCategory() *ErrorDefinitionUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ErrorDefinitionUpdateOne) SetDescription(v string) *ErrorDefinitionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdateOne) SetNillableDescription(v *string) *ErrorDefinitionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ErrorDefinitionUpdateOne) ClearDescription() *ErrorDefinitionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
 // SetBaseWeight sets the "base_weight" field.
 func (_u *ErrorDefinitionUpdateOne) SetBaseWeight(v float64) *ErrorDefinitionUpdateOne {
 	_u.mutation.ResetBaseWeight()
@@ -303,6 +540,20 @@ func (_u *ErrorDefinitionUpdateOne) SetNillableIsSystem(v *bool) *ErrorDefinitio
 	return _u
 }
 
+// SetIsArchived sets the "is_archived" field.
+func (_u *ErrorDefinitionUpdateOne) SetIsArchived(v bool) *ErrorDefinitionUpdateOne {
+	_u.mutation.SetIsArchived(v)
+	return _u
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (_u *ErrorDefinitionUpdateOne) SetNillableIsArchived(v *bool) *ErrorDefinitionUpdateOne {
+	if v != nil {
+		_u.SetIsArchived(*v)
+	}
+	return _u
+}
+
 // AddAttemptIDs adds the "attempts" edge to the Attempt entity by IDs.
 func (_u *ErrorDefinitionUpdateOne) AddAttemptIDs(ids ...uuid.UUID) *ErrorDefinitionUpdateOne {
 	_u.mutation.AddAttemptIDs(ids...)
@@ -318,6 +569,21 @@ func (_u *ErrorDefinitionUpdateOne) AddAttempts(v ...*Attempt) *ErrorDefinitionU
 	return _u.AddAttemptIDs(ids...)
 }
 
+// AddAttemptErrorIDs adds the "attempt_errors" edge to the AttemptError entity by IDs.
+func (_u *ErrorDefinitionUpdateOne) AddAttemptErrorIDs(ids ...uuid.UUID) *ErrorDefinitionUpdateOne {
+	_u.mutation.AddAttemptErrorIDs(ids...)
+	return _u
+}
+
+// AddAttemptErrors adds the "attempt_errors" edges to the AttemptError entity.
+func (_u *ErrorDefinitionUpdateOne) AddAttemptErrors(v ...*AttemptError) *ErrorDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptErrorIDs(ids...)
+}
+
 // Mutation returns the ErrorDefinitionMutation object of the builder.
 func (_u *ErrorDefinitionUpdateOne) Mutation() *ErrorDefinitionMutation {
 	return _u.mutation
@@ -344,6 +610,27 @@ func (_u *ErrorDefinitionUpdateOne) RemoveAttempts(v ...*Attempt) *ErrorDefiniti
 	return _u.RemoveAttemptIDs(ids...)
 }
 
+// ClearAttemptErrors clears all "attempt_errors" edges to the AttemptError entity.
+func (_u *ErrorDefinitionUpdateOne) ClearAttemptErrors() *ErrorDefinitionUpdateOne {
+	_u.mutation.ClearAttemptErrors()
+	return _u
+}
+
+// RemoveAttemptErrorIDs removes the "attempt_errors" edge to AttemptError entities by IDs.
+func (_u *ErrorDefinitionUpdateOne) RemoveAttemptErrorIDs(ids ...uuid.UUID) *ErrorDefinitionUpdateOne {
+	_u.mutation.RemoveAttemptErrorIDs(ids...)
+	return _u
+}
+
+// RemoveAttemptErrors removes "attempt_errors" edges to AttemptError entities.
+func (_u *ErrorDefinitionUpdateOne) RemoveAttemptErrors(v ...*AttemptError) *ErrorDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptErrorIDs(ids...)
+}
+
 // Where appends a list predicates to the ErrorDefinitionUpdate builder.
 func (_u *ErrorDefinitionUpdateOne) Where(ps ...predicate.ErrorDefinition) *ErrorDefinitionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -429,9 +716,27 @@ func (_u *ErrorDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *ErrorDe
 			}
 		}
 	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(errordefinition.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(errordefinition.FieldCode, field.TypeString)
+	}
 	if value, ok := _u.mutation.Label(); ok {
 		_spec.SetField(errordefinition.FieldLabel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(errordefinition.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(errordefinition.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(errordefinition.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(errordefinition.FieldDescription, field.TypeString)
+	}
 	if value, ok := _u.mutation.BaseWeight(); ok {
 		_spec.SetField(errordefinition.FieldBaseWeight, field.TypeFloat64, value)
 	}
@@ -441,6 +746,9 @@ func (_u *ErrorDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *ErrorDe
 	if value, ok := _u.mutation.IsSystem(); ok {
 		_spec.SetField(errordefinition.FieldIsSystem, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsArchived(); ok {
+		_spec.SetField(errordefinition.FieldIsArchived, field.TypeBool, value)
+	}
 	if _u.mutation.AttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -486,6 +794,51 @@ func (_u *ErrorDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *ErrorDe
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttemptErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   errordefinition.AttemptErrorsTable,
+			Columns: []string{errordefinition.AttemptErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptErrorsIDs(); len(nodes) > 0 && !_u.mutation.AttemptErrorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   errordefinition.AttemptErrorsTable,
+			Columns: []string{errordefinition.AttemptErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptErrorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   errordefinition.AttemptErrorsTable,
+			Columns: []string{errordefinition.AttemptErrorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attempterror.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &ErrorDefinition{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -29,8 +29,12 @@ type ErrorResolution struct {
 	IsResolved bool `json:"is_resolved,omitempty"`
 	// User explanation of the fix
 	ResolutionNotes string `json:"resolution_notes,omitempty"`
+	// Times the error was recorded while open
+	Occurrences int `json:"occurrences,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the error was last recorded; correct answers after it count towards resolving
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
 	// ResolvedAt holds the value of the "resolved_at" field.
 	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -68,9 +72,11 @@ func (*ErrorResolution) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case errorresolution.FieldWeightImpact:
 			values[i] = new(sql.NullFloat64)
+		case errorresolution.FieldOccurrences:
+			values[i] = new(sql.NullInt64)
 		case errorresolution.FieldResolutionNotes:
 			values[i] = new(sql.NullString)
-		case errorresolution.FieldCreatedAt, errorresolution.FieldResolvedAt:
+		case errorresolution.FieldCreatedAt, errorresolution.FieldLastSeenAt, errorresolution.FieldResolvedAt:
 			values[i] = new(sql.NullTime)
 		case errorresolution.FieldID, errorresolution.FieldNodeID, errorresolution.FieldErrorTypeID:
 			values[i] = new(uuid.UUID)
@@ -125,12 +131,25 @@ func (_m *ErrorResolution) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ResolutionNotes = value.String
 			}
+		case errorresolution.FieldOccurrences:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrences", values[i])
+			} else if value.Valid {
+				_m.Occurrences = int(value.Int64)
+			}
 		case errorresolution.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case errorresolution.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = new(time.Time)
+				*_m.LastSeenAt = value.Time
+			}
 		case errorresolution.FieldResolvedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
@@ -194,9 +213,17 @@ func (_m *ErrorResolution) String() string {
 	builder.WriteString("resolution_notes=")
 	builder.WriteString(_m.ResolutionNotes)
 	builder.WriteString(", ")
+	builder.WriteString("occurrences=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Occurrences))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.ResolvedAt; v != nil {
 		builder.WriteString("resolved_at=")
 		builder.WriteString(v.Format(time.ANSIC))
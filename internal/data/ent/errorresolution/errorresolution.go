@@ -25,8 +25,12 @@ const (
 	FieldIsResolved = "is_resolved"
 	// FieldResolutionNotes holds the string denoting the resolution_notes field in the database.
 	FieldResolutionNotes = "resolution_notes"
+	// FieldOccurrences holds the string denoting the occurrences field in the database.
+	FieldOccurrences = "occurrences"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
 	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
 	FieldResolvedAt = "resolved_at"
 	// EdgeNode holds the string denoting the node edge name in mutations.
@@ -52,7 +56,9 @@ var Columns = []string{
 	FieldWeightImpact,
 	FieldIsResolved,
 	FieldResolutionNotes,
+	FieldOccurrences,
 	FieldCreatedAt,
+	FieldLastSeenAt,
 	FieldResolvedAt,
 }
 
@@ -71,6 +77,8 @@ var (
 	DefaultWeightImpact float64
 	// DefaultIsResolved holds the default value on creation for the "is_resolved" field.
 	DefaultIsResolved bool
+	// DefaultOccurrences holds the default value on creation for the "occurrences" field.
+	DefaultOccurrences int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -110,11 +118,21 @@ func ByResolutionNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolutionNotes, opts...).ToFunc()
 }
 
+// ByOccurrences orders the results by the occurrences field.
+func ByOccurrences(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurrences, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
 // ByResolvedAt orders the results by the resolved_at field.
 func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
@@ -81,11 +81,21 @@ func ResolutionNotes(v string) predicate.ErrorResolution {
 	return predicate.ErrorResolution(sql.FieldEQ(FieldResolutionNotes, v))
 }
 
+// Occurrences applies equality check predicate on the "occurrences" field. It's identical to OccurrencesEQ.
+func Occurrences(v int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldEQ(FieldOccurrences, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ErrorResolution {
 	return predicate.ErrorResolution(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
 // ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
 func ResolvedAt(v time.Time) predicate.ErrorResolution {
 	return predicate.ErrorResolution(sql.FieldEQ(FieldResolvedAt, v))
@@ -276,6 +286,46 @@ func ResolutionNotesContainsFold(v string) predicate.ErrorResolution {
 	return predicate.ErrorResolution(sql.FieldContainsFold(FieldResolutionNotes, v))
 }
 
+// OccurrencesEQ applies the EQ predicate on the "occurrences" field.
+func OccurrencesEQ(v int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldEQ(FieldOccurrences, v))
+}
+
+// OccurrencesNEQ applies the NEQ predicate on the "occurrences" field.
+func OccurrencesNEQ(v int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldNEQ(FieldOccurrences, v))
+}
+
+// OccurrencesIn applies the In predicate on the "occurrences" field.
+func OccurrencesIn(vs ...int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldIn(FieldOccurrences, vs...))
+}
+
+// OccurrencesNotIn applies the NotIn predicate on the "occurrences" field.
+func OccurrencesNotIn(vs ...int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldNotIn(FieldOccurrences, vs...))
+}
+
+// OccurrencesGT applies the GT predicate on the "occurrences" field.
+func OccurrencesGT(v int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldGT(FieldOccurrences, v))
+}
+
+// OccurrencesGTE applies the GTE predicate on the "occurrences" field.
+func OccurrencesGTE(v int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldGTE(FieldOccurrences, v))
+}
+
+// OccurrencesLT applies the LT predicate on the "occurrences" field.
+func OccurrencesLT(v int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldLT(FieldOccurrences, v))
+}
+
+// OccurrencesLTE applies the LTE predicate on the "occurrences" field.
+func OccurrencesLTE(v int) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldLTE(FieldOccurrences, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ErrorResolution {
 	return predicate.ErrorResolution(sql.FieldEQ(FieldCreatedAt, v))
@@ -316,6 +366,56 @@ func CreatedAtLTE(v time.Time) predicate.ErrorResolution {
 	return predicate.ErrorResolution(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.ErrorResolution {
+	return predicate.ErrorResolution(sql.FieldNotNull(FieldLastSeenAt))
+}
+
 // ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
 func ResolvedAtEQ(v time.Time) predicate.ErrorResolution {
 	return predicate.ErrorResolution(sql.FieldEQ(FieldResolvedAt, v))
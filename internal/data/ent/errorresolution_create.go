@@ -76,6 +76,20 @@ func (_c *ErrorResolutionCreate) SetNillableResolutionNotes(v *string) *ErrorRes
 	return _c
 }
 
+// SetOccurrences sets the "occurrences" field.
+func (_c *ErrorResolutionCreate) SetOccurrences(v int) *ErrorResolutionCreate {
+	_c.mutation.SetOccurrences(v)
+	return _c
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (_c *ErrorResolutionCreate) SetNillableOccurrences(v *int) *ErrorResolutionCreate {
+	if v != nil {
+		_c.SetOccurrences(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ErrorResolutionCreate) SetCreatedAt(v time.Time) *ErrorResolutionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -90,6 +104,20 @@ func (_c *ErrorResolutionCreate) SetNillableCreatedAt(v *time.Time) *ErrorResolu
 	return _c
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *ErrorResolutionCreate) SetLastSeenAt(v time.Time) *ErrorResolutionCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *ErrorResolutionCreate) SetNillableLastSeenAt(v *time.Time) *ErrorResolutionCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
 // SetResolvedAt sets the "resolved_at" field.
 func (_c *ErrorResolutionCreate) SetResolvedAt(v time.Time) *ErrorResolutionCreate {
 	_c.mutation.SetResolvedAt(v)
@@ -166,6 +194,10 @@ func (_c *ErrorResolutionCreate) defaults() {
 		v := errorresolution.DefaultIsResolved
 		_c.mutation.SetIsResolved(v)
 	}
+	if _, ok := _c.mutation.Occurrences(); !ok {
+		v := errorresolution.DefaultOccurrences
+		_c.mutation.SetOccurrences(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := errorresolution.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -190,6 +222,9 @@ func (_c *ErrorResolutionCreate) check() error {
 	if _, ok := _c.mutation.IsResolved(); !ok {
 		return &ValidationError{Name: "is_resolved", err: errors.New(`ent: missing required field "ErrorResolution.is_resolved"`)}
 	}
+	if _, ok := _c.mutation.Occurrences(); !ok {
+		return &ValidationError{Name: "occurrences", err: errors.New(`ent: missing required field "ErrorResolution.occurrences"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ErrorResolution.created_at"`)}
 	}
@@ -247,10 +282,18 @@ func (_c *ErrorResolutionCreate) createSpec() (*ErrorResolution, *sqlgraph.Creat
 		_spec.SetField(errorresolution.FieldResolutionNotes, field.TypeString, value)
 		_node.ResolutionNotes = value
 	}
+	if value, ok := _c.mutation.Occurrences(); ok {
+		_spec.SetField(errorresolution.FieldOccurrences, field.TypeInt, value)
+		_node.Occurrences = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(errorresolution.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(errorresolution.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
 	if value, ok := _c.mutation.ResolvedAt(); ok {
 		_spec.SetField(errorresolution.FieldResolvedAt, field.TypeTime, value)
 		_node.ResolvedAt = &value
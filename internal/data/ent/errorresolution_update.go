@@ -114,6 +114,27 @@ func (_u *ErrorResolutionUpdate) ClearResolutionNotes() *ErrorResolutionUpdate {
 	return _u
 }
 
+// SetOccurrences sets the "occurrences" field.
+func (_u *ErrorResolutionUpdate) SetOccurrences(v int) *ErrorResolutionUpdate {
+	_u.mutation.ResetOccurrences()
+	_u.mutation.SetOccurrences(v)
+	return _u
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (_u *ErrorResolutionUpdate) SetNillableOccurrences(v *int) *ErrorResolutionUpdate {
+	if v != nil {
+		_u.SetOccurrences(*v)
+	}
+	return _u
+}
+
+// AddOccurrences adds value to the "occurrences" field.
+func (_u *ErrorResolutionUpdate) AddOccurrences(v int) *ErrorResolutionUpdate {
+	_u.mutation.AddOccurrences(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ErrorResolutionUpdate) SetCreatedAt(v time.Time) *ErrorResolutionUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -128,6 +149,26 @@ func (_u *ErrorResolutionUpdate) SetNillableCreatedAt(v *time.Time) *ErrorResolu
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ErrorResolutionUpdate) SetLastSeenAt(v time.Time) *ErrorResolutionUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ErrorResolutionUpdate) SetNillableLastSeenAt(v *time.Time) *ErrorResolutionUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *ErrorResolutionUpdate) ClearLastSeenAt() *ErrorResolutionUpdate {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
 // SetResolvedAt sets the "resolved_at" field.
 func (_u *ErrorResolutionUpdate) SetResolvedAt(v time.Time) *ErrorResolutionUpdate {
 	_u.mutation.SetResolvedAt(v)
@@ -235,9 +276,21 @@ func (_u *ErrorResolutionUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.ResolutionNotesCleared() {
 		_spec.ClearField(errorresolution.FieldResolutionNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.Occurrences(); ok {
+		_spec.SetField(errorresolution.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOccurrences(); ok {
+		_spec.AddField(errorresolution.FieldOccurrences, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(errorresolution.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(errorresolution.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(errorresolution.FieldLastSeenAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ResolvedAt(); ok {
 		_spec.SetField(errorresolution.FieldResolvedAt, field.TypeTime, value)
 	}
@@ -378,6 +431,27 @@ func (_u *ErrorResolutionUpdateOne) ClearResolutionNotes() *ErrorResolutionUpdat
 	return _u
 }
 
+// SetOccurrences sets the "occurrences" field.
+func (_u *ErrorResolutionUpdateOne) SetOccurrences(v int) *ErrorResolutionUpdateOne {
+	_u.mutation.ResetOccurrences()
+	_u.mutation.SetOccurrences(v)
+	return _u
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (_u *ErrorResolutionUpdateOne) SetNillableOccurrences(v *int) *ErrorResolutionUpdateOne {
+	if v != nil {
+		_u.SetOccurrences(*v)
+	}
+	return _u
+}
+
+// AddOccurrences adds value to the "occurrences" field.
+func (_u *ErrorResolutionUpdateOne) AddOccurrences(v int) *ErrorResolutionUpdateOne {
+	_u.mutation.AddOccurrences(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ErrorResolutionUpdateOne) SetCreatedAt(v time.Time) *ErrorResolutionUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -392,6 +466,26 @@ func (_u *ErrorResolutionUpdateOne) SetNillableCreatedAt(v *time.Time) *ErrorRes
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ErrorResolutionUpdateOne) SetLastSeenAt(v time.Time) *ErrorResolutionUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ErrorResolutionUpdateOne) SetNillableLastSeenAt(v *time.Time) *ErrorResolutionUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *ErrorResolutionUpdateOne) ClearLastSeenAt() *ErrorResolutionUpdateOne {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
 // SetResolvedAt sets the "resolved_at" field.
 func (_u *ErrorResolutionUpdateOne) SetResolvedAt(v time.Time) *ErrorResolutionUpdateOne {
 	_u.mutation.SetResolvedAt(v)
@@ -529,9 +623,21 @@ func (_u *ErrorResolutionUpdateOne) sqlSave(ctx context.Context) (_node *ErrorRe
 	if _u.mutation.ResolutionNotesCleared() {
 		_spec.ClearField(errorresolution.FieldResolutionNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.Occurrences(); ok {
+		_spec.SetField(errorresolution.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOccurrences(); ok {
+		_spec.AddField(errorresolution.FieldOccurrences, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(errorresolution.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(errorresolution.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(errorresolution.FieldLastSeenAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ResolvedAt(); ok {
 		_spec.SetField(errorresolution.FieldResolvedAt, field.TypeTime, value)
 	}
@@ -31,20 +31,28 @@ type FsrsCard struct {
 	Reps int `json:"reps,omitempty"`
 	// Times the user forgot the card (rated 'Again').
 	Lapses int `json:"lapses,omitempty"`
-	// Current FSRS state.
+	// Current state: new, learning, review, relearning.
 	State fsrscard.State `json:"state,omitempty"`
 	// Last time the user attempted this card.
 	LastReview *time.Time `json:"last_review,omitempty"`
-	// Next scheduled review date.
+	// When this card should be reviewed next.
 	Due time.Time `json:"due,omitempty"`
 	// NodeID holds the value of the "node_id" field.
 	NodeID uuid.UUID `json:"node_id,omitempty"`
-	// Current state: new, learning, review, relearning
-	CardState string `json:"card_state,omitempty"`
 	// Current index in learning/relearning steps
 	CurrentStep int `json:"current_step,omitempty"`
-	// When this card should be reviewed next
-	NextReview time.Time `json:"next_review,omitempty"`
+	// SM-2 ease factor (EF)
+	EaseFactor float64 `json:"ease_factor,omitempty"`
+	// Leitner box index, 0 is the most frequent
+	LeitnerBox int `json:"leitner_box,omitempty"`
+	// Lapsed at least the preset's leech threshold
+	IsLeech bool `json:"is_leech,omitempty"`
+	// Excluded from study sessions until unsuspended
+	IsSuspended bool `json:"is_suspended,omitempty"`
+	// Excluded from study sessions until this time
+	BuriedUntil *time.Time `json:"buried_until,omitempty"`
+	// Bumped by every review; a stale version means someone else graded the card
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FsrsCardQuery when eager-loading is set.
 	Edges        FsrsCardEdges `json:"edges"`
@@ -87,13 +95,15 @@ func (*FsrsCard) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case fsrscard.FieldStability, fsrscard.FieldDifficulty:
+		case fsrscard.FieldIsLeech, fsrscard.FieldIsSuspended:
+			values[i] = new(sql.NullBool)
+		case fsrscard.FieldStability, fsrscard.FieldDifficulty, fsrscard.FieldEaseFactor:
 			values[i] = new(sql.NullFloat64)
-		case fsrscard.FieldElapsedDays, fsrscard.FieldScheduledDays, fsrscard.FieldReps, fsrscard.FieldLapses, fsrscard.FieldCurrentStep:
+		case fsrscard.FieldElapsedDays, fsrscard.FieldScheduledDays, fsrscard.FieldReps, fsrscard.FieldLapses, fsrscard.FieldCurrentStep, fsrscard.FieldLeitnerBox, fsrscard.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case fsrscard.FieldState, fsrscard.FieldCardState:
+		case fsrscard.FieldState:
 			values[i] = new(sql.NullString)
-		case fsrscard.FieldLastReview, fsrscard.FieldDue, fsrscard.FieldNextReview:
+		case fsrscard.FieldLastReview, fsrscard.FieldDue, fsrscard.FieldBuriedUntil:
 			values[i] = new(sql.NullTime)
 		case fsrscard.FieldID, fsrscard.FieldNodeID:
 			values[i] = new(uuid.UUID)
@@ -179,23 +189,48 @@ func (_m *FsrsCard) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.NodeID = *value
 			}
-		case fsrscard.FieldCardState:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field card_state", values[i])
-			} else if value.Valid {
-				_m.CardState = value.String
-			}
 		case fsrscard.FieldCurrentStep:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field current_step", values[i])
 			} else if value.Valid {
 				_m.CurrentStep = int(value.Int64)
 			}
-		case fsrscard.FieldNextReview:
+		case fsrscard.FieldEaseFactor:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ease_factor", values[i])
+			} else if value.Valid {
+				_m.EaseFactor = value.Float64
+			}
+		case fsrscard.FieldLeitnerBox:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leitner_box", values[i])
+			} else if value.Valid {
+				_m.LeitnerBox = int(value.Int64)
+			}
+		case fsrscard.FieldIsLeech:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_leech", values[i])
+			} else if value.Valid {
+				_m.IsLeech = value.Bool
+			}
+		case fsrscard.FieldIsSuspended:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_suspended", values[i])
+			} else if value.Valid {
+				_m.IsSuspended = value.Bool
+			}
+		case fsrscard.FieldBuriedUntil:
 			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field next_review", values[i])
+				return fmt.Errorf("unexpected type %T for field buried_until", values[i])
+			} else if value.Valid {
+				_m.BuriedUntil = new(time.Time)
+				*_m.BuriedUntil = value.Time
+			}
+		case fsrscard.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
 			} else if value.Valid {
-				_m.NextReview = value.Time
+				_m.Version = int(value.Int64)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -275,14 +310,28 @@ func (_m *FsrsCard) String() string {
 	builder.WriteString("node_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.NodeID))
 	builder.WriteString(", ")
-	builder.WriteString("card_state=")
-	builder.WriteString(_m.CardState)
-	builder.WriteString(", ")
 	builder.WriteString("current_step=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CurrentStep))
 	builder.WriteString(", ")
-	builder.WriteString("next_review=")
-	builder.WriteString(_m.NextReview.Format(time.ANSIC))
+	builder.WriteString("ease_factor=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EaseFactor))
+	builder.WriteString(", ")
+	builder.WriteString("leitner_box=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeitnerBox))
+	builder.WriteString(", ")
+	builder.WriteString("is_leech=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsLeech))
+	builder.WriteString(", ")
+	builder.WriteString("is_suspended=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsSuspended))
+	builder.WriteString(", ")
+	if v := _m.BuriedUntil; v != nil {
+		builder.WriteString("buried_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -36,12 +36,20 @@ const (
 	FieldDue = "due"
 	// FieldNodeID holds the string denoting the node_id field in the database.
 	FieldNodeID = "node_id"
-	// FieldCardState holds the string denoting the card_state field in the database.
-	FieldCardState = "card_state"
 	// FieldCurrentStep holds the string denoting the current_step field in the database.
 	FieldCurrentStep = "current_step"
-	// FieldNextReview holds the string denoting the next_review field in the database.
-	FieldNextReview = "next_review"
+	// FieldEaseFactor holds the string denoting the ease_factor field in the database.
+	FieldEaseFactor = "ease_factor"
+	// FieldLeitnerBox holds the string denoting the leitner_box field in the database.
+	FieldLeitnerBox = "leitner_box"
+	// FieldIsLeech holds the string denoting the is_leech field in the database.
+	FieldIsLeech = "is_leech"
+	// FieldIsSuspended holds the string denoting the is_suspended field in the database.
+	FieldIsSuspended = "is_suspended"
+	// FieldBuriedUntil holds the string denoting the buried_until field in the database.
+	FieldBuriedUntil = "buried_until"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeNode holds the string denoting the node edge name in mutations.
 	EdgeNode = "node"
 	// EdgeAttempts holds the string denoting the attempts edge name in mutations.
@@ -81,9 +89,13 @@ var Columns = []string{
 	FieldLastReview,
 	FieldDue,
 	FieldNodeID,
-	FieldCardState,
 	FieldCurrentStep,
-	FieldNextReview,
+	FieldEaseFactor,
+	FieldLeitnerBox,
+	FieldIsLeech,
+	FieldIsSuspended,
+	FieldBuriedUntil,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -111,12 +123,18 @@ var (
 	DefaultLapses int
 	// DefaultDue holds the default value on creation for the "due" field.
 	DefaultDue func() time.Time
-	// DefaultCardState holds the default value on creation for the "card_state" field.
-	DefaultCardState string
 	// DefaultCurrentStep holds the default value on creation for the "current_step" field.
 	DefaultCurrentStep int
-	// DefaultNextReview holds the default value on creation for the "next_review" field.
-	DefaultNextReview func() time.Time
+	// DefaultEaseFactor holds the default value on creation for the "ease_factor" field.
+	DefaultEaseFactor float64
+	// DefaultLeitnerBox holds the default value on creation for the "leitner_box" field.
+	DefaultLeitnerBox int
+	// DefaultIsLeech holds the default value on creation for the "is_leech" field.
+	DefaultIsLeech bool
+	// DefaultIsSuspended holds the default value on creation for the "is_suspended" field.
+	DefaultIsSuspended bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -207,19 +225,39 @@ func ByNodeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNodeID, opts...).ToFunc()
 }
 
-// ByCardState orders the results by the card_state field.
-func ByCardState(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCardState, opts...).ToFunc()
-}
-
 // ByCurrentStep orders the results by the current_step field.
 func ByCurrentStep(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrentStep, opts...).ToFunc()
 }
 
-// ByNextReview orders the results by the next_review field.
-func ByNextReview(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldNextReview, opts...).ToFunc()
+// ByEaseFactor orders the results by the ease_factor field.
+func ByEaseFactor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEaseFactor, opts...).ToFunc()
+}
+
+// ByLeitnerBox orders the results by the leitner_box field.
+func ByLeitnerBox(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeitnerBox, opts...).ToFunc()
+}
+
+// ByIsLeech orders the results by the is_leech field.
+func ByIsLeech(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsLeech, opts...).ToFunc()
+}
+
+// ByIsSuspended orders the results by the is_suspended field.
+func ByIsSuspended(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsSuspended, opts...).ToFunc()
+}
+
+// ByBuriedUntil orders the results by the buried_until field.
+func ByBuriedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuriedUntil, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
 }
 
 // ByNodeField orders the results by node field.
@@ -101,19 +101,39 @@ func NodeID(v uuid.UUID) predicate.FsrsCard {
 	return predicate.FsrsCard(sql.FieldEQ(FieldNodeID, v))
 }
 
-// CardState applies equality check predicate on the "card_state" field. It's identical to CardStateEQ.
-func CardState(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldEQ(FieldCardState, v))
-}
-
 // CurrentStep applies equality check predicate on the "current_step" field. It's identical to CurrentStepEQ.
 func CurrentStep(v int) predicate.FsrsCard {
 	return predicate.FsrsCard(sql.FieldEQ(FieldCurrentStep, v))
 }
 
-// NextReview applies equality check predicate on the "next_review" field. It's identical to NextReviewEQ.
-func NextReview(v time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldEQ(FieldNextReview, v))
+// EaseFactor applies equality check predicate on the "ease_factor" field. It's identical to EaseFactorEQ.
+func EaseFactor(v float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldEaseFactor, v))
+}
+
+// LeitnerBox applies equality check predicate on the "leitner_box" field. It's identical to LeitnerBoxEQ.
+func LeitnerBox(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldLeitnerBox, v))
+}
+
+// IsLeech applies equality check predicate on the "is_leech" field. It's identical to IsLeechEQ.
+func IsLeech(v bool) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldIsLeech, v))
+}
+
+// IsSuspended applies equality check predicate on the "is_suspended" field. It's identical to IsSuspendedEQ.
+func IsSuspended(v bool) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldIsSuspended, v))
+}
+
+// BuriedUntil applies equality check predicate on the "buried_until" field. It's identical to BuriedUntilEQ.
+func BuriedUntil(v time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldBuriedUntil, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldVersion, v))
 }
 
 // StabilityEQ applies the EQ predicate on the "stability" field.
@@ -486,149 +506,234 @@ func NodeIDNotIn(vs ...uuid.UUID) predicate.FsrsCard {
 	return predicate.FsrsCard(sql.FieldNotIn(FieldNodeID, vs...))
 }
 
-// CardStateEQ applies the EQ predicate on the "card_state" field.
-func CardStateEQ(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldEQ(FieldCardState, v))
+// CurrentStepEQ applies the EQ predicate on the "current_step" field.
+func CurrentStepEQ(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldCurrentStep, v))
 }
 
-// CardStateNEQ applies the NEQ predicate on the "card_state" field.
-func CardStateNEQ(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldNEQ(FieldCardState, v))
+// CurrentStepNEQ applies the NEQ predicate on the "current_step" field.
+func CurrentStepNEQ(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNEQ(FieldCurrentStep, v))
 }
 
-// CardStateIn applies the In predicate on the "card_state" field.
-func CardStateIn(vs ...string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldIn(FieldCardState, vs...))
+// CurrentStepIn applies the In predicate on the "current_step" field.
+func CurrentStepIn(vs ...int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldIn(FieldCurrentStep, vs...))
 }
 
-// CardStateNotIn applies the NotIn predicate on the "card_state" field.
-func CardStateNotIn(vs ...string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldNotIn(FieldCardState, vs...))
+// CurrentStepNotIn applies the NotIn predicate on the "current_step" field.
+func CurrentStepNotIn(vs ...int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNotIn(FieldCurrentStep, vs...))
 }
 
-// CardStateGT applies the GT predicate on the "card_state" field.
-func CardStateGT(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldGT(FieldCardState, v))
+// CurrentStepGT applies the GT predicate on the "current_step" field.
+func CurrentStepGT(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGT(FieldCurrentStep, v))
 }
 
-// CardStateGTE applies the GTE predicate on the "card_state" field.
-func CardStateGTE(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldGTE(FieldCardState, v))
+// CurrentStepGTE applies the GTE predicate on the "current_step" field.
+func CurrentStepGTE(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGTE(FieldCurrentStep, v))
 }
 
-// CardStateLT applies the LT predicate on the "card_state" field.
-func CardStateLT(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldLT(FieldCardState, v))
+// CurrentStepLT applies the LT predicate on the "current_step" field.
+func CurrentStepLT(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLT(FieldCurrentStep, v))
 }
 
-// CardStateLTE applies the LTE predicate on the "card_state" field.
-func CardStateLTE(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldLTE(FieldCardState, v))
+// CurrentStepLTE applies the LTE predicate on the "current_step" field.
+func CurrentStepLTE(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLTE(FieldCurrentStep, v))
 }
 
-// CardStateContains applies the Contains predicate on the "card_state" field.
-func CardStateContains(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldContains(FieldCardState, v))
+// EaseFactorEQ applies the EQ predicate on the "ease_factor" field.
+func EaseFactorEQ(v float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldEaseFactor, v))
 }
 
-// CardStateHasPrefix applies the HasPrefix predicate on the "card_state" field.
-func CardStateHasPrefix(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldHasPrefix(FieldCardState, v))
+// EaseFactorNEQ applies the NEQ predicate on the "ease_factor" field.
+func EaseFactorNEQ(v float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNEQ(FieldEaseFactor, v))
 }
 
-// CardStateHasSuffix applies the HasSuffix predicate on the "card_state" field.
-func CardStateHasSuffix(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldHasSuffix(FieldCardState, v))
+// EaseFactorIn applies the In predicate on the "ease_factor" field.
+func EaseFactorIn(vs ...float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldIn(FieldEaseFactor, vs...))
 }
 
-// CardStateEqualFold applies the EqualFold predicate on the "card_state" field.
-func CardStateEqualFold(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldEqualFold(FieldCardState, v))
+// EaseFactorNotIn applies the NotIn predicate on the "ease_factor" field.
+func EaseFactorNotIn(vs ...float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNotIn(FieldEaseFactor, vs...))
 }
 
-// CardStateContainsFold applies the ContainsFold predicate on the "card_state" field.
-func CardStateContainsFold(v string) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldContainsFold(FieldCardState, v))
+// EaseFactorGT applies the GT predicate on the "ease_factor" field.
+func EaseFactorGT(v float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGT(FieldEaseFactor, v))
 }
 
-// CurrentStepEQ applies the EQ predicate on the "current_step" field.
-func CurrentStepEQ(v int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldEQ(FieldCurrentStep, v))
+// EaseFactorGTE applies the GTE predicate on the "ease_factor" field.
+func EaseFactorGTE(v float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGTE(FieldEaseFactor, v))
 }
 
-// CurrentStepNEQ applies the NEQ predicate on the "current_step" field.
-func CurrentStepNEQ(v int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldNEQ(FieldCurrentStep, v))
+// EaseFactorLT applies the LT predicate on the "ease_factor" field.
+func EaseFactorLT(v float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLT(FieldEaseFactor, v))
 }
 
-// CurrentStepIn applies the In predicate on the "current_step" field.
-func CurrentStepIn(vs ...int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldIn(FieldCurrentStep, vs...))
+// EaseFactorLTE applies the LTE predicate on the "ease_factor" field.
+func EaseFactorLTE(v float64) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLTE(FieldEaseFactor, v))
 }
 
-// CurrentStepNotIn applies the NotIn predicate on the "current_step" field.
-func CurrentStepNotIn(vs ...int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldNotIn(FieldCurrentStep, vs...))
+// LeitnerBoxEQ applies the EQ predicate on the "leitner_box" field.
+func LeitnerBoxEQ(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldLeitnerBox, v))
 }
 
-// CurrentStepGT applies the GT predicate on the "current_step" field.
-func CurrentStepGT(v int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldGT(FieldCurrentStep, v))
+// LeitnerBoxNEQ applies the NEQ predicate on the "leitner_box" field.
+func LeitnerBoxNEQ(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNEQ(FieldLeitnerBox, v))
 }
 
-// CurrentStepGTE applies the GTE predicate on the "current_step" field.
-func CurrentStepGTE(v int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldGTE(FieldCurrentStep, v))
+// LeitnerBoxIn applies the In predicate on the "leitner_box" field.
+func LeitnerBoxIn(vs ...int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldIn(FieldLeitnerBox, vs...))
 }
 
-// CurrentStepLT applies the LT predicate on the "current_step" field.
-func CurrentStepLT(v int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldLT(FieldCurrentStep, v))
+// LeitnerBoxNotIn applies the NotIn predicate on the "leitner_box" field.
+func LeitnerBoxNotIn(vs ...int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNotIn(FieldLeitnerBox, vs...))
 }
 
-// CurrentStepLTE applies the LTE predicate on the "current_step" field.
-func CurrentStepLTE(v int) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldLTE(FieldCurrentStep, v))
+// LeitnerBoxGT applies the GT predicate on the "leitner_box" field.
+func LeitnerBoxGT(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGT(FieldLeitnerBox, v))
+}
+
+// LeitnerBoxGTE applies the GTE predicate on the "leitner_box" field.
+func LeitnerBoxGTE(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGTE(FieldLeitnerBox, v))
+}
+
+// LeitnerBoxLT applies the LT predicate on the "leitner_box" field.
+func LeitnerBoxLT(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLT(FieldLeitnerBox, v))
+}
+
+// LeitnerBoxLTE applies the LTE predicate on the "leitner_box" field.
+func LeitnerBoxLTE(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLTE(FieldLeitnerBox, v))
+}
+
+// IsLeechEQ applies the EQ predicate on the "is_leech" field.
+func IsLeechEQ(v bool) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldIsLeech, v))
+}
+
+// IsLeechNEQ applies the NEQ predicate on the "is_leech" field.
+func IsLeechNEQ(v bool) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNEQ(FieldIsLeech, v))
+}
+
+// IsSuspendedEQ applies the EQ predicate on the "is_suspended" field.
+func IsSuspendedEQ(v bool) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldIsSuspended, v))
+}
+
+// IsSuspendedNEQ applies the NEQ predicate on the "is_suspended" field.
+func IsSuspendedNEQ(v bool) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNEQ(FieldIsSuspended, v))
+}
+
+// BuriedUntilEQ applies the EQ predicate on the "buried_until" field.
+func BuriedUntilEQ(v time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldBuriedUntil, v))
+}
+
+// BuriedUntilNEQ applies the NEQ predicate on the "buried_until" field.
+func BuriedUntilNEQ(v time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNEQ(FieldBuriedUntil, v))
+}
+
+// BuriedUntilIn applies the In predicate on the "buried_until" field.
+func BuriedUntilIn(vs ...time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldIn(FieldBuriedUntil, vs...))
+}
+
+// BuriedUntilNotIn applies the NotIn predicate on the "buried_until" field.
+func BuriedUntilNotIn(vs ...time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNotIn(FieldBuriedUntil, vs...))
+}
+
+// BuriedUntilGT applies the GT predicate on the "buried_until" field.
+func BuriedUntilGT(v time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGT(FieldBuriedUntil, v))
+}
+
+// BuriedUntilGTE applies the GTE predicate on the "buried_until" field.
+func BuriedUntilGTE(v time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGTE(FieldBuriedUntil, v))
+}
+
+// BuriedUntilLT applies the LT predicate on the "buried_until" field.
+func BuriedUntilLT(v time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLT(FieldBuriedUntil, v))
+}
+
+// BuriedUntilLTE applies the LTE predicate on the "buried_until" field.
+func BuriedUntilLTE(v time.Time) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLTE(FieldBuriedUntil, v))
+}
+
+// BuriedUntilIsNil applies the IsNil predicate on the "buried_until" field.
+func BuriedUntilIsNil() predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldIsNull(FieldBuriedUntil))
+}
+
+// BuriedUntilNotNil applies the NotNil predicate on the "buried_until" field.
+func BuriedUntilNotNil() predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNotNull(FieldBuriedUntil))
 }
 
-// NextReviewEQ applies the EQ predicate on the "next_review" field.
-func NextReviewEQ(v time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldEQ(FieldNextReview, v))
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldEQ(FieldVersion, v))
 }
 
-// NextReviewNEQ applies the NEQ predicate on the "next_review" field.
-func NextReviewNEQ(v time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldNEQ(FieldNextReview, v))
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNEQ(FieldVersion, v))
 }
 
-// NextReviewIn applies the In predicate on the "next_review" field.
-func NextReviewIn(vs ...time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldIn(FieldNextReview, vs...))
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldIn(FieldVersion, vs...))
 }
 
-// NextReviewNotIn applies the NotIn predicate on the "next_review" field.
-func NextReviewNotIn(vs ...time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldNotIn(FieldNextReview, vs...))
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldNotIn(FieldVersion, vs...))
 }
 
-// NextReviewGT applies the GT predicate on the "next_review" field.
-func NextReviewGT(v time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldGT(FieldNextReview, v))
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGT(FieldVersion, v))
 }
 
-// NextReviewGTE applies the GTE predicate on the "next_review" field.
-func NextReviewGTE(v time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldGTE(FieldNextReview, v))
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldGTE(FieldVersion, v))
 }
 
-// NextReviewLT applies the LT predicate on the "next_review" field.
-func NextReviewLT(v time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldLT(FieldNextReview, v))
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLT(FieldVersion, v))
 }
 
-// NextReviewLTE applies the LTE predicate on the "next_review" field.
-func NextReviewLTE(v time.Time) predicate.FsrsCard {
-	return predicate.FsrsCard(sql.FieldLTE(FieldNextReview, v))
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.FsrsCard {
+	return predicate.FsrsCard(sql.FieldLTE(FieldVersion, v))
 }
 
 // HasNode applies the HasEdge predicate on the "node" edge.
@@ -155,44 +155,100 @@ func (_c *FsrsCardCreate) SetNodeID(v uuid.UUID) *FsrsCardCreate {
 	return _c
 }
 
-// SetCardState sets the "card_state" field.
-func (_c *FsrsCardCreate) SetCardState(v string) *FsrsCardCreate {
-	_c.mutation.SetCardState(v)
+// SetCurrentStep sets the "current_step" field.
+func (_c *FsrsCardCreate) SetCurrentStep(v int) *FsrsCardCreate {
+	_c.mutation.SetCurrentStep(v)
 	return _c
 }
 
-// SetNillableCardState sets the "card_state" field if the given value is not nil.
-func (_c *FsrsCardCreate) SetNillableCardState(v *string) *FsrsCardCreate {
+// SetNillableCurrentStep sets the "current_step" field if the given value is not nil.
+func (_c *FsrsCardCreate) SetNillableCurrentStep(v *int) *FsrsCardCreate {
 	if v != nil {
-		_c.SetCardState(*v)
+		_c.SetCurrentStep(*v)
 	}
 	return _c
 }
 
-// SetCurrentStep sets the "current_step" field.
-func (_c *FsrsCardCreate) SetCurrentStep(v int) *FsrsCardCreate {
-	_c.mutation.SetCurrentStep(v)
+// SetEaseFactor sets the "ease_factor" field.
+func (_c *FsrsCardCreate) SetEaseFactor(v float64) *FsrsCardCreate {
+	_c.mutation.SetEaseFactor(v)
 	return _c
 }
 
-// SetNillableCurrentStep sets the "current_step" field if the given value is not nil.
-func (_c *FsrsCardCreate) SetNillableCurrentStep(v *int) *FsrsCardCreate {
+// SetNillableEaseFactor sets the "ease_factor" field if the given value is not nil.
+func (_c *FsrsCardCreate) SetNillableEaseFactor(v *float64) *FsrsCardCreate {
 	if v != nil {
-		_c.SetCurrentStep(*v)
+		_c.SetEaseFactor(*v)
+	}
+	return _c
+}
+
+// SetLeitnerBox sets the "leitner_box" field.
+func (_c *FsrsCardCreate) SetLeitnerBox(v int) *FsrsCardCreate {
+	_c.mutation.SetLeitnerBox(v)
+	return _c
+}
+
+// SetNillableLeitnerBox sets the "leitner_box" field if the given value is not nil.
+func (_c *FsrsCardCreate) SetNillableLeitnerBox(v *int) *FsrsCardCreate {
+	if v != nil {
+		_c.SetLeitnerBox(*v)
+	}
+	return _c
+}
+
+// SetIsLeech sets the "is_leech" field.
+func (_c *FsrsCardCreate) SetIsLeech(v bool) *FsrsCardCreate {
+	_c.mutation.SetIsLeech(v)
+	return _c
+}
+
+// SetNillableIsLeech sets the "is_leech" field if the given value is not nil.
+func (_c *FsrsCardCreate) SetNillableIsLeech(v *bool) *FsrsCardCreate {
+	if v != nil {
+		_c.SetIsLeech(*v)
+	}
+	return _c
+}
+
+// SetIsSuspended sets the "is_suspended" field.
+func (_c *FsrsCardCreate) SetIsSuspended(v bool) *FsrsCardCreate {
+	_c.mutation.SetIsSuspended(v)
+	return _c
+}
+
+// SetNillableIsSuspended sets the "is_suspended" field if the given value is not nil.
+func (_c *FsrsCardCreate) SetNillableIsSuspended(v *bool) *FsrsCardCreate {
+	if v != nil {
+		_c.SetIsSuspended(*v)
 	}
 	return _c
 }
 
-// SetNextReview sets the "next_review" field.
-func (_c *FsrsCardCreate) SetNextReview(v time.Time) *FsrsCardCreate {
-	_c.mutation.SetNextReview(v)
+// SetBuriedUntil sets the "buried_until" field.
+func (_c *FsrsCardCreate) SetBuriedUntil(v time.Time) *FsrsCardCreate {
+	_c.mutation.SetBuriedUntil(v)
 	return _c
 }
 
-// SetNillableNextReview sets the "next_review" field if the given value is not nil.
-func (_c *FsrsCardCreate) SetNillableNextReview(v *time.Time) *FsrsCardCreate {
+// SetNillableBuriedUntil sets the "buried_until" field if the given value is not nil.
+func (_c *FsrsCardCreate) SetNillableBuriedUntil(v *time.Time) *FsrsCardCreate {
 	if v != nil {
-		_c.SetNextReview(*v)
+		_c.SetBuriedUntil(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *FsrsCardCreate) SetVersion(v int) *FsrsCardCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *FsrsCardCreate) SetNillableVersion(v *int) *FsrsCardCreate {
+	if v != nil {
+		_c.SetVersion(*v)
 	}
 	return _c
 }
@@ -298,17 +354,29 @@ func (_c *FsrsCardCreate) defaults() {
 		v := fsrscard.DefaultDue()
 		_c.mutation.SetDue(v)
 	}
-	if _, ok := _c.mutation.CardState(); !ok {
-		v := fsrscard.DefaultCardState
-		_c.mutation.SetCardState(v)
-	}
 	if _, ok := _c.mutation.CurrentStep(); !ok {
 		v := fsrscard.DefaultCurrentStep
 		_c.mutation.SetCurrentStep(v)
 	}
-	if _, ok := _c.mutation.NextReview(); !ok {
-		v := fsrscard.DefaultNextReview()
-		_c.mutation.SetNextReview(v)
+	if _, ok := _c.mutation.EaseFactor(); !ok {
+		v := fsrscard.DefaultEaseFactor
+		_c.mutation.SetEaseFactor(v)
+	}
+	if _, ok := _c.mutation.LeitnerBox(); !ok {
+		v := fsrscard.DefaultLeitnerBox
+		_c.mutation.SetLeitnerBox(v)
+	}
+	if _, ok := _c.mutation.IsLeech(); !ok {
+		v := fsrscard.DefaultIsLeech
+		_c.mutation.SetIsLeech(v)
+	}
+	if _, ok := _c.mutation.IsSuspended(); !ok {
+		v := fsrscard.DefaultIsSuspended
+		_c.mutation.SetIsSuspended(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := fsrscard.DefaultVersion
+		_c.mutation.SetVersion(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := fsrscard.DefaultID()
@@ -350,14 +418,23 @@ func (_c *FsrsCardCreate) check() error {
 	if _, ok := _c.mutation.NodeID(); !ok {
 		return &ValidationError{Name: "node_id", err: errors.New(`ent: missing required field "FsrsCard.node_id"`)}
 	}
-	if _, ok := _c.mutation.CardState(); !ok {
-		return &ValidationError{Name: "card_state", err: errors.New(`ent: missing required field "FsrsCard.card_state"`)}
-	}
 	if _, ok := _c.mutation.CurrentStep(); !ok {
 		return &ValidationError{Name: "current_step", err: errors.New(`ent: missing required field "FsrsCard.current_step"`)}
 	}
-	if _, ok := _c.mutation.NextReview(); !ok {
-		return &ValidationError{Name: "next_review", err: errors.New(`ent: missing required field "FsrsCard.next_review"`)}
+	if _, ok := _c.mutation.EaseFactor(); !ok {
+		return &ValidationError{Name: "ease_factor", err: errors.New(`ent: missing required field "FsrsCard.ease_factor"`)}
+	}
+	if _, ok := _c.mutation.LeitnerBox(); !ok {
+		return &ValidationError{Name: "leitner_box", err: errors.New(`ent: missing required field "FsrsCard.leitner_box"`)}
+	}
+	if _, ok := _c.mutation.IsLeech(); !ok {
+		return &ValidationError{Name: "is_leech", err: errors.New(`ent: missing required field "FsrsCard.is_leech"`)}
+	}
+	if _, ok := _c.mutation.IsSuspended(); !ok {
+		return &ValidationError{Name: "is_suspended", err: errors.New(`ent: missing required field "FsrsCard.is_suspended"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "FsrsCard.version"`)}
 	}
 	if len(_c.mutation.NodeIDs()) == 0 {
 		return &ValidationError{Name: "node", err: errors.New(`ent: missing required edge "FsrsCard.node"`)}
@@ -433,17 +510,33 @@ func (_c *FsrsCardCreate) createSpec() (*FsrsCard, *sqlgraph.CreateSpec) {
 		_spec.SetField(fsrscard.FieldDue, field.TypeTime, value)
 		_node.Due = value
 	}
-	if value, ok := _c.mutation.CardState(); ok {
-		_spec.SetField(fsrscard.FieldCardState, field.TypeString, value)
-		_node.CardState = value
-	}
 	if value, ok := _c.mutation.CurrentStep(); ok {
 		_spec.SetField(fsrscard.FieldCurrentStep, field.TypeInt, value)
 		_node.CurrentStep = value
 	}
-	if value, ok := _c.mutation.NextReview(); ok {
-		_spec.SetField(fsrscard.FieldNextReview, field.TypeTime, value)
-		_node.NextReview = value
+	if value, ok := _c.mutation.EaseFactor(); ok {
+		_spec.SetField(fsrscard.FieldEaseFactor, field.TypeFloat64, value)
+		_node.EaseFactor = value
+	}
+	if value, ok := _c.mutation.LeitnerBox(); ok {
+		_spec.SetField(fsrscard.FieldLeitnerBox, field.TypeInt, value)
+		_node.LeitnerBox = value
+	}
+	if value, ok := _c.mutation.IsLeech(); ok {
+		_spec.SetField(fsrscard.FieldIsLeech, field.TypeBool, value)
+		_node.IsLeech = value
+	}
+	if value, ok := _c.mutation.IsSuspended(); ok {
+		_spec.SetField(fsrscard.FieldIsSuspended, field.TypeBool, value)
+		_node.IsSuspended = value
+	}
+	if value, ok := _c.mutation.BuriedUntil(); ok {
+		_spec.SetField(fsrscard.FieldBuriedUntil, field.TypeTime, value)
+		_node.BuriedUntil = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(fsrscard.FieldVersion, field.TypeInt, value)
+		_node.Version = value
 	}
 	if nodes := _c.mutation.NodeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
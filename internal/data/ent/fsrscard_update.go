@@ -220,20 +220,6 @@ func (_u *FsrsCardUpdate) SetNillableNodeID(v *uuid.UUID) *FsrsCardUpdate {
 	return _u
 }
 
-// SetCardState sets the "card_state" field.
-func (_u *FsrsCardUpdate) SetCardState(v string) *FsrsCardUpdate {
-	_u.mutation.SetCardState(v)
-	return _u
-}
-
-// SetNillableCardState sets the "card_state" field if the given value is not nil.
-func (_u *FsrsCardUpdate) SetNillableCardState(v *string) *FsrsCardUpdate {
-	if v != nil {
-		_u.SetCardState(*v)
-	}
-	return _u
-}
-
 // SetCurrentStep sets the "current_step" field.
 func (_u *FsrsCardUpdate) SetCurrentStep(v int) *FsrsCardUpdate {
 	_u.mutation.ResetCurrentStep()
@@ -255,20 +241,117 @@ func (_u *FsrsCardUpdate) AddCurrentStep(v int) *FsrsCardUpdate {
 	return _u
 }
 
-// SetNextReview sets the "next_review" field.
-func (_u *FsrsCardUpdate) SetNextReview(v time.Time) *FsrsCardUpdate {
-	_u.mutation.SetNextReview(v)
+// SetEaseFactor sets the "ease_factor" field.
+func (_u *FsrsCardUpdate) SetEaseFactor(v float64) *FsrsCardUpdate {
+	_u.mutation.ResetEaseFactor()
+	_u.mutation.SetEaseFactor(v)
+	return _u
+}
+
+// SetNillableEaseFactor sets the "ease_factor" field if the given value is not nil.
+func (_u *FsrsCardUpdate) SetNillableEaseFactor(v *float64) *FsrsCardUpdate {
+	if v != nil {
+		_u.SetEaseFactor(*v)
+	}
+	return _u
+}
+
+// AddEaseFactor adds value to the "ease_factor" field.
+func (_u *FsrsCardUpdate) AddEaseFactor(v float64) *FsrsCardUpdate {
+	_u.mutation.AddEaseFactor(v)
+	return _u
+}
+
+// SetLeitnerBox sets the "leitner_box" field.
+func (_u *FsrsCardUpdate) SetLeitnerBox(v int) *FsrsCardUpdate {
+	_u.mutation.ResetLeitnerBox()
+	_u.mutation.SetLeitnerBox(v)
+	return _u
+}
+
+// SetNillableLeitnerBox sets the "leitner_box" field if the given value is not nil.
+func (_u *FsrsCardUpdate) SetNillableLeitnerBox(v *int) *FsrsCardUpdate {
+	if v != nil {
+		_u.SetLeitnerBox(*v)
+	}
+	return _u
+}
+
+// AddLeitnerBox adds value to the "leitner_box" field.
+func (_u *FsrsCardUpdate) AddLeitnerBox(v int) *FsrsCardUpdate {
+	_u.mutation.AddLeitnerBox(v)
+	return _u
+}
+
+// SetIsLeech sets the "is_leech" field.
+func (_u *FsrsCardUpdate) SetIsLeech(v bool) *FsrsCardUpdate {
+	_u.mutation.SetIsLeech(v)
+	return _u
+}
+
+// SetNillableIsLeech sets the "is_leech" field if the given value is not nil.
+func (_u *FsrsCardUpdate) SetNillableIsLeech(v *bool) *FsrsCardUpdate {
+	if v != nil {
+		_u.SetIsLeech(*v)
+	}
+	return _u
+}
+
+// SetIsSuspended sets the "is_suspended" field.
+func (_u *FsrsCardUpdate) SetIsSuspended(v bool) *FsrsCardUpdate {
+	_u.mutation.SetIsSuspended(v)
+	return _u
+}
+
+// SetNillableIsSuspended sets the "is_suspended" field if the given value is not nil.
+func (_u *FsrsCardUpdate) SetNillableIsSuspended(v *bool) *FsrsCardUpdate {
+	if v != nil {
+		_u.SetIsSuspended(*v)
+	}
+	return _u
+}
+
+// SetBuriedUntil sets the "buried_until" field.
+func (_u *FsrsCardUpdate) SetBuriedUntil(v time.Time) *FsrsCardUpdate {
+	_u.mutation.SetBuriedUntil(v)
 	return _u
 }
 
-// SetNillableNextReview sets the "next_review" field if the given value is not nil.
-func (_u *FsrsCardUpdate) SetNillableNextReview(v *time.Time) *FsrsCardUpdate {
+// SetNillableBuriedUntil sets the "buried_until" field if the given value is not nil.
+func (_u *FsrsCardUpdate) SetNillableBuriedUntil(v *time.Time) *FsrsCardUpdate {
 	if v != nil {
-		_u.SetNextReview(*v)
+		_u.SetBuriedUntil(*v)
 	}
 	return _u
 }
 
+// ClearBuriedUntil clears the value of the "buried_until" field.
+func (_u *FsrsCardUpdate) ClearBuriedUntil() *FsrsCardUpdate {
+	_u.mutation.ClearBuriedUntil()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *FsrsCardUpdate) SetVersion(v int) *FsrsCardUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *FsrsCardUpdate) SetNillableVersion(v *int) *FsrsCardUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *FsrsCardUpdate) AddVersion(v int) *FsrsCardUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetNode sets the "node" edge to the Node entity.
 func (_u *FsrsCardUpdate) SetNode(v *Node) *FsrsCardUpdate {
 	return _u.SetNodeID(v.ID)
@@ -427,17 +510,41 @@ func (_u *FsrsCardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Due(); ok {
 		_spec.SetField(fsrscard.FieldDue, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.CardState(); ok {
-		_spec.SetField(fsrscard.FieldCardState, field.TypeString, value)
-	}
 	if value, ok := _u.mutation.CurrentStep(); ok {
 		_spec.SetField(fsrscard.FieldCurrentStep, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedCurrentStep(); ok {
 		_spec.AddField(fsrscard.FieldCurrentStep, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.NextReview(); ok {
-		_spec.SetField(fsrscard.FieldNextReview, field.TypeTime, value)
+	if value, ok := _u.mutation.EaseFactor(); ok {
+		_spec.SetField(fsrscard.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEaseFactor(); ok {
+		_spec.AddField(fsrscard.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LeitnerBox(); ok {
+		_spec.SetField(fsrscard.FieldLeitnerBox, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeitnerBox(); ok {
+		_spec.AddField(fsrscard.FieldLeitnerBox, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsLeech(); ok {
+		_spec.SetField(fsrscard.FieldIsLeech, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsSuspended(); ok {
+		_spec.SetField(fsrscard.FieldIsSuspended, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BuriedUntil(); ok {
+		_spec.SetField(fsrscard.FieldBuriedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.BuriedUntilCleared() {
+		_spec.ClearField(fsrscard.FieldBuriedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(fsrscard.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(fsrscard.FieldVersion, field.TypeInt, value)
 	}
 	if _u.mutation.NodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -723,20 +830,6 @@ func (_u *FsrsCardUpdateOne) SetNillableNodeID(v *uuid.UUID) *FsrsCardUpdateOne
 	return _u
 }
 
-// SetCardState sets the "card_state" field.
-func (_u *FsrsCardUpdateOne) SetCardState(v string) *FsrsCardUpdateOne {
-	_u.mutation.SetCardState(v)
-	return _u
-}
-
-// SetNillableCardState sets the "card_state" field if the given value is not nil.
-func (_u *FsrsCardUpdateOne) SetNillableCardState(v *string) *FsrsCardUpdateOne {
-	if v != nil {
-		_u.SetCardState(*v)
-	}
-	return _u
-}
-
 // SetCurrentStep sets the "current_step" field.
 func (_u *FsrsCardUpdateOne) SetCurrentStep(v int) *FsrsCardUpdateOne {
 	_u.mutation.ResetCurrentStep()
@@ -758,20 +851,117 @@ func (_u *FsrsCardUpdateOne) AddCurrentStep(v int) *FsrsCardUpdateOne {
 	return _u
 }
 
-// SetNextReview sets the "next_review" field.
-func (_u *FsrsCardUpdateOne) SetNextReview(v time.Time) *FsrsCardUpdateOne {
-	_u.mutation.SetNextReview(v)
+// SetEaseFactor sets the "ease_factor" field.
+func (_u *FsrsCardUpdateOne) SetEaseFactor(v float64) *FsrsCardUpdateOne {
+	_u.mutation.ResetEaseFactor()
+	_u.mutation.SetEaseFactor(v)
+	return _u
+}
+
+// SetNillableEaseFactor sets the "ease_factor" field if the given value is not nil.
+func (_u *FsrsCardUpdateOne) SetNillableEaseFactor(v *float64) *FsrsCardUpdateOne {
+	if v != nil {
+		_u.SetEaseFactor(*v)
+	}
+	return _u
+}
+
+// AddEaseFactor adds value to the "ease_factor" field.
+func (_u *FsrsCardUpdateOne) AddEaseFactor(v float64) *FsrsCardUpdateOne {
+	_u.mutation.AddEaseFactor(v)
+	return _u
+}
+
+// SetLeitnerBox sets the "leitner_box" field.
+func (_u *FsrsCardUpdateOne) SetLeitnerBox(v int) *FsrsCardUpdateOne {
+	_u.mutation.ResetLeitnerBox()
+	_u.mutation.SetLeitnerBox(v)
+	return _u
+}
+
+// SetNillableLeitnerBox sets the "leitner_box" field if the given value is not nil.
+func (_u *FsrsCardUpdateOne) SetNillableLeitnerBox(v *int) *FsrsCardUpdateOne {
+	if v != nil {
+		_u.SetLeitnerBox(*v)
+	}
+	return _u
+}
+
+// AddLeitnerBox adds value to the "leitner_box" field.
+func (_u *FsrsCardUpdateOne) AddLeitnerBox(v int) *FsrsCardUpdateOne {
+	_u.mutation.AddLeitnerBox(v)
+	return _u
+}
+
+// SetIsLeech sets the "is_leech" field.
+func (_u *FsrsCardUpdateOne) SetIsLeech(v bool) *FsrsCardUpdateOne {
+	_u.mutation.SetIsLeech(v)
+	return _u
+}
+
+// SetNillableIsLeech sets the "is_leech" field if the given value is not nil.
+func (_u *FsrsCardUpdateOne) SetNillableIsLeech(v *bool) *FsrsCardUpdateOne {
+	if v != nil {
+		_u.SetIsLeech(*v)
+	}
+	return _u
+}
+
+// SetIsSuspended sets the "is_suspended" field.
+func (_u *FsrsCardUpdateOne) SetIsSuspended(v bool) *FsrsCardUpdateOne {
+	_u.mutation.SetIsSuspended(v)
+	return _u
+}
+
+// SetNillableIsSuspended sets the "is_suspended" field if the given value is not nil.
+func (_u *FsrsCardUpdateOne) SetNillableIsSuspended(v *bool) *FsrsCardUpdateOne {
+	if v != nil {
+		_u.SetIsSuspended(*v)
+	}
+	return _u
+}
+
+// SetBuriedUntil sets the "buried_until" field.
+func (_u *FsrsCardUpdateOne) SetBuriedUntil(v time.Time) *FsrsCardUpdateOne {
+	_u.mutation.SetBuriedUntil(v)
 	return _u
 }
 
-// SetNillableNextReview sets the "next_review" field if the given value is not nil.
-func (_u *FsrsCardUpdateOne) SetNillableNextReview(v *time.Time) *FsrsCardUpdateOne {
+// SetNillableBuriedUntil sets the "buried_until" field if the given value is not nil.
+func (_u *FsrsCardUpdateOne) SetNillableBuriedUntil(v *time.Time) *FsrsCardUpdateOne {
 	if v != nil {
-		_u.SetNextReview(*v)
+		_u.SetBuriedUntil(*v)
 	}
 	return _u
 }
 
+// ClearBuriedUntil clears the value of the "buried_until" field.
+func (_u *FsrsCardUpdateOne) ClearBuriedUntil() *FsrsCardUpdateOne {
+	_u.mutation.ClearBuriedUntil()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *FsrsCardUpdateOne) SetVersion(v int) *FsrsCardUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *FsrsCardUpdateOne) SetNillableVersion(v *int) *FsrsCardUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *FsrsCardUpdateOne) AddVersion(v int) *FsrsCardUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetNode sets the "node" edge to the Node entity.
 func (_u *FsrsCardUpdateOne) SetNode(v *Node) *FsrsCardUpdateOne {
 	return _u.SetNodeID(v.ID)
@@ -960,17 +1150,41 @@ func (_u *FsrsCardUpdateOne) sqlSave(ctx context.Context) (_node *FsrsCard, err
 	if value, ok := _u.mutation.Due(); ok {
 		_spec.SetField(fsrscard.FieldDue, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.CardState(); ok {
-		_spec.SetField(fsrscard.FieldCardState, field.TypeString, value)
-	}
 	if value, ok := _u.mutation.CurrentStep(); ok {
 		_spec.SetField(fsrscard.FieldCurrentStep, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedCurrentStep(); ok {
 		_spec.AddField(fsrscard.FieldCurrentStep, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.NextReview(); ok {
-		_spec.SetField(fsrscard.FieldNextReview, field.TypeTime, value)
+	if value, ok := _u.mutation.EaseFactor(); ok {
+		_spec.SetField(fsrscard.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEaseFactor(); ok {
+		_spec.AddField(fsrscard.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LeitnerBox(); ok {
+		_spec.SetField(fsrscard.FieldLeitnerBox, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeitnerBox(); ok {
+		_spec.AddField(fsrscard.FieldLeitnerBox, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsLeech(); ok {
+		_spec.SetField(fsrscard.FieldIsLeech, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsSuspended(); ok {
+		_spec.SetField(fsrscard.FieldIsSuspended, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BuriedUntil(); ok {
+		_spec.SetField(fsrscard.FieldBuriedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.BuriedUntilCleared() {
+		_spec.ClearField(fsrscard.FieldBuriedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(fsrscard.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(fsrscard.FieldVersion, field.TypeInt, value)
 	}
 	if _u.mutation.NodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
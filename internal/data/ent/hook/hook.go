@@ -20,6 +20,18 @@ func (f AttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttemptMutation", m)
 }
 
+// The AttemptErrorFunc type is an adapter to allow the use of ordinary
+// function as AttemptError mutator.
+type AttemptErrorFunc func(context.Context, *ent.AttemptErrorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttemptErrorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttemptErrorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttemptErrorMutation", m)
+}
+
 // The ErrorDefinitionFunc type is an adapter to allow the use of ordinary
 // function as ErrorDefinition mutator.
 type ErrorDefinitionFunc func(context.Context, *ent.ErrorDefinitionMutation) (ent.Value, error)
@@ -92,6 +104,30 @@ func (f NodeClosureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NodeClosureMutation", m)
 }
 
+// The SchedulerPresetFunc type is an adapter to allow the use of ordinary
+// function as SchedulerPreset mutator.
+type SchedulerPresetFunc func(context.Context, *ent.SchedulerPresetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SchedulerPresetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SchedulerPresetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchedulerPresetMutation", m)
+}
+
+// The SettingsFunc type is an adapter to allow the use of ordinary
+// function as Settings mutator.
+type SettingsFunc func(context.Context, *ent.SettingsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettingsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SettingsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingsMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
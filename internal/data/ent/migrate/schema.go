@@ -19,8 +19,14 @@ var (
 		{Name: "difficulty", Type: field.TypeFloat64},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "is_correct", Type: field.TypeBool},
+		{Name: "error_note", Type: field.TypeString, Nullable: true},
 		{Name: "user_answer", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "review_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "scheduler", Type: field.TypeEnum, Nullable: true, Enums: []string{"learning_steps", "fsrs", "sm2", "leitner"}},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"review", "implicit"}, Default: "review"},
+		{Name: "card_before", Type: field.TypeJSON, Nullable: true},
+		{Name: "effects", Type: field.TypeJSON, Nullable: true},
 		{Name: "error_type_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "card_id", Type: field.TypeUUID},
 	}
@@ -32,24 +38,61 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attempts_error_definitions_attempts",
-				Columns:    []*schema.Column{AttemptsColumns[10]},
+				Columns:    []*schema.Column{AttemptsColumns[16]},
 				RefColumns: []*schema.Column{ErrorDefinitionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "attempts_fsrs_cards_attempts",
-				Columns:    []*schema.Column{AttemptsColumns[11]},
+				Columns:    []*schema.Column{AttemptsColumns[17]},
 				RefColumns: []*schema.Column{FsrsCardsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// AttemptErrorsColumns holds the columns for the "attempt_errors" table.
+	AttemptErrorsColumns = []*schema.Column{
+		{Name: "attempt_error_id", Type: field.TypeUUID},
+		{Name: "attempt_id", Type: field.TypeUUID},
+		{Name: "error_type_id", Type: field.TypeUUID},
+	}
+	// AttemptErrorsTable holds the schema information for the "attempt_errors" table.
+	AttemptErrorsTable = &schema.Table{
+		Name:       "attempt_errors",
+		Columns:    AttemptErrorsColumns,
+		PrimaryKey: []*schema.Column{AttemptErrorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attempt_errors_attempts_errors",
+				Columns:    []*schema.Column{AttemptErrorsColumns[1]},
+				RefColumns: []*schema.Column{AttemptsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "attempt_errors_error_definitions_attempt_errors",
+				Columns:    []*schema.Column{AttemptErrorsColumns[2]},
+				RefColumns: []*schema.Column{ErrorDefinitionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attempterror_attempt_id_error_type_id",
+				Unique:  true,
+				Columns: []*schema.Column{AttemptErrorsColumns[1], AttemptErrorsColumns[2]},
+			},
+		},
+	}
 	// ErrorDefinitionsColumns holds the columns for the "error_definitions" table.
 	ErrorDefinitionsColumns = []*schema.Column{
 		{Name: "error_type_id", Type: field.TypeUUID},
+		{Name: "code", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "label", Type: field.TypeString, Unique: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "base_weight", Type: field.TypeFloat64, Default: 1},
 		{Name: "is_system", Type: field.TypeBool, Default: false},
+		{Name: "is_archived", Type: field.TypeBool, Default: false},
 	}
 	// ErrorDefinitionsTable holds the schema information for the "error_definitions" table.
 	ErrorDefinitionsTable = &schema.Table{
@@ -64,7 +107,9 @@ var (
 		{Name: "weight_impact", Type: field.TypeFloat64, Default: 1},
 		{Name: "is_resolved", Type: field.TypeBool, Default: false},
 		{Name: "resolution_notes", Type: field.TypeString, Nullable: true},
+		{Name: "occurrences", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
 		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "node_id", Type: field.TypeUUID},
 	}
@@ -76,7 +121,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "error_resolutions_nodes_error_resolutions",
-				Columns:    []*schema.Column{ErrorResolutionsColumns[7]},
+				Columns:    []*schema.Column{ErrorResolutionsColumns[9]},
 				RefColumns: []*schema.Column{NodesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -94,9 +139,13 @@ var (
 		{Name: "state", Type: field.TypeEnum, Enums: []string{"new", "learning", "review", "relearning"}, Default: "new"},
 		{Name: "last_review", Type: field.TypeTime, Nullable: true},
 		{Name: "due", Type: field.TypeTime},
-		{Name: "card_state", Type: field.TypeString, Default: "new"},
 		{Name: "current_step", Type: field.TypeInt, Default: 0},
-		{Name: "next_review", Type: field.TypeTime},
+		{Name: "ease_factor", Type: field.TypeFloat64, Default: 2.5},
+		{Name: "leitner_box", Type: field.TypeInt, Default: 0},
+		{Name: "is_leech", Type: field.TypeBool, Default: false},
+		{Name: "is_suspended", Type: field.TypeBool, Default: false},
+		{Name: "buried_until", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 0},
 		{Name: "node_id", Type: field.TypeUUID, Unique: true},
 	}
 	// FsrsCardsTable holds the schema information for the "fsrs_cards" table.
@@ -107,7 +156,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "fsrs_cards_nodes_fsrs_card",
-				Columns:    []*schema.Column{FsrsCardsColumns[13]},
+				Columns:    []*schema.Column{FsrsCardsColumns[17]},
 				RefColumns: []*schema.Column{NodesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -122,6 +171,7 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "preset_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// NodesTable holds the schema information for the "nodes" table.
 	NodesTable = &schema.Table{
@@ -135,6 +185,12 @@ var (
 				RefColumns: []*schema.Column{NodesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "nodes_scheduler_presets_nodes",
+				Columns:    []*schema.Column{NodesColumns[7]},
+				RefColumns: []*schema.Column{SchedulerPresetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// NodeAssociationsColumns holds the columns for the "node_associations" table.
@@ -205,24 +261,81 @@ var (
 			},
 		},
 	}
+	// SchedulerPresetsColumns holds the columns for the "scheduler_presets" table.
+	SchedulerPresetsColumns = []*schema.Column{
+		{Name: "preset_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "scheduler", Type: field.TypeEnum, Enums: []string{"fsrs", "sm2", "leitner"}, Default: "fsrs"},
+		{Name: "leitner_intervals", Type: field.TypeJSON, Nullable: true},
+		{Name: "algorithm_version", Type: field.TypeEnum, Enums: []string{"v4", "v5", "v6"}, Default: "v4"},
+		{Name: "weights", Type: field.TypeJSON},
+		{Name: "desired_retention", Type: field.TypeFloat64, Default: 0.9},
+		{Name: "max_interval", Type: field.TypeInt, Default: 36500},
+		{Name: "enable_fuzz", Type: field.TypeBool, Default: true},
+		{Name: "enable_load_balance", Type: field.TypeBool, Default: false},
+		{Name: "learning_steps", Type: field.TypeJSON},
+		{Name: "relearning_steps", Type: field.TypeJSON},
+		{Name: "graduating_interval", Type: field.TypeInt, Default: 1},
+		{Name: "easy_interval", Type: field.TypeInt, Default: 4},
+		{Name: "leech_threshold", Type: field.TypeInt, Default: 8},
+		{Name: "leech_suspend", Type: field.TypeBool, Default: false},
+		{Name: "bury_translations", Type: field.TypeBool, Default: false},
+		{Name: "implicit_credit", Type: field.TypeFloat64, Default: 0.25},
+		{Name: "implicit_lapse_pull", Type: field.TypeFloat64, Default: 0.5},
+		{Name: "prerequisite_gate", Type: field.TypeEnum, Enums: []string{"off", "stability", "mastery"}, Default: "off"},
+		{Name: "prerequisite_min_stability", Type: field.TypeFloat64, Default: 3},
+		{Name: "error_resolve_after", Type: field.TypeInt, Default: 3},
+		{Name: "new_per_day", Type: field.TypeInt, Default: 20},
+		{Name: "reviews_per_day", Type: field.TypeInt, Default: 200},
+		{Name: "learn_ahead_minutes", Type: field.TypeInt, Default: 20},
+		{Name: "queue_order", Type: field.TypeEnum, Enums: []string{"mixed", "new_first", "reviews_first"}, Default: "mixed"},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SchedulerPresetsTable holds the schema information for the "scheduler_presets" table.
+	SchedulerPresetsTable = &schema.Table{
+		Name:       "scheduler_presets",
+		Columns:    SchedulerPresetsColumns,
+		PrimaryKey: []*schema.Column{SchedulerPresetsColumns[0]},
+	}
+	// SettingsColumns holds the columns for the "settings" table.
+	SettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "day_start_hour", Type: field.TypeInt, Default: 4},
+		{Name: "timezone", Type: field.TypeString, Default: ""},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SettingsTable holds the schema information for the "settings" table.
+	SettingsTable = &schema.Table{
+		Name:       "settings",
+		Columns:    SettingsColumns,
+		PrimaryKey: []*schema.Column{SettingsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttemptsTable,
+		AttemptErrorsTable,
 		ErrorDefinitionsTable,
 		ErrorResolutionsTable,
 		FsrsCardsTable,
 		NodesTable,
 		NodeAssociationsTable,
 		NodeClosuresTable,
+		SchedulerPresetsTable,
+		SettingsTable,
 	}
 )
 
 func init() {
 	AttemptsTable.ForeignKeys[0].RefTable = ErrorDefinitionsTable
 	AttemptsTable.ForeignKeys[1].RefTable = FsrsCardsTable
+	AttemptErrorsTable.ForeignKeys[0].RefTable = AttemptsTable
+	AttemptErrorsTable.ForeignKeys[1].RefTable = ErrorDefinitionsTable
 	ErrorResolutionsTable.ForeignKeys[0].RefTable = NodesTable
 	FsrsCardsTable.ForeignKeys[0].RefTable = NodesTable
 	NodesTable.ForeignKeys[0].RefTable = NodesTable
+	NodesTable.ForeignKeys[1].RefTable = SchedulerPresetsTable
 	NodeAssociationsTable.ForeignKeys[0].RefTable = NodesTable
 	NodeAssociationsTable.ForeignKeys[1].RefTable = NodesTable
 	NodeAssociationsTable.Annotation = &entsql.Annotation{}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/errorresolution"
 	"profen/internal/data/ent/fsrscard"
@@ -14,6 +15,9 @@ import (
 	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
 	"profen/internal/data/ent/predicate"
+	"profen/internal/data/ent/schedulerpreset"
+	"profen/internal/data/ent/schema"
+	"profen/internal/data/ent/settings"
 	"sync"
 	"time"
 
@@ -32,12 +36,15 @@ const (
 
 	// Node types.
 	TypeAttempt         = "Attempt"
+	TypeAttemptError    = "AttemptError"
 	TypeErrorDefinition = "ErrorDefinition"
 	TypeErrorResolution = "ErrorResolution"
 	TypeFsrsCard        = "FsrsCard"
 	TypeNode            = "Node"
 	TypeNodeAssociation = "NodeAssociation"
 	TypeNodeClosure     = "NodeClosure"
+	TypeSchedulerPreset = "SchedulerPreset"
+	TypeSettings        = "Settings"
 )
 
 // AttemptMutation represents an operation that mutates the Attempt nodes in the graph.
@@ -57,13 +64,22 @@ type AttemptMutation struct {
 	adddifficulty           *float64
 	created_at              *time.Time
 	is_correct              *bool
+	error_note              *string
 	user_answer             *string
 	metadata                *map[string]interface{}
+	review_id               *uuid.UUID
+	scheduler               *attempt.Scheduler
+	kind                    *attempt.Kind
+	card_before             **schema.CardSnapshot
+	effects                 **schema.ReviewEffects
 	clearedFields           map[string]struct{}
 	card                    *uuid.UUID
 	clearedcard             bool
 	error_definition        *uuid.UUID
 	clearederror_definition bool
+	errors                  map[uuid.UUID]struct{}
+	removederrors           map[uuid.UUID]struct{}
+	clearederrors           bool
 	done                    bool
 	oldValue                func(context.Context) (*Attempt, error)
 	predicates              []predicate.Attempt
@@ -590,6 +606,55 @@ func (m *AttemptMutation) ResetErrorTypeID() {
 	delete(m.clearedFields, attempt.FieldErrorTypeID)
 }
 
+// SetErrorNote sets the "error_note" field.
+func (m *AttemptMutation) SetErrorNote(s string) {
+	m.error_note = &s
+}
+
+// ErrorNote returns the value of the "error_note" field in the mutation.
+func (m *AttemptMutation) ErrorNote() (r string, exists bool) {
+	v := m.error_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorNote returns the old "error_note" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldErrorNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorNote: %w", err)
+	}
+	return oldValue.ErrorNote, nil
+}
+
+// ClearErrorNote clears the value of the "error_note" field.
+func (m *AttemptMutation) ClearErrorNote() {
+	m.error_note = nil
+	m.clearedFields[attempt.FieldErrorNote] = struct{}{}
+}
+
+// ErrorNoteCleared returns if the "error_note" field was cleared in this mutation.
+func (m *AttemptMutation) ErrorNoteCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldErrorNote]
+	return ok
+}
+
+// ResetErrorNote resets all changes to the "error_note" field.
+func (m *AttemptMutation) ResetErrorNote() {
+	m.error_note = nil
+	delete(m.clearedFields, attempt.FieldErrorNote)
+}
+
 // SetUserAnswer sets the "user_answer" field.
 func (m *AttemptMutation) SetUserAnswer(s string) {
 	m.user_answer = &s
@@ -688,6 +753,238 @@ func (m *AttemptMutation) ResetMetadata() {
 	delete(m.clearedFields, attempt.FieldMetadata)
 }
 
+// SetReviewID sets the "review_id" field.
+func (m *AttemptMutation) SetReviewID(u uuid.UUID) {
+	m.review_id = &u
+}
+
+// ReviewID returns the value of the "review_id" field in the mutation.
+func (m *AttemptMutation) ReviewID() (r uuid.UUID, exists bool) {
+	v := m.review_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewID returns the old "review_id" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldReviewID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewID: %w", err)
+	}
+	return oldValue.ReviewID, nil
+}
+
+// ClearReviewID clears the value of the "review_id" field.
+func (m *AttemptMutation) ClearReviewID() {
+	m.review_id = nil
+	m.clearedFields[attempt.FieldReviewID] = struct{}{}
+}
+
+// ReviewIDCleared returns if the "review_id" field was cleared in this mutation.
+func (m *AttemptMutation) ReviewIDCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldReviewID]
+	return ok
+}
+
+// ResetReviewID resets all changes to the "review_id" field.
+func (m *AttemptMutation) ResetReviewID() {
+	m.review_id = nil
+	delete(m.clearedFields, attempt.FieldReviewID)
+}
+
+// SetScheduler sets the "scheduler" field.
+func (m *AttemptMutation) SetScheduler(a attempt.Scheduler) {
+	m.scheduler = &a
+}
+
+// Scheduler returns the value of the "scheduler" field in the mutation.
+func (m *AttemptMutation) Scheduler() (r attempt.Scheduler, exists bool) {
+	v := m.scheduler
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduler returns the old "scheduler" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldScheduler(ctx context.Context) (v *attempt.Scheduler, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduler is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduler requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduler: %w", err)
+	}
+	return oldValue.Scheduler, nil
+}
+
+// ClearScheduler clears the value of the "scheduler" field.
+func (m *AttemptMutation) ClearScheduler() {
+	m.scheduler = nil
+	m.clearedFields[attempt.FieldScheduler] = struct{}{}
+}
+
+// SchedulerCleared returns if the "scheduler" field was cleared in this mutation.
+func (m *AttemptMutation) SchedulerCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldScheduler]
+	return ok
+}
+
+// ResetScheduler resets all changes to the "scheduler" field.
+func (m *AttemptMutation) ResetScheduler() {
+	m.scheduler = nil
+	delete(m.clearedFields, attempt.FieldScheduler)
+}
+
+// SetKind sets the "kind" field.
+func (m *AttemptMutation) SetKind(a attempt.Kind) {
+	m.kind = &a
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AttemptMutation) Kind() (r attempt.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldKind(ctx context.Context) (v attempt.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *AttemptMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCardBefore sets the "card_before" field.
+func (m *AttemptMutation) SetCardBefore(ss *schema.CardSnapshot) {
+	m.card_before = &ss
+}
+
+// CardBefore returns the value of the "card_before" field in the mutation.
+func (m *AttemptMutation) CardBefore() (r *schema.CardSnapshot, exists bool) {
+	v := m.card_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCardBefore returns the old "card_before" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldCardBefore(ctx context.Context) (v *schema.CardSnapshot, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCardBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCardBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCardBefore: %w", err)
+	}
+	return oldValue.CardBefore, nil
+}
+
+// ClearCardBefore clears the value of the "card_before" field.
+func (m *AttemptMutation) ClearCardBefore() {
+	m.card_before = nil
+	m.clearedFields[attempt.FieldCardBefore] = struct{}{}
+}
+
+// CardBeforeCleared returns if the "card_before" field was cleared in this mutation.
+func (m *AttemptMutation) CardBeforeCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldCardBefore]
+	return ok
+}
+
+// ResetCardBefore resets all changes to the "card_before" field.
+func (m *AttemptMutation) ResetCardBefore() {
+	m.card_before = nil
+	delete(m.clearedFields, attempt.FieldCardBefore)
+}
+
+// SetEffects sets the "effects" field.
+func (m *AttemptMutation) SetEffects(se *schema.ReviewEffects) {
+	m.effects = &se
+}
+
+// Effects returns the value of the "effects" field in the mutation.
+func (m *AttemptMutation) Effects() (r *schema.ReviewEffects, exists bool) {
+	v := m.effects
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEffects returns the old "effects" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldEffects(ctx context.Context) (v *schema.ReviewEffects, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEffects is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEffects requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEffects: %w", err)
+	}
+	return oldValue.Effects, nil
+}
+
+// ClearEffects clears the value of the "effects" field.
+func (m *AttemptMutation) ClearEffects() {
+	m.effects = nil
+	m.clearedFields[attempt.FieldEffects] = struct{}{}
+}
+
+// EffectsCleared returns if the "effects" field was cleared in this mutation.
+func (m *AttemptMutation) EffectsCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldEffects]
+	return ok
+}
+
+// ResetEffects resets all changes to the "effects" field.
+func (m *AttemptMutation) ResetEffects() {
+	m.effects = nil
+	delete(m.clearedFields, attempt.FieldEffects)
+}
+
 // ClearCard clears the "card" edge to the FsrsCard entity.
 func (m *AttemptMutation) ClearCard() {
 	m.clearedcard = true
@@ -755,6 +1052,60 @@ func (m *AttemptMutation) ResetErrorDefinition() {
 	m.clearederror_definition = false
 }
 
+// AddErrorIDs adds the "errors" edge to the AttemptError entity by ids.
+func (m *AttemptMutation) AddErrorIDs(ids ...uuid.UUID) {
+	if m.errors == nil {
+		m.errors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.errors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearErrors clears the "errors" edge to the AttemptError entity.
+func (m *AttemptMutation) ClearErrors() {
+	m.clearederrors = true
+}
+
+// ErrorsCleared reports if the "errors" edge to the AttemptError entity was cleared.
+func (m *AttemptMutation) ErrorsCleared() bool {
+	return m.clearederrors
+}
+
+// RemoveErrorIDs removes the "errors" edge to the AttemptError entity by IDs.
+func (m *AttemptMutation) RemoveErrorIDs(ids ...uuid.UUID) {
+	if m.removederrors == nil {
+		m.removederrors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.errors, ids[i])
+		m.removederrors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedErrors returns the removed IDs of the "errors" edge to the AttemptError entity.
+func (m *AttemptMutation) RemovedErrorsIDs() (ids []uuid.UUID) {
+	for id := range m.removederrors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ErrorsIDs returns the "errors" edge IDs in the mutation.
+func (m *AttemptMutation) ErrorsIDs() (ids []uuid.UUID) {
+	for id := range m.errors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetErrors resets all changes to the "errors" edge.
+func (m *AttemptMutation) ResetErrors() {
+	m.errors = nil
+	m.clearederrors = false
+	m.removederrors = nil
+}
+
 // Where appends a list predicates to the AttemptMutation builder.
 func (m *AttemptMutation) Where(ps ...predicate.Attempt) {
 	m.predicates = append(m.predicates, ps...)
@@ -789,7 +1140,7 @@ func (m *AttemptMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AttemptMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 17)
 	if m.rating != nil {
 		fields = append(fields, attempt.FieldRating)
 	}
@@ -817,12 +1168,30 @@ func (m *AttemptMutation) Fields() []string {
 	if m.error_definition != nil {
 		fields = append(fields, attempt.FieldErrorTypeID)
 	}
+	if m.error_note != nil {
+		fields = append(fields, attempt.FieldErrorNote)
+	}
 	if m.user_answer != nil {
 		fields = append(fields, attempt.FieldUserAnswer)
 	}
 	if m.metadata != nil {
 		fields = append(fields, attempt.FieldMetadata)
 	}
+	if m.review_id != nil {
+		fields = append(fields, attempt.FieldReviewID)
+	}
+	if m.scheduler != nil {
+		fields = append(fields, attempt.FieldScheduler)
+	}
+	if m.kind != nil {
+		fields = append(fields, attempt.FieldKind)
+	}
+	if m.card_before != nil {
+		fields = append(fields, attempt.FieldCardBefore)
+	}
+	if m.effects != nil {
+		fields = append(fields, attempt.FieldEffects)
+	}
 	return fields
 }
 
@@ -849,10 +1218,22 @@ func (m *AttemptMutation) Field(name string) (ent.Value, bool) {
 		return m.IsCorrect()
 	case attempt.FieldErrorTypeID:
 		return m.ErrorTypeID()
+	case attempt.FieldErrorNote:
+		return m.ErrorNote()
 	case attempt.FieldUserAnswer:
 		return m.UserAnswer()
 	case attempt.FieldMetadata:
 		return m.Metadata()
+	case attempt.FieldReviewID:
+		return m.ReviewID()
+	case attempt.FieldScheduler:
+		return m.Scheduler()
+	case attempt.FieldKind:
+		return m.Kind()
+	case attempt.FieldCardBefore:
+		return m.CardBefore()
+	case attempt.FieldEffects:
+		return m.Effects()
 	}
 	return nil, false
 }
@@ -880,10 +1261,22 @@ func (m *AttemptMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldIsCorrect(ctx)
 	case attempt.FieldErrorTypeID:
 		return m.OldErrorTypeID(ctx)
+	case attempt.FieldErrorNote:
+		return m.OldErrorNote(ctx)
 	case attempt.FieldUserAnswer:
 		return m.OldUserAnswer(ctx)
 	case attempt.FieldMetadata:
 		return m.OldMetadata(ctx)
+	case attempt.FieldReviewID:
+		return m.OldReviewID(ctx)
+	case attempt.FieldScheduler:
+		return m.OldScheduler(ctx)
+	case attempt.FieldKind:
+		return m.OldKind(ctx)
+	case attempt.FieldCardBefore:
+		return m.OldCardBefore(ctx)
+	case attempt.FieldEffects:
+		return m.OldEffects(ctx)
 	}
 	return nil, fmt.Errorf("unknown Attempt field %s", name)
 }
@@ -956,6 +1349,13 @@ func (m *AttemptMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetErrorTypeID(v)
 		return nil
+	case attempt.FieldErrorNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorNote(v)
+		return nil
 	case attempt.FieldUserAnswer:
 		v, ok := value.(string)
 		if !ok {
@@ -970,6 +1370,41 @@ func (m *AttemptMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetMetadata(v)
 		return nil
+	case attempt.FieldReviewID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewID(v)
+		return nil
+	case attempt.FieldScheduler:
+		v, ok := value.(attempt.Scheduler)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduler(v)
+		return nil
+	case attempt.FieldKind:
+		v, ok := value.(attempt.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case attempt.FieldCardBefore:
+		v, ok := value.(*schema.CardSnapshot)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCardBefore(v)
+		return nil
+	case attempt.FieldEffects:
+		v, ok := value.(*schema.ReviewEffects)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffects(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Attempt field %s", name)
 }
@@ -1054,12 +1489,27 @@ func (m *AttemptMutation) ClearedFields() []string {
 	if m.FieldCleared(attempt.FieldErrorTypeID) {
 		fields = append(fields, attempt.FieldErrorTypeID)
 	}
+	if m.FieldCleared(attempt.FieldErrorNote) {
+		fields = append(fields, attempt.FieldErrorNote)
+	}
 	if m.FieldCleared(attempt.FieldUserAnswer) {
 		fields = append(fields, attempt.FieldUserAnswer)
 	}
 	if m.FieldCleared(attempt.FieldMetadata) {
 		fields = append(fields, attempt.FieldMetadata)
 	}
+	if m.FieldCleared(attempt.FieldReviewID) {
+		fields = append(fields, attempt.FieldReviewID)
+	}
+	if m.FieldCleared(attempt.FieldScheduler) {
+		fields = append(fields, attempt.FieldScheduler)
+	}
+	if m.FieldCleared(attempt.FieldCardBefore) {
+		fields = append(fields, attempt.FieldCardBefore)
+	}
+	if m.FieldCleared(attempt.FieldEffects) {
+		fields = append(fields, attempt.FieldEffects)
+	}
 	return fields
 }
 
@@ -1077,12 +1527,27 @@ func (m *AttemptMutation) ClearField(name string) error {
 	case attempt.FieldErrorTypeID:
 		m.ClearErrorTypeID()
 		return nil
+	case attempt.FieldErrorNote:
+		m.ClearErrorNote()
+		return nil
 	case attempt.FieldUserAnswer:
 		m.ClearUserAnswer()
 		return nil
 	case attempt.FieldMetadata:
 		m.ClearMetadata()
 		return nil
+	case attempt.FieldReviewID:
+		m.ClearReviewID()
+		return nil
+	case attempt.FieldScheduler:
+		m.ClearScheduler()
+		return nil
+	case attempt.FieldCardBefore:
+		m.ClearCardBefore()
+		return nil
+	case attempt.FieldEffects:
+		m.ClearEffects()
+		return nil
 	}
 	return fmt.Errorf("unknown Attempt nullable field %s", name)
 }
@@ -1118,25 +1583,46 @@ func (m *AttemptMutation) ResetField(name string) error {
 	case attempt.FieldErrorTypeID:
 		m.ResetErrorTypeID()
 		return nil
+	case attempt.FieldErrorNote:
+		m.ResetErrorNote()
+		return nil
 	case attempt.FieldUserAnswer:
 		m.ResetUserAnswer()
 		return nil
 	case attempt.FieldMetadata:
 		m.ResetMetadata()
 		return nil
+	case attempt.FieldReviewID:
+		m.ResetReviewID()
+		return nil
+	case attempt.FieldScheduler:
+		m.ResetScheduler()
+		return nil
+	case attempt.FieldKind:
+		m.ResetKind()
+		return nil
+	case attempt.FieldCardBefore:
+		m.ResetCardBefore()
+		return nil
+	case attempt.FieldEffects:
+		m.ResetEffects()
+		return nil
 	}
 	return fmt.Errorf("unknown Attempt field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AttemptMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.card != nil {
 		edges = append(edges, attempt.EdgeCard)
 	}
 	if m.error_definition != nil {
 		edges = append(edges, attempt.EdgeErrorDefinition)
 	}
+	if m.errors != nil {
+		edges = append(edges, attempt.EdgeErrors)
+	}
 	return edges
 }
 
@@ -1152,31 +1638,51 @@ func (m *AttemptMutation) AddedIDs(name string) []ent.Value {
 		if id := m.error_definition; id != nil {
 			return []ent.Value{*id}
 		}
+	case attempt.EdgeErrors:
+		ids := make([]ent.Value, 0, len(m.errors))
+		for id := range m.errors {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AttemptMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
+	if m.removederrors != nil {
+		edges = append(edges, attempt.EdgeErrors)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *AttemptMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case attempt.EdgeErrors:
+		ids := make([]ent.Value, 0, len(m.removederrors))
+		for id := range m.removederrors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AttemptMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedcard {
 		edges = append(edges, attempt.EdgeCard)
 	}
 	if m.clearederror_definition {
 		edges = append(edges, attempt.EdgeErrorDefinition)
 	}
+	if m.clearederrors {
+		edges = append(edges, attempt.EdgeErrors)
+	}
 	return edges
 }
 
@@ -1188,6 +1694,8 @@ func (m *AttemptMutation) EdgeCleared(name string) bool {
 		return m.clearedcard
 	case attempt.EdgeErrorDefinition:
 		return m.clearederror_definition
+	case attempt.EdgeErrors:
+		return m.clearederrors
 	}
 	return false
 }
@@ -1216,40 +1724,40 @@ func (m *AttemptMutation) ResetEdge(name string) error {
 	case attempt.EdgeErrorDefinition:
 		m.ResetErrorDefinition()
 		return nil
+	case attempt.EdgeErrors:
+		m.ResetErrors()
+		return nil
 	}
 	return fmt.Errorf("unknown Attempt edge %s", name)
 }
 
-// ErrorDefinitionMutation represents an operation that mutates the ErrorDefinition nodes in the graph.
-type ErrorDefinitionMutation struct {
+// AttemptErrorMutation represents an operation that mutates the AttemptError nodes in the graph.
+type AttemptErrorMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	label           *string
-	base_weight     *float64
-	addbase_weight  *float64
-	is_system       *bool
-	clearedFields   map[string]struct{}
-	attempts        map[uuid.UUID]struct{}
-	removedattempts map[uuid.UUID]struct{}
-	clearedattempts bool
-	done            bool
-	oldValue        func(context.Context) (*ErrorDefinition, error)
-	predicates      []predicate.ErrorDefinition
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	clearedFields           map[string]struct{}
+	attempt                 *uuid.UUID
+	clearedattempt          bool
+	error_definition        *uuid.UUID
+	clearederror_definition bool
+	done                    bool
+	oldValue                func(context.Context) (*AttemptError, error)
+	predicates              []predicate.AttemptError
 }
 
-var _ ent.Mutation = (*ErrorDefinitionMutation)(nil)
+var _ ent.Mutation = (*AttemptErrorMutation)(nil)
 
-// errordefinitionOption allows management of the mutation configuration using functional options.
-type errordefinitionOption func(*ErrorDefinitionMutation)
+// attempterrorOption allows management of the mutation configuration using functional options.
+type attempterrorOption func(*AttemptErrorMutation)
 
-// newErrorDefinitionMutation creates new mutation for the ErrorDefinition entity.
-func newErrorDefinitionMutation(c config, op Op, opts ...errordefinitionOption) *ErrorDefinitionMutation {
-	m := &ErrorDefinitionMutation{
+// newAttemptErrorMutation creates new mutation for the AttemptError entity.
+func newAttemptErrorMutation(c config, op Op, opts ...attempterrorOption) *AttemptErrorMutation {
+	m := &AttemptErrorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeErrorDefinition,
+		typ:           TypeAttemptError,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1258,20 +1766,20 @@ func newErrorDefinitionMutation(c config, op Op, opts ...errordefinitionOption)
 	return m
 }
 
-// withErrorDefinitionID sets the ID field of the mutation.
-func withErrorDefinitionID(id uuid.UUID) errordefinitionOption {
-	return func(m *ErrorDefinitionMutation) {
+// withAttemptErrorID sets the ID field of the mutation.
+func withAttemptErrorID(id uuid.UUID) attempterrorOption {
+	return func(m *AttemptErrorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ErrorDefinition
+			value *AttemptError
 		)
-		m.oldValue = func(ctx context.Context) (*ErrorDefinition, error) {
+		m.oldValue = func(ctx context.Context) (*AttemptError, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ErrorDefinition.Get(ctx, id)
+					value, err = m.Client().AttemptError.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1280,10 +1788,10 @@ func withErrorDefinitionID(id uuid.UUID) errordefinitionOption {
 	}
 }
 
-// withErrorDefinition sets the old ErrorDefinition of the mutation.
-func withErrorDefinition(node *ErrorDefinition) errordefinitionOption {
-	return func(m *ErrorDefinitionMutation) {
-		m.oldValue = func(context.Context) (*ErrorDefinition, error) {
+// withAttemptError sets the old AttemptError of the mutation.
+func withAttemptError(node *AttemptError) attempterrorOption {
+	return func(m *AttemptErrorMutation) {
+		m.oldValue = func(context.Context) (*AttemptError, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1292,7 +1800,7 @@ func withErrorDefinition(node *ErrorDefinition) errordefinitionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ErrorDefinitionMutation) Client() *Client {
+func (m AttemptErrorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1300,7 +1808,7 @@ func (m ErrorDefinitionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ErrorDefinitionMutation) Tx() (*Tx, error) {
+func (m AttemptErrorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1310,14 +1818,14 @@ func (m ErrorDefinitionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ErrorDefinition entities.
-func (m *ErrorDefinitionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AttemptError entities.
+func (m *AttemptErrorMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ErrorDefinitionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AttemptErrorMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1328,7 +1836,7 @@ func (m *ErrorDefinitionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ErrorDefinitionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AttemptErrorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1337,203 +1845,160 @@ func (m *ErrorDefinitionMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ErrorDefinition.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AttemptError.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLabel sets the "label" field.
-func (m *ErrorDefinitionMutation) SetLabel(s string) {
-	m.label = &s
+// SetAttemptID sets the "attempt_id" field.
+func (m *AttemptErrorMutation) SetAttemptID(u uuid.UUID) {
+	m.attempt = &u
 }
 
-// Label returns the value of the "label" field in the mutation.
-func (m *ErrorDefinitionMutation) Label() (r string, exists bool) {
-	v := m.label
+// AttemptID returns the value of the "attempt_id" field in the mutation.
+func (m *AttemptErrorMutation) AttemptID() (r uuid.UUID, exists bool) {
+	v := m.attempt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLabel returns the old "label" field's value of the ErrorDefinition entity.
-// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
+// OldAttemptID returns the old "attempt_id" field's value of the AttemptError entity.
+// If the AttemptError object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorDefinitionMutation) OldLabel(ctx context.Context) (v string, err error) {
+func (m *AttemptErrorMutation) OldAttemptID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttemptID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLabel requires an ID field in the mutation")
+		return v, errors.New("OldAttemptID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttemptID: %w", err)
 	}
-	return oldValue.Label, nil
+	return oldValue.AttemptID, nil
 }
 
-// ResetLabel resets all changes to the "label" field.
-func (m *ErrorDefinitionMutation) ResetLabel() {
-	m.label = nil
+// ResetAttemptID resets all changes to the "attempt_id" field.
+func (m *AttemptErrorMutation) ResetAttemptID() {
+	m.attempt = nil
 }
 
-// SetBaseWeight sets the "base_weight" field.
-func (m *ErrorDefinitionMutation) SetBaseWeight(f float64) {
-	m.base_weight = &f
-	m.addbase_weight = nil
+// SetErrorTypeID sets the "error_type_id" field.
+func (m *AttemptErrorMutation) SetErrorTypeID(u uuid.UUID) {
+	m.error_definition = &u
 }
 
-// BaseWeight returns the value of the "base_weight" field in the mutation.
-func (m *ErrorDefinitionMutation) BaseWeight() (r float64, exists bool) {
-	v := m.base_weight
+// ErrorTypeID returns the value of the "error_type_id" field in the mutation.
+func (m *AttemptErrorMutation) ErrorTypeID() (r uuid.UUID, exists bool) {
+	v := m.error_definition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseWeight returns the old "base_weight" field's value of the ErrorDefinition entity.
-// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorTypeID returns the old "error_type_id" field's value of the AttemptError entity.
+// If the AttemptError object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorDefinitionMutation) OldBaseWeight(ctx context.Context) (v float64, err error) {
+func (m *AttemptErrorMutation) OldErrorTypeID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseWeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorTypeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseWeight requires an ID field in the mutation")
+		return v, errors.New("OldErrorTypeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseWeight: %w", err)
-	}
-	return oldValue.BaseWeight, nil
-}
-
-// AddBaseWeight adds f to the "base_weight" field.
-func (m *ErrorDefinitionMutation) AddBaseWeight(f float64) {
-	if m.addbase_weight != nil {
-		*m.addbase_weight += f
-	} else {
-		m.addbase_weight = &f
-	}
-}
-
-// AddedBaseWeight returns the value that was added to the "base_weight" field in this mutation.
-func (m *ErrorDefinitionMutation) AddedBaseWeight() (r float64, exists bool) {
-	v := m.addbase_weight
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldErrorTypeID: %w", err)
 	}
-	return *v, true
+	return oldValue.ErrorTypeID, nil
 }
 
-// ResetBaseWeight resets all changes to the "base_weight" field.
-func (m *ErrorDefinitionMutation) ResetBaseWeight() {
-	m.base_weight = nil
-	m.addbase_weight = nil
+// ResetErrorTypeID resets all changes to the "error_type_id" field.
+func (m *AttemptErrorMutation) ResetErrorTypeID() {
+	m.error_definition = nil
 }
 
-// SetIsSystem sets the "is_system" field.
-func (m *ErrorDefinitionMutation) SetIsSystem(b bool) {
-	m.is_system = &b
+// ClearAttempt clears the "attempt" edge to the Attempt entity.
+func (m *AttemptErrorMutation) ClearAttempt() {
+	m.clearedattempt = true
+	m.clearedFields[attempterror.FieldAttemptID] = struct{}{}
 }
 
-// IsSystem returns the value of the "is_system" field in the mutation.
-func (m *ErrorDefinitionMutation) IsSystem() (r bool, exists bool) {
-	v := m.is_system
-	if v == nil {
-		return
-	}
-	return *v, true
+// AttemptCleared reports if the "attempt" edge to the Attempt entity was cleared.
+func (m *AttemptErrorMutation) AttemptCleared() bool {
+	return m.clearedattempt
 }
 
-// OldIsSystem returns the old "is_system" field's value of the ErrorDefinition entity.
-// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorDefinitionMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSystem requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+// AttemptIDs returns the "attempt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttemptID instead. It exists only for internal usage by the builders.
+func (m *AttemptErrorMutation) AttemptIDs() (ids []uuid.UUID) {
+	if id := m.attempt; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.IsSystem, nil
-}
-
-// ResetIsSystem resets all changes to the "is_system" field.
-func (m *ErrorDefinitionMutation) ResetIsSystem() {
-	m.is_system = nil
+	return
 }
 
-// AddAttemptIDs adds the "attempts" edge to the Attempt entity by ids.
-func (m *ErrorDefinitionMutation) AddAttemptIDs(ids ...uuid.UUID) {
-	if m.attempts == nil {
-		m.attempts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.attempts[ids[i]] = struct{}{}
-	}
+// ResetAttempt resets all changes to the "attempt" edge.
+func (m *AttemptErrorMutation) ResetAttempt() {
+	m.attempt = nil
+	m.clearedattempt = false
 }
 
-// ClearAttempts clears the "attempts" edge to the Attempt entity.
-func (m *ErrorDefinitionMutation) ClearAttempts() {
-	m.clearedattempts = true
+// SetErrorDefinitionID sets the "error_definition" edge to the ErrorDefinition entity by id.
+func (m *AttemptErrorMutation) SetErrorDefinitionID(id uuid.UUID) {
+	m.error_definition = &id
 }
 
-// AttemptsCleared reports if the "attempts" edge to the Attempt entity was cleared.
-func (m *ErrorDefinitionMutation) AttemptsCleared() bool {
-	return m.clearedattempts
+// ClearErrorDefinition clears the "error_definition" edge to the ErrorDefinition entity.
+func (m *AttemptErrorMutation) ClearErrorDefinition() {
+	m.clearederror_definition = true
+	m.clearedFields[attempterror.FieldErrorTypeID] = struct{}{}
 }
 
-// RemoveAttemptIDs removes the "attempts" edge to the Attempt entity by IDs.
-func (m *ErrorDefinitionMutation) RemoveAttemptIDs(ids ...uuid.UUID) {
-	if m.removedattempts == nil {
-		m.removedattempts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.attempts, ids[i])
-		m.removedattempts[ids[i]] = struct{}{}
-	}
+// ErrorDefinitionCleared reports if the "error_definition" edge to the ErrorDefinition entity was cleared.
+func (m *AttemptErrorMutation) ErrorDefinitionCleared() bool {
+	return m.clearederror_definition
 }
 
-// RemovedAttempts returns the removed IDs of the "attempts" edge to the Attempt entity.
-func (m *ErrorDefinitionMutation) RemovedAttemptsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattempts {
-		ids = append(ids, id)
+// ErrorDefinitionID returns the "error_definition" edge ID in the mutation.
+func (m *AttemptErrorMutation) ErrorDefinitionID() (id uuid.UUID, exists bool) {
+	if m.error_definition != nil {
+		return *m.error_definition, true
 	}
 	return
 }
 
-// AttemptsIDs returns the "attempts" edge IDs in the mutation.
-func (m *ErrorDefinitionMutation) AttemptsIDs() (ids []uuid.UUID) {
-	for id := range m.attempts {
-		ids = append(ids, id)
+// ErrorDefinitionIDs returns the "error_definition" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ErrorDefinitionID instead. It exists only for internal usage by the builders.
+func (m *AttemptErrorMutation) ErrorDefinitionIDs() (ids []uuid.UUID) {
+	if id := m.error_definition; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAttempts resets all changes to the "attempts" edge.
-func (m *ErrorDefinitionMutation) ResetAttempts() {
-	m.attempts = nil
-	m.clearedattempts = false
-	m.removedattempts = nil
+// ResetErrorDefinition resets all changes to the "error_definition" edge.
+func (m *AttemptErrorMutation) ResetErrorDefinition() {
+	m.error_definition = nil
+	m.clearederror_definition = false
 }
 
-// Where appends a list predicates to the ErrorDefinitionMutation builder.
-func (m *ErrorDefinitionMutation) Where(ps ...predicate.ErrorDefinition) {
+// Where appends a list predicates to the AttemptErrorMutation builder.
+func (m *AttemptErrorMutation) Where(ps ...predicate.AttemptError) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ErrorDefinitionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AttemptErrorMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ErrorDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ErrorDefinition, len(ps))
+func (m *AttemptErrorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AttemptError, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1541,33 +2006,30 @@ func (m *ErrorDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ErrorDefinitionMutation) Op() Op {
+func (m *AttemptErrorMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ErrorDefinitionMutation) SetOp(op Op) {
+func (m *AttemptErrorMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ErrorDefinition).
-func (m *ErrorDefinitionMutation) Type() string {
+// Type returns the node type of this mutation (AttemptError).
+func (m *AttemptErrorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ErrorDefinitionMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.label != nil {
-		fields = append(fields, errordefinition.FieldLabel)
+func (m *AttemptErrorMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.attempt != nil {
+		fields = append(fields, attempterror.FieldAttemptID)
 	}
-	if m.base_weight != nil {
-		fields = append(fields, errordefinition.FieldBaseWeight)
-	}
-	if m.is_system != nil {
-		fields = append(fields, errordefinition.FieldIsSystem)
+	if m.error_definition != nil {
+		fields = append(fields, attempterror.FieldErrorTypeID)
 	}
 	return fields
 }
@@ -1575,14 +2037,12 @@ func (m *ErrorDefinitionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ErrorDefinitionMutation) Field(name string) (ent.Value, bool) {
+func (m *AttemptErrorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case errordefinition.FieldLabel:
-		return m.Label()
-	case errordefinition.FieldBaseWeight:
-		return m.BaseWeight()
-	case errordefinition.FieldIsSystem:
-		return m.IsSystem()
+	case attempterror.FieldAttemptID:
+		return m.AttemptID()
+	case attempterror.FieldErrorTypeID:
+		return m.ErrorTypeID()
 	}
 	return nil, false
 }
@@ -1590,237 +2050,223 @@ func (m *ErrorDefinitionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ErrorDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AttemptErrorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case errordefinition.FieldLabel:
-		return m.OldLabel(ctx)
-	case errordefinition.FieldBaseWeight:
-		return m.OldBaseWeight(ctx)
-	case errordefinition.FieldIsSystem:
-		return m.OldIsSystem(ctx)
+	case attempterror.FieldAttemptID:
+		return m.OldAttemptID(ctx)
+	case attempterror.FieldErrorTypeID:
+		return m.OldErrorTypeID(ctx)
 	}
-	return nil, fmt.Errorf("unknown ErrorDefinition field %s", name)
+	return nil, fmt.Errorf("unknown AttemptError field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ErrorDefinitionMutation) SetField(name string, value ent.Value) error {
+func (m *AttemptErrorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case errordefinition.FieldLabel:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLabel(v)
-		return nil
-	case errordefinition.FieldBaseWeight:
-		v, ok := value.(float64)
+	case attempterror.FieldAttemptID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseWeight(v)
+		m.SetAttemptID(v)
 		return nil
-	case errordefinition.FieldIsSystem:
-		v, ok := value.(bool)
+	case attempterror.FieldErrorTypeID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsSystem(v)
+		m.SetErrorTypeID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorDefinition field %s", name)
+	return fmt.Errorf("unknown AttemptError field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ErrorDefinitionMutation) AddedFields() []string {
-	var fields []string
-	if m.addbase_weight != nil {
-		fields = append(fields, errordefinition.FieldBaseWeight)
-	}
-	return fields
+func (m *AttemptErrorMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ErrorDefinitionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case errordefinition.FieldBaseWeight:
-		return m.AddedBaseWeight()
-	}
+func (m *AttemptErrorMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ErrorDefinitionMutation) AddField(name string, value ent.Value) error {
+func (m *AttemptErrorMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case errordefinition.FieldBaseWeight:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBaseWeight(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ErrorDefinition numeric field %s", name)
+	return fmt.Errorf("unknown AttemptError numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ErrorDefinitionMutation) ClearedFields() []string {
+func (m *AttemptErrorMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ErrorDefinitionMutation) FieldCleared(name string) bool {
+func (m *AttemptErrorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ErrorDefinitionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ErrorDefinition nullable field %s", name)
+func (m *AttemptErrorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AttemptError nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ErrorDefinitionMutation) ResetField(name string) error {
+func (m *AttemptErrorMutation) ResetField(name string) error {
 	switch name {
-	case errordefinition.FieldLabel:
-		m.ResetLabel()
-		return nil
-	case errordefinition.FieldBaseWeight:
-		m.ResetBaseWeight()
+	case attempterror.FieldAttemptID:
+		m.ResetAttemptID()
 		return nil
-	case errordefinition.FieldIsSystem:
-		m.ResetIsSystem()
+	case attempterror.FieldErrorTypeID:
+		m.ResetErrorTypeID()
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorDefinition field %s", name)
+	return fmt.Errorf("unknown AttemptError field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ErrorDefinitionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.attempts != nil {
-		edges = append(edges, errordefinition.EdgeAttempts)
+func (m *AttemptErrorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.attempt != nil {
+		edges = append(edges, attempterror.EdgeAttempt)
+	}
+	if m.error_definition != nil {
+		edges = append(edges, attempterror.EdgeErrorDefinition)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ErrorDefinitionMutation) AddedIDs(name string) []ent.Value {
+func (m *AttemptErrorMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case errordefinition.EdgeAttempts:
-		ids := make([]ent.Value, 0, len(m.attempts))
-		for id := range m.attempts {
-			ids = append(ids, id)
+	case attempterror.EdgeAttempt:
+		if id := m.attempt; id != nil {
+			return []ent.Value{*id}
+		}
+	case attempterror.EdgeErrorDefinition:
+		if id := m.error_definition; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ErrorDefinitionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedattempts != nil {
-		edges = append(edges, errordefinition.EdgeAttempts)
-	}
+func (m *AttemptErrorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ErrorDefinitionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case errordefinition.EdgeAttempts:
-		ids := make([]ent.Value, 0, len(m.removedattempts))
-		for id := range m.removedattempts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AttemptErrorMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ErrorDefinitionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedattempts {
-		edges = append(edges, errordefinition.EdgeAttempts)
+func (m *AttemptErrorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedattempt {
+		edges = append(edges, attempterror.EdgeAttempt)
+	}
+	if m.clearederror_definition {
+		edges = append(edges, attempterror.EdgeErrorDefinition)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ErrorDefinitionMutation) EdgeCleared(name string) bool {
+func (m *AttemptErrorMutation) EdgeCleared(name string) bool {
 	switch name {
-	case errordefinition.EdgeAttempts:
-		return m.clearedattempts
+	case attempterror.EdgeAttempt:
+		return m.clearedattempt
+	case attempterror.EdgeErrorDefinition:
+		return m.clearederror_definition
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ErrorDefinitionMutation) ClearEdge(name string) error {
+func (m *AttemptErrorMutation) ClearEdge(name string) error {
 	switch name {
+	case attempterror.EdgeAttempt:
+		m.ClearAttempt()
+		return nil
+	case attempterror.EdgeErrorDefinition:
+		m.ClearErrorDefinition()
+		return nil
 	}
-	return fmt.Errorf("unknown ErrorDefinition unique edge %s", name)
+	return fmt.Errorf("unknown AttemptError unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ErrorDefinitionMutation) ResetEdge(name string) error {
+func (m *AttemptErrorMutation) ResetEdge(name string) error {
 	switch name {
-	case errordefinition.EdgeAttempts:
-		m.ResetAttempts()
+	case attempterror.EdgeAttempt:
+		m.ResetAttempt()
+		return nil
+	case attempterror.EdgeErrorDefinition:
+		m.ResetErrorDefinition()
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorDefinition edge %s", name)
+	return fmt.Errorf("unknown AttemptError edge %s", name)
 }
 
-// ErrorResolutionMutation represents an operation that mutates the ErrorResolution nodes in the graph.
-type ErrorResolutionMutation struct {
+// ErrorDefinitionMutation represents an operation that mutates the ErrorDefinition nodes in the graph.
+type ErrorDefinitionMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	error_type_id    *uuid.UUID
-	weight_impact    *float64
-	addweight_impact *float64
-	is_resolved      *bool
-	resolution_notes *string
-	created_at       *time.Time
-	resolved_at      *time.Time
-	clearedFields    map[string]struct{}
-	node             *uuid.UUID
-	clearednode      bool
-	done             bool
-	oldValue         func(context.Context) (*ErrorResolution, error)
-	predicates       []predicate.ErrorResolution
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	code                  *string
+	label                 *string
+	category              *string
+	description           *string
+	base_weight           *float64
+	addbase_weight        *float64
+	is_system             *bool
+	is_archived           *bool
+	clearedFields         map[string]struct{}
+	attempts              map[uuid.UUID]struct{}
+	removedattempts       map[uuid.UUID]struct{}
+	clearedattempts       bool
+	attempt_errors        map[uuid.UUID]struct{}
+	removedattempt_errors map[uuid.UUID]struct{}
+	clearedattempt_errors bool
+	done                  bool
+	oldValue              func(context.Context) (*ErrorDefinition, error)
+	predicates            []predicate.ErrorDefinition
 }
 
-var _ ent.Mutation = (*ErrorResolutionMutation)(nil)
+var _ ent.Mutation = (*ErrorDefinitionMutation)(nil)
 
-// errorresolutionOption allows management of the mutation configuration using functional options.
-type errorresolutionOption func(*ErrorResolutionMutation)
+// errordefinitionOption allows management of the mutation configuration using functional options.
+type errordefinitionOption func(*ErrorDefinitionMutation)
 
-// newErrorResolutionMutation creates new mutation for the ErrorResolution entity.
-func newErrorResolutionMutation(c config, op Op, opts ...errorresolutionOption) *ErrorResolutionMutation {
-	m := &ErrorResolutionMutation{
+// newErrorDefinitionMutation creates new mutation for the ErrorDefinition entity.
+func newErrorDefinitionMutation(c config, op Op, opts ...errordefinitionOption) *ErrorDefinitionMutation {
+	m := &ErrorDefinitionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeErrorResolution,
+		typ:           TypeErrorDefinition,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1829,20 +2275,20 @@ func newErrorResolutionMutation(c config, op Op, opts ...errorresolutionOption)
 	return m
 }
 
-// withErrorResolutionID sets the ID field of the mutation.
-func withErrorResolutionID(id uuid.UUID) errorresolutionOption {
-	return func(m *ErrorResolutionMutation) {
+// withErrorDefinitionID sets the ID field of the mutation.
+func withErrorDefinitionID(id uuid.UUID) errordefinitionOption {
+	return func(m *ErrorDefinitionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ErrorResolution
+			value *ErrorDefinition
 		)
-		m.oldValue = func(ctx context.Context) (*ErrorResolution, error) {
+		m.oldValue = func(ctx context.Context) (*ErrorDefinition, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ErrorResolution.Get(ctx, id)
+					value, err = m.Client().ErrorDefinition.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1851,10 +2297,10 @@ func withErrorResolutionID(id uuid.UUID) errorresolutionOption {
 	}
 }
 
-// withErrorResolution sets the old ErrorResolution of the mutation.
-func withErrorResolution(node *ErrorResolution) errorresolutionOption {
-	return func(m *ErrorResolutionMutation) {
-		m.oldValue = func(context.Context) (*ErrorResolution, error) {
+// withErrorDefinition sets the old ErrorDefinition of the mutation.
+func withErrorDefinition(node *ErrorDefinition) errordefinitionOption {
+	return func(m *ErrorDefinitionMutation) {
+		m.oldValue = func(context.Context) (*ErrorDefinition, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1863,7 +2309,7 @@ func withErrorResolution(node *ErrorResolution) errorresolutionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ErrorResolutionMutation) Client() *Client {
+func (m ErrorDefinitionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1871,7 +2317,7 @@ func (m ErrorResolutionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ErrorResolutionMutation) Tx() (*Tx, error) {
+func (m ErrorDefinitionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1881,14 +2327,14 @@ func (m ErrorResolutionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ErrorResolution entities.
-func (m *ErrorResolutionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ErrorDefinition entities.
+func (m *ErrorDefinitionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ErrorResolutionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ErrorDefinitionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1899,7 +2345,7 @@ func (m *ErrorResolutionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ErrorResolutionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ErrorDefinitionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1908,346 +2354,440 @@ func (m *ErrorResolutionMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ErrorResolution.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ErrorDefinition.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetNodeID sets the "node_id" field.
-func (m *ErrorResolutionMutation) SetNodeID(u uuid.UUID) {
-	m.node = &u
+// SetCode sets the "code" field.
+func (m *ErrorDefinitionMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// NodeID returns the value of the "node_id" field in the mutation.
-func (m *ErrorResolutionMutation) NodeID() (r uuid.UUID, exists bool) {
-	v := m.node
+// Code returns the value of the "code" field in the mutation.
+func (m *ErrorDefinitionMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNodeID returns the old "node_id" field's value of the ErrorResolution entity.
-// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the ErrorDefinition entity.
+// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorResolutionMutation) OldNodeID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ErrorDefinitionMutation) OldCode(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNodeID requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.NodeID, nil
+	return oldValue.Code, nil
 }
 
-// ResetNodeID resets all changes to the "node_id" field.
-func (m *ErrorResolutionMutation) ResetNodeID() {
-	m.node = nil
+// ClearCode clears the value of the "code" field.
+func (m *ErrorDefinitionMutation) ClearCode() {
+	m.code = nil
+	m.clearedFields[errordefinition.FieldCode] = struct{}{}
 }
 
-// SetErrorTypeID sets the "error_type_id" field.
-func (m *ErrorResolutionMutation) SetErrorTypeID(u uuid.UUID) {
-	m.error_type_id = &u
+// CodeCleared returns if the "code" field was cleared in this mutation.
+func (m *ErrorDefinitionMutation) CodeCleared() bool {
+	_, ok := m.clearedFields[errordefinition.FieldCode]
+	return ok
 }
 
-// ErrorTypeID returns the value of the "error_type_id" field in the mutation.
-func (m *ErrorResolutionMutation) ErrorTypeID() (r uuid.UUID, exists bool) {
-	v := m.error_type_id
+// ResetCode resets all changes to the "code" field.
+func (m *ErrorDefinitionMutation) ResetCode() {
+	m.code = nil
+	delete(m.clearedFields, errordefinition.FieldCode)
+}
+
+// SetLabel sets the "label" field.
+func (m *ErrorDefinitionMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *ErrorDefinitionMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorTypeID returns the old "error_type_id" field's value of the ErrorResolution entity.
-// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the ErrorDefinition entity.
+// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorResolutionMutation) OldErrorTypeID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ErrorDefinitionMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorTypeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorTypeID requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorTypeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
 	}
-	return oldValue.ErrorTypeID, nil
+	return oldValue.Label, nil
 }
 
-// ResetErrorTypeID resets all changes to the "error_type_id" field.
-func (m *ErrorResolutionMutation) ResetErrorTypeID() {
-	m.error_type_id = nil
+// ResetLabel resets all changes to the "label" field.
+func (m *ErrorDefinitionMutation) ResetLabel() {
+	m.label = nil
 }
 
-// SetWeightImpact sets the "weight_impact" field.
-func (m *ErrorResolutionMutation) SetWeightImpact(f float64) {
-	m.weight_impact = &f
-	m.addweight_impact = nil
+// SetCategory sets the "category" field.
+func (m *ErrorDefinitionMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// WeightImpact returns the value of the "weight_impact" field in the mutation.
-func (m *ErrorResolutionMutation) WeightImpact() (r float64, exists bool) {
-	v := m.weight_impact
+// Category returns the value of the "category" field in the mutation.
+func (m *ErrorDefinitionMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeightImpact returns the old "weight_impact" field's value of the ErrorResolution entity.
-// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the ErrorDefinition entity.
+// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorResolutionMutation) OldWeightImpact(ctx context.Context) (v float64, err error) {
+func (m *ErrorDefinitionMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeightImpact is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeightImpact requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeightImpact: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.WeightImpact, nil
+	return oldValue.Category, nil
 }
 
-// AddWeightImpact adds f to the "weight_impact" field.
-func (m *ErrorResolutionMutation) AddWeightImpact(f float64) {
-	if m.addweight_impact != nil {
-		*m.addweight_impact += f
-	} else {
-		m.addweight_impact = &f
-	}
+// ClearCategory clears the value of the "category" field.
+func (m *ErrorDefinitionMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[errordefinition.FieldCategory] = struct{}{}
 }
 
-// AddedWeightImpact returns the value that was added to the "weight_impact" field in this mutation.
-func (m *ErrorResolutionMutation) AddedWeightImpact() (r float64, exists bool) {
-	v := m.addweight_impact
-	if v == nil {
-		return
-	}
-	return *v, true
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *ErrorDefinitionMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[errordefinition.FieldCategory]
+	return ok
 }
 
-// ResetWeightImpact resets all changes to the "weight_impact" field.
-func (m *ErrorResolutionMutation) ResetWeightImpact() {
-	m.weight_impact = nil
-	m.addweight_impact = nil
+// ResetCategory resets all changes to the "category" field.
+func (m *ErrorDefinitionMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, errordefinition.FieldCategory)
 }
 
-// SetIsResolved sets the "is_resolved" field.
-func (m *ErrorResolutionMutation) SetIsResolved(b bool) {
-	m.is_resolved = &b
+// SetDescription sets the "description" field.
+func (m *ErrorDefinitionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// IsResolved returns the value of the "is_resolved" field in the mutation.
-func (m *ErrorResolutionMutation) IsResolved() (r bool, exists bool) {
-	v := m.is_resolved
+// Description returns the value of the "description" field in the mutation.
+func (m *ErrorDefinitionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsResolved returns the old "is_resolved" field's value of the ErrorResolution entity.
-// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the ErrorDefinition entity.
+// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorResolutionMutation) OldIsResolved(ctx context.Context) (v bool, err error) {
+func (m *ErrorDefinitionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsResolved is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsResolved requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsResolved: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.IsResolved, nil
+	return oldValue.Description, nil
 }
 
-// ResetIsResolved resets all changes to the "is_resolved" field.
-func (m *ErrorResolutionMutation) ResetIsResolved() {
-	m.is_resolved = nil
+// ClearDescription clears the value of the "description" field.
+func (m *ErrorDefinitionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[errordefinition.FieldDescription] = struct{}{}
 }
 
-// SetResolutionNotes sets the "resolution_notes" field.
-func (m *ErrorResolutionMutation) SetResolutionNotes(s string) {
-	m.resolution_notes = &s
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ErrorDefinitionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[errordefinition.FieldDescription]
+	return ok
 }
 
-// ResolutionNotes returns the value of the "resolution_notes" field in the mutation.
-func (m *ErrorResolutionMutation) ResolutionNotes() (r string, exists bool) {
-	v := m.resolution_notes
+// ResetDescription resets all changes to the "description" field.
+func (m *ErrorDefinitionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, errordefinition.FieldDescription)
+}
+
+// SetBaseWeight sets the "base_weight" field.
+func (m *ErrorDefinitionMutation) SetBaseWeight(f float64) {
+	m.base_weight = &f
+	m.addbase_weight = nil
+}
+
+// BaseWeight returns the value of the "base_weight" field in the mutation.
+func (m *ErrorDefinitionMutation) BaseWeight() (r float64, exists bool) {
+	v := m.base_weight
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolutionNotes returns the old "resolution_notes" field's value of the ErrorResolution entity.
-// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// OldBaseWeight returns the old "base_weight" field's value of the ErrorDefinition entity.
+// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorResolutionMutation) OldResolutionNotes(ctx context.Context) (v string, err error) {
+func (m *ErrorDefinitionMutation) OldBaseWeight(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolutionNotes is only allowed on UpdateOne operations")
+		return v, errors.New("OldBaseWeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolutionNotes requires an ID field in the mutation")
+		return v, errors.New("OldBaseWeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolutionNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldBaseWeight: %w", err)
 	}
-	return oldValue.ResolutionNotes, nil
+	return oldValue.BaseWeight, nil
 }
 
-// ClearResolutionNotes clears the value of the "resolution_notes" field.
-func (m *ErrorResolutionMutation) ClearResolutionNotes() {
-	m.resolution_notes = nil
-	m.clearedFields[errorresolution.FieldResolutionNotes] = struct{}{}
+// AddBaseWeight adds f to the "base_weight" field.
+func (m *ErrorDefinitionMutation) AddBaseWeight(f float64) {
+	if m.addbase_weight != nil {
+		*m.addbase_weight += f
+	} else {
+		m.addbase_weight = &f
+	}
 }
 
-// ResolutionNotesCleared returns if the "resolution_notes" field was cleared in this mutation.
-func (m *ErrorResolutionMutation) ResolutionNotesCleared() bool {
-	_, ok := m.clearedFields[errorresolution.FieldResolutionNotes]
-	return ok
+// AddedBaseWeight returns the value that was added to the "base_weight" field in this mutation.
+func (m *ErrorDefinitionMutation) AddedBaseWeight() (r float64, exists bool) {
+	v := m.addbase_weight
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetResolutionNotes resets all changes to the "resolution_notes" field.
-func (m *ErrorResolutionMutation) ResetResolutionNotes() {
-	m.resolution_notes = nil
-	delete(m.clearedFields, errorresolution.FieldResolutionNotes)
+// ResetBaseWeight resets all changes to the "base_weight" field.
+func (m *ErrorDefinitionMutation) ResetBaseWeight() {
+	m.base_weight = nil
+	m.addbase_weight = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ErrorResolutionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetIsSystem sets the "is_system" field.
+func (m *ErrorDefinitionMutation) SetIsSystem(b bool) {
+	m.is_system = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ErrorResolutionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// IsSystem returns the value of the "is_system" field in the mutation.
+func (m *ErrorDefinitionMutation) IsSystem() (r bool, exists bool) {
+	v := m.is_system
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ErrorResolution entity.
-// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// OldIsSystem returns the old "is_system" field's value of the ErrorDefinition entity.
+// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorResolutionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ErrorDefinitionMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsSystem requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.IsSystem, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ErrorResolutionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetIsSystem resets all changes to the "is_system" field.
+func (m *ErrorDefinitionMutation) ResetIsSystem() {
+	m.is_system = nil
 }
 
-// SetResolvedAt sets the "resolved_at" field.
-func (m *ErrorResolutionMutation) SetResolvedAt(t time.Time) {
-	m.resolved_at = &t
+// SetIsArchived sets the "is_archived" field.
+func (m *ErrorDefinitionMutation) SetIsArchived(b bool) {
+	m.is_archived = &b
 }
 
-// ResolvedAt returns the value of the "resolved_at" field in the mutation.
-func (m *ErrorResolutionMutation) ResolvedAt() (r time.Time, exists bool) {
-	v := m.resolved_at
+// IsArchived returns the value of the "is_archived" field in the mutation.
+func (m *ErrorDefinitionMutation) IsArchived() (r bool, exists bool) {
+	v := m.is_archived
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedAt returns the old "resolved_at" field's value of the ErrorResolution entity.
-// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// OldIsArchived returns the old "is_archived" field's value of the ErrorDefinition entity.
+// If the ErrorDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ErrorResolutionMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ErrorDefinitionMutation) OldIsArchived(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsArchived is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsArchived requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsArchived: %w", err)
 	}
-	return oldValue.ResolvedAt, nil
+	return oldValue.IsArchived, nil
 }
 
-// ClearResolvedAt clears the value of the "resolved_at" field.
-func (m *ErrorResolutionMutation) ClearResolvedAt() {
-	m.resolved_at = nil
-	m.clearedFields[errorresolution.FieldResolvedAt] = struct{}{}
+// ResetIsArchived resets all changes to the "is_archived" field.
+func (m *ErrorDefinitionMutation) ResetIsArchived() {
+	m.is_archived = nil
 }
 
-// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
-func (m *ErrorResolutionMutation) ResolvedAtCleared() bool {
-	_, ok := m.clearedFields[errorresolution.FieldResolvedAt]
-	return ok
+// AddAttemptIDs adds the "attempts" edge to the Attempt entity by ids.
+func (m *ErrorDefinitionMutation) AddAttemptIDs(ids ...uuid.UUID) {
+	if m.attempts == nil {
+		m.attempts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attempts[ids[i]] = struct{}{}
+	}
 }
 
-// ResetResolvedAt resets all changes to the "resolved_at" field.
-func (m *ErrorResolutionMutation) ResetResolvedAt() {
-	m.resolved_at = nil
-	delete(m.clearedFields, errorresolution.FieldResolvedAt)
+// ClearAttempts clears the "attempts" edge to the Attempt entity.
+func (m *ErrorDefinitionMutation) ClearAttempts() {
+	m.clearedattempts = true
 }
 
-// ClearNode clears the "node" edge to the Node entity.
-func (m *ErrorResolutionMutation) ClearNode() {
-	m.clearednode = true
-	m.clearedFields[errorresolution.FieldNodeID] = struct{}{}
+// AttemptsCleared reports if the "attempts" edge to the Attempt entity was cleared.
+func (m *ErrorDefinitionMutation) AttemptsCleared() bool {
+	return m.clearedattempts
 }
 
-// NodeCleared reports if the "node" edge to the Node entity was cleared.
-func (m *ErrorResolutionMutation) NodeCleared() bool {
-	return m.clearednode
+// RemoveAttemptIDs removes the "attempts" edge to the Attempt entity by IDs.
+func (m *ErrorDefinitionMutation) RemoveAttemptIDs(ids ...uuid.UUID) {
+	if m.removedattempts == nil {
+		m.removedattempts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attempts, ids[i])
+		m.removedattempts[ids[i]] = struct{}{}
+	}
 }
 
-// NodeIDs returns the "node" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NodeID instead. It exists only for internal usage by the builders.
-func (m *ErrorResolutionMutation) NodeIDs() (ids []uuid.UUID) {
-	if id := m.node; id != nil {
-		ids = append(ids, *id)
+// RemovedAttempts returns the removed IDs of the "attempts" edge to the Attempt entity.
+func (m *ErrorDefinitionMutation) RemovedAttemptsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattempts {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNode resets all changes to the "node" edge.
-func (m *ErrorResolutionMutation) ResetNode() {
-	m.node = nil
-	m.clearednode = false
+// AttemptsIDs returns the "attempts" edge IDs in the mutation.
+func (m *ErrorDefinitionMutation) AttemptsIDs() (ids []uuid.UUID) {
+	for id := range m.attempts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the ErrorResolutionMutation builder.
-func (m *ErrorResolutionMutation) Where(ps ...predicate.ErrorResolution) {
+// ResetAttempts resets all changes to the "attempts" edge.
+func (m *ErrorDefinitionMutation) ResetAttempts() {
+	m.attempts = nil
+	m.clearedattempts = false
+	m.removedattempts = nil
+}
+
+// AddAttemptErrorIDs adds the "attempt_errors" edge to the AttemptError entity by ids.
+func (m *ErrorDefinitionMutation) AddAttemptErrorIDs(ids ...uuid.UUID) {
+	if m.attempt_errors == nil {
+		m.attempt_errors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attempt_errors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttemptErrors clears the "attempt_errors" edge to the AttemptError entity.
+func (m *ErrorDefinitionMutation) ClearAttemptErrors() {
+	m.clearedattempt_errors = true
+}
+
+// AttemptErrorsCleared reports if the "attempt_errors" edge to the AttemptError entity was cleared.
+func (m *ErrorDefinitionMutation) AttemptErrorsCleared() bool {
+	return m.clearedattempt_errors
+}
+
+// RemoveAttemptErrorIDs removes the "attempt_errors" edge to the AttemptError entity by IDs.
+func (m *ErrorDefinitionMutation) RemoveAttemptErrorIDs(ids ...uuid.UUID) {
+	if m.removedattempt_errors == nil {
+		m.removedattempt_errors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attempt_errors, ids[i])
+		m.removedattempt_errors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttemptErrors returns the removed IDs of the "attempt_errors" edge to the AttemptError entity.
+func (m *ErrorDefinitionMutation) RemovedAttemptErrorsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattempt_errors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttemptErrorsIDs returns the "attempt_errors" edge IDs in the mutation.
+func (m *ErrorDefinitionMutation) AttemptErrorsIDs() (ids []uuid.UUID) {
+	for id := range m.attempt_errors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttemptErrors resets all changes to the "attempt_errors" edge.
+func (m *ErrorDefinitionMutation) ResetAttemptErrors() {
+	m.attempt_errors = nil
+	m.clearedattempt_errors = false
+	m.removedattempt_errors = nil
+}
+
+// Where appends a list predicates to the ErrorDefinitionMutation builder.
+func (m *ErrorDefinitionMutation) Where(ps ...predicate.ErrorDefinition) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ErrorResolutionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ErrorDefinitionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ErrorResolutionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ErrorResolution, len(ps))
+func (m *ErrorDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ErrorDefinition, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2255,45 +2795,45 @@ func (m *ErrorResolutionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ErrorResolutionMutation) Op() Op {
+func (m *ErrorDefinitionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ErrorResolutionMutation) SetOp(op Op) {
+func (m *ErrorDefinitionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ErrorResolution).
-func (m *ErrorResolutionMutation) Type() string {
+// Type returns the node type of this mutation (ErrorDefinition).
+func (m *ErrorDefinitionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ErrorResolutionMutation) Fields() []string {
+func (m *ErrorDefinitionMutation) Fields() []string {
 	fields := make([]string, 0, 7)
-	if m.node != nil {
-		fields = append(fields, errorresolution.FieldNodeID)
+	if m.code != nil {
+		fields = append(fields, errordefinition.FieldCode)
 	}
-	if m.error_type_id != nil {
-		fields = append(fields, errorresolution.FieldErrorTypeID)
+	if m.label != nil {
+		fields = append(fields, errordefinition.FieldLabel)
 	}
-	if m.weight_impact != nil {
-		fields = append(fields, errorresolution.FieldWeightImpact)
+	if m.category != nil {
+		fields = append(fields, errordefinition.FieldCategory)
 	}
-	if m.is_resolved != nil {
-		fields = append(fields, errorresolution.FieldIsResolved)
+	if m.description != nil {
+		fields = append(fields, errordefinition.FieldDescription)
 	}
-	if m.resolution_notes != nil {
-		fields = append(fields, errorresolution.FieldResolutionNotes)
+	if m.base_weight != nil {
+		fields = append(fields, errordefinition.FieldBaseWeight)
 	}
-	if m.created_at != nil {
-		fields = append(fields, errorresolution.FieldCreatedAt)
+	if m.is_system != nil {
+		fields = append(fields, errordefinition.FieldIsSystem)
 	}
-	if m.resolved_at != nil {
-		fields = append(fields, errorresolution.FieldResolvedAt)
+	if m.is_archived != nil {
+		fields = append(fields, errordefinition.FieldIsArchived)
 	}
 	return fields
 }
@@ -2301,22 +2841,22 @@ func (m *ErrorResolutionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ErrorResolutionMutation) Field(name string) (ent.Value, bool) {
+func (m *ErrorDefinitionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case errorresolution.FieldNodeID:
-		return m.NodeID()
-	case errorresolution.FieldErrorTypeID:
-		return m.ErrorTypeID()
-	case errorresolution.FieldWeightImpact:
-		return m.WeightImpact()
-	case errorresolution.FieldIsResolved:
-		return m.IsResolved()
-	case errorresolution.FieldResolutionNotes:
-		return m.ResolutionNotes()
-	case errorresolution.FieldCreatedAt:
-		return m.CreatedAt()
-	case errorresolution.FieldResolvedAt:
-		return m.ResolvedAt()
+	case errordefinition.FieldCode:
+		return m.Code()
+	case errordefinition.FieldLabel:
+		return m.Label()
+	case errordefinition.FieldCategory:
+		return m.Category()
+	case errordefinition.FieldDescription:
+		return m.Description()
+	case errordefinition.FieldBaseWeight:
+		return m.BaseWeight()
+	case errordefinition.FieldIsSystem:
+		return m.IsSystem()
+	case errordefinition.FieldIsArchived:
+		return m.IsArchived()
 	}
 	return nil, false
 }
@@ -2324,90 +2864,90 @@ func (m *ErrorResolutionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ErrorResolutionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ErrorDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case errorresolution.FieldNodeID:
-		return m.OldNodeID(ctx)
-	case errorresolution.FieldErrorTypeID:
-		return m.OldErrorTypeID(ctx)
-	case errorresolution.FieldWeightImpact:
-		return m.OldWeightImpact(ctx)
-	case errorresolution.FieldIsResolved:
-		return m.OldIsResolved(ctx)
-	case errorresolution.FieldResolutionNotes:
-		return m.OldResolutionNotes(ctx)
-	case errorresolution.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case errorresolution.FieldResolvedAt:
-		return m.OldResolvedAt(ctx)
+	case errordefinition.FieldCode:
+		return m.OldCode(ctx)
+	case errordefinition.FieldLabel:
+		return m.OldLabel(ctx)
+	case errordefinition.FieldCategory:
+		return m.OldCategory(ctx)
+	case errordefinition.FieldDescription:
+		return m.OldDescription(ctx)
+	case errordefinition.FieldBaseWeight:
+		return m.OldBaseWeight(ctx)
+	case errordefinition.FieldIsSystem:
+		return m.OldIsSystem(ctx)
+	case errordefinition.FieldIsArchived:
+		return m.OldIsArchived(ctx)
 	}
-	return nil, fmt.Errorf("unknown ErrorResolution field %s", name)
+	return nil, fmt.Errorf("unknown ErrorDefinition field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ErrorResolutionMutation) SetField(name string, value ent.Value) error {
+func (m *ErrorDefinitionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case errorresolution.FieldNodeID:
-		v, ok := value.(uuid.UUID)
+	case errordefinition.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNodeID(v)
+		m.SetCode(v)
 		return nil
-	case errorresolution.FieldErrorTypeID:
-		v, ok := value.(uuid.UUID)
+	case errordefinition.FieldLabel:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorTypeID(v)
+		m.SetLabel(v)
 		return nil
-	case errorresolution.FieldWeightImpact:
-		v, ok := value.(float64)
+	case errordefinition.FieldCategory:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeightImpact(v)
+		m.SetCategory(v)
 		return nil
-	case errorresolution.FieldIsResolved:
-		v, ok := value.(bool)
+	case errordefinition.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsResolved(v)
+		m.SetDescription(v)
 		return nil
-	case errorresolution.FieldResolutionNotes:
-		v, ok := value.(string)
+	case errordefinition.FieldBaseWeight:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolutionNotes(v)
+		m.SetBaseWeight(v)
 		return nil
-	case errorresolution.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case errordefinition.FieldIsSystem:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetIsSystem(v)
 		return nil
-	case errorresolution.FieldResolvedAt:
-		v, ok := value.(time.Time)
+	case errordefinition.FieldIsArchived:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedAt(v)
+		m.SetIsArchived(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorResolution field %s", name)
+	return fmt.Errorf("unknown ErrorDefinition field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ErrorResolutionMutation) AddedFields() []string {
+func (m *ErrorDefinitionMutation) AddedFields() []string {
 	var fields []string
-	if m.addweight_impact != nil {
-		fields = append(fields, errorresolution.FieldWeightImpact)
+	if m.addbase_weight != nil {
+		fields = append(fields, errordefinition.FieldBaseWeight)
 	}
 	return fields
 }
@@ -2415,10 +2955,10 @@ func (m *ErrorResolutionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ErrorResolutionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ErrorDefinitionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case errorresolution.FieldWeightImpact:
-		return m.AddedWeightImpact()
+	case errordefinition.FieldBaseWeight:
+		return m.AddedBaseWeight()
 	}
 	return nil, false
 }
@@ -2426,203 +2966,233 @@ func (m *ErrorResolutionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ErrorResolutionMutation) AddField(name string, value ent.Value) error {
+func (m *ErrorDefinitionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case errorresolution.FieldWeightImpact:
+	case errordefinition.FieldBaseWeight:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWeightImpact(v)
+		m.AddBaseWeight(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorResolution numeric field %s", name)
+	return fmt.Errorf("unknown ErrorDefinition numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ErrorResolutionMutation) ClearedFields() []string {
+func (m *ErrorDefinitionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(errorresolution.FieldResolutionNotes) {
-		fields = append(fields, errorresolution.FieldResolutionNotes)
+	if m.FieldCleared(errordefinition.FieldCode) {
+		fields = append(fields, errordefinition.FieldCode)
 	}
-	if m.FieldCleared(errorresolution.FieldResolvedAt) {
-		fields = append(fields, errorresolution.FieldResolvedAt)
+	if m.FieldCleared(errordefinition.FieldCategory) {
+		fields = append(fields, errordefinition.FieldCategory)
+	}
+	if m.FieldCleared(errordefinition.FieldDescription) {
+		fields = append(fields, errordefinition.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ErrorResolutionMutation) FieldCleared(name string) bool {
+func (m *ErrorDefinitionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ErrorResolutionMutation) ClearField(name string) error {
+func (m *ErrorDefinitionMutation) ClearField(name string) error {
 	switch name {
-	case errorresolution.FieldResolutionNotes:
-		m.ClearResolutionNotes()
+	case errordefinition.FieldCode:
+		m.ClearCode()
 		return nil
-	case errorresolution.FieldResolvedAt:
-		m.ClearResolvedAt()
+	case errordefinition.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case errordefinition.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorResolution nullable field %s", name)
+	return fmt.Errorf("unknown ErrorDefinition nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ErrorResolutionMutation) ResetField(name string) error {
+func (m *ErrorDefinitionMutation) ResetField(name string) error {
 	switch name {
-	case errorresolution.FieldNodeID:
-		m.ResetNodeID()
+	case errordefinition.FieldCode:
+		m.ResetCode()
 		return nil
-	case errorresolution.FieldErrorTypeID:
-		m.ResetErrorTypeID()
+	case errordefinition.FieldLabel:
+		m.ResetLabel()
 		return nil
-	case errorresolution.FieldWeightImpact:
-		m.ResetWeightImpact()
+	case errordefinition.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case errorresolution.FieldIsResolved:
-		m.ResetIsResolved()
+	case errordefinition.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case errorresolution.FieldResolutionNotes:
-		m.ResetResolutionNotes()
+	case errordefinition.FieldBaseWeight:
+		m.ResetBaseWeight()
 		return nil
-	case errorresolution.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case errordefinition.FieldIsSystem:
+		m.ResetIsSystem()
 		return nil
-	case errorresolution.FieldResolvedAt:
-		m.ResetResolvedAt()
+	case errordefinition.FieldIsArchived:
+		m.ResetIsArchived()
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorResolution field %s", name)
+	return fmt.Errorf("unknown ErrorDefinition field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ErrorResolutionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.node != nil {
-		edges = append(edges, errorresolution.EdgeNode)
+func (m *ErrorDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.attempts != nil {
+		edges = append(edges, errordefinition.EdgeAttempts)
+	}
+	if m.attempt_errors != nil {
+		edges = append(edges, errordefinition.EdgeAttemptErrors)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ErrorResolutionMutation) AddedIDs(name string) []ent.Value {
+func (m *ErrorDefinitionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case errorresolution.EdgeNode:
-		if id := m.node; id != nil {
-			return []ent.Value{*id}
+	case errordefinition.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.attempts))
+		for id := range m.attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case errordefinition.EdgeAttemptErrors:
+		ids := make([]ent.Value, 0, len(m.attempt_errors))
+		for id := range m.attempt_errors {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ErrorResolutionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ErrorDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattempts != nil {
+		edges = append(edges, errordefinition.EdgeAttempts)
+	}
+	if m.removedattempt_errors != nil {
+		edges = append(edges, errordefinition.EdgeAttemptErrors)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ErrorResolutionMutation) RemovedIDs(name string) []ent.Value {
+func (m *ErrorDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case errordefinition.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.removedattempts))
+		for id := range m.removedattempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case errordefinition.EdgeAttemptErrors:
+		ids := make([]ent.Value, 0, len(m.removedattempt_errors))
+		for id := range m.removedattempt_errors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ErrorResolutionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearednode {
-		edges = append(edges, errorresolution.EdgeNode)
+func (m *ErrorDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedattempts {
+		edges = append(edges, errordefinition.EdgeAttempts)
+	}
+	if m.clearedattempt_errors {
+		edges = append(edges, errordefinition.EdgeAttemptErrors)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ErrorResolutionMutation) EdgeCleared(name string) bool {
+func (m *ErrorDefinitionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case errorresolution.EdgeNode:
-		return m.clearednode
+	case errordefinition.EdgeAttempts:
+		return m.clearedattempts
+	case errordefinition.EdgeAttemptErrors:
+		return m.clearedattempt_errors
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ErrorResolutionMutation) ClearEdge(name string) error {
+func (m *ErrorDefinitionMutation) ClearEdge(name string) error {
 	switch name {
-	case errorresolution.EdgeNode:
-		m.ClearNode()
-		return nil
 	}
-	return fmt.Errorf("unknown ErrorResolution unique edge %s", name)
+	return fmt.Errorf("unknown ErrorDefinition unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ErrorResolutionMutation) ResetEdge(name string) error {
+func (m *ErrorDefinitionMutation) ResetEdge(name string) error {
 	switch name {
-	case errorresolution.EdgeNode:
-		m.ResetNode()
+	case errordefinition.EdgeAttempts:
+		m.ResetAttempts()
+		return nil
+	case errordefinition.EdgeAttemptErrors:
+		m.ResetAttemptErrors()
 		return nil
 	}
-	return fmt.Errorf("unknown ErrorResolution edge %s", name)
+	return fmt.Errorf("unknown ErrorDefinition edge %s", name)
 }
 
-// FsrsCardMutation represents an operation that mutates the FsrsCard nodes in the graph.
-type FsrsCardMutation struct {
+// ErrorResolutionMutation represents an operation that mutates the ErrorResolution nodes in the graph.
+type ErrorResolutionMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	stability         *float64
-	addstability      *float64
-	difficulty        *float64
-	adddifficulty     *float64
-	elapsed_days      *int
-	addelapsed_days   *int
-	scheduled_days    *int
-	addscheduled_days *int
-	reps              *int
-	addreps           *int
-	lapses            *int
-	addlapses         *int
-	state             *fsrscard.State
-	last_review       *time.Time
-	due               *time.Time
-	card_state        *string
-	current_step      *int
-	addcurrent_step   *int
-	next_review       *time.Time
-	clearedFields     map[string]struct{}
-	node              *uuid.UUID
-	clearednode       bool
-	attempts          map[uuid.UUID]struct{}
-	removedattempts   map[uuid.UUID]struct{}
-	clearedattempts   bool
-	done              bool
-	oldValue          func(context.Context) (*FsrsCard, error)
-	predicates        []predicate.FsrsCard
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	error_type_id    *uuid.UUID
+	weight_impact    *float64
+	addweight_impact *float64
+	is_resolved      *bool
+	resolution_notes *string
+	occurrences      *int
+	addoccurrences   *int
+	created_at       *time.Time
+	last_seen_at     *time.Time
+	resolved_at      *time.Time
+	clearedFields    map[string]struct{}
+	node             *uuid.UUID
+	clearednode      bool
+	done             bool
+	oldValue         func(context.Context) (*ErrorResolution, error)
+	predicates       []predicate.ErrorResolution
 }
 
-var _ ent.Mutation = (*FsrsCardMutation)(nil)
+var _ ent.Mutation = (*ErrorResolutionMutation)(nil)
 
-// fsrscardOption allows management of the mutation configuration using functional options.
-type fsrscardOption func(*FsrsCardMutation)
+// errorresolutionOption allows management of the mutation configuration using functional options.
+type errorresolutionOption func(*ErrorResolutionMutation)
 
-// newFsrsCardMutation creates new mutation for the FsrsCard entity.
-func newFsrsCardMutation(c config, op Op, opts ...fsrscardOption) *FsrsCardMutation {
-	m := &FsrsCardMutation{
+// newErrorResolutionMutation creates new mutation for the ErrorResolution entity.
+func newErrorResolutionMutation(c config, op Op, opts ...errorresolutionOption) *ErrorResolutionMutation {
+	m := &ErrorResolutionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFsrsCard,
+		typ:           TypeErrorResolution,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2631,20 +3201,20 @@ func newFsrsCardMutation(c config, op Op, opts ...fsrscardOption) *FsrsCardMutat
 	return m
 }
 
-// withFsrsCardID sets the ID field of the mutation.
-func withFsrsCardID(id uuid.UUID) fsrscardOption {
-	return func(m *FsrsCardMutation) {
+// withErrorResolutionID sets the ID field of the mutation.
+func withErrorResolutionID(id uuid.UUID) errorresolutionOption {
+	return func(m *ErrorResolutionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FsrsCard
+			value *ErrorResolution
 		)
-		m.oldValue = func(ctx context.Context) (*FsrsCard, error) {
+		m.oldValue = func(ctx context.Context) (*ErrorResolution, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FsrsCard.Get(ctx, id)
+					value, err = m.Client().ErrorResolution.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2653,19 +3223,19 @@ func withFsrsCardID(id uuid.UUID) fsrscardOption {
 	}
 }
 
-// withFsrsCard sets the old FsrsCard of the mutation.
-func withFsrsCard(node *FsrsCard) fsrscardOption {
-	return func(m *FsrsCardMutation) {
-		m.oldValue = func(context.Context) (*FsrsCard, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// withErrorResolution sets the old ErrorResolution of the mutation.
+func withErrorResolution(node *ErrorResolution) errorresolutionOption {
+	return func(m *ErrorResolutionMutation) {
+		m.oldValue = func(context.Context) (*ErrorResolution, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FsrsCardMutation) Client() *Client {
+func (m ErrorResolutionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2673,7 +3243,7 @@ func (m FsrsCardMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FsrsCardMutation) Tx() (*Tx, error) {
+func (m ErrorResolutionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2683,14 +3253,14 @@ func (m FsrsCardMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FsrsCard entities.
-func (m *FsrsCardMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ErrorResolution entities.
+func (m *ErrorResolutionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FsrsCardMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ErrorResolutionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2701,7 +3271,7 @@ func (m *FsrsCardMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FsrsCardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ErrorResolutionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2710,723 +3280,4775 @@ func (m *FsrsCardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FsrsCard.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ErrorResolution.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStability sets the "stability" field.
-func (m *FsrsCardMutation) SetStability(f float64) {
-	m.stability = &f
-	m.addstability = nil
+// SetNodeID sets the "node_id" field.
+func (m *ErrorResolutionMutation) SetNodeID(u uuid.UUID) {
+	m.node = &u
 }
 
-// Stability returns the value of the "stability" field in the mutation.
-func (m *FsrsCardMutation) Stability() (r float64, exists bool) {
-	v := m.stability
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *ErrorResolutionMutation) NodeID() (r uuid.UUID, exists bool) {
+	v := m.node
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStability returns the old "stability" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldNodeID returns the old "node_id" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldStability(ctx context.Context) (v float64, err error) {
+func (m *ErrorResolutionMutation) OldNodeID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStability is only allowed on UpdateOne operations")
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStability requires an ID field in the mutation")
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStability: %w", err)
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
 	}
-	return oldValue.Stability, nil
+	return oldValue.NodeID, nil
 }
 
-// AddStability adds f to the "stability" field.
-func (m *FsrsCardMutation) AddStability(f float64) {
-	if m.addstability != nil {
-		*m.addstability += f
-	} else {
-		m.addstability = &f
-	}
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *ErrorResolutionMutation) ResetNodeID() {
+	m.node = nil
 }
 
-// AddedStability returns the value that was added to the "stability" field in this mutation.
-func (m *FsrsCardMutation) AddedStability() (r float64, exists bool) {
-	v := m.addstability
+// SetErrorTypeID sets the "error_type_id" field.
+func (m *ErrorResolutionMutation) SetErrorTypeID(u uuid.UUID) {
+	m.error_type_id = &u
+}
+
+// ErrorTypeID returns the value of the "error_type_id" field in the mutation.
+func (m *ErrorResolutionMutation) ErrorTypeID() (r uuid.UUID, exists bool) {
+	v := m.error_type_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStability resets all changes to the "stability" field.
-func (m *FsrsCardMutation) ResetStability() {
-	m.stability = nil
-	m.addstability = nil
+// OldErrorTypeID returns the old "error_type_id" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ErrorResolutionMutation) OldErrorTypeID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorTypeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorTypeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorTypeID: %w", err)
+	}
+	return oldValue.ErrorTypeID, nil
 }
 
-// SetDifficulty sets the "difficulty" field.
-func (m *FsrsCardMutation) SetDifficulty(f float64) {
-	m.difficulty = &f
-	m.adddifficulty = nil
+// ResetErrorTypeID resets all changes to the "error_type_id" field.
+func (m *ErrorResolutionMutation) ResetErrorTypeID() {
+	m.error_type_id = nil
 }
 
-// Difficulty returns the value of the "difficulty" field in the mutation.
-func (m *FsrsCardMutation) Difficulty() (r float64, exists bool) {
-	v := m.difficulty
+// SetWeightImpact sets the "weight_impact" field.
+func (m *ErrorResolutionMutation) SetWeightImpact(f float64) {
+	m.weight_impact = &f
+	m.addweight_impact = nil
+}
+
+// WeightImpact returns the value of the "weight_impact" field in the mutation.
+func (m *ErrorResolutionMutation) WeightImpact() (r float64, exists bool) {
+	v := m.weight_impact
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDifficulty returns the old "difficulty" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldWeightImpact returns the old "weight_impact" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldDifficulty(ctx context.Context) (v float64, err error) {
+func (m *ErrorResolutionMutation) OldWeightImpact(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeightImpact is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+		return v, errors.New("OldWeightImpact requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeightImpact: %w", err)
 	}
-	return oldValue.Difficulty, nil
+	return oldValue.WeightImpact, nil
 }
 
-// AddDifficulty adds f to the "difficulty" field.
-func (m *FsrsCardMutation) AddDifficulty(f float64) {
-	if m.adddifficulty != nil {
-		*m.adddifficulty += f
+// AddWeightImpact adds f to the "weight_impact" field.
+func (m *ErrorResolutionMutation) AddWeightImpact(f float64) {
+	if m.addweight_impact != nil {
+		*m.addweight_impact += f
 	} else {
-		m.adddifficulty = &f
+		m.addweight_impact = &f
 	}
 }
 
-// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
-func (m *FsrsCardMutation) AddedDifficulty() (r float64, exists bool) {
-	v := m.adddifficulty
+// AddedWeightImpact returns the value that was added to the "weight_impact" field in this mutation.
+func (m *ErrorResolutionMutation) AddedWeightImpact() (r float64, exists bool) {
+	v := m.addweight_impact
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDifficulty resets all changes to the "difficulty" field.
-func (m *FsrsCardMutation) ResetDifficulty() {
-	m.difficulty = nil
-	m.adddifficulty = nil
+// ResetWeightImpact resets all changes to the "weight_impact" field.
+func (m *ErrorResolutionMutation) ResetWeightImpact() {
+	m.weight_impact = nil
+	m.addweight_impact = nil
 }
 
-// SetElapsedDays sets the "elapsed_days" field.
-func (m *FsrsCardMutation) SetElapsedDays(i int) {
-	m.elapsed_days = &i
-	m.addelapsed_days = nil
+// SetIsResolved sets the "is_resolved" field.
+func (m *ErrorResolutionMutation) SetIsResolved(b bool) {
+	m.is_resolved = &b
 }
 
-// ElapsedDays returns the value of the "elapsed_days" field in the mutation.
-func (m *FsrsCardMutation) ElapsedDays() (r int, exists bool) {
-	v := m.elapsed_days
+// IsResolved returns the value of the "is_resolved" field in the mutation.
+func (m *ErrorResolutionMutation) IsResolved() (r bool, exists bool) {
+	v := m.is_resolved
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldElapsedDays returns the old "elapsed_days" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldIsResolved returns the old "is_resolved" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldElapsedDays(ctx context.Context) (v int, err error) {
+func (m *ErrorResolutionMutation) OldIsResolved(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldElapsedDays is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsResolved is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldElapsedDays requires an ID field in the mutation")
+		return v, errors.New("OldIsResolved requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldElapsedDays: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsResolved: %w", err)
 	}
-	return oldValue.ElapsedDays, nil
+	return oldValue.IsResolved, nil
 }
 
-// AddElapsedDays adds i to the "elapsed_days" field.
-func (m *FsrsCardMutation) AddElapsedDays(i int) {
-	if m.addelapsed_days != nil {
-		*m.addelapsed_days += i
-	} else {
-		m.addelapsed_days = &i
-	}
+// ResetIsResolved resets all changes to the "is_resolved" field.
+func (m *ErrorResolutionMutation) ResetIsResolved() {
+	m.is_resolved = nil
 }
 
-// AddedElapsedDays returns the value that was added to the "elapsed_days" field in this mutation.
-func (m *FsrsCardMutation) AddedElapsedDays() (r int, exists bool) {
-	v := m.addelapsed_days
+// SetResolutionNotes sets the "resolution_notes" field.
+func (m *ErrorResolutionMutation) SetResolutionNotes(s string) {
+	m.resolution_notes = &s
+}
+
+// ResolutionNotes returns the value of the "resolution_notes" field in the mutation.
+func (m *ErrorResolutionMutation) ResolutionNotes() (r string, exists bool) {
+	v := m.resolution_notes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetElapsedDays resets all changes to the "elapsed_days" field.
-func (m *FsrsCardMutation) ResetElapsedDays() {
-	m.elapsed_days = nil
-	m.addelapsed_days = nil
+// OldResolutionNotes returns the old "resolution_notes" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ErrorResolutionMutation) OldResolutionNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolutionNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolutionNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolutionNotes: %w", err)
+	}
+	return oldValue.ResolutionNotes, nil
 }
 
-// SetScheduledDays sets the "scheduled_days" field.
-func (m *FsrsCardMutation) SetScheduledDays(i int) {
-	m.scheduled_days = &i
-	m.addscheduled_days = nil
+// ClearResolutionNotes clears the value of the "resolution_notes" field.
+func (m *ErrorResolutionMutation) ClearResolutionNotes() {
+	m.resolution_notes = nil
+	m.clearedFields[errorresolution.FieldResolutionNotes] = struct{}{}
 }
 
-// ScheduledDays returns the value of the "scheduled_days" field in the mutation.
-func (m *FsrsCardMutation) ScheduledDays() (r int, exists bool) {
-	v := m.scheduled_days
+// ResolutionNotesCleared returns if the "resolution_notes" field was cleared in this mutation.
+func (m *ErrorResolutionMutation) ResolutionNotesCleared() bool {
+	_, ok := m.clearedFields[errorresolution.FieldResolutionNotes]
+	return ok
+}
+
+// ResetResolutionNotes resets all changes to the "resolution_notes" field.
+func (m *ErrorResolutionMutation) ResetResolutionNotes() {
+	m.resolution_notes = nil
+	delete(m.clearedFields, errorresolution.FieldResolutionNotes)
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (m *ErrorResolutionMutation) SetOccurrences(i int) {
+	m.occurrences = &i
+	m.addoccurrences = nil
+}
+
+// Occurrences returns the value of the "occurrences" field in the mutation.
+func (m *ErrorResolutionMutation) Occurrences() (r int, exists bool) {
+	v := m.occurrences
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheduledDays returns the old "scheduled_days" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldOccurrences returns the old "occurrences" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldScheduledDays(ctx context.Context) (v int, err error) {
+func (m *ErrorResolutionMutation) OldOccurrences(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheduledDays is only allowed on UpdateOne operations")
+		return v, errors.New("OldOccurrences is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheduledDays requires an ID field in the mutation")
+		return v, errors.New("OldOccurrences requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheduledDays: %w", err)
+		return v, fmt.Errorf("querying old value for OldOccurrences: %w", err)
 	}
-	return oldValue.ScheduledDays, nil
+	return oldValue.Occurrences, nil
 }
 
-// AddScheduledDays adds i to the "scheduled_days" field.
-func (m *FsrsCardMutation) AddScheduledDays(i int) {
-	if m.addscheduled_days != nil {
-		*m.addscheduled_days += i
+// AddOccurrences adds i to the "occurrences" field.
+func (m *ErrorResolutionMutation) AddOccurrences(i int) {
+	if m.addoccurrences != nil {
+		*m.addoccurrences += i
 	} else {
-		m.addscheduled_days = &i
+		m.addoccurrences = &i
 	}
 }
 
-// AddedScheduledDays returns the value that was added to the "scheduled_days" field in this mutation.
-func (m *FsrsCardMutation) AddedScheduledDays() (r int, exists bool) {
-	v := m.addscheduled_days
+// AddedOccurrences returns the value that was added to the "occurrences" field in this mutation.
+func (m *ErrorResolutionMutation) AddedOccurrences() (r int, exists bool) {
+	v := m.addoccurrences
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetScheduledDays resets all changes to the "scheduled_days" field.
-func (m *FsrsCardMutation) ResetScheduledDays() {
-	m.scheduled_days = nil
-	m.addscheduled_days = nil
+// ResetOccurrences resets all changes to the "occurrences" field.
+func (m *ErrorResolutionMutation) ResetOccurrences() {
+	m.occurrences = nil
+	m.addoccurrences = nil
 }
 
-// SetReps sets the "reps" field.
-func (m *FsrsCardMutation) SetReps(i int) {
-	m.reps = &i
-	m.addreps = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *ErrorResolutionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Reps returns the value of the "reps" field in the mutation.
-func (m *FsrsCardMutation) Reps() (r int, exists bool) {
-	v := m.reps
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ErrorResolutionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReps returns the old "reps" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldReps(ctx context.Context) (v int, err error) {
+func (m *ErrorResolutionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReps is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReps requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReps: %w", err)
-	}
-	return oldValue.Reps, nil
-}
-
-// AddReps adds i to the "reps" field.
-func (m *FsrsCardMutation) AddReps(i int) {
-	if m.addreps != nil {
-		*m.addreps += i
-	} else {
-		m.addreps = &i
-	}
-}
-
-// AddedReps returns the value that was added to the "reps" field in this mutation.
-func (m *FsrsCardMutation) AddedReps() (r int, exists bool) {
-	v := m.addreps
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetReps resets all changes to the "reps" field.
-func (m *FsrsCardMutation) ResetReps() {
-	m.reps = nil
-	m.addreps = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ErrorResolutionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetLapses sets the "lapses" field.
-func (m *FsrsCardMutation) SetLapses(i int) {
-	m.lapses = &i
-	m.addlapses = nil
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *ErrorResolutionMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
 }
 
-// Lapses returns the value of the "lapses" field in the mutation.
-func (m *FsrsCardMutation) Lapses() (r int, exists bool) {
-	v := m.lapses
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *ErrorResolutionMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLapses returns the old "lapses" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSeenAt returns the old "last_seen_at" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldLapses(ctx context.Context) (v int, err error) {
+func (m *ErrorResolutionMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLapses is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLapses requires an ID field in the mutation")
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLapses: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
 	}
-	return oldValue.Lapses, nil
+	return oldValue.LastSeenAt, nil
 }
 
-// AddLapses adds i to the "lapses" field.
-func (m *FsrsCardMutation) AddLapses(i int) {
-	if m.addlapses != nil {
-		*m.addlapses += i
-	} else {
-		m.addlapses = &i
-	}
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *ErrorResolutionMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[errorresolution.FieldLastSeenAt] = struct{}{}
 }
 
-// AddedLapses returns the value that was added to the "lapses" field in this mutation.
-func (m *FsrsCardMutation) AddedLapses() (r int, exists bool) {
-	v := m.addlapses
-	if v == nil {
-		return
-	}
-	return *v, true
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *ErrorResolutionMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[errorresolution.FieldLastSeenAt]
+	return ok
 }
 
-// ResetLapses resets all changes to the "lapses" field.
-func (m *FsrsCardMutation) ResetLapses() {
-	m.lapses = nil
-	m.addlapses = nil
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *ErrorResolutionMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, errorresolution.FieldLastSeenAt)
 }
 
-// SetState sets the "state" field.
-func (m *FsrsCardMutation) SetState(f fsrscard.State) {
-	m.state = &f
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ErrorResolutionMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *FsrsCardMutation) State() (r fsrscard.State, exists bool) {
-	v := m.state
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ErrorResolutionMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldResolvedAt returns the old "resolved_at" field's value of the ErrorResolution entity.
+// If the ErrorResolution object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldState(ctx context.Context) (v fsrscard.State, err error) {
+func (m *ErrorResolutionMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.ResolvedAt, nil
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *FsrsCardMutation) ResetState() {
-	m.state = nil
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ErrorResolutionMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[errorresolution.FieldResolvedAt] = struct{}{}
 }
 
-// SetLastReview sets the "last_review" field.
-func (m *FsrsCardMutation) SetLastReview(t time.Time) {
-	m.last_review = &t
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ErrorResolutionMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[errorresolution.FieldResolvedAt]
+	return ok
 }
 
-// LastReview returns the value of the "last_review" field in the mutation.
-func (m *FsrsCardMutation) LastReview() (r time.Time, exists bool) {
-	v := m.last_review
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ErrorResolutionMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, errorresolution.FieldResolvedAt)
 }
 
-// OldLastReview returns the old "last_review" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldLastReview(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastReview is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastReview requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastReview: %w", err)
-	}
-	return oldValue.LastReview, nil
+// ClearNode clears the "node" edge to the Node entity.
+func (m *ErrorResolutionMutation) ClearNode() {
+	m.clearednode = true
+	m.clearedFields[errorresolution.FieldNodeID] = struct{}{}
 }
 
-// ClearLastReview clears the value of the "last_review" field.
-func (m *FsrsCardMutation) ClearLastReview() {
-	m.last_review = nil
-	m.clearedFields[fsrscard.FieldLastReview] = struct{}{}
+// NodeCleared reports if the "node" edge to the Node entity was cleared.
+func (m *ErrorResolutionMutation) NodeCleared() bool {
+	return m.clearednode
 }
 
-// LastReviewCleared returns if the "last_review" field was cleared in this mutation.
-func (m *FsrsCardMutation) LastReviewCleared() bool {
-	_, ok := m.clearedFields[fsrscard.FieldLastReview]
-	return ok
+// NodeIDs returns the "node" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NodeID instead. It exists only for internal usage by the builders.
+func (m *ErrorResolutionMutation) NodeIDs() (ids []uuid.UUID) {
+	if id := m.node; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ResetLastReview resets all changes to the "last_review" field.
-func (m *FsrsCardMutation) ResetLastReview() {
-	m.last_review = nil
-	delete(m.clearedFields, fsrscard.FieldLastReview)
+// ResetNode resets all changes to the "node" edge.
+func (m *ErrorResolutionMutation) ResetNode() {
+	m.node = nil
+	m.clearednode = false
 }
 
-// SetDue sets the "due" field.
-func (m *FsrsCardMutation) SetDue(t time.Time) {
-	m.due = &t
+// Where appends a list predicates to the ErrorResolutionMutation builder.
+func (m *ErrorResolutionMutation) Where(ps ...predicate.ErrorResolution) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Due returns the value of the "due" field in the mutation.
-func (m *FsrsCardMutation) Due() (r time.Time, exists bool) {
-	v := m.due
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ErrorResolutionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ErrorResolutionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ErrorResolution, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldDue returns the old "due" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldDue(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDue requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDue: %w", err)
-	}
-	return oldValue.Due, nil
+// Op returns the operation name.
+func (m *ErrorResolutionMutation) Op() Op {
+	return m.op
 }
 
-// ResetDue resets all changes to the "due" field.
-func (m *FsrsCardMutation) ResetDue() {
-	m.due = nil
+// SetOp allows setting the mutation operation.
+func (m *ErrorResolutionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetNodeID sets the "node_id" field.
-func (m *FsrsCardMutation) SetNodeID(u uuid.UUID) {
-	m.node = &u
+// Type returns the node type of this mutation (ErrorResolution).
+func (m *ErrorResolutionMutation) Type() string {
+	return m.typ
 }
 
-// NodeID returns the value of the "node_id" field in the mutation.
-func (m *FsrsCardMutation) NodeID() (r uuid.UUID, exists bool) {
-	v := m.node
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ErrorResolutionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.node != nil {
+		fields = append(fields, errorresolution.FieldNodeID)
 	}
-	return *v, true
-}
-
-// OldNodeID returns the old "node_id" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldNodeID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	if m.error_type_id != nil {
+		fields = append(fields, errorresolution.FieldErrorTypeID)
 	}
-	if m.id == nil || m.oldValue == nil {
+	if m.weight_impact != nil {
+		fields = append(fields, errorresolution.FieldWeightImpact)
+	}
+	if m.is_resolved != nil {
+		fields = append(fields, errorresolution.FieldIsResolved)
+	}
+	if m.resolution_notes != nil {
+		fields = append(fields, errorresolution.FieldResolutionNotes)
+	}
+	if m.occurrences != nil {
+		fields = append(fields, errorresolution.FieldOccurrences)
+	}
+	if m.created_at != nil {
+		fields = append(fields, errorresolution.FieldCreatedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, errorresolution.FieldLastSeenAt)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, errorresolution.FieldResolvedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ErrorResolutionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case errorresolution.FieldNodeID:
+		return m.NodeID()
+	case errorresolution.FieldErrorTypeID:
+		return m.ErrorTypeID()
+	case errorresolution.FieldWeightImpact:
+		return m.WeightImpact()
+	case errorresolution.FieldIsResolved:
+		return m.IsResolved()
+	case errorresolution.FieldResolutionNotes:
+		return m.ResolutionNotes()
+	case errorresolution.FieldOccurrences:
+		return m.Occurrences()
+	case errorresolution.FieldCreatedAt:
+		return m.CreatedAt()
+	case errorresolution.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case errorresolution.FieldResolvedAt:
+		return m.ResolvedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ErrorResolutionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case errorresolution.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case errorresolution.FieldErrorTypeID:
+		return m.OldErrorTypeID(ctx)
+	case errorresolution.FieldWeightImpact:
+		return m.OldWeightImpact(ctx)
+	case errorresolution.FieldIsResolved:
+		return m.OldIsResolved(ctx)
+	case errorresolution.FieldResolutionNotes:
+		return m.OldResolutionNotes(ctx)
+	case errorresolution.FieldOccurrences:
+		return m.OldOccurrences(ctx)
+	case errorresolution.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case errorresolution.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case errorresolution.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ErrorResolution field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ErrorResolutionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case errorresolution.FieldNodeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	case errorresolution.FieldErrorTypeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorTypeID(v)
+		return nil
+	case errorresolution.FieldWeightImpact:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeightImpact(v)
+		return nil
+	case errorresolution.FieldIsResolved:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsResolved(v)
+		return nil
+	case errorresolution.FieldResolutionNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolutionNotes(v)
+		return nil
+	case errorresolution.FieldOccurrences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurrences(v)
+		return nil
+	case errorresolution.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case errorresolution.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case errorresolution.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ErrorResolution field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ErrorResolutionMutation) AddedFields() []string {
+	var fields []string
+	if m.addweight_impact != nil {
+		fields = append(fields, errorresolution.FieldWeightImpact)
+	}
+	if m.addoccurrences != nil {
+		fields = append(fields, errorresolution.FieldOccurrences)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ErrorResolutionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case errorresolution.FieldWeightImpact:
+		return m.AddedWeightImpact()
+	case errorresolution.FieldOccurrences:
+		return m.AddedOccurrences()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ErrorResolutionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case errorresolution.FieldWeightImpact:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeightImpact(v)
+		return nil
+	case errorresolution.FieldOccurrences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOccurrences(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ErrorResolution numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ErrorResolutionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(errorresolution.FieldResolutionNotes) {
+		fields = append(fields, errorresolution.FieldResolutionNotes)
+	}
+	if m.FieldCleared(errorresolution.FieldLastSeenAt) {
+		fields = append(fields, errorresolution.FieldLastSeenAt)
+	}
+	if m.FieldCleared(errorresolution.FieldResolvedAt) {
+		fields = append(fields, errorresolution.FieldResolvedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ErrorResolutionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ErrorResolutionMutation) ClearField(name string) error {
+	switch name {
+	case errorresolution.FieldResolutionNotes:
+		m.ClearResolutionNotes()
+		return nil
+	case errorresolution.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	case errorresolution.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ErrorResolution nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ErrorResolutionMutation) ResetField(name string) error {
+	switch name {
+	case errorresolution.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	case errorresolution.FieldErrorTypeID:
+		m.ResetErrorTypeID()
+		return nil
+	case errorresolution.FieldWeightImpact:
+		m.ResetWeightImpact()
+		return nil
+	case errorresolution.FieldIsResolved:
+		m.ResetIsResolved()
+		return nil
+	case errorresolution.FieldResolutionNotes:
+		m.ResetResolutionNotes()
+		return nil
+	case errorresolution.FieldOccurrences:
+		m.ResetOccurrences()
+		return nil
+	case errorresolution.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case errorresolution.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case errorresolution.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ErrorResolution field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ErrorResolutionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.node != nil {
+		edges = append(edges, errorresolution.EdgeNode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ErrorResolutionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case errorresolution.EdgeNode:
+		if id := m.node; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ErrorResolutionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ErrorResolutionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ErrorResolutionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednode {
+		edges = append(edges, errorresolution.EdgeNode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ErrorResolutionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case errorresolution.EdgeNode:
+		return m.clearednode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ErrorResolutionMutation) ClearEdge(name string) error {
+	switch name {
+	case errorresolution.EdgeNode:
+		m.ClearNode()
+		return nil
+	}
+	return fmt.Errorf("unknown ErrorResolution unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ErrorResolutionMutation) ResetEdge(name string) error {
+	switch name {
+	case errorresolution.EdgeNode:
+		m.ResetNode()
+		return nil
+	}
+	return fmt.Errorf("unknown ErrorResolution edge %s", name)
+}
+
+// FsrsCardMutation represents an operation that mutates the FsrsCard nodes in the graph.
+type FsrsCardMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	stability         *float64
+	addstability      *float64
+	difficulty        *float64
+	adddifficulty     *float64
+	elapsed_days      *int
+	addelapsed_days   *int
+	scheduled_days    *int
+	addscheduled_days *int
+	reps              *int
+	addreps           *int
+	lapses            *int
+	addlapses         *int
+	state             *fsrscard.State
+	last_review       *time.Time
+	due               *time.Time
+	current_step      *int
+	addcurrent_step   *int
+	ease_factor       *float64
+	addease_factor    *float64
+	leitner_box       *int
+	addleitner_box    *int
+	is_leech          *bool
+	is_suspended      *bool
+	buried_until      *time.Time
+	version           *int
+	addversion        *int
+	clearedFields     map[string]struct{}
+	node              *uuid.UUID
+	clearednode       bool
+	attempts          map[uuid.UUID]struct{}
+	removedattempts   map[uuid.UUID]struct{}
+	clearedattempts   bool
+	done              bool
+	oldValue          func(context.Context) (*FsrsCard, error)
+	predicates        []predicate.FsrsCard
+}
+
+var _ ent.Mutation = (*FsrsCardMutation)(nil)
+
+// fsrscardOption allows management of the mutation configuration using functional options.
+type fsrscardOption func(*FsrsCardMutation)
+
+// newFsrsCardMutation creates new mutation for the FsrsCard entity.
+func newFsrsCardMutation(c config, op Op, opts ...fsrscardOption) *FsrsCardMutation {
+	m := &FsrsCardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFsrsCard,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFsrsCardID sets the ID field of the mutation.
+func withFsrsCardID(id uuid.UUID) fsrscardOption {
+	return func(m *FsrsCardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FsrsCard
+		)
+		m.oldValue = func(ctx context.Context) (*FsrsCard, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FsrsCard.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFsrsCard sets the old FsrsCard of the mutation.
+func withFsrsCard(node *FsrsCard) fsrscardOption {
+	return func(m *FsrsCardMutation) {
+		m.oldValue = func(context.Context) (*FsrsCard, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FsrsCardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FsrsCardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FsrsCard entities.
+func (m *FsrsCardMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FsrsCardMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FsrsCardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FsrsCard.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStability sets the "stability" field.
+func (m *FsrsCardMutation) SetStability(f float64) {
+	m.stability = &f
+	m.addstability = nil
+}
+
+// Stability returns the value of the "stability" field in the mutation.
+func (m *FsrsCardMutation) Stability() (r float64, exists bool) {
+	v := m.stability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStability returns the old "stability" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldStability(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStability: %w", err)
+	}
+	return oldValue.Stability, nil
+}
+
+// AddStability adds f to the "stability" field.
+func (m *FsrsCardMutation) AddStability(f float64) {
+	if m.addstability != nil {
+		*m.addstability += f
+	} else {
+		m.addstability = &f
+	}
+}
+
+// AddedStability returns the value that was added to the "stability" field in this mutation.
+func (m *FsrsCardMutation) AddedStability() (r float64, exists bool) {
+	v := m.addstability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStability resets all changes to the "stability" field.
+func (m *FsrsCardMutation) ResetStability() {
+	m.stability = nil
+	m.addstability = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *FsrsCardMutation) SetDifficulty(f float64) {
+	m.difficulty = &f
+	m.adddifficulty = nil
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *FsrsCardMutation) Difficulty() (r float64, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldDifficulty(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// AddDifficulty adds f to the "difficulty" field.
+func (m *FsrsCardMutation) AddDifficulty(f float64) {
+	if m.adddifficulty != nil {
+		*m.adddifficulty += f
+	} else {
+		m.adddifficulty = &f
+	}
+}
+
+// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
+func (m *FsrsCardMutation) AddedDifficulty() (r float64, exists bool) {
+	v := m.adddifficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *FsrsCardMutation) ResetDifficulty() {
+	m.difficulty = nil
+	m.adddifficulty = nil
+}
+
+// SetElapsedDays sets the "elapsed_days" field.
+func (m *FsrsCardMutation) SetElapsedDays(i int) {
+	m.elapsed_days = &i
+	m.addelapsed_days = nil
+}
+
+// ElapsedDays returns the value of the "elapsed_days" field in the mutation.
+func (m *FsrsCardMutation) ElapsedDays() (r int, exists bool) {
+	v := m.elapsed_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldElapsedDays returns the old "elapsed_days" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldElapsedDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldElapsedDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldElapsedDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldElapsedDays: %w", err)
+	}
+	return oldValue.ElapsedDays, nil
+}
+
+// AddElapsedDays adds i to the "elapsed_days" field.
+func (m *FsrsCardMutation) AddElapsedDays(i int) {
+	if m.addelapsed_days != nil {
+		*m.addelapsed_days += i
+	} else {
+		m.addelapsed_days = &i
+	}
+}
+
+// AddedElapsedDays returns the value that was added to the "elapsed_days" field in this mutation.
+func (m *FsrsCardMutation) AddedElapsedDays() (r int, exists bool) {
+	v := m.addelapsed_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetElapsedDays resets all changes to the "elapsed_days" field.
+func (m *FsrsCardMutation) ResetElapsedDays() {
+	m.elapsed_days = nil
+	m.addelapsed_days = nil
+}
+
+// SetScheduledDays sets the "scheduled_days" field.
+func (m *FsrsCardMutation) SetScheduledDays(i int) {
+	m.scheduled_days = &i
+	m.addscheduled_days = nil
+}
+
+// ScheduledDays returns the value of the "scheduled_days" field in the mutation.
+func (m *FsrsCardMutation) ScheduledDays() (r int, exists bool) {
+	v := m.scheduled_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledDays returns the old "scheduled_days" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldScheduledDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledDays: %w", err)
+	}
+	return oldValue.ScheduledDays, nil
+}
+
+// AddScheduledDays adds i to the "scheduled_days" field.
+func (m *FsrsCardMutation) AddScheduledDays(i int) {
+	if m.addscheduled_days != nil {
+		*m.addscheduled_days += i
+	} else {
+		m.addscheduled_days = &i
+	}
+}
+
+// AddedScheduledDays returns the value that was added to the "scheduled_days" field in this mutation.
+func (m *FsrsCardMutation) AddedScheduledDays() (r int, exists bool) {
+	v := m.addscheduled_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScheduledDays resets all changes to the "scheduled_days" field.
+func (m *FsrsCardMutation) ResetScheduledDays() {
+	m.scheduled_days = nil
+	m.addscheduled_days = nil
+}
+
+// SetReps sets the "reps" field.
+func (m *FsrsCardMutation) SetReps(i int) {
+	m.reps = &i
+	m.addreps = nil
+}
+
+// Reps returns the value of the "reps" field in the mutation.
+func (m *FsrsCardMutation) Reps() (r int, exists bool) {
+	v := m.reps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReps returns the old "reps" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldReps(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReps: %w", err)
+	}
+	return oldValue.Reps, nil
+}
+
+// AddReps adds i to the "reps" field.
+func (m *FsrsCardMutation) AddReps(i int) {
+	if m.addreps != nil {
+		*m.addreps += i
+	} else {
+		m.addreps = &i
+	}
+}
+
+// AddedReps returns the value that was added to the "reps" field in this mutation.
+func (m *FsrsCardMutation) AddedReps() (r int, exists bool) {
+	v := m.addreps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReps resets all changes to the "reps" field.
+func (m *FsrsCardMutation) ResetReps() {
+	m.reps = nil
+	m.addreps = nil
+}
+
+// SetLapses sets the "lapses" field.
+func (m *FsrsCardMutation) SetLapses(i int) {
+	m.lapses = &i
+	m.addlapses = nil
+}
+
+// Lapses returns the value of the "lapses" field in the mutation.
+func (m *FsrsCardMutation) Lapses() (r int, exists bool) {
+	v := m.lapses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLapses returns the old "lapses" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldLapses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLapses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLapses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLapses: %w", err)
+	}
+	return oldValue.Lapses, nil
+}
+
+// AddLapses adds i to the "lapses" field.
+func (m *FsrsCardMutation) AddLapses(i int) {
+	if m.addlapses != nil {
+		*m.addlapses += i
+	} else {
+		m.addlapses = &i
+	}
+}
+
+// AddedLapses returns the value that was added to the "lapses" field in this mutation.
+func (m *FsrsCardMutation) AddedLapses() (r int, exists bool) {
+	v := m.addlapses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLapses resets all changes to the "lapses" field.
+func (m *FsrsCardMutation) ResetLapses() {
+	m.lapses = nil
+	m.addlapses = nil
+}
+
+// SetState sets the "state" field.
+func (m *FsrsCardMutation) SetState(f fsrscard.State) {
+	m.state = &f
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *FsrsCardMutation) State() (r fsrscard.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldState(ctx context.Context) (v fsrscard.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *FsrsCardMutation) ResetState() {
+	m.state = nil
+}
+
+// SetLastReview sets the "last_review" field.
+func (m *FsrsCardMutation) SetLastReview(t time.Time) {
+	m.last_review = &t
+}
+
+// LastReview returns the value of the "last_review" field in the mutation.
+func (m *FsrsCardMutation) LastReview() (r time.Time, exists bool) {
+	v := m.last_review
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastReview returns the old "last_review" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldLastReview(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastReview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastReview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastReview: %w", err)
+	}
+	return oldValue.LastReview, nil
+}
+
+// ClearLastReview clears the value of the "last_review" field.
+func (m *FsrsCardMutation) ClearLastReview() {
+	m.last_review = nil
+	m.clearedFields[fsrscard.FieldLastReview] = struct{}{}
+}
+
+// LastReviewCleared returns if the "last_review" field was cleared in this mutation.
+func (m *FsrsCardMutation) LastReviewCleared() bool {
+	_, ok := m.clearedFields[fsrscard.FieldLastReview]
+	return ok
+}
+
+// ResetLastReview resets all changes to the "last_review" field.
+func (m *FsrsCardMutation) ResetLastReview() {
+	m.last_review = nil
+	delete(m.clearedFields, fsrscard.FieldLastReview)
+}
+
+// SetDue sets the "due" field.
+func (m *FsrsCardMutation) SetDue(t time.Time) {
+	m.due = &t
+}
+
+// Due returns the value of the "due" field in the mutation.
+func (m *FsrsCardMutation) Due() (r time.Time, exists bool) {
+	v := m.due
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDue returns the old "due" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldDue(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDue: %w", err)
+	}
+	return oldValue.Due, nil
+}
+
+// ResetDue resets all changes to the "due" field.
+func (m *FsrsCardMutation) ResetDue() {
+	m.due = nil
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *FsrsCardMutation) SetNodeID(u uuid.UUID) {
+	m.node = &u
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *FsrsCardMutation) NodeID() (r uuid.UUID, exists bool) {
+	v := m.node
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldNodeID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
 		return v, errors.New("OldNodeID requires an ID field in the mutation")
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *FsrsCardMutation) ResetNodeID() {
+	m.node = nil
+}
+
+// SetCurrentStep sets the "current_step" field.
+func (m *FsrsCardMutation) SetCurrentStep(i int) {
+	m.current_step = &i
+	m.addcurrent_step = nil
+}
+
+// CurrentStep returns the value of the "current_step" field in the mutation.
+func (m *FsrsCardMutation) CurrentStep() (r int, exists bool) {
+	v := m.current_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentStep returns the old "current_step" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldCurrentStep(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentStep: %w", err)
+	}
+	return oldValue.CurrentStep, nil
+}
+
+// AddCurrentStep adds i to the "current_step" field.
+func (m *FsrsCardMutation) AddCurrentStep(i int) {
+	if m.addcurrent_step != nil {
+		*m.addcurrent_step += i
+	} else {
+		m.addcurrent_step = &i
+	}
+}
+
+// AddedCurrentStep returns the value that was added to the "current_step" field in this mutation.
+func (m *FsrsCardMutation) AddedCurrentStep() (r int, exists bool) {
+	v := m.addcurrent_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentStep resets all changes to the "current_step" field.
+func (m *FsrsCardMutation) ResetCurrentStep() {
+	m.current_step = nil
+	m.addcurrent_step = nil
+}
+
+// SetEaseFactor sets the "ease_factor" field.
+func (m *FsrsCardMutation) SetEaseFactor(f float64) {
+	m.ease_factor = &f
+	m.addease_factor = nil
+}
+
+// EaseFactor returns the value of the "ease_factor" field in the mutation.
+func (m *FsrsCardMutation) EaseFactor() (r float64, exists bool) {
+	v := m.ease_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEaseFactor returns the old "ease_factor" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldEaseFactor(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEaseFactor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEaseFactor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEaseFactor: %w", err)
+	}
+	return oldValue.EaseFactor, nil
+}
+
+// AddEaseFactor adds f to the "ease_factor" field.
+func (m *FsrsCardMutation) AddEaseFactor(f float64) {
+	if m.addease_factor != nil {
+		*m.addease_factor += f
+	} else {
+		m.addease_factor = &f
+	}
+}
+
+// AddedEaseFactor returns the value that was added to the "ease_factor" field in this mutation.
+func (m *FsrsCardMutation) AddedEaseFactor() (r float64, exists bool) {
+	v := m.addease_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEaseFactor resets all changes to the "ease_factor" field.
+func (m *FsrsCardMutation) ResetEaseFactor() {
+	m.ease_factor = nil
+	m.addease_factor = nil
+}
+
+// SetLeitnerBox sets the "leitner_box" field.
+func (m *FsrsCardMutation) SetLeitnerBox(i int) {
+	m.leitner_box = &i
+	m.addleitner_box = nil
+}
+
+// LeitnerBox returns the value of the "leitner_box" field in the mutation.
+func (m *FsrsCardMutation) LeitnerBox() (r int, exists bool) {
+	v := m.leitner_box
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeitnerBox returns the old "leitner_box" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldLeitnerBox(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeitnerBox is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeitnerBox requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeitnerBox: %w", err)
+	}
+	return oldValue.LeitnerBox, nil
+}
+
+// AddLeitnerBox adds i to the "leitner_box" field.
+func (m *FsrsCardMutation) AddLeitnerBox(i int) {
+	if m.addleitner_box != nil {
+		*m.addleitner_box += i
+	} else {
+		m.addleitner_box = &i
+	}
+}
+
+// AddedLeitnerBox returns the value that was added to the "leitner_box" field in this mutation.
+func (m *FsrsCardMutation) AddedLeitnerBox() (r int, exists bool) {
+	v := m.addleitner_box
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLeitnerBox resets all changes to the "leitner_box" field.
+func (m *FsrsCardMutation) ResetLeitnerBox() {
+	m.leitner_box = nil
+	m.addleitner_box = nil
+}
+
+// SetIsLeech sets the "is_leech" field.
+func (m *FsrsCardMutation) SetIsLeech(b bool) {
+	m.is_leech = &b
+}
+
+// IsLeech returns the value of the "is_leech" field in the mutation.
+func (m *FsrsCardMutation) IsLeech() (r bool, exists bool) {
+	v := m.is_leech
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsLeech returns the old "is_leech" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldIsLeech(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLeech is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLeech requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLeech: %w", err)
+	}
+	return oldValue.IsLeech, nil
+}
+
+// ResetIsLeech resets all changes to the "is_leech" field.
+func (m *FsrsCardMutation) ResetIsLeech() {
+	m.is_leech = nil
+}
+
+// SetIsSuspended sets the "is_suspended" field.
+func (m *FsrsCardMutation) SetIsSuspended(b bool) {
+	m.is_suspended = &b
+}
+
+// IsSuspended returns the value of the "is_suspended" field in the mutation.
+func (m *FsrsCardMutation) IsSuspended() (r bool, exists bool) {
+	v := m.is_suspended
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSuspended returns the old "is_suspended" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldIsSuspended(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSuspended is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSuspended requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSuspended: %w", err)
+	}
+	return oldValue.IsSuspended, nil
+}
+
+// ResetIsSuspended resets all changes to the "is_suspended" field.
+func (m *FsrsCardMutation) ResetIsSuspended() {
+	m.is_suspended = nil
+}
+
+// SetBuriedUntil sets the "buried_until" field.
+func (m *FsrsCardMutation) SetBuriedUntil(t time.Time) {
+	m.buried_until = &t
+}
+
+// BuriedUntil returns the value of the "buried_until" field in the mutation.
+func (m *FsrsCardMutation) BuriedUntil() (r time.Time, exists bool) {
+	v := m.buried_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuriedUntil returns the old "buried_until" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldBuriedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuriedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuriedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuriedUntil: %w", err)
+	}
+	return oldValue.BuriedUntil, nil
+}
+
+// ClearBuriedUntil clears the value of the "buried_until" field.
+func (m *FsrsCardMutation) ClearBuriedUntil() {
+	m.buried_until = nil
+	m.clearedFields[fsrscard.FieldBuriedUntil] = struct{}{}
+}
+
+// BuriedUntilCleared returns if the "buried_until" field was cleared in this mutation.
+func (m *FsrsCardMutation) BuriedUntilCleared() bool {
+	_, ok := m.clearedFields[fsrscard.FieldBuriedUntil]
+	return ok
+}
+
+// ResetBuriedUntil resets all changes to the "buried_until" field.
+func (m *FsrsCardMutation) ResetBuriedUntil() {
+	m.buried_until = nil
+	delete(m.clearedFields, fsrscard.FieldBuriedUntil)
+}
+
+// SetVersion sets the "version" field.
+func (m *FsrsCardMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *FsrsCardMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the FsrsCard entity.
+// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsrsCardMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *FsrsCardMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *FsrsCardMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *FsrsCardMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (m *FsrsCardMutation) ClearNode() {
+	m.clearednode = true
+	m.clearedFields[fsrscard.FieldNodeID] = struct{}{}
+}
+
+// NodeCleared reports if the "node" edge to the Node entity was cleared.
+func (m *FsrsCardMutation) NodeCleared() bool {
+	return m.clearednode
+}
+
+// NodeIDs returns the "node" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NodeID instead. It exists only for internal usage by the builders.
+func (m *FsrsCardMutation) NodeIDs() (ids []uuid.UUID) {
+	if id := m.node; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNode resets all changes to the "node" edge.
+func (m *FsrsCardMutation) ResetNode() {
+	m.node = nil
+	m.clearednode = false
+}
+
+// AddAttemptIDs adds the "attempts" edge to the Attempt entity by ids.
+func (m *FsrsCardMutation) AddAttemptIDs(ids ...uuid.UUID) {
+	if m.attempts == nil {
+		m.attempts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attempts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttempts clears the "attempts" edge to the Attempt entity.
+func (m *FsrsCardMutation) ClearAttempts() {
+	m.clearedattempts = true
+}
+
+// AttemptsCleared reports if the "attempts" edge to the Attempt entity was cleared.
+func (m *FsrsCardMutation) AttemptsCleared() bool {
+	return m.clearedattempts
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to the Attempt entity by IDs.
+func (m *FsrsCardMutation) RemoveAttemptIDs(ids ...uuid.UUID) {
+	if m.removedattempts == nil {
+		m.removedattempts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attempts, ids[i])
+		m.removedattempts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttempts returns the removed IDs of the "attempts" edge to the Attempt entity.
+func (m *FsrsCardMutation) RemovedAttemptsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttemptsIDs returns the "attempts" edge IDs in the mutation.
+func (m *FsrsCardMutation) AttemptsIDs() (ids []uuid.UUID) {
+	for id := range m.attempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttempts resets all changes to the "attempts" edge.
+func (m *FsrsCardMutation) ResetAttempts() {
+	m.attempts = nil
+	m.clearedattempts = false
+	m.removedattempts = nil
+}
+
+// Where appends a list predicates to the FsrsCardMutation builder.
+func (m *FsrsCardMutation) Where(ps ...predicate.FsrsCard) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FsrsCardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FsrsCardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FsrsCard, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FsrsCardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FsrsCardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FsrsCard).
+func (m *FsrsCardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FsrsCardMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.stability != nil {
+		fields = append(fields, fsrscard.FieldStability)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, fsrscard.FieldDifficulty)
+	}
+	if m.elapsed_days != nil {
+		fields = append(fields, fsrscard.FieldElapsedDays)
+	}
+	if m.scheduled_days != nil {
+		fields = append(fields, fsrscard.FieldScheduledDays)
+	}
+	if m.reps != nil {
+		fields = append(fields, fsrscard.FieldReps)
+	}
+	if m.lapses != nil {
+		fields = append(fields, fsrscard.FieldLapses)
+	}
+	if m.state != nil {
+		fields = append(fields, fsrscard.FieldState)
+	}
+	if m.last_review != nil {
+		fields = append(fields, fsrscard.FieldLastReview)
+	}
+	if m.due != nil {
+		fields = append(fields, fsrscard.FieldDue)
+	}
+	if m.node != nil {
+		fields = append(fields, fsrscard.FieldNodeID)
+	}
+	if m.current_step != nil {
+		fields = append(fields, fsrscard.FieldCurrentStep)
+	}
+	if m.ease_factor != nil {
+		fields = append(fields, fsrscard.FieldEaseFactor)
+	}
+	if m.leitner_box != nil {
+		fields = append(fields, fsrscard.FieldLeitnerBox)
+	}
+	if m.is_leech != nil {
+		fields = append(fields, fsrscard.FieldIsLeech)
+	}
+	if m.is_suspended != nil {
+		fields = append(fields, fsrscard.FieldIsSuspended)
+	}
+	if m.buried_until != nil {
+		fields = append(fields, fsrscard.FieldBuriedUntil)
+	}
+	if m.version != nil {
+		fields = append(fields, fsrscard.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FsrsCardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fsrscard.FieldStability:
+		return m.Stability()
+	case fsrscard.FieldDifficulty:
+		return m.Difficulty()
+	case fsrscard.FieldElapsedDays:
+		return m.ElapsedDays()
+	case fsrscard.FieldScheduledDays:
+		return m.ScheduledDays()
+	case fsrscard.FieldReps:
+		return m.Reps()
+	case fsrscard.FieldLapses:
+		return m.Lapses()
+	case fsrscard.FieldState:
+		return m.State()
+	case fsrscard.FieldLastReview:
+		return m.LastReview()
+	case fsrscard.FieldDue:
+		return m.Due()
+	case fsrscard.FieldNodeID:
+		return m.NodeID()
+	case fsrscard.FieldCurrentStep:
+		return m.CurrentStep()
+	case fsrscard.FieldEaseFactor:
+		return m.EaseFactor()
+	case fsrscard.FieldLeitnerBox:
+		return m.LeitnerBox()
+	case fsrscard.FieldIsLeech:
+		return m.IsLeech()
+	case fsrscard.FieldIsSuspended:
+		return m.IsSuspended()
+	case fsrscard.FieldBuriedUntil:
+		return m.BuriedUntil()
+	case fsrscard.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FsrsCardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fsrscard.FieldStability:
+		return m.OldStability(ctx)
+	case fsrscard.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case fsrscard.FieldElapsedDays:
+		return m.OldElapsedDays(ctx)
+	case fsrscard.FieldScheduledDays:
+		return m.OldScheduledDays(ctx)
+	case fsrscard.FieldReps:
+		return m.OldReps(ctx)
+	case fsrscard.FieldLapses:
+		return m.OldLapses(ctx)
+	case fsrscard.FieldState:
+		return m.OldState(ctx)
+	case fsrscard.FieldLastReview:
+		return m.OldLastReview(ctx)
+	case fsrscard.FieldDue:
+		return m.OldDue(ctx)
+	case fsrscard.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case fsrscard.FieldCurrentStep:
+		return m.OldCurrentStep(ctx)
+	case fsrscard.FieldEaseFactor:
+		return m.OldEaseFactor(ctx)
+	case fsrscard.FieldLeitnerBox:
+		return m.OldLeitnerBox(ctx)
+	case fsrscard.FieldIsLeech:
+		return m.OldIsLeech(ctx)
+	case fsrscard.FieldIsSuspended:
+		return m.OldIsSuspended(ctx)
+	case fsrscard.FieldBuriedUntil:
+		return m.OldBuriedUntil(ctx)
+	case fsrscard.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown FsrsCard field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FsrsCardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fsrscard.FieldStability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStability(v)
+		return nil
+	case fsrscard.FieldDifficulty:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case fsrscard.FieldElapsedDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetElapsedDays(v)
+		return nil
+	case fsrscard.FieldScheduledDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledDays(v)
+		return nil
+	case fsrscard.FieldReps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReps(v)
+		return nil
+	case fsrscard.FieldLapses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLapses(v)
+		return nil
+	case fsrscard.FieldState:
+		v, ok := value.(fsrscard.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case fsrscard.FieldLastReview:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastReview(v)
+		return nil
+	case fsrscard.FieldDue:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDue(v)
+		return nil
+	case fsrscard.FieldNodeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	case fsrscard.FieldCurrentStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentStep(v)
+		return nil
+	case fsrscard.FieldEaseFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEaseFactor(v)
+		return nil
+	case fsrscard.FieldLeitnerBox:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeitnerBox(v)
+		return nil
+	case fsrscard.FieldIsLeech:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsLeech(v)
+		return nil
+	case fsrscard.FieldIsSuspended:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSuspended(v)
+		return nil
+	case fsrscard.FieldBuriedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuriedUntil(v)
+		return nil
+	case fsrscard.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FsrsCard field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FsrsCardMutation) AddedFields() []string {
+	var fields []string
+	if m.addstability != nil {
+		fields = append(fields, fsrscard.FieldStability)
+	}
+	if m.adddifficulty != nil {
+		fields = append(fields, fsrscard.FieldDifficulty)
+	}
+	if m.addelapsed_days != nil {
+		fields = append(fields, fsrscard.FieldElapsedDays)
+	}
+	if m.addscheduled_days != nil {
+		fields = append(fields, fsrscard.FieldScheduledDays)
+	}
+	if m.addreps != nil {
+		fields = append(fields, fsrscard.FieldReps)
+	}
+	if m.addlapses != nil {
+		fields = append(fields, fsrscard.FieldLapses)
+	}
+	if m.addcurrent_step != nil {
+		fields = append(fields, fsrscard.FieldCurrentStep)
+	}
+	if m.addease_factor != nil {
+		fields = append(fields, fsrscard.FieldEaseFactor)
+	}
+	if m.addleitner_box != nil {
+		fields = append(fields, fsrscard.FieldLeitnerBox)
+	}
+	if m.addversion != nil {
+		fields = append(fields, fsrscard.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FsrsCardMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fsrscard.FieldStability:
+		return m.AddedStability()
+	case fsrscard.FieldDifficulty:
+		return m.AddedDifficulty()
+	case fsrscard.FieldElapsedDays:
+		return m.AddedElapsedDays()
+	case fsrscard.FieldScheduledDays:
+		return m.AddedScheduledDays()
+	case fsrscard.FieldReps:
+		return m.AddedReps()
+	case fsrscard.FieldLapses:
+		return m.AddedLapses()
+	case fsrscard.FieldCurrentStep:
+		return m.AddedCurrentStep()
+	case fsrscard.FieldEaseFactor:
+		return m.AddedEaseFactor()
+	case fsrscard.FieldLeitnerBox:
+		return m.AddedLeitnerBox()
+	case fsrscard.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FsrsCardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fsrscard.FieldStability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStability(v)
+		return nil
+	case fsrscard.FieldDifficulty:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDifficulty(v)
+		return nil
+	case fsrscard.FieldElapsedDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddElapsedDays(v)
+		return nil
+	case fsrscard.FieldScheduledDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScheduledDays(v)
+		return nil
+	case fsrscard.FieldReps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReps(v)
+		return nil
+	case fsrscard.FieldLapses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLapses(v)
+		return nil
+	case fsrscard.FieldCurrentStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentStep(v)
+		return nil
+	case fsrscard.FieldEaseFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEaseFactor(v)
+		return nil
+	case fsrscard.FieldLeitnerBox:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeitnerBox(v)
+		return nil
+	case fsrscard.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FsrsCard numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FsrsCardMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fsrscard.FieldLastReview) {
+		fields = append(fields, fsrscard.FieldLastReview)
+	}
+	if m.FieldCleared(fsrscard.FieldBuriedUntil) {
+		fields = append(fields, fsrscard.FieldBuriedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FsrsCardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FsrsCardMutation) ClearField(name string) error {
+	switch name {
+	case fsrscard.FieldLastReview:
+		m.ClearLastReview()
+		return nil
+	case fsrscard.FieldBuriedUntil:
+		m.ClearBuriedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown FsrsCard nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FsrsCardMutation) ResetField(name string) error {
+	switch name {
+	case fsrscard.FieldStability:
+		m.ResetStability()
+		return nil
+	case fsrscard.FieldDifficulty:
+		m.ResetDifficulty()
+		return nil
+	case fsrscard.FieldElapsedDays:
+		m.ResetElapsedDays()
+		return nil
+	case fsrscard.FieldScheduledDays:
+		m.ResetScheduledDays()
+		return nil
+	case fsrscard.FieldReps:
+		m.ResetReps()
+		return nil
+	case fsrscard.FieldLapses:
+		m.ResetLapses()
+		return nil
+	case fsrscard.FieldState:
+		m.ResetState()
+		return nil
+	case fsrscard.FieldLastReview:
+		m.ResetLastReview()
+		return nil
+	case fsrscard.FieldDue:
+		m.ResetDue()
+		return nil
+	case fsrscard.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	case fsrscard.FieldCurrentStep:
+		m.ResetCurrentStep()
+		return nil
+	case fsrscard.FieldEaseFactor:
+		m.ResetEaseFactor()
+		return nil
+	case fsrscard.FieldLeitnerBox:
+		m.ResetLeitnerBox()
+		return nil
+	case fsrscard.FieldIsLeech:
+		m.ResetIsLeech()
+		return nil
+	case fsrscard.FieldIsSuspended:
+		m.ResetIsSuspended()
+		return nil
+	case fsrscard.FieldBuriedUntil:
+		m.ResetBuriedUntil()
+		return nil
+	case fsrscard.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown FsrsCard field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FsrsCardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.node != nil {
+		edges = append(edges, fsrscard.EdgeNode)
+	}
+	if m.attempts != nil {
+		edges = append(edges, fsrscard.EdgeAttempts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FsrsCardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fsrscard.EdgeNode:
+		if id := m.node; id != nil {
+			return []ent.Value{*id}
+		}
+	case fsrscard.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.attempts))
+		for id := range m.attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FsrsCardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattempts != nil {
+		edges = append(edges, fsrscard.EdgeAttempts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FsrsCardMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case fsrscard.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.removedattempts))
+		for id := range m.removedattempts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FsrsCardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearednode {
+		edges = append(edges, fsrscard.EdgeNode)
+	}
+	if m.clearedattempts {
+		edges = append(edges, fsrscard.EdgeAttempts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FsrsCardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fsrscard.EdgeNode:
+		return m.clearednode
+	case fsrscard.EdgeAttempts:
+		return m.clearedattempts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FsrsCardMutation) ClearEdge(name string) error {
+	switch name {
+	case fsrscard.EdgeNode:
+		m.ClearNode()
+		return nil
+	}
+	return fmt.Errorf("unknown FsrsCard unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FsrsCardMutation) ResetEdge(name string) error {
+	switch name {
+	case fsrscard.EdgeNode:
+		m.ResetNode()
+		return nil
+	case fsrscard.EdgeAttempts:
+		m.ResetAttempts()
+		return nil
+	}
+	return fmt.Errorf("unknown FsrsCard edge %s", name)
+}
+
+// NodeMutation represents an operation that mutates the Node nodes in the graph.
+type NodeMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	title                        *string
+	_type                        *node.Type
+	body                         *string
+	metadata                     *map[string]interface{}
+	created_at                   *time.Time
+	clearedFields                map[string]struct{}
+	parent                       *uuid.UUID
+	clearedparent                bool
+	children                     map[uuid.UUID]struct{}
+	removedchildren              map[uuid.UUID]struct{}
+	clearedchildren              bool
+	child_closures               map[int]struct{}
+	removedchild_closures        map[int]struct{}
+	clearedchild_closures        bool
+	parent_closures              map[int]struct{}
+	removedparent_closures       map[int]struct{}
+	clearedparent_closures       bool
+	outgoing_associations        map[int]struct{}
+	removedoutgoing_associations map[int]struct{}
+	clearedoutgoing_associations bool
+	incoming_associations        map[int]struct{}
+	removedincoming_associations map[int]struct{}
+	clearedincoming_associations bool
+	fsrs_card                    *uuid.UUID
+	clearedfsrs_card             bool
+	error_resolutions            map[uuid.UUID]struct{}
+	removederror_resolutions     map[uuid.UUID]struct{}
+	clearederror_resolutions     bool
+	preset                       *uuid.UUID
+	clearedpreset                bool
+	done                         bool
+	oldValue                     func(context.Context) (*Node, error)
+	predicates                   []predicate.Node
+}
+
+var _ ent.Mutation = (*NodeMutation)(nil)
+
+// nodeOption allows management of the mutation configuration using functional options.
+type nodeOption func(*NodeMutation)
+
+// newNodeMutation creates new mutation for the Node entity.
+func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
+	m := &NodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeID sets the ID field of the mutation.
+func withNodeID(id uuid.UUID) nodeOption {
+	return func(m *NodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Node
+		)
+		m.oldValue = func(ctx context.Context) (*Node, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Node.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNode sets the old Node of the mutation.
+func withNode(node *Node) nodeOption {
+	return func(m *NodeMutation) {
+		m.oldValue = func(context.Context) (*Node, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Node entities.
+func (m *NodeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *NodeMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NodeMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NodeMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetType sets the "type" field.
+func (m *NodeMutation) SetType(n node.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NodeMutation) GetType() (r node.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldType(ctx context.Context) (v node.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NodeMutation) ResetType() {
+	m._type = nil
+}
+
+// SetBody sets the "body" field.
+func (m *NodeMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *NodeMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *NodeMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[node.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *NodeMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[node.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *NodeMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, node.FieldBody)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *NodeMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *NodeMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *NodeMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[node.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *NodeMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[node.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *NodeMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, node.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *NodeMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *NodeMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *NodeMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[node.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *NodeMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[node.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *NodeMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, node.FieldParentID)
+}
+
+// SetPresetID sets the "preset_id" field.
+func (m *NodeMutation) SetPresetID(u uuid.UUID) {
+	m.preset = &u
+}
+
+// PresetID returns the value of the "preset_id" field in the mutation.
+func (m *NodeMutation) PresetID() (r uuid.UUID, exists bool) {
+	v := m.preset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPresetID returns the old "preset_id" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldPresetID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPresetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPresetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPresetID: %w", err)
+	}
+	return oldValue.PresetID, nil
+}
+
+// ClearPresetID clears the value of the "preset_id" field.
+func (m *NodeMutation) ClearPresetID() {
+	m.preset = nil
+	m.clearedFields[node.FieldPresetID] = struct{}{}
+}
+
+// PresetIDCleared returns if the "preset_id" field was cleared in this mutation.
+func (m *NodeMutation) PresetIDCleared() bool {
+	_, ok := m.clearedFields[node.FieldPresetID]
+	return ok
+}
+
+// ResetPresetID resets all changes to the "preset_id" field.
+func (m *NodeMutation) ResetPresetID() {
+	m.preset = nil
+	delete(m.clearedFields, node.FieldPresetID)
+}
+
+// ClearParent clears the "parent" edge to the Node entity.
+func (m *NodeMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[node.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Node entity was cleared.
+func (m *NodeMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *NodeMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *NodeMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Node entity by ids.
+func (m *NodeMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Node entity.
+func (m *NodeMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Node entity was cleared.
+func (m *NodeMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Node entity by IDs.
+func (m *NodeMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Node entity.
+func (m *NodeMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *NodeMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *NodeMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddChildClosureIDs adds the "child_closures" edge to the NodeClosure entity by ids.
+func (m *NodeMutation) AddChildClosureIDs(ids ...int) {
+	if m.child_closures == nil {
+		m.child_closures = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.child_closures[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildClosures clears the "child_closures" edge to the NodeClosure entity.
+func (m *NodeMutation) ClearChildClosures() {
+	m.clearedchild_closures = true
+}
+
+// ChildClosuresCleared reports if the "child_closures" edge to the NodeClosure entity was cleared.
+func (m *NodeMutation) ChildClosuresCleared() bool {
+	return m.clearedchild_closures
+}
+
+// RemoveChildClosureIDs removes the "child_closures" edge to the NodeClosure entity by IDs.
+func (m *NodeMutation) RemoveChildClosureIDs(ids ...int) {
+	if m.removedchild_closures == nil {
+		m.removedchild_closures = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.child_closures, ids[i])
+		m.removedchild_closures[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildClosures returns the removed IDs of the "child_closures" edge to the NodeClosure entity.
+func (m *NodeMutation) RemovedChildClosuresIDs() (ids []int) {
+	for id := range m.removedchild_closures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildClosuresIDs returns the "child_closures" edge IDs in the mutation.
+func (m *NodeMutation) ChildClosuresIDs() (ids []int) {
+	for id := range m.child_closures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildClosures resets all changes to the "child_closures" edge.
+func (m *NodeMutation) ResetChildClosures() {
+	m.child_closures = nil
+	m.clearedchild_closures = false
+	m.removedchild_closures = nil
+}
+
+// AddParentClosureIDs adds the "parent_closures" edge to the NodeClosure entity by ids.
+func (m *NodeMutation) AddParentClosureIDs(ids ...int) {
+	if m.parent_closures == nil {
+		m.parent_closures = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.parent_closures[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParentClosures clears the "parent_closures" edge to the NodeClosure entity.
+func (m *NodeMutation) ClearParentClosures() {
+	m.clearedparent_closures = true
+}
+
+// ParentClosuresCleared reports if the "parent_closures" edge to the NodeClosure entity was cleared.
+func (m *NodeMutation) ParentClosuresCleared() bool {
+	return m.clearedparent_closures
+}
+
+// RemoveParentClosureIDs removes the "parent_closures" edge to the NodeClosure entity by IDs.
+func (m *NodeMutation) RemoveParentClosureIDs(ids ...int) {
+	if m.removedparent_closures == nil {
+		m.removedparent_closures = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.parent_closures, ids[i])
+		m.removedparent_closures[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParentClosures returns the removed IDs of the "parent_closures" edge to the NodeClosure entity.
+func (m *NodeMutation) RemovedParentClosuresIDs() (ids []int) {
+	for id := range m.removedparent_closures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParentClosuresIDs returns the "parent_closures" edge IDs in the mutation.
+func (m *NodeMutation) ParentClosuresIDs() (ids []int) {
+	for id := range m.parent_closures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParentClosures resets all changes to the "parent_closures" edge.
+func (m *NodeMutation) ResetParentClosures() {
+	m.parent_closures = nil
+	m.clearedparent_closures = false
+	m.removedparent_closures = nil
+}
+
+// AddOutgoingAssociationIDs adds the "outgoing_associations" edge to the NodeAssociation entity by ids.
+func (m *NodeMutation) AddOutgoingAssociationIDs(ids ...int) {
+	if m.outgoing_associations == nil {
+		m.outgoing_associations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.outgoing_associations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOutgoingAssociations clears the "outgoing_associations" edge to the NodeAssociation entity.
+func (m *NodeMutation) ClearOutgoingAssociations() {
+	m.clearedoutgoing_associations = true
+}
+
+// OutgoingAssociationsCleared reports if the "outgoing_associations" edge to the NodeAssociation entity was cleared.
+func (m *NodeMutation) OutgoingAssociationsCleared() bool {
+	return m.clearedoutgoing_associations
+}
+
+// RemoveOutgoingAssociationIDs removes the "outgoing_associations" edge to the NodeAssociation entity by IDs.
+func (m *NodeMutation) RemoveOutgoingAssociationIDs(ids ...int) {
+	if m.removedoutgoing_associations == nil {
+		m.removedoutgoing_associations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.outgoing_associations, ids[i])
+		m.removedoutgoing_associations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOutgoingAssociations returns the removed IDs of the "outgoing_associations" edge to the NodeAssociation entity.
+func (m *NodeMutation) RemovedOutgoingAssociationsIDs() (ids []int) {
+	for id := range m.removedoutgoing_associations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OutgoingAssociationsIDs returns the "outgoing_associations" edge IDs in the mutation.
+func (m *NodeMutation) OutgoingAssociationsIDs() (ids []int) {
+	for id := range m.outgoing_associations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOutgoingAssociations resets all changes to the "outgoing_associations" edge.
+func (m *NodeMutation) ResetOutgoingAssociations() {
+	m.outgoing_associations = nil
+	m.clearedoutgoing_associations = false
+	m.removedoutgoing_associations = nil
+}
+
+// AddIncomingAssociationIDs adds the "incoming_associations" edge to the NodeAssociation entity by ids.
+func (m *NodeMutation) AddIncomingAssociationIDs(ids ...int) {
+	if m.incoming_associations == nil {
+		m.incoming_associations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.incoming_associations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIncomingAssociations clears the "incoming_associations" edge to the NodeAssociation entity.
+func (m *NodeMutation) ClearIncomingAssociations() {
+	m.clearedincoming_associations = true
+}
+
+// IncomingAssociationsCleared reports if the "incoming_associations" edge to the NodeAssociation entity was cleared.
+func (m *NodeMutation) IncomingAssociationsCleared() bool {
+	return m.clearedincoming_associations
+}
+
+// RemoveIncomingAssociationIDs removes the "incoming_associations" edge to the NodeAssociation entity by IDs.
+func (m *NodeMutation) RemoveIncomingAssociationIDs(ids ...int) {
+	if m.removedincoming_associations == nil {
+		m.removedincoming_associations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.incoming_associations, ids[i])
+		m.removedincoming_associations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIncomingAssociations returns the removed IDs of the "incoming_associations" edge to the NodeAssociation entity.
+func (m *NodeMutation) RemovedIncomingAssociationsIDs() (ids []int) {
+	for id := range m.removedincoming_associations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IncomingAssociationsIDs returns the "incoming_associations" edge IDs in the mutation.
+func (m *NodeMutation) IncomingAssociationsIDs() (ids []int) {
+	for id := range m.incoming_associations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIncomingAssociations resets all changes to the "incoming_associations" edge.
+func (m *NodeMutation) ResetIncomingAssociations() {
+	m.incoming_associations = nil
+	m.clearedincoming_associations = false
+	m.removedincoming_associations = nil
+}
+
+// SetFsrsCardID sets the "fsrs_card" edge to the FsrsCard entity by id.
+func (m *NodeMutation) SetFsrsCardID(id uuid.UUID) {
+	m.fsrs_card = &id
+}
+
+// ClearFsrsCard clears the "fsrs_card" edge to the FsrsCard entity.
+func (m *NodeMutation) ClearFsrsCard() {
+	m.clearedfsrs_card = true
+}
+
+// FsrsCardCleared reports if the "fsrs_card" edge to the FsrsCard entity was cleared.
+func (m *NodeMutation) FsrsCardCleared() bool {
+	return m.clearedfsrs_card
+}
+
+// FsrsCardID returns the "fsrs_card" edge ID in the mutation.
+func (m *NodeMutation) FsrsCardID() (id uuid.UUID, exists bool) {
+	if m.fsrs_card != nil {
+		return *m.fsrs_card, true
+	}
+	return
+}
+
+// FsrsCardIDs returns the "fsrs_card" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FsrsCardID instead. It exists only for internal usage by the builders.
+func (m *NodeMutation) FsrsCardIDs() (ids []uuid.UUID) {
+	if id := m.fsrs_card; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFsrsCard resets all changes to the "fsrs_card" edge.
+func (m *NodeMutation) ResetFsrsCard() {
+	m.fsrs_card = nil
+	m.clearedfsrs_card = false
+}
+
+// AddErrorResolutionIDs adds the "error_resolutions" edge to the ErrorResolution entity by ids.
+func (m *NodeMutation) AddErrorResolutionIDs(ids ...uuid.UUID) {
+	if m.error_resolutions == nil {
+		m.error_resolutions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.error_resolutions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearErrorResolutions clears the "error_resolutions" edge to the ErrorResolution entity.
+func (m *NodeMutation) ClearErrorResolutions() {
+	m.clearederror_resolutions = true
+}
+
+// ErrorResolutionsCleared reports if the "error_resolutions" edge to the ErrorResolution entity was cleared.
+func (m *NodeMutation) ErrorResolutionsCleared() bool {
+	return m.clearederror_resolutions
+}
+
+// RemoveErrorResolutionIDs removes the "error_resolutions" edge to the ErrorResolution entity by IDs.
+func (m *NodeMutation) RemoveErrorResolutionIDs(ids ...uuid.UUID) {
+	if m.removederror_resolutions == nil {
+		m.removederror_resolutions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.error_resolutions, ids[i])
+		m.removederror_resolutions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedErrorResolutions returns the removed IDs of the "error_resolutions" edge to the ErrorResolution entity.
+func (m *NodeMutation) RemovedErrorResolutionsIDs() (ids []uuid.UUID) {
+	for id := range m.removederror_resolutions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ErrorResolutionsIDs returns the "error_resolutions" edge IDs in the mutation.
+func (m *NodeMutation) ErrorResolutionsIDs() (ids []uuid.UUID) {
+	for id := range m.error_resolutions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetErrorResolutions resets all changes to the "error_resolutions" edge.
+func (m *NodeMutation) ResetErrorResolutions() {
+	m.error_resolutions = nil
+	m.clearederror_resolutions = false
+	m.removederror_resolutions = nil
+}
+
+// ClearPreset clears the "preset" edge to the SchedulerPreset entity.
+func (m *NodeMutation) ClearPreset() {
+	m.clearedpreset = true
+	m.clearedFields[node.FieldPresetID] = struct{}{}
+}
+
+// PresetCleared reports if the "preset" edge to the SchedulerPreset entity was cleared.
+func (m *NodeMutation) PresetCleared() bool {
+	return m.PresetIDCleared() || m.clearedpreset
+}
+
+// PresetIDs returns the "preset" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PresetID instead. It exists only for internal usage by the builders.
+func (m *NodeMutation) PresetIDs() (ids []uuid.UUID) {
+	if id := m.preset; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPreset resets all changes to the "preset" edge.
+func (m *NodeMutation) ResetPreset() {
+	m.preset = nil
+	m.clearedpreset = false
+}
+
+// Where appends a list predicates to the NodeMutation builder.
+func (m *NodeMutation) Where(ps ...predicate.Node) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Node).
+func (m *NodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.title != nil {
+		fields = append(fields, node.FieldTitle)
+	}
+	if m._type != nil {
+		fields = append(fields, node.FieldType)
+	}
+	if m.body != nil {
+		fields = append(fields, node.FieldBody)
+	}
+	if m.metadata != nil {
+		fields = append(fields, node.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, node.FieldCreatedAt)
+	}
+	if m.parent != nil {
+		fields = append(fields, node.FieldParentID)
+	}
+	if m.preset != nil {
+		fields = append(fields, node.FieldPresetID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case node.FieldTitle:
+		return m.Title()
+	case node.FieldType:
+		return m.GetType()
+	case node.FieldBody:
+		return m.Body()
+	case node.FieldMetadata:
+		return m.Metadata()
+	case node.FieldCreatedAt:
+		return m.CreatedAt()
+	case node.FieldParentID:
+		return m.ParentID()
+	case node.FieldPresetID:
+		return m.PresetID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case node.FieldTitle:
+		return m.OldTitle(ctx)
+	case node.FieldType:
+		return m.OldType(ctx)
+	case node.FieldBody:
+		return m.OldBody(ctx)
+	case node.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case node.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case node.FieldParentID:
+		return m.OldParentID(ctx)
+	case node.FieldPresetID:
+		return m.OldPresetID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Node field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case node.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case node.FieldType:
+		v, ok := value.(node.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case node.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case node.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case node.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case node.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case node.FieldPresetID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPresetID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Node numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(node.FieldBody) {
+		fields = append(fields, node.FieldBody)
+	}
+	if m.FieldCleared(node.FieldMetadata) {
+		fields = append(fields, node.FieldMetadata)
+	}
+	if m.FieldCleared(node.FieldParentID) {
+		fields = append(fields, node.FieldParentID)
+	}
+	if m.FieldCleared(node.FieldPresetID) {
+		fields = append(fields, node.FieldPresetID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeMutation) ClearField(name string) error {
+	switch name {
+	case node.FieldBody:
+		m.ClearBody()
+		return nil
+	case node.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case node.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case node.FieldPresetID:
+		m.ClearPresetID()
+		return nil
+	}
+	return fmt.Errorf("unknown Node nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeMutation) ResetField(name string) error {
+	switch name {
+	case node.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case node.FieldType:
+		m.ResetType()
+		return nil
+	case node.FieldBody:
+		m.ResetBody()
+		return nil
+	case node.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case node.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case node.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case node.FieldPresetID:
+		m.ResetPresetID()
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.parent != nil {
+		edges = append(edges, node.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, node.EdgeChildren)
+	}
+	if m.child_closures != nil {
+		edges = append(edges, node.EdgeChildClosures)
+	}
+	if m.parent_closures != nil {
+		edges = append(edges, node.EdgeParentClosures)
+	}
+	if m.outgoing_associations != nil {
+		edges = append(edges, node.EdgeOutgoingAssociations)
+	}
+	if m.incoming_associations != nil {
+		edges = append(edges, node.EdgeIncomingAssociations)
+	}
+	if m.fsrs_card != nil {
+		edges = append(edges, node.EdgeFsrsCard)
+	}
+	if m.error_resolutions != nil {
+		edges = append(edges, node.EdgeErrorResolutions)
+	}
+	if m.preset != nil {
+		edges = append(edges, node.EdgePreset)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case node.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case node.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeChildClosures:
+		ids := make([]ent.Value, 0, len(m.child_closures))
+		for id := range m.child_closures {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeParentClosures:
+		ids := make([]ent.Value, 0, len(m.parent_closures))
+		for id := range m.parent_closures {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeOutgoingAssociations:
+		ids := make([]ent.Value, 0, len(m.outgoing_associations))
+		for id := range m.outgoing_associations {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeIncomingAssociations:
+		ids := make([]ent.Value, 0, len(m.incoming_associations))
+		for id := range m.incoming_associations {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeFsrsCard:
+		if id := m.fsrs_card; id != nil {
+			return []ent.Value{*id}
+		}
+	case node.EdgeErrorResolutions:
+		ids := make([]ent.Value, 0, len(m.error_resolutions))
+		for id := range m.error_resolutions {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgePreset:
+		if id := m.preset; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedchildren != nil {
+		edges = append(edges, node.EdgeChildren)
+	}
+	if m.removedchild_closures != nil {
+		edges = append(edges, node.EdgeChildClosures)
+	}
+	if m.removedparent_closures != nil {
+		edges = append(edges, node.EdgeParentClosures)
+	}
+	if m.removedoutgoing_associations != nil {
+		edges = append(edges, node.EdgeOutgoingAssociations)
+	}
+	if m.removedincoming_associations != nil {
+		edges = append(edges, node.EdgeIncomingAssociations)
+	}
+	if m.removederror_resolutions != nil {
+		edges = append(edges, node.EdgeErrorResolutions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case node.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeChildClosures:
+		ids := make([]ent.Value, 0, len(m.removedchild_closures))
+		for id := range m.removedchild_closures {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeParentClosures:
+		ids := make([]ent.Value, 0, len(m.removedparent_closures))
+		for id := range m.removedparent_closures {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeOutgoingAssociations:
+		ids := make([]ent.Value, 0, len(m.removedoutgoing_associations))
+		for id := range m.removedoutgoing_associations {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeIncomingAssociations:
+		ids := make([]ent.Value, 0, len(m.removedincoming_associations))
+		for id := range m.removedincoming_associations {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgeErrorResolutions:
+		ids := make([]ent.Value, 0, len(m.removederror_resolutions))
+		for id := range m.removederror_resolutions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedparent {
+		edges = append(edges, node.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, node.EdgeChildren)
+	}
+	if m.clearedchild_closures {
+		edges = append(edges, node.EdgeChildClosures)
+	}
+	if m.clearedparent_closures {
+		edges = append(edges, node.EdgeParentClosures)
+	}
+	if m.clearedoutgoing_associations {
+		edges = append(edges, node.EdgeOutgoingAssociations)
+	}
+	if m.clearedincoming_associations {
+		edges = append(edges, node.EdgeIncomingAssociations)
+	}
+	if m.clearedfsrs_card {
+		edges = append(edges, node.EdgeFsrsCard)
+	}
+	if m.clearederror_resolutions {
+		edges = append(edges, node.EdgeErrorResolutions)
+	}
+	if m.clearedpreset {
+		edges = append(edges, node.EdgePreset)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case node.EdgeParent:
+		return m.clearedparent
+	case node.EdgeChildren:
+		return m.clearedchildren
+	case node.EdgeChildClosures:
+		return m.clearedchild_closures
+	case node.EdgeParentClosures:
+		return m.clearedparent_closures
+	case node.EdgeOutgoingAssociations:
+		return m.clearedoutgoing_associations
+	case node.EdgeIncomingAssociations:
+		return m.clearedincoming_associations
+	case node.EdgeFsrsCard:
+		return m.clearedfsrs_card
+	case node.EdgeErrorResolutions:
+		return m.clearederror_resolutions
+	case node.EdgePreset:
+		return m.clearedpreset
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeMutation) ClearEdge(name string) error {
+	switch name {
+	case node.EdgeParent:
+		m.ClearParent()
+		return nil
+	case node.EdgeFsrsCard:
+		m.ClearFsrsCard()
+		return nil
+	case node.EdgePreset:
+		m.ClearPreset()
+		return nil
+	}
+	return fmt.Errorf("unknown Node unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeMutation) ResetEdge(name string) error {
+	switch name {
+	case node.EdgeParent:
+		m.ResetParent()
+		return nil
+	case node.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case node.EdgeChildClosures:
+		m.ResetChildClosures()
+		return nil
+	case node.EdgeParentClosures:
+		m.ResetParentClosures()
+		return nil
+	case node.EdgeOutgoingAssociations:
+		m.ResetOutgoingAssociations()
+		return nil
+	case node.EdgeIncomingAssociations:
+		m.ResetIncomingAssociations()
+		return nil
+	case node.EdgeFsrsCard:
+		m.ResetFsrsCard()
+		return nil
+	case node.EdgeErrorResolutions:
+		m.ResetErrorResolutions()
+		return nil
+	case node.EdgePreset:
+		m.ResetPreset()
+		return nil
+	}
+	return fmt.Errorf("unknown Node edge %s", name)
+}
+
+// NodeAssociationMutation represents an operation that mutates the NodeAssociation nodes in the graph.
+type NodeAssociationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	rel_type      *nodeassociation.RelType
+	clearedFields map[string]struct{}
+	source        *uuid.UUID
+	clearedsource bool
+	target        *uuid.UUID
+	clearedtarget bool
+	done          bool
+	oldValue      func(context.Context) (*NodeAssociation, error)
+	predicates    []predicate.NodeAssociation
+}
+
+var _ ent.Mutation = (*NodeAssociationMutation)(nil)
+
+// nodeassociationOption allows management of the mutation configuration using functional options.
+type nodeassociationOption func(*NodeAssociationMutation)
+
+// newNodeAssociationMutation creates new mutation for the NodeAssociation entity.
+func newNodeAssociationMutation(c config, op Op, opts ...nodeassociationOption) *NodeAssociationMutation {
+	m := &NodeAssociationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNodeAssociation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeAssociationID sets the ID field of the mutation.
+func withNodeAssociationID(id int) nodeassociationOption {
+	return func(m *NodeAssociationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NodeAssociation
+		)
+		m.oldValue = func(ctx context.Context) (*NodeAssociation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NodeAssociation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNodeAssociation sets the old NodeAssociation of the mutation.
+func withNodeAssociation(node *NodeAssociation) nodeassociationOption {
+	return func(m *NodeAssociationMutation) {
+		m.oldValue = func(context.Context) (*NodeAssociation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeAssociationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeAssociationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeAssociationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeAssociationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NodeAssociation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *NodeAssociationMutation) SetSourceID(u uuid.UUID) {
+	m.source = &u
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *NodeAssociationMutation) SourceID() (r uuid.UUID, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the NodeAssociation entity.
+// If the NodeAssociation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeAssociationMutation) OldSourceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *NodeAssociationMutation) ResetSourceID() {
+	m.source = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *NodeAssociationMutation) SetTargetID(u uuid.UUID) {
+	m.target = &u
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *NodeAssociationMutation) TargetID() (r uuid.UUID, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the NodeAssociation entity.
+// If the NodeAssociation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeAssociationMutation) OldTargetID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *NodeAssociationMutation) ResetTargetID() {
+	m.target = nil
+}
+
+// SetRelType sets the "rel_type" field.
+func (m *NodeAssociationMutation) SetRelType(nt nodeassociation.RelType) {
+	m.rel_type = &nt
+}
+
+// RelType returns the value of the "rel_type" field in the mutation.
+func (m *NodeAssociationMutation) RelType() (r nodeassociation.RelType, exists bool) {
+	v := m.rel_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRelType returns the old "rel_type" field's value of the NodeAssociation entity.
+// If the NodeAssociation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeAssociationMutation) OldRelType(ctx context.Context) (v nodeassociation.RelType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelType: %w", err)
+	}
+	return oldValue.RelType, nil
+}
+
+// ResetRelType resets all changes to the "rel_type" field.
+func (m *NodeAssociationMutation) ResetRelType() {
+	m.rel_type = nil
+}
+
+// ClearSource clears the "source" edge to the Node entity.
+func (m *NodeAssociationMutation) ClearSource() {
+	m.clearedsource = true
+	m.clearedFields[nodeassociation.FieldSourceID] = struct{}{}
+}
+
+// SourceCleared reports if the "source" edge to the Node entity was cleared.
+func (m *NodeAssociationMutation) SourceCleared() bool {
+	return m.clearedsource
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *NodeAssociationMutation) SourceIDs() (ids []uuid.UUID) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *NodeAssociationMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// ClearTarget clears the "target" edge to the Node entity.
+func (m *NodeAssociationMutation) ClearTarget() {
+	m.clearedtarget = true
+	m.clearedFields[nodeassociation.FieldTargetID] = struct{}{}
+}
+
+// TargetCleared reports if the "target" edge to the Node entity was cleared.
+func (m *NodeAssociationMutation) TargetCleared() bool {
+	return m.clearedtarget
+}
+
+// TargetIDs returns the "target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetID instead. It exists only for internal usage by the builders.
+func (m *NodeAssociationMutation) TargetIDs() (ids []uuid.UUID) {
+	if id := m.target; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTarget resets all changes to the "target" edge.
+func (m *NodeAssociationMutation) ResetTarget() {
+	m.target = nil
+	m.clearedtarget = false
+}
+
+// Where appends a list predicates to the NodeAssociationMutation builder.
+func (m *NodeAssociationMutation) Where(ps ...predicate.NodeAssociation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NodeAssociationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeAssociationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NodeAssociation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NodeAssociationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NodeAssociationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NodeAssociation).
+func (m *NodeAssociationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeAssociationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.source != nil {
+		fields = append(fields, nodeassociation.FieldSourceID)
+	}
+	if m.target != nil {
+		fields = append(fields, nodeassociation.FieldTargetID)
+	}
+	if m.rel_type != nil {
+		fields = append(fields, nodeassociation.FieldRelType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeAssociationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nodeassociation.FieldSourceID:
+		return m.SourceID()
+	case nodeassociation.FieldTargetID:
+		return m.TargetID()
+	case nodeassociation.FieldRelType:
+		return m.RelType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeAssociationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nodeassociation.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case nodeassociation.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case nodeassociation.FieldRelType:
+		return m.OldRelType(ctx)
+	}
+	return nil, fmt.Errorf("unknown NodeAssociation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeAssociationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nodeassociation.FieldSourceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case nodeassociation.FieldTargetID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case nodeassociation.FieldRelType:
+		v, ok := value.(nodeassociation.RelType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NodeAssociation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeAssociationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeAssociationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeAssociationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NodeAssociation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeAssociationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeAssociationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeAssociationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NodeAssociation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeAssociationMutation) ResetField(name string) error {
+	switch name {
+	case nodeassociation.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case nodeassociation.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case nodeassociation.FieldRelType:
+		m.ResetRelType()
+		return nil
+	}
+	return fmt.Errorf("unknown NodeAssociation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeAssociationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.source != nil {
+		edges = append(edges, nodeassociation.EdgeSource)
+	}
+	if m.target != nil {
+		edges = append(edges, nodeassociation.EdgeTarget)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeAssociationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case nodeassociation.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case nodeassociation.EdgeTarget:
+		if id := m.target; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeAssociationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeAssociationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeAssociationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsource {
+		edges = append(edges, nodeassociation.EdgeSource)
+	}
+	if m.clearedtarget {
+		edges = append(edges, nodeassociation.EdgeTarget)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeAssociationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case nodeassociation.EdgeSource:
+		return m.clearedsource
+	case nodeassociation.EdgeTarget:
+		return m.clearedtarget
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeAssociationMutation) ClearEdge(name string) error {
+	switch name {
+	case nodeassociation.EdgeSource:
+		m.ClearSource()
+		return nil
+	case nodeassociation.EdgeTarget:
+		m.ClearTarget()
+		return nil
+	}
+	return fmt.Errorf("unknown NodeAssociation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeAssociationMutation) ResetEdge(name string) error {
+	switch name {
+	case nodeassociation.EdgeSource:
+		m.ResetSource()
+		return nil
+	case nodeassociation.EdgeTarget:
+		m.ResetTarget()
+		return nil
+	}
+	return fmt.Errorf("unknown NodeAssociation edge %s", name)
+}
+
+// NodeClosureMutation represents an operation that mutates the NodeClosure nodes in the graph.
+type NodeClosureMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	depth             *int
+	adddepth          *int
+	clearedFields     map[string]struct{}
+	ancestor          *uuid.UUID
+	clearedancestor   bool
+	descendant        *uuid.UUID
+	cleareddescendant bool
+	done              bool
+	oldValue          func(context.Context) (*NodeClosure, error)
+	predicates        []predicate.NodeClosure
+}
+
+var _ ent.Mutation = (*NodeClosureMutation)(nil)
+
+// nodeclosureOption allows management of the mutation configuration using functional options.
+type nodeclosureOption func(*NodeClosureMutation)
+
+// newNodeClosureMutation creates new mutation for the NodeClosure entity.
+func newNodeClosureMutation(c config, op Op, opts ...nodeclosureOption) *NodeClosureMutation {
+	m := &NodeClosureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNodeClosure,
+		clearedFields: make(map[string]struct{}),
 	}
-	return oldValue.NodeID, nil
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetNodeID resets all changes to the "node_id" field.
-func (m *FsrsCardMutation) ResetNodeID() {
-	m.node = nil
+// withNodeClosureID sets the ID field of the mutation.
+func withNodeClosureID(id int) nodeclosureOption {
+	return func(m *NodeClosureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NodeClosure
+		)
+		m.oldValue = func(ctx context.Context) (*NodeClosure, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NodeClosure.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetCardState sets the "card_state" field.
-func (m *FsrsCardMutation) SetCardState(s string) {
-	m.card_state = &s
+// withNodeClosure sets the old NodeClosure of the mutation.
+func withNodeClosure(node *NodeClosure) nodeclosureOption {
+	return func(m *NodeClosureMutation) {
+		m.oldValue = func(context.Context) (*NodeClosure, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeClosureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeClosureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeClosureMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeClosureMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NodeClosure.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAncestorID sets the "ancestor_id" field.
+func (m *NodeClosureMutation) SetAncestorID(u uuid.UUID) {
+	m.ancestor = &u
 }
 
-// CardState returns the value of the "card_state" field in the mutation.
-func (m *FsrsCardMutation) CardState() (r string, exists bool) {
-	v := m.card_state
+// AncestorID returns the value of the "ancestor_id" field in the mutation.
+func (m *NodeClosureMutation) AncestorID() (r uuid.UUID, exists bool) {
+	v := m.ancestor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCardState returns the old "card_state" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldAncestorID returns the old "ancestor_id" field's value of the NodeClosure entity.
+// If the NodeClosure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldCardState(ctx context.Context) (v string, err error) {
+func (m *NodeClosureMutation) OldAncestorID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCardState is only allowed on UpdateOne operations")
+		return v, errors.New("OldAncestorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCardState requires an ID field in the mutation")
+		return v, errors.New("OldAncestorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCardState: %w", err)
+		return v, fmt.Errorf("querying old value for OldAncestorID: %w", err)
 	}
-	return oldValue.CardState, nil
+	return oldValue.AncestorID, nil
 }
 
-// ResetCardState resets all changes to the "card_state" field.
-func (m *FsrsCardMutation) ResetCardState() {
-	m.card_state = nil
+// ResetAncestorID resets all changes to the "ancestor_id" field.
+func (m *NodeClosureMutation) ResetAncestorID() {
+	m.ancestor = nil
 }
 
-// SetCurrentStep sets the "current_step" field.
-func (m *FsrsCardMutation) SetCurrentStep(i int) {
-	m.current_step = &i
-	m.addcurrent_step = nil
+// SetDescendantID sets the "descendant_id" field.
+func (m *NodeClosureMutation) SetDescendantID(u uuid.UUID) {
+	m.descendant = &u
 }
 
-// CurrentStep returns the value of the "current_step" field in the mutation.
-func (m *FsrsCardMutation) CurrentStep() (r int, exists bool) {
-	v := m.current_step
+// DescendantID returns the value of the "descendant_id" field in the mutation.
+func (m *NodeClosureMutation) DescendantID() (r uuid.UUID, exists bool) {
+	v := m.descendant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrentStep returns the old "current_step" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldDescendantID returns the old "descendant_id" field's value of the NodeClosure entity.
+// If the NodeClosure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldCurrentStep(ctx context.Context) (v int, err error) {
+func (m *NodeClosureMutation) OldDescendantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentStep is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescendantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentStep requires an ID field in the mutation")
+		return v, errors.New("OldDescendantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentStep: %w", err)
-	}
-	return oldValue.CurrentStep, nil
-}
-
-// AddCurrentStep adds i to the "current_step" field.
-func (m *FsrsCardMutation) AddCurrentStep(i int) {
-	if m.addcurrent_step != nil {
-		*m.addcurrent_step += i
-	} else {
-		m.addcurrent_step = &i
-	}
-}
-
-// AddedCurrentStep returns the value that was added to the "current_step" field in this mutation.
-func (m *FsrsCardMutation) AddedCurrentStep() (r int, exists bool) {
-	v := m.addcurrent_step
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDescendantID: %w", err)
 	}
-	return *v, true
+	return oldValue.DescendantID, nil
 }
 
-// ResetCurrentStep resets all changes to the "current_step" field.
-func (m *FsrsCardMutation) ResetCurrentStep() {
-	m.current_step = nil
-	m.addcurrent_step = nil
+// ResetDescendantID resets all changes to the "descendant_id" field.
+func (m *NodeClosureMutation) ResetDescendantID() {
+	m.descendant = nil
 }
 
-// SetNextReview sets the "next_review" field.
-func (m *FsrsCardMutation) SetNextReview(t time.Time) {
-	m.next_review = &t
+// SetDepth sets the "depth" field.
+func (m *NodeClosureMutation) SetDepth(i int) {
+	m.depth = &i
+	m.adddepth = nil
 }
 
-// NextReview returns the value of the "next_review" field in the mutation.
-func (m *FsrsCardMutation) NextReview() (r time.Time, exists bool) {
-	v := m.next_review
+// Depth returns the value of the "depth" field in the mutation.
+func (m *NodeClosureMutation) Depth() (r int, exists bool) {
+	v := m.depth
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNextReview returns the old "next_review" field's value of the FsrsCard entity.
-// If the FsrsCard object wasn't provided to the builder, the object is fetched from the database.
+// OldDepth returns the old "depth" field's value of the NodeClosure entity.
+// If the NodeClosure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsrsCardMutation) OldNextReview(ctx context.Context) (v time.Time, err error) {
+func (m *NodeClosureMutation) OldDepth(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextReview is only allowed on UpdateOne operations")
+		return v, errors.New("OldDepth is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextReview requires an ID field in the mutation")
+		return v, errors.New("OldDepth requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextReview: %w", err)
+		return v, fmt.Errorf("querying old value for OldDepth: %w", err)
 	}
-	return oldValue.NextReview, nil
-}
-
-// ResetNextReview resets all changes to the "next_review" field.
-func (m *FsrsCardMutation) ResetNextReview() {
-	m.next_review = nil
-}
-
-// ClearNode clears the "node" edge to the Node entity.
-func (m *FsrsCardMutation) ClearNode() {
-	m.clearednode = true
-	m.clearedFields[fsrscard.FieldNodeID] = struct{}{}
+	return oldValue.Depth, nil
 }
 
-// NodeCleared reports if the "node" edge to the Node entity was cleared.
-func (m *FsrsCardMutation) NodeCleared() bool {
-	return m.clearednode
+// AddDepth adds i to the "depth" field.
+func (m *NodeClosureMutation) AddDepth(i int) {
+	if m.adddepth != nil {
+		*m.adddepth += i
+	} else {
+		m.adddepth = &i
+	}
 }
 
-// NodeIDs returns the "node" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NodeID instead. It exists only for internal usage by the builders.
-func (m *FsrsCardMutation) NodeIDs() (ids []uuid.UUID) {
-	if id := m.node; id != nil {
-		ids = append(ids, *id)
+// AddedDepth returns the value that was added to the "depth" field in this mutation.
+func (m *NodeClosureMutation) AddedDepth() (r int, exists bool) {
+	v := m.adddepth
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetNode resets all changes to the "node" edge.
-func (m *FsrsCardMutation) ResetNode() {
-	m.node = nil
-	m.clearednode = false
+// ResetDepth resets all changes to the "depth" field.
+func (m *NodeClosureMutation) ResetDepth() {
+	m.depth = nil
+	m.adddepth = nil
 }
 
-// AddAttemptIDs adds the "attempts" edge to the Attempt entity by ids.
-func (m *FsrsCardMutation) AddAttemptIDs(ids ...uuid.UUID) {
-	if m.attempts == nil {
-		m.attempts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.attempts[ids[i]] = struct{}{}
-	}
+// ClearAncestor clears the "ancestor" edge to the Node entity.
+func (m *NodeClosureMutation) ClearAncestor() {
+	m.clearedancestor = true
+	m.clearedFields[nodeclosure.FieldAncestorID] = struct{}{}
 }
 
-// ClearAttempts clears the "attempts" edge to the Attempt entity.
-func (m *FsrsCardMutation) ClearAttempts() {
-	m.clearedattempts = true
+// AncestorCleared reports if the "ancestor" edge to the Node entity was cleared.
+func (m *NodeClosureMutation) AncestorCleared() bool {
+	return m.clearedancestor
 }
 
-// AttemptsCleared reports if the "attempts" edge to the Attempt entity was cleared.
-func (m *FsrsCardMutation) AttemptsCleared() bool {
-	return m.clearedattempts
+// AncestorIDs returns the "ancestor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AncestorID instead. It exists only for internal usage by the builders.
+func (m *NodeClosureMutation) AncestorIDs() (ids []uuid.UUID) {
+	if id := m.ancestor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// RemoveAttemptIDs removes the "attempts" edge to the Attempt entity by IDs.
-func (m *FsrsCardMutation) RemoveAttemptIDs(ids ...uuid.UUID) {
-	if m.removedattempts == nil {
-		m.removedattempts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.attempts, ids[i])
-		m.removedattempts[ids[i]] = struct{}{}
-	}
+// ResetAncestor resets all changes to the "ancestor" edge.
+func (m *NodeClosureMutation) ResetAncestor() {
+	m.ancestor = nil
+	m.clearedancestor = false
 }
 
-// RemovedAttempts returns the removed IDs of the "attempts" edge to the Attempt entity.
-func (m *FsrsCardMutation) RemovedAttemptsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattempts {
-		ids = append(ids, id)
-	}
-	return
+// ClearDescendant clears the "descendant" edge to the Node entity.
+func (m *NodeClosureMutation) ClearDescendant() {
+	m.cleareddescendant = true
+	m.clearedFields[nodeclosure.FieldDescendantID] = struct{}{}
 }
 
-// AttemptsIDs returns the "attempts" edge IDs in the mutation.
-func (m *FsrsCardMutation) AttemptsIDs() (ids []uuid.UUID) {
-	for id := range m.attempts {
-		ids = append(ids, id)
+// DescendantCleared reports if the "descendant" edge to the Node entity was cleared.
+func (m *NodeClosureMutation) DescendantCleared() bool {
+	return m.cleareddescendant
+}
+
+// DescendantIDs returns the "descendant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DescendantID instead. It exists only for internal usage by the builders.
+func (m *NodeClosureMutation) DescendantIDs() (ids []uuid.UUID) {
+	if id := m.descendant; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAttempts resets all changes to the "attempts" edge.
-func (m *FsrsCardMutation) ResetAttempts() {
-	m.attempts = nil
-	m.clearedattempts = false
-	m.removedattempts = nil
+// ResetDescendant resets all changes to the "descendant" edge.
+func (m *NodeClosureMutation) ResetDescendant() {
+	m.descendant = nil
+	m.cleareddescendant = false
 }
 
-// Where appends a list predicates to the FsrsCardMutation builder.
-func (m *FsrsCardMutation) Where(ps ...predicate.FsrsCard) {
+// Where appends a list predicates to the NodeClosureMutation builder.
+func (m *NodeClosureMutation) Where(ps ...predicate.NodeClosure) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FsrsCardMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NodeClosureMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FsrsCardMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FsrsCard, len(ps))
+func (m *NodeClosureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NodeClosure, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3434,63 +8056,33 @@ func (m *FsrsCardMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FsrsCardMutation) Op() Op {
+func (m *NodeClosureMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FsrsCardMutation) SetOp(op Op) {
+func (m *NodeClosureMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FsrsCard).
-func (m *FsrsCardMutation) Type() string {
+// Type returns the node type of this mutation (NodeClosure).
+func (m *NodeClosureMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FsrsCardMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.stability != nil {
-		fields = append(fields, fsrscard.FieldStability)
-	}
-	if m.difficulty != nil {
-		fields = append(fields, fsrscard.FieldDifficulty)
-	}
-	if m.elapsed_days != nil {
-		fields = append(fields, fsrscard.FieldElapsedDays)
-	}
-	if m.scheduled_days != nil {
-		fields = append(fields, fsrscard.FieldScheduledDays)
-	}
-	if m.reps != nil {
-		fields = append(fields, fsrscard.FieldReps)
-	}
-	if m.lapses != nil {
-		fields = append(fields, fsrscard.FieldLapses)
-	}
-	if m.state != nil {
-		fields = append(fields, fsrscard.FieldState)
-	}
-	if m.last_review != nil {
-		fields = append(fields, fsrscard.FieldLastReview)
-	}
-	if m.due != nil {
-		fields = append(fields, fsrscard.FieldDue)
-	}
-	if m.node != nil {
-		fields = append(fields, fsrscard.FieldNodeID)
-	}
-	if m.card_state != nil {
-		fields = append(fields, fsrscard.FieldCardState)
+func (m *NodeClosureMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.ancestor != nil {
+		fields = append(fields, nodeclosure.FieldAncestorID)
 	}
-	if m.current_step != nil {
-		fields = append(fields, fsrscard.FieldCurrentStep)
+	if m.descendant != nil {
+		fields = append(fields, nodeclosure.FieldDescendantID)
 	}
-	if m.next_review != nil {
-		fields = append(fields, fsrscard.FieldNextReview)
+	if m.depth != nil {
+		fields = append(fields, nodeclosure.FieldDepth)
 	}
 	return fields
 }
@@ -3498,197 +8090,69 @@ func (m *FsrsCardMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FsrsCardMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case fsrscard.FieldStability:
-		return m.Stability()
-	case fsrscard.FieldDifficulty:
-		return m.Difficulty()
-	case fsrscard.FieldElapsedDays:
-		return m.ElapsedDays()
-	case fsrscard.FieldScheduledDays:
-		return m.ScheduledDays()
-	case fsrscard.FieldReps:
-		return m.Reps()
-	case fsrscard.FieldLapses:
-		return m.Lapses()
-	case fsrscard.FieldState:
-		return m.State()
-	case fsrscard.FieldLastReview:
-		return m.LastReview()
-	case fsrscard.FieldDue:
-		return m.Due()
-	case fsrscard.FieldNodeID:
-		return m.NodeID()
-	case fsrscard.FieldCardState:
-		return m.CardState()
-	case fsrscard.FieldCurrentStep:
-		return m.CurrentStep()
-	case fsrscard.FieldNextReview:
-		return m.NextReview()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *FsrsCardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case fsrscard.FieldStability:
-		return m.OldStability(ctx)
-	case fsrscard.FieldDifficulty:
-		return m.OldDifficulty(ctx)
-	case fsrscard.FieldElapsedDays:
-		return m.OldElapsedDays(ctx)
-	case fsrscard.FieldScheduledDays:
-		return m.OldScheduledDays(ctx)
-	case fsrscard.FieldReps:
-		return m.OldReps(ctx)
-	case fsrscard.FieldLapses:
-		return m.OldLapses(ctx)
-	case fsrscard.FieldState:
-		return m.OldState(ctx)
-	case fsrscard.FieldLastReview:
-		return m.OldLastReview(ctx)
-	case fsrscard.FieldDue:
-		return m.OldDue(ctx)
-	case fsrscard.FieldNodeID:
-		return m.OldNodeID(ctx)
-	case fsrscard.FieldCardState:
-		return m.OldCardState(ctx)
-	case fsrscard.FieldCurrentStep:
-		return m.OldCurrentStep(ctx)
-	case fsrscard.FieldNextReview:
-		return m.OldNextReview(ctx)
-	}
-	return nil, fmt.Errorf("unknown FsrsCard field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *FsrsCardMutation) SetField(name string, value ent.Value) error {
+func (m *NodeClosureMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case fsrscard.FieldStability:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStability(v)
-		return nil
-	case fsrscard.FieldDifficulty:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDifficulty(v)
-		return nil
-	case fsrscard.FieldElapsedDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetElapsedDays(v)
-		return nil
-	case fsrscard.FieldScheduledDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScheduledDays(v)
-		return nil
-	case fsrscard.FieldReps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReps(v)
-		return nil
-	case fsrscard.FieldLapses:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLapses(v)
-		return nil
-	case fsrscard.FieldState:
-		v, ok := value.(fsrscard.State)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetState(v)
-		return nil
-	case fsrscard.FieldLastReview:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastReview(v)
-		return nil
-	case fsrscard.FieldDue:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDue(v)
-		return nil
-	case fsrscard.FieldNodeID:
+	case nodeclosure.FieldAncestorID:
+		return m.AncestorID()
+	case nodeclosure.FieldDescendantID:
+		return m.DescendantID()
+	case nodeclosure.FieldDepth:
+		return m.Depth()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeClosureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nodeclosure.FieldAncestorID:
+		return m.OldAncestorID(ctx)
+	case nodeclosure.FieldDescendantID:
+		return m.OldDescendantID(ctx)
+	case nodeclosure.FieldDepth:
+		return m.OldDepth(ctx)
+	}
+	return nil, fmt.Errorf("unknown NodeClosure field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeClosureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nodeclosure.FieldAncestorID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNodeID(v)
+		m.SetAncestorID(v)
 		return nil
-	case fsrscard.FieldCardState:
-		v, ok := value.(string)
+	case nodeclosure.FieldDescendantID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCardState(v)
+		m.SetDescendantID(v)
 		return nil
-	case fsrscard.FieldCurrentStep:
+	case nodeclosure.FieldDepth:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrentStep(v)
-		return nil
-	case fsrscard.FieldNextReview:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNextReview(v)
+		m.SetDepth(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FsrsCard field %s", name)
+	return fmt.Errorf("unknown NodeClosure field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FsrsCardMutation) AddedFields() []string {
+func (m *NodeClosureMutation) AddedFields() []string {
 	var fields []string
-	if m.addstability != nil {
-		fields = append(fields, fsrscard.FieldStability)
-	}
-	if m.adddifficulty != nil {
-		fields = append(fields, fsrscard.FieldDifficulty)
-	}
-	if m.addelapsed_days != nil {
-		fields = append(fields, fsrscard.FieldElapsedDays)
-	}
-	if m.addscheduled_days != nil {
-		fields = append(fields, fsrscard.FieldScheduledDays)
-	}
-	if m.addreps != nil {
-		fields = append(fields, fsrscard.FieldReps)
-	}
-	if m.addlapses != nil {
-		fields = append(fields, fsrscard.FieldLapses)
-	}
-	if m.addcurrent_step != nil {
-		fields = append(fields, fsrscard.FieldCurrentStep)
+	if m.adddepth != nil {
+		fields = append(fields, nodeclosure.FieldDepth)
 	}
 	return fields
 }
@@ -3696,22 +8160,10 @@ func (m *FsrsCardMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FsrsCardMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NodeClosureMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case fsrscard.FieldStability:
-		return m.AddedStability()
-	case fsrscard.FieldDifficulty:
-		return m.AddedDifficulty()
-	case fsrscard.FieldElapsedDays:
-		return m.AddedElapsedDays()
-	case fsrscard.FieldScheduledDays:
-		return m.AddedScheduledDays()
-	case fsrscard.FieldReps:
-		return m.AddedReps()
-	case fsrscard.FieldLapses:
-		return m.AddedLapses()
-	case fsrscard.FieldCurrentStep:
-		return m.AddedCurrentStep()
+	case nodeclosure.FieldDepth:
+		return m.AddedDepth()
 	}
 	return nil, false
 }
@@ -3719,288 +8171,217 @@ func (m *FsrsCardMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FsrsCardMutation) AddField(name string, value ent.Value) error {
+func (m *NodeClosureMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case fsrscard.FieldStability:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStability(v)
-		return nil
-	case fsrscard.FieldDifficulty:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDifficulty(v)
-		return nil
-	case fsrscard.FieldElapsedDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddElapsedDays(v)
-		return nil
-	case fsrscard.FieldScheduledDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddScheduledDays(v)
-		return nil
-	case fsrscard.FieldReps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddReps(v)
-		return nil
-	case fsrscard.FieldLapses:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLapses(v)
-		return nil
-	case fsrscard.FieldCurrentStep:
+	case nodeclosure.FieldDepth:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCurrentStep(v)
+		m.AddDepth(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FsrsCard numeric field %s", name)
+	return fmt.Errorf("unknown NodeClosure numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FsrsCardMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(fsrscard.FieldLastReview) {
-		fields = append(fields, fsrscard.FieldLastReview)
-	}
-	return fields
+func (m *NodeClosureMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FsrsCardMutation) FieldCleared(name string) bool {
+func (m *NodeClosureMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FsrsCardMutation) ClearField(name string) error {
-	switch name {
-	case fsrscard.FieldLastReview:
-		m.ClearLastReview()
-		return nil
-	}
-	return fmt.Errorf("unknown FsrsCard nullable field %s", name)
+func (m *NodeClosureMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NodeClosure nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FsrsCardMutation) ResetField(name string) error {
+func (m *NodeClosureMutation) ResetField(name string) error {
 	switch name {
-	case fsrscard.FieldStability:
-		m.ResetStability()
-		return nil
-	case fsrscard.FieldDifficulty:
-		m.ResetDifficulty()
-		return nil
-	case fsrscard.FieldElapsedDays:
-		m.ResetElapsedDays()
-		return nil
-	case fsrscard.FieldScheduledDays:
-		m.ResetScheduledDays()
-		return nil
-	case fsrscard.FieldReps:
-		m.ResetReps()
-		return nil
-	case fsrscard.FieldLapses:
-		m.ResetLapses()
-		return nil
-	case fsrscard.FieldState:
-		m.ResetState()
-		return nil
-	case fsrscard.FieldLastReview:
-		m.ResetLastReview()
-		return nil
-	case fsrscard.FieldDue:
-		m.ResetDue()
-		return nil
-	case fsrscard.FieldNodeID:
-		m.ResetNodeID()
-		return nil
-	case fsrscard.FieldCardState:
-		m.ResetCardState()
+	case nodeclosure.FieldAncestorID:
+		m.ResetAncestorID()
 		return nil
-	case fsrscard.FieldCurrentStep:
-		m.ResetCurrentStep()
+	case nodeclosure.FieldDescendantID:
+		m.ResetDescendantID()
 		return nil
-	case fsrscard.FieldNextReview:
-		m.ResetNextReview()
+	case nodeclosure.FieldDepth:
+		m.ResetDepth()
 		return nil
 	}
-	return fmt.Errorf("unknown FsrsCard field %s", name)
+	return fmt.Errorf("unknown NodeClosure field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FsrsCardMutation) AddedEdges() []string {
+func (m *NodeClosureMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.node != nil {
-		edges = append(edges, fsrscard.EdgeNode)
+	if m.ancestor != nil {
+		edges = append(edges, nodeclosure.EdgeAncestor)
 	}
-	if m.attempts != nil {
-		edges = append(edges, fsrscard.EdgeAttempts)
+	if m.descendant != nil {
+		edges = append(edges, nodeclosure.EdgeDescendant)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FsrsCardMutation) AddedIDs(name string) []ent.Value {
+func (m *NodeClosureMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case fsrscard.EdgeNode:
-		if id := m.node; id != nil {
+	case nodeclosure.EdgeAncestor:
+		if id := m.ancestor; id != nil {
 			return []ent.Value{*id}
 		}
-	case fsrscard.EdgeAttempts:
-		ids := make([]ent.Value, 0, len(m.attempts))
-		for id := range m.attempts {
-			ids = append(ids, id)
+	case nodeclosure.EdgeDescendant:
+		if id := m.descendant; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FsrsCardMutation) RemovedEdges() []string {
+func (m *NodeClosureMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedattempts != nil {
-		edges = append(edges, fsrscard.EdgeAttempts)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FsrsCardMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case fsrscard.EdgeAttempts:
-		ids := make([]ent.Value, 0, len(m.removedattempts))
-		for id := range m.removedattempts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *NodeClosureMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FsrsCardMutation) ClearedEdges() []string {
+func (m *NodeClosureMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearednode {
-		edges = append(edges, fsrscard.EdgeNode)
+	if m.clearedancestor {
+		edges = append(edges, nodeclosure.EdgeAncestor)
 	}
-	if m.clearedattempts {
-		edges = append(edges, fsrscard.EdgeAttempts)
+	if m.cleareddescendant {
+		edges = append(edges, nodeclosure.EdgeDescendant)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FsrsCardMutation) EdgeCleared(name string) bool {
+func (m *NodeClosureMutation) EdgeCleared(name string) bool {
 	switch name {
-	case fsrscard.EdgeNode:
-		return m.clearednode
-	case fsrscard.EdgeAttempts:
-		return m.clearedattempts
+	case nodeclosure.EdgeAncestor:
+		return m.clearedancestor
+	case nodeclosure.EdgeDescendant:
+		return m.cleareddescendant
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FsrsCardMutation) ClearEdge(name string) error {
+func (m *NodeClosureMutation) ClearEdge(name string) error {
 	switch name {
-	case fsrscard.EdgeNode:
-		m.ClearNode()
+	case nodeclosure.EdgeAncestor:
+		m.ClearAncestor()
+		return nil
+	case nodeclosure.EdgeDescendant:
+		m.ClearDescendant()
 		return nil
 	}
-	return fmt.Errorf("unknown FsrsCard unique edge %s", name)
+	return fmt.Errorf("unknown NodeClosure unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FsrsCardMutation) ResetEdge(name string) error {
+func (m *NodeClosureMutation) ResetEdge(name string) error {
 	switch name {
-	case fsrscard.EdgeNode:
-		m.ResetNode()
+	case nodeclosure.EdgeAncestor:
+		m.ResetAncestor()
 		return nil
-	case fsrscard.EdgeAttempts:
-		m.ResetAttempts()
+	case nodeclosure.EdgeDescendant:
+		m.ResetDescendant()
 		return nil
 	}
-	return fmt.Errorf("unknown FsrsCard edge %s", name)
+	return fmt.Errorf("unknown NodeClosure edge %s", name)
 }
 
-// NodeMutation represents an operation that mutates the Node nodes in the graph.
-type NodeMutation struct {
+// SchedulerPresetMutation represents an operation that mutates the SchedulerPreset nodes in the graph.
+type SchedulerPresetMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uuid.UUID
-	title                        *string
-	_type                        *node.Type
-	body                         *string
-	metadata                     *map[string]interface{}
-	created_at                   *time.Time
-	clearedFields                map[string]struct{}
-	parent                       *uuid.UUID
-	clearedparent                bool
-	children                     map[uuid.UUID]struct{}
-	removedchildren              map[uuid.UUID]struct{}
-	clearedchildren              bool
-	child_closures               map[int]struct{}
-	removedchild_closures        map[int]struct{}
-	clearedchild_closures        bool
-	parent_closures              map[int]struct{}
-	removedparent_closures       map[int]struct{}
-	clearedparent_closures       bool
-	outgoing_associations        map[int]struct{}
-	removedoutgoing_associations map[int]struct{}
-	clearedoutgoing_associations bool
-	incoming_associations        map[int]struct{}
-	removedincoming_associations map[int]struct{}
-	clearedincoming_associations bool
-	fsrs_card                    *uuid.UUID
-	clearedfsrs_card             bool
-	error_resolutions            map[uuid.UUID]struct{}
-	removederror_resolutions     map[uuid.UUID]struct{}
-	clearederror_resolutions     bool
-	done                         bool
-	oldValue                     func(context.Context) (*Node, error)
-	predicates                   []predicate.Node
-}
-
-var _ ent.Mutation = (*NodeMutation)(nil)
-
-// nodeOption allows management of the mutation configuration using functional options.
-type nodeOption func(*NodeMutation)
-
-// newNodeMutation creates new mutation for the Node entity.
-func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
-	m := &NodeMutation{
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	name                          *string
+	scheduler                     *schedulerpreset.Scheduler
+	leitner_intervals             *[]int
+	appendleitner_intervals       []int
+	algorithm_version             *schedulerpreset.AlgorithmVersion
+	weights                       *[]float64
+	appendweights                 []float64
+	desired_retention             *float64
+	adddesired_retention          *float64
+	max_interval                  *int
+	addmax_interval               *int
+	enable_fuzz                   *bool
+	enable_load_balance           *bool
+	learning_steps                *[]int
+	appendlearning_steps          []int
+	relearning_steps              *[]int
+	appendrelearning_steps        []int
+	graduating_interval           *int
+	addgraduating_interval        *int
+	easy_interval                 *int
+	addeasy_interval              *int
+	leech_threshold               *int
+	addleech_threshold            *int
+	leech_suspend                 *bool
+	bury_translations             *bool
+	implicit_credit               *float64
+	addimplicit_credit            *float64
+	implicit_lapse_pull           *float64
+	addimplicit_lapse_pull        *float64
+	prerequisite_gate             *schedulerpreset.PrerequisiteGate
+	prerequisite_min_stability    *float64
+	addprerequisite_min_stability *float64
+	error_resolve_after           *int
+	adderror_resolve_after        *int
+	new_per_day                   *int
+	addnew_per_day                *int
+	reviews_per_day               *int
+	addreviews_per_day            *int
+	learn_ahead_minutes           *int
+	addlearn_ahead_minutes        *int
+	queue_order                   *schedulerpreset.QueueOrder
+	is_default                    *bool
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	nodes                         map[uuid.UUID]struct{}
+	removednodes                  map[uuid.UUID]struct{}
+	clearednodes                  bool
+	done                          bool
+	oldValue                      func(context.Context) (*SchedulerPreset, error)
+	predicates                    []predicate.SchedulerPreset
+}
+
+var _ ent.Mutation = (*SchedulerPresetMutation)(nil)
+
+// schedulerpresetOption allows management of the mutation configuration using functional options.
+type schedulerpresetOption func(*SchedulerPresetMutation)
+
+// newSchedulerPresetMutation creates new mutation for the SchedulerPreset entity.
+func newSchedulerPresetMutation(c config, op Op, opts ...schedulerpresetOption) *SchedulerPresetMutation {
+	m := &SchedulerPresetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNode,
+		typ:           TypeSchedulerPreset,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4009,20 +8390,20 @@ func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
 	return m
 }
 
-// withNodeID sets the ID field of the mutation.
-func withNodeID(id uuid.UUID) nodeOption {
-	return func(m *NodeMutation) {
+// withSchedulerPresetID sets the ID field of the mutation.
+func withSchedulerPresetID(id uuid.UUID) schedulerpresetOption {
+	return func(m *SchedulerPresetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Node
+			value *SchedulerPreset
 		)
-		m.oldValue = func(ctx context.Context) (*Node, error) {
+		m.oldValue = func(ctx context.Context) (*SchedulerPreset, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Node.Get(ctx, id)
+					value, err = m.Client().SchedulerPreset.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4031,10 +8412,10 @@ func withNodeID(id uuid.UUID) nodeOption {
 	}
 }
 
-// withNode sets the old Node of the mutation.
-func withNode(node *Node) nodeOption {
-	return func(m *NodeMutation) {
-		m.oldValue = func(context.Context) (*Node, error) {
+// withSchedulerPreset sets the old SchedulerPreset of the mutation.
+func withSchedulerPreset(node *SchedulerPreset) schedulerpresetOption {
+	return func(m *SchedulerPresetMutation) {
+		m.oldValue = func(context.Context) (*SchedulerPreset, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4043,7 +8424,7 @@ func withNode(node *Node) nodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NodeMutation) Client() *Client {
+func (m SchedulerPresetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4051,7 +8432,7 @@ func (m NodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NodeMutation) Tx() (*Tx, error) {
+func (m SchedulerPresetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4061,14 +8442,14 @@ func (m NodeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Node entities.
-func (m *NodeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SchedulerPreset entities.
+func (m *SchedulerPresetMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NodeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SchedulerPresetMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4079,7 +8460,7 @@ func (m *NodeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SchedulerPresetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4088,1430 +8469,1397 @@ func (m *NodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SchedulerPreset.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *NodeMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *SchedulerPresetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *NodeMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *SchedulerPresetMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *SchedulerPresetMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *NodeMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *SchedulerPresetMutation) ResetName() {
+	m.name = nil
 }
 
-// SetType sets the "type" field.
-func (m *NodeMutation) SetType(n node.Type) {
-	m._type = &n
+// SetScheduler sets the "scheduler" field.
+func (m *SchedulerPresetMutation) SetScheduler(s schedulerpreset.Scheduler) {
+	m.scheduler = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *NodeMutation) GetType() (r node.Type, exists bool) {
-	v := m._type
+// Scheduler returns the value of the "scheduler" field in the mutation.
+func (m *SchedulerPresetMutation) Scheduler() (r schedulerpreset.Scheduler, exists bool) {
+	v := m.scheduler
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduler returns the old "scheduler" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldType(ctx context.Context) (v node.Type, err error) {
+func (m *SchedulerPresetMutation) OldScheduler(ctx context.Context) (v schedulerpreset.Scheduler, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduler is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldScheduler requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduler: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Scheduler, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *NodeMutation) ResetType() {
-	m._type = nil
+// ResetScheduler resets all changes to the "scheduler" field.
+func (m *SchedulerPresetMutation) ResetScheduler() {
+	m.scheduler = nil
 }
 
-// SetBody sets the "body" field.
-func (m *NodeMutation) SetBody(s string) {
-	m.body = &s
+// SetLeitnerIntervals sets the "leitner_intervals" field.
+func (m *SchedulerPresetMutation) SetLeitnerIntervals(i []int) {
+	m.leitner_intervals = &i
+	m.appendleitner_intervals = nil
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *NodeMutation) Body() (r string, exists bool) {
-	v := m.body
+// LeitnerIntervals returns the value of the "leitner_intervals" field in the mutation.
+func (m *SchedulerPresetMutation) LeitnerIntervals() (r []int, exists bool) {
+	v := m.leitner_intervals
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldLeitnerIntervals returns the old "leitner_intervals" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *SchedulerPresetMutation) OldLeitnerIntervals(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldLeitnerIntervals is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldLeitnerIntervals requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldLeitnerIntervals: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.LeitnerIntervals, nil
 }
 
-// ClearBody clears the value of the "body" field.
-func (m *NodeMutation) ClearBody() {
-	m.body = nil
-	m.clearedFields[node.FieldBody] = struct{}{}
+// AppendLeitnerIntervals adds i to the "leitner_intervals" field.
+func (m *SchedulerPresetMutation) AppendLeitnerIntervals(i []int) {
+	m.appendleitner_intervals = append(m.appendleitner_intervals, i...)
 }
 
-// BodyCleared returns if the "body" field was cleared in this mutation.
-func (m *NodeMutation) BodyCleared() bool {
-	_, ok := m.clearedFields[node.FieldBody]
+// AppendedLeitnerIntervals returns the list of values that were appended to the "leitner_intervals" field in this mutation.
+func (m *SchedulerPresetMutation) AppendedLeitnerIntervals() ([]int, bool) {
+	if len(m.appendleitner_intervals) == 0 {
+		return nil, false
+	}
+	return m.appendleitner_intervals, true
+}
+
+// ClearLeitnerIntervals clears the value of the "leitner_intervals" field.
+func (m *SchedulerPresetMutation) ClearLeitnerIntervals() {
+	m.leitner_intervals = nil
+	m.appendleitner_intervals = nil
+	m.clearedFields[schedulerpreset.FieldLeitnerIntervals] = struct{}{}
+}
+
+// LeitnerIntervalsCleared returns if the "leitner_intervals" field was cleared in this mutation.
+func (m *SchedulerPresetMutation) LeitnerIntervalsCleared() bool {
+	_, ok := m.clearedFields[schedulerpreset.FieldLeitnerIntervals]
 	return ok
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *NodeMutation) ResetBody() {
-	m.body = nil
-	delete(m.clearedFields, node.FieldBody)
+// ResetLeitnerIntervals resets all changes to the "leitner_intervals" field.
+func (m *SchedulerPresetMutation) ResetLeitnerIntervals() {
+	m.leitner_intervals = nil
+	m.appendleitner_intervals = nil
+	delete(m.clearedFields, schedulerpreset.FieldLeitnerIntervals)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *NodeMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetAlgorithmVersion sets the "algorithm_version" field.
+func (m *SchedulerPresetMutation) SetAlgorithmVersion(sv schedulerpreset.AlgorithmVersion) {
+	m.algorithm_version = &sv
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *NodeMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// AlgorithmVersion returns the value of the "algorithm_version" field in the mutation.
+func (m *SchedulerPresetMutation) AlgorithmVersion() (r schedulerpreset.AlgorithmVersion, exists bool) {
+	v := m.algorithm_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldAlgorithmVersion returns the old "algorithm_version" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *SchedulerPresetMutation) OldAlgorithmVersion(ctx context.Context) (v schedulerpreset.AlgorithmVersion, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldAlgorithmVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldAlgorithmVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldAlgorithmVersion: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.AlgorithmVersion, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *NodeMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[node.FieldMetadata] = struct{}{}
-}
-
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *NodeMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[node.FieldMetadata]
-	return ok
-}
-
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *NodeMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, node.FieldMetadata)
+// ResetAlgorithmVersion resets all changes to the "algorithm_version" field.
+func (m *SchedulerPresetMutation) ResetAlgorithmVersion() {
+	m.algorithm_version = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NodeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetWeights sets the "weights" field.
+func (m *SchedulerPresetMutation) SetWeights(f []float64) {
+	m.weights = &f
+	m.appendweights = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Weights returns the value of the "weights" field in the mutation.
+func (m *SchedulerPresetMutation) Weights() (r []float64, exists bool) {
+	v := m.weights
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldWeights returns the old "weights" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SchedulerPresetMutation) OldWeights(ctx context.Context) (v []float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeights is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldWeights requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeights: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Weights, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NodeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AppendWeights adds f to the "weights" field.
+func (m *SchedulerPresetMutation) AppendWeights(f []float64) {
+	m.appendweights = append(m.appendweights, f...)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *NodeMutation) SetParentID(u uuid.UUID) {
-	m.parent = &u
+// AppendedWeights returns the list of values that were appended to the "weights" field in this mutation.
+func (m *SchedulerPresetMutation) AppendedWeights() ([]float64, bool) {
+	if len(m.appendweights) == 0 {
+		return nil, false
+	}
+	return m.appendweights, true
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *NodeMutation) ParentID() (r uuid.UUID, exists bool) {
-	v := m.parent
+// ResetWeights resets all changes to the "weights" field.
+func (m *SchedulerPresetMutation) ResetWeights() {
+	m.weights = nil
+	m.appendweights = nil
+}
+
+// SetDesiredRetention sets the "desired_retention" field.
+func (m *SchedulerPresetMutation) SetDesiredRetention(f float64) {
+	m.desired_retention = &f
+	m.adddesired_retention = nil
+}
+
+// DesiredRetention returns the value of the "desired_retention" field in the mutation.
+func (m *SchedulerPresetMutation) DesiredRetention() (r float64, exists bool) {
+	v := m.desired_retention
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldDesiredRetention returns the old "desired_retention" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *SchedulerPresetMutation) OldDesiredRetention(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDesiredRetention is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldDesiredRetention requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
-	}
-	return oldValue.ParentID, nil
-}
-
-// ClearParentID clears the value of the "parent_id" field.
-func (m *NodeMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[node.FieldParentID] = struct{}{}
-}
-
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *NodeMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[node.FieldParentID]
-	return ok
-}
-
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *NodeMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, node.FieldParentID)
-}
-
-// ClearParent clears the "parent" edge to the Node entity.
-func (m *NodeMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[node.FieldParentID] = struct{}{}
-}
-
-// ParentCleared reports if the "parent" edge to the Node entity was cleared.
-func (m *NodeMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
-}
-
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *NodeMutation) ParentIDs() (ids []uuid.UUID) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetParent resets all changes to the "parent" edge.
-func (m *NodeMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+		return v, fmt.Errorf("querying old value for OldDesiredRetention: %w", err)
+	}
+	return oldValue.DesiredRetention, nil
 }
 
-// AddChildIDs adds the "children" edge to the Node entity by ids.
-func (m *NodeMutation) AddChildIDs(ids ...uuid.UUID) {
-	if m.children == nil {
-		m.children = make(map[uuid.UUID]struct{})
+// AddDesiredRetention adds f to the "desired_retention" field.
+func (m *SchedulerPresetMutation) AddDesiredRetention(f float64) {
+	if m.adddesired_retention != nil {
+		*m.adddesired_retention += f
+	} else {
+		m.adddesired_retention = &f
 	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+}
+
+// AddedDesiredRetention returns the value that was added to the "desired_retention" field in this mutation.
+func (m *SchedulerPresetMutation) AddedDesiredRetention() (r float64, exists bool) {
+	v := m.adddesired_retention
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the Node entity.
-func (m *NodeMutation) ClearChildren() {
-	m.clearedchildren = true
+// ResetDesiredRetention resets all changes to the "desired_retention" field.
+func (m *SchedulerPresetMutation) ResetDesiredRetention() {
+	m.desired_retention = nil
+	m.adddesired_retention = nil
 }
 
-// ChildrenCleared reports if the "children" edge to the Node entity was cleared.
-func (m *NodeMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SetMaxInterval sets the "max_interval" field.
+func (m *SchedulerPresetMutation) SetMaxInterval(i int) {
+	m.max_interval = &i
+	m.addmax_interval = nil
 }
 
-// RemoveChildIDs removes the "children" edge to the Node entity by IDs.
-func (m *NodeMutation) RemoveChildIDs(ids ...uuid.UUID) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+// MaxInterval returns the value of the "max_interval" field in the mutation.
+func (m *SchedulerPresetMutation) MaxInterval() (r int, exists bool) {
+	v := m.max_interval
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Node entity.
-func (m *NodeMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// OldMaxInterval returns the old "max_interval" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldMaxInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxInterval is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxInterval: %w", err)
+	}
+	return oldValue.MaxInterval, nil
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *NodeMutation) ChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.children {
-		ids = append(ids, id)
+// AddMaxInterval adds i to the "max_interval" field.
+func (m *SchedulerPresetMutation) AddMaxInterval(i int) {
+	if m.addmax_interval != nil {
+		*m.addmax_interval += i
+	} else {
+		m.addmax_interval = &i
 	}
-	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *NodeMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// AddedMaxInterval returns the value that was added to the "max_interval" field in this mutation.
+func (m *SchedulerPresetMutation) AddedMaxInterval() (r int, exists bool) {
+	v := m.addmax_interval
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddChildClosureIDs adds the "child_closures" edge to the NodeClosure entity by ids.
-func (m *NodeMutation) AddChildClosureIDs(ids ...int) {
-	if m.child_closures == nil {
-		m.child_closures = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.child_closures[ids[i]] = struct{}{}
-	}
+// ResetMaxInterval resets all changes to the "max_interval" field.
+func (m *SchedulerPresetMutation) ResetMaxInterval() {
+	m.max_interval = nil
+	m.addmax_interval = nil
 }
 
-// ClearChildClosures clears the "child_closures" edge to the NodeClosure entity.
-func (m *NodeMutation) ClearChildClosures() {
-	m.clearedchild_closures = true
+// SetEnableFuzz sets the "enable_fuzz" field.
+func (m *SchedulerPresetMutation) SetEnableFuzz(b bool) {
+	m.enable_fuzz = &b
 }
 
-// ChildClosuresCleared reports if the "child_closures" edge to the NodeClosure entity was cleared.
-func (m *NodeMutation) ChildClosuresCleared() bool {
-	return m.clearedchild_closures
+// EnableFuzz returns the value of the "enable_fuzz" field in the mutation.
+func (m *SchedulerPresetMutation) EnableFuzz() (r bool, exists bool) {
+	v := m.enable_fuzz
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveChildClosureIDs removes the "child_closures" edge to the NodeClosure entity by IDs.
-func (m *NodeMutation) RemoveChildClosureIDs(ids ...int) {
-	if m.removedchild_closures == nil {
-		m.removedchild_closures = make(map[int]struct{})
+// OldEnableFuzz returns the old "enable_fuzz" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldEnableFuzz(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableFuzz is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.child_closures, ids[i])
-		m.removedchild_closures[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableFuzz requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableFuzz: %w", err)
 	}
+	return oldValue.EnableFuzz, nil
 }
 
-// RemovedChildClosures returns the removed IDs of the "child_closures" edge to the NodeClosure entity.
-func (m *NodeMutation) RemovedChildClosuresIDs() (ids []int) {
-	for id := range m.removedchild_closures {
-		ids = append(ids, id)
-	}
-	return
+// ResetEnableFuzz resets all changes to the "enable_fuzz" field.
+func (m *SchedulerPresetMutation) ResetEnableFuzz() {
+	m.enable_fuzz = nil
 }
 
-// ChildClosuresIDs returns the "child_closures" edge IDs in the mutation.
-func (m *NodeMutation) ChildClosuresIDs() (ids []int) {
-	for id := range m.child_closures {
-		ids = append(ids, id)
-	}
-	return
+// SetEnableLoadBalance sets the "enable_load_balance" field.
+func (m *SchedulerPresetMutation) SetEnableLoadBalance(b bool) {
+	m.enable_load_balance = &b
 }
 
-// ResetChildClosures resets all changes to the "child_closures" edge.
-func (m *NodeMutation) ResetChildClosures() {
-	m.child_closures = nil
-	m.clearedchild_closures = false
-	m.removedchild_closures = nil
+// EnableLoadBalance returns the value of the "enable_load_balance" field in the mutation.
+func (m *SchedulerPresetMutation) EnableLoadBalance() (r bool, exists bool) {
+	v := m.enable_load_balance
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddParentClosureIDs adds the "parent_closures" edge to the NodeClosure entity by ids.
-func (m *NodeMutation) AddParentClosureIDs(ids ...int) {
-	if m.parent_closures == nil {
-		m.parent_closures = make(map[int]struct{})
+// OldEnableLoadBalance returns the old "enable_load_balance" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldEnableLoadBalance(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableLoadBalance is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.parent_closures[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableLoadBalance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableLoadBalance: %w", err)
 	}
+	return oldValue.EnableLoadBalance, nil
 }
 
-// ClearParentClosures clears the "parent_closures" edge to the NodeClosure entity.
-func (m *NodeMutation) ClearParentClosures() {
-	m.clearedparent_closures = true
+// ResetEnableLoadBalance resets all changes to the "enable_load_balance" field.
+func (m *SchedulerPresetMutation) ResetEnableLoadBalance() {
+	m.enable_load_balance = nil
 }
 
-// ParentClosuresCleared reports if the "parent_closures" edge to the NodeClosure entity was cleared.
-func (m *NodeMutation) ParentClosuresCleared() bool {
-	return m.clearedparent_closures
+// SetLearningSteps sets the "learning_steps" field.
+func (m *SchedulerPresetMutation) SetLearningSteps(i []int) {
+	m.learning_steps = &i
+	m.appendlearning_steps = nil
 }
 
-// RemoveParentClosureIDs removes the "parent_closures" edge to the NodeClosure entity by IDs.
-func (m *NodeMutation) RemoveParentClosureIDs(ids ...int) {
-	if m.removedparent_closures == nil {
-		m.removedparent_closures = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.parent_closures, ids[i])
-		m.removedparent_closures[ids[i]] = struct{}{}
+// LearningSteps returns the value of the "learning_steps" field in the mutation.
+func (m *SchedulerPresetMutation) LearningSteps() (r []int, exists bool) {
+	v := m.learning_steps
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedParentClosures returns the removed IDs of the "parent_closures" edge to the NodeClosure entity.
-func (m *NodeMutation) RemovedParentClosuresIDs() (ids []int) {
-	for id := range m.removedparent_closures {
-		ids = append(ids, id)
+// OldLearningSteps returns the old "learning_steps" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldLearningSteps(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLearningSteps is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ParentClosuresIDs returns the "parent_closures" edge IDs in the mutation.
-func (m *NodeMutation) ParentClosuresIDs() (ids []int) {
-	for id := range m.parent_closures {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLearningSteps requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLearningSteps: %w", err)
+	}
+	return oldValue.LearningSteps, nil
 }
 
-// ResetParentClosures resets all changes to the "parent_closures" edge.
-func (m *NodeMutation) ResetParentClosures() {
-	m.parent_closures = nil
-	m.clearedparent_closures = false
-	m.removedparent_closures = nil
+// AppendLearningSteps adds i to the "learning_steps" field.
+func (m *SchedulerPresetMutation) AppendLearningSteps(i []int) {
+	m.appendlearning_steps = append(m.appendlearning_steps, i...)
 }
 
-// AddOutgoingAssociationIDs adds the "outgoing_associations" edge to the NodeAssociation entity by ids.
-func (m *NodeMutation) AddOutgoingAssociationIDs(ids ...int) {
-	if m.outgoing_associations == nil {
-		m.outgoing_associations = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.outgoing_associations[ids[i]] = struct{}{}
+// AppendedLearningSteps returns the list of values that were appended to the "learning_steps" field in this mutation.
+func (m *SchedulerPresetMutation) AppendedLearningSteps() ([]int, bool) {
+	if len(m.appendlearning_steps) == 0 {
+		return nil, false
 	}
+	return m.appendlearning_steps, true
 }
 
-// ClearOutgoingAssociations clears the "outgoing_associations" edge to the NodeAssociation entity.
-func (m *NodeMutation) ClearOutgoingAssociations() {
-	m.clearedoutgoing_associations = true
+// ResetLearningSteps resets all changes to the "learning_steps" field.
+func (m *SchedulerPresetMutation) ResetLearningSteps() {
+	m.learning_steps = nil
+	m.appendlearning_steps = nil
 }
 
-// OutgoingAssociationsCleared reports if the "outgoing_associations" edge to the NodeAssociation entity was cleared.
-func (m *NodeMutation) OutgoingAssociationsCleared() bool {
-	return m.clearedoutgoing_associations
+// SetRelearningSteps sets the "relearning_steps" field.
+func (m *SchedulerPresetMutation) SetRelearningSteps(i []int) {
+	m.relearning_steps = &i
+	m.appendrelearning_steps = nil
 }
 
-// RemoveOutgoingAssociationIDs removes the "outgoing_associations" edge to the NodeAssociation entity by IDs.
-func (m *NodeMutation) RemoveOutgoingAssociationIDs(ids ...int) {
-	if m.removedoutgoing_associations == nil {
-		m.removedoutgoing_associations = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.outgoing_associations, ids[i])
-		m.removedoutgoing_associations[ids[i]] = struct{}{}
+// RelearningSteps returns the value of the "relearning_steps" field in the mutation.
+func (m *SchedulerPresetMutation) RelearningSteps() (r []int, exists bool) {
+	v := m.relearning_steps
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedOutgoingAssociations returns the removed IDs of the "outgoing_associations" edge to the NodeAssociation entity.
-func (m *NodeMutation) RemovedOutgoingAssociationsIDs() (ids []int) {
-	for id := range m.removedoutgoing_associations {
-		ids = append(ids, id)
+// OldRelearningSteps returns the old "relearning_steps" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldRelearningSteps(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelearningSteps is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// OutgoingAssociationsIDs returns the "outgoing_associations" edge IDs in the mutation.
-func (m *NodeMutation) OutgoingAssociationsIDs() (ids []int) {
-	for id := range m.outgoing_associations {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelearningSteps requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelearningSteps: %w", err)
+	}
+	return oldValue.RelearningSteps, nil
 }
 
-// ResetOutgoingAssociations resets all changes to the "outgoing_associations" edge.
-func (m *NodeMutation) ResetOutgoingAssociations() {
-	m.outgoing_associations = nil
-	m.clearedoutgoing_associations = false
-	m.removedoutgoing_associations = nil
+// AppendRelearningSteps adds i to the "relearning_steps" field.
+func (m *SchedulerPresetMutation) AppendRelearningSteps(i []int) {
+	m.appendrelearning_steps = append(m.appendrelearning_steps, i...)
 }
 
-// AddIncomingAssociationIDs adds the "incoming_associations" edge to the NodeAssociation entity by ids.
-func (m *NodeMutation) AddIncomingAssociationIDs(ids ...int) {
-	if m.incoming_associations == nil {
-		m.incoming_associations = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.incoming_associations[ids[i]] = struct{}{}
+// AppendedRelearningSteps returns the list of values that were appended to the "relearning_steps" field in this mutation.
+func (m *SchedulerPresetMutation) AppendedRelearningSteps() ([]int, bool) {
+	if len(m.appendrelearning_steps) == 0 {
+		return nil, false
 	}
+	return m.appendrelearning_steps, true
 }
 
-// ClearIncomingAssociations clears the "incoming_associations" edge to the NodeAssociation entity.
-func (m *NodeMutation) ClearIncomingAssociations() {
-	m.clearedincoming_associations = true
+// ResetRelearningSteps resets all changes to the "relearning_steps" field.
+func (m *SchedulerPresetMutation) ResetRelearningSteps() {
+	m.relearning_steps = nil
+	m.appendrelearning_steps = nil
 }
 
-// IncomingAssociationsCleared reports if the "incoming_associations" edge to the NodeAssociation entity was cleared.
-func (m *NodeMutation) IncomingAssociationsCleared() bool {
-	return m.clearedincoming_associations
+// SetGraduatingInterval sets the "graduating_interval" field.
+func (m *SchedulerPresetMutation) SetGraduatingInterval(i int) {
+	m.graduating_interval = &i
+	m.addgraduating_interval = nil
 }
 
-// RemoveIncomingAssociationIDs removes the "incoming_associations" edge to the NodeAssociation entity by IDs.
-func (m *NodeMutation) RemoveIncomingAssociationIDs(ids ...int) {
-	if m.removedincoming_associations == nil {
-		m.removedincoming_associations = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.incoming_associations, ids[i])
-		m.removedincoming_associations[ids[i]] = struct{}{}
+// GraduatingInterval returns the value of the "graduating_interval" field in the mutation.
+func (m *SchedulerPresetMutation) GraduatingInterval() (r int, exists bool) {
+	v := m.graduating_interval
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedIncomingAssociations returns the removed IDs of the "incoming_associations" edge to the NodeAssociation entity.
-func (m *NodeMutation) RemovedIncomingAssociationsIDs() (ids []int) {
-	for id := range m.removedincoming_associations {
-		ids = append(ids, id)
+// OldGraduatingInterval returns the old "graduating_interval" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldGraduatingInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGraduatingInterval is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// IncomingAssociationsIDs returns the "incoming_associations" edge IDs in the mutation.
-func (m *NodeMutation) IncomingAssociationsIDs() (ids []int) {
-	for id := range m.incoming_associations {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGraduatingInterval requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGraduatingInterval: %w", err)
+	}
+	return oldValue.GraduatingInterval, nil
 }
-
-// ResetIncomingAssociations resets all changes to the "incoming_associations" edge.
-func (m *NodeMutation) ResetIncomingAssociations() {
-	m.incoming_associations = nil
-	m.clearedincoming_associations = false
-	m.removedincoming_associations = nil
+
+// AddGraduatingInterval adds i to the "graduating_interval" field.
+func (m *SchedulerPresetMutation) AddGraduatingInterval(i int) {
+	if m.addgraduating_interval != nil {
+		*m.addgraduating_interval += i
+	} else {
+		m.addgraduating_interval = &i
+	}
 }
 
-// SetFsrsCardID sets the "fsrs_card" edge to the FsrsCard entity by id.
-func (m *NodeMutation) SetFsrsCardID(id uuid.UUID) {
-	m.fsrs_card = &id
+// AddedGraduatingInterval returns the value that was added to the "graduating_interval" field in this mutation.
+func (m *SchedulerPresetMutation) AddedGraduatingInterval() (r int, exists bool) {
+	v := m.addgraduating_interval
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearFsrsCard clears the "fsrs_card" edge to the FsrsCard entity.
-func (m *NodeMutation) ClearFsrsCard() {
-	m.clearedfsrs_card = true
+// ResetGraduatingInterval resets all changes to the "graduating_interval" field.
+func (m *SchedulerPresetMutation) ResetGraduatingInterval() {
+	m.graduating_interval = nil
+	m.addgraduating_interval = nil
 }
 
-// FsrsCardCleared reports if the "fsrs_card" edge to the FsrsCard entity was cleared.
-func (m *NodeMutation) FsrsCardCleared() bool {
-	return m.clearedfsrs_card
+// SetEasyInterval sets the "easy_interval" field.
+func (m *SchedulerPresetMutation) SetEasyInterval(i int) {
+	m.easy_interval = &i
+	m.addeasy_interval = nil
 }
 
-// FsrsCardID returns the "fsrs_card" edge ID in the mutation.
-func (m *NodeMutation) FsrsCardID() (id uuid.UUID, exists bool) {
-	if m.fsrs_card != nil {
-		return *m.fsrs_card, true
+// EasyInterval returns the value of the "easy_interval" field in the mutation.
+func (m *SchedulerPresetMutation) EasyInterval() (r int, exists bool) {
+	v := m.easy_interval
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FsrsCardIDs returns the "fsrs_card" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FsrsCardID instead. It exists only for internal usage by the builders.
-func (m *NodeMutation) FsrsCardIDs() (ids []uuid.UUID) {
-	if id := m.fsrs_card; id != nil {
-		ids = append(ids, *id)
+// OldEasyInterval returns the old "easy_interval" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldEasyInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEasyInterval is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEasyInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEasyInterval: %w", err)
+	}
+	return oldValue.EasyInterval, nil
 }
 
-// ResetFsrsCard resets all changes to the "fsrs_card" edge.
-func (m *NodeMutation) ResetFsrsCard() {
-	m.fsrs_card = nil
-	m.clearedfsrs_card = false
+// AddEasyInterval adds i to the "easy_interval" field.
+func (m *SchedulerPresetMutation) AddEasyInterval(i int) {
+	if m.addeasy_interval != nil {
+		*m.addeasy_interval += i
+	} else {
+		m.addeasy_interval = &i
+	}
 }
 
-// AddErrorResolutionIDs adds the "error_resolutions" edge to the ErrorResolution entity by ids.
-func (m *NodeMutation) AddErrorResolutionIDs(ids ...uuid.UUID) {
-	if m.error_resolutions == nil {
-		m.error_resolutions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.error_resolutions[ids[i]] = struct{}{}
+// AddedEasyInterval returns the value that was added to the "easy_interval" field in this mutation.
+func (m *SchedulerPresetMutation) AddedEasyInterval() (r int, exists bool) {
+	v := m.addeasy_interval
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearErrorResolutions clears the "error_resolutions" edge to the ErrorResolution entity.
-func (m *NodeMutation) ClearErrorResolutions() {
-	m.clearederror_resolutions = true
+// ResetEasyInterval resets all changes to the "easy_interval" field.
+func (m *SchedulerPresetMutation) ResetEasyInterval() {
+	m.easy_interval = nil
+	m.addeasy_interval = nil
 }
 
-// ErrorResolutionsCleared reports if the "error_resolutions" edge to the ErrorResolution entity was cleared.
-func (m *NodeMutation) ErrorResolutionsCleared() bool {
-	return m.clearederror_resolutions
+// SetLeechThreshold sets the "leech_threshold" field.
+func (m *SchedulerPresetMutation) SetLeechThreshold(i int) {
+	m.leech_threshold = &i
+	m.addleech_threshold = nil
 }
 
-// RemoveErrorResolutionIDs removes the "error_resolutions" edge to the ErrorResolution entity by IDs.
-func (m *NodeMutation) RemoveErrorResolutionIDs(ids ...uuid.UUID) {
-	if m.removederror_resolutions == nil {
-		m.removederror_resolutions = make(map[uuid.UUID]struct{})
+// LeechThreshold returns the value of the "leech_threshold" field in the mutation.
+func (m *SchedulerPresetMutation) LeechThreshold() (r int, exists bool) {
+	v := m.leech_threshold
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.error_resolutions, ids[i])
-		m.removederror_resolutions[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLeechThreshold returns the old "leech_threshold" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldLeechThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeechThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeechThreshold requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeechThreshold: %w", err)
+	}
+	return oldValue.LeechThreshold, nil
 }
 
-// RemovedErrorResolutions returns the removed IDs of the "error_resolutions" edge to the ErrorResolution entity.
-func (m *NodeMutation) RemovedErrorResolutionsIDs() (ids []uuid.UUID) {
-	for id := range m.removederror_resolutions {
-		ids = append(ids, id)
+// AddLeechThreshold adds i to the "leech_threshold" field.
+func (m *SchedulerPresetMutation) AddLeechThreshold(i int) {
+	if m.addleech_threshold != nil {
+		*m.addleech_threshold += i
+	} else {
+		m.addleech_threshold = &i
 	}
-	return
 }
 
-// ErrorResolutionsIDs returns the "error_resolutions" edge IDs in the mutation.
-func (m *NodeMutation) ErrorResolutionsIDs() (ids []uuid.UUID) {
-	for id := range m.error_resolutions {
-		ids = append(ids, id)
+// AddedLeechThreshold returns the value that was added to the "leech_threshold" field in this mutation.
+func (m *SchedulerPresetMutation) AddedLeechThreshold() (r int, exists bool) {
+	v := m.addleech_threshold
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetErrorResolutions resets all changes to the "error_resolutions" edge.
-func (m *NodeMutation) ResetErrorResolutions() {
-	m.error_resolutions = nil
-	m.clearederror_resolutions = false
-	m.removederror_resolutions = nil
+// ResetLeechThreshold resets all changes to the "leech_threshold" field.
+func (m *SchedulerPresetMutation) ResetLeechThreshold() {
+	m.leech_threshold = nil
+	m.addleech_threshold = nil
 }
 
-// Where appends a list predicates to the NodeMutation builder.
-func (m *NodeMutation) Where(ps ...predicate.Node) {
-	m.predicates = append(m.predicates, ps...)
+// SetLeechSuspend sets the "leech_suspend" field.
+func (m *SchedulerPresetMutation) SetLeechSuspend(b bool) {
+	m.leech_suspend = &b
 }
 
-// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Node, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// LeechSuspend returns the value of the "leech_suspend" field in the mutation.
+func (m *SchedulerPresetMutation) LeechSuspend() (r bool, exists bool) {
+	v := m.leech_suspend
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NodeMutation) Op() Op {
-	return m.op
+// OldLeechSuspend returns the old "leech_suspend" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldLeechSuspend(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeechSuspend is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeechSuspend requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeechSuspend: %w", err)
+	}
+	return oldValue.LeechSuspend, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NodeMutation) SetOp(op Op) {
-	m.op = op
+// ResetLeechSuspend resets all changes to the "leech_suspend" field.
+func (m *SchedulerPresetMutation) ResetLeechSuspend() {
+	m.leech_suspend = nil
 }
 
-// Type returns the node type of this mutation (Node).
-func (m *NodeMutation) Type() string {
-	return m.typ
+// SetBuryTranslations sets the "bury_translations" field.
+func (m *SchedulerPresetMutation) SetBuryTranslations(b bool) {
+	m.bury_translations = &b
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NodeMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.title != nil {
-		fields = append(fields, node.FieldTitle)
-	}
-	if m._type != nil {
-		fields = append(fields, node.FieldType)
-	}
-	if m.body != nil {
-		fields = append(fields, node.FieldBody)
+// BuryTranslations returns the value of the "bury_translations" field in the mutation.
+func (m *SchedulerPresetMutation) BuryTranslations() (r bool, exists bool) {
+	v := m.bury_translations
+	if v == nil {
+		return
 	}
-	if m.metadata != nil {
-		fields = append(fields, node.FieldMetadata)
+	return *v, true
+}
+
+// OldBuryTranslations returns the old "bury_translations" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldBuryTranslations(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuryTranslations is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, node.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuryTranslations requires an ID field in the mutation")
 	}
-	if m.parent != nil {
-		fields = append(fields, node.FieldParentID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuryTranslations: %w", err)
 	}
-	return fields
+	return oldValue.BuryTranslations, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NodeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case node.FieldTitle:
-		return m.Title()
-	case node.FieldType:
-		return m.GetType()
-	case node.FieldBody:
-		return m.Body()
-	case node.FieldMetadata:
-		return m.Metadata()
-	case node.FieldCreatedAt:
-		return m.CreatedAt()
-	case node.FieldParentID:
-		return m.ParentID()
+// ResetBuryTranslations resets all changes to the "bury_translations" field.
+func (m *SchedulerPresetMutation) ResetBuryTranslations() {
+	m.bury_translations = nil
+}
+
+// SetImplicitCredit sets the "implicit_credit" field.
+func (m *SchedulerPresetMutation) SetImplicitCredit(f float64) {
+	m.implicit_credit = &f
+	m.addimplicit_credit = nil
+}
+
+// ImplicitCredit returns the value of the "implicit_credit" field in the mutation.
+func (m *SchedulerPresetMutation) ImplicitCredit() (r float64, exists bool) {
+	v := m.implicit_credit
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case node.FieldTitle:
-		return m.OldTitle(ctx)
-	case node.FieldType:
-		return m.OldType(ctx)
-	case node.FieldBody:
-		return m.OldBody(ctx)
-	case node.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case node.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case node.FieldParentID:
-		return m.OldParentID(ctx)
+// OldImplicitCredit returns the old "implicit_credit" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldImplicitCredit(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImplicitCredit is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Node field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImplicitCredit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImplicitCredit: %w", err)
+	}
+	return oldValue.ImplicitCredit, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NodeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case node.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case node.FieldType:
-		v, ok := value.(node.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case node.FieldBody:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBody(v)
-		return nil
-	case node.FieldMetadata:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case node.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case node.FieldParentID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParentID(v)
-		return nil
+// AddImplicitCredit adds f to the "implicit_credit" field.
+func (m *SchedulerPresetMutation) AddImplicitCredit(f float64) {
+	if m.addimplicit_credit != nil {
+		*m.addimplicit_credit += f
+	} else {
+		m.addimplicit_credit = &f
+	}
+}
+
+// AddedImplicitCredit returns the value that was added to the "implicit_credit" field in this mutation.
+func (m *SchedulerPresetMutation) AddedImplicitCredit() (r float64, exists bool) {
+	v := m.addimplicit_credit
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Node field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NodeMutation) AddedFields() []string {
-	return nil
+// ResetImplicitCredit resets all changes to the "implicit_credit" field.
+func (m *SchedulerPresetMutation) ResetImplicitCredit() {
+	m.implicit_credit = nil
+	m.addimplicit_credit = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetImplicitLapsePull sets the "implicit_lapse_pull" field.
+func (m *SchedulerPresetMutation) SetImplicitLapsePull(f float64) {
+	m.implicit_lapse_pull = &f
+	m.addimplicit_lapse_pull = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NodeMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ImplicitLapsePull returns the value of the "implicit_lapse_pull" field in the mutation.
+func (m *SchedulerPresetMutation) ImplicitLapsePull() (r float64, exists bool) {
+	v := m.implicit_lapse_pull
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Node numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *NodeMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(node.FieldBody) {
-		fields = append(fields, node.FieldBody)
+// OldImplicitLapsePull returns the old "implicit_lapse_pull" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldImplicitLapsePull(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImplicitLapsePull is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(node.FieldMetadata) {
-		fields = append(fields, node.FieldMetadata)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImplicitLapsePull requires an ID field in the mutation")
 	}
-	if m.FieldCleared(node.FieldParentID) {
-		fields = append(fields, node.FieldParentID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImplicitLapsePull: %w", err)
 	}
-	return fields
+	return oldValue.ImplicitLapsePull, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *NodeMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddImplicitLapsePull adds f to the "implicit_lapse_pull" field.
+func (m *SchedulerPresetMutation) AddImplicitLapsePull(f float64) {
+	if m.addimplicit_lapse_pull != nil {
+		*m.addimplicit_lapse_pull += f
+	} else {
+		m.addimplicit_lapse_pull = &f
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *NodeMutation) ClearField(name string) error {
-	switch name {
-	case node.FieldBody:
-		m.ClearBody()
-		return nil
-	case node.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case node.FieldParentID:
-		m.ClearParentID()
-		return nil
+// AddedImplicitLapsePull returns the value that was added to the "implicit_lapse_pull" field in this mutation.
+func (m *SchedulerPresetMutation) AddedImplicitLapsePull() (r float64, exists bool) {
+	v := m.addimplicit_lapse_pull
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Node nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NodeMutation) ResetField(name string) error {
-	switch name {
-	case node.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case node.FieldType:
-		m.ResetType()
-		return nil
-	case node.FieldBody:
-		m.ResetBody()
-		return nil
-	case node.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case node.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case node.FieldParentID:
-		m.ResetParentID()
-		return nil
-	}
-	return fmt.Errorf("unknown Node field %s", name)
+// ResetImplicitLapsePull resets all changes to the "implicit_lapse_pull" field.
+func (m *SchedulerPresetMutation) ResetImplicitLapsePull() {
+	m.implicit_lapse_pull = nil
+	m.addimplicit_lapse_pull = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.parent != nil {
-		edges = append(edges, node.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, node.EdgeChildren)
-	}
-	if m.child_closures != nil {
-		edges = append(edges, node.EdgeChildClosures)
-	}
-	if m.parent_closures != nil {
-		edges = append(edges, node.EdgeParentClosures)
-	}
-	if m.outgoing_associations != nil {
-		edges = append(edges, node.EdgeOutgoingAssociations)
+// SetPrerequisiteGate sets the "prerequisite_gate" field.
+func (m *SchedulerPresetMutation) SetPrerequisiteGate(sg schedulerpreset.PrerequisiteGate) {
+	m.prerequisite_gate = &sg
+}
+
+// PrerequisiteGate returns the value of the "prerequisite_gate" field in the mutation.
+func (m *SchedulerPresetMutation) PrerequisiteGate() (r schedulerpreset.PrerequisiteGate, exists bool) {
+	v := m.prerequisite_gate
+	if v == nil {
+		return
 	}
-	if m.incoming_associations != nil {
-		edges = append(edges, node.EdgeIncomingAssociations)
+	return *v, true
+}
+
+// OldPrerequisiteGate returns the old "prerequisite_gate" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldPrerequisiteGate(ctx context.Context) (v schedulerpreset.PrerequisiteGate, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrerequisiteGate is only allowed on UpdateOne operations")
 	}
-	if m.fsrs_card != nil {
-		edges = append(edges, node.EdgeFsrsCard)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrerequisiteGate requires an ID field in the mutation")
 	}
-	if m.error_resolutions != nil {
-		edges = append(edges, node.EdgeErrorResolutions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrerequisiteGate: %w", err)
 	}
-	return edges
+	return oldValue.PrerequisiteGate, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *NodeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case node.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case node.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeChildClosures:
-		ids := make([]ent.Value, 0, len(m.child_closures))
-		for id := range m.child_closures {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeParentClosures:
-		ids := make([]ent.Value, 0, len(m.parent_closures))
-		for id := range m.parent_closures {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeOutgoingAssociations:
-		ids := make([]ent.Value, 0, len(m.outgoing_associations))
-		for id := range m.outgoing_associations {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeIncomingAssociations:
-		ids := make([]ent.Value, 0, len(m.incoming_associations))
-		for id := range m.incoming_associations {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeFsrsCard:
-		if id := m.fsrs_card; id != nil {
-			return []ent.Value{*id}
-		}
-	case node.EdgeErrorResolutions:
-		ids := make([]ent.Value, 0, len(m.error_resolutions))
-		for id := range m.error_resolutions {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetPrerequisiteGate resets all changes to the "prerequisite_gate" field.
+func (m *SchedulerPresetMutation) ResetPrerequisiteGate() {
+	m.prerequisite_gate = nil
+}
+
+// SetPrerequisiteMinStability sets the "prerequisite_min_stability" field.
+func (m *SchedulerPresetMutation) SetPrerequisiteMinStability(f float64) {
+	m.prerequisite_min_stability = &f
+	m.addprerequisite_min_stability = nil
+}
+
+// PrerequisiteMinStability returns the value of the "prerequisite_min_stability" field in the mutation.
+func (m *SchedulerPresetMutation) PrerequisiteMinStability() (r float64, exists bool) {
+	v := m.prerequisite_min_stability
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.removedchildren != nil {
-		edges = append(edges, node.EdgeChildren)
+// OldPrerequisiteMinStability returns the old "prerequisite_min_stability" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldPrerequisiteMinStability(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrerequisiteMinStability is only allowed on UpdateOne operations")
 	}
-	if m.removedchild_closures != nil {
-		edges = append(edges, node.EdgeChildClosures)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrerequisiteMinStability requires an ID field in the mutation")
 	}
-	if m.removedparent_closures != nil {
-		edges = append(edges, node.EdgeParentClosures)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrerequisiteMinStability: %w", err)
 	}
-	if m.removedoutgoing_associations != nil {
-		edges = append(edges, node.EdgeOutgoingAssociations)
+	return oldValue.PrerequisiteMinStability, nil
+}
+
+// AddPrerequisiteMinStability adds f to the "prerequisite_min_stability" field.
+func (m *SchedulerPresetMutation) AddPrerequisiteMinStability(f float64) {
+	if m.addprerequisite_min_stability != nil {
+		*m.addprerequisite_min_stability += f
+	} else {
+		m.addprerequisite_min_stability = &f
 	}
-	if m.removedincoming_associations != nil {
-		edges = append(edges, node.EdgeIncomingAssociations)
+}
+
+// AddedPrerequisiteMinStability returns the value that was added to the "prerequisite_min_stability" field in this mutation.
+func (m *SchedulerPresetMutation) AddedPrerequisiteMinStability() (r float64, exists bool) {
+	v := m.addprerequisite_min_stability
+	if v == nil {
+		return
 	}
-	if m.removederror_resolutions != nil {
-		edges = append(edges, node.EdgeErrorResolutions)
+	return *v, true
+}
+
+// ResetPrerequisiteMinStability resets all changes to the "prerequisite_min_stability" field.
+func (m *SchedulerPresetMutation) ResetPrerequisiteMinStability() {
+	m.prerequisite_min_stability = nil
+	m.addprerequisite_min_stability = nil
+}
+
+// SetErrorResolveAfter sets the "error_resolve_after" field.
+func (m *SchedulerPresetMutation) SetErrorResolveAfter(i int) {
+	m.error_resolve_after = &i
+	m.adderror_resolve_after = nil
+}
+
+// ErrorResolveAfter returns the value of the "error_resolve_after" field in the mutation.
+func (m *SchedulerPresetMutation) ErrorResolveAfter() (r int, exists bool) {
+	v := m.error_resolve_after
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case node.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeChildClosures:
-		ids := make([]ent.Value, 0, len(m.removedchild_closures))
-		for id := range m.removedchild_closures {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeParentClosures:
-		ids := make([]ent.Value, 0, len(m.removedparent_closures))
-		for id := range m.removedparent_closures {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeOutgoingAssociations:
-		ids := make([]ent.Value, 0, len(m.removedoutgoing_associations))
-		for id := range m.removedoutgoing_associations {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeIncomingAssociations:
-		ids := make([]ent.Value, 0, len(m.removedincoming_associations))
-		for id := range m.removedincoming_associations {
-			ids = append(ids, id)
-		}
-		return ids
-	case node.EdgeErrorResolutions:
-		ids := make([]ent.Value, 0, len(m.removederror_resolutions))
-		for id := range m.removederror_resolutions {
-			ids = append(ids, id)
-		}
-		return ids
+// OldErrorResolveAfter returns the old "error_resolve_after" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldErrorResolveAfter(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorResolveAfter is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorResolveAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorResolveAfter: %w", err)
+	}
+	return oldValue.ErrorResolveAfter, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.clearedparent {
-		edges = append(edges, node.EdgeParent)
+// AddErrorResolveAfter adds i to the "error_resolve_after" field.
+func (m *SchedulerPresetMutation) AddErrorResolveAfter(i int) {
+	if m.adderror_resolve_after != nil {
+		*m.adderror_resolve_after += i
+	} else {
+		m.adderror_resolve_after = &i
 	}
-	if m.clearedchildren {
-		edges = append(edges, node.EdgeChildren)
+}
+
+// AddedErrorResolveAfter returns the value that was added to the "error_resolve_after" field in this mutation.
+func (m *SchedulerPresetMutation) AddedErrorResolveAfter() (r int, exists bool) {
+	v := m.adderror_resolve_after
+	if v == nil {
+		return
 	}
-	if m.clearedchild_closures {
-		edges = append(edges, node.EdgeChildClosures)
+	return *v, true
+}
+
+// ResetErrorResolveAfter resets all changes to the "error_resolve_after" field.
+func (m *SchedulerPresetMutation) ResetErrorResolveAfter() {
+	m.error_resolve_after = nil
+	m.adderror_resolve_after = nil
+}
+
+// SetNewPerDay sets the "new_per_day" field.
+func (m *SchedulerPresetMutation) SetNewPerDay(i int) {
+	m.new_per_day = &i
+	m.addnew_per_day = nil
+}
+
+// NewPerDay returns the value of the "new_per_day" field in the mutation.
+func (m *SchedulerPresetMutation) NewPerDay() (r int, exists bool) {
+	v := m.new_per_day
+	if v == nil {
+		return
 	}
-	if m.clearedparent_closures {
-		edges = append(edges, node.EdgeParentClosures)
+	return *v, true
+}
+
+// OldNewPerDay returns the old "new_per_day" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldNewPerDay(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewPerDay is only allowed on UpdateOne operations")
 	}
-	if m.clearedoutgoing_associations {
-		edges = append(edges, node.EdgeOutgoingAssociations)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewPerDay requires an ID field in the mutation")
 	}
-	if m.clearedincoming_associations {
-		edges = append(edges, node.EdgeIncomingAssociations)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewPerDay: %w", err)
 	}
-	if m.clearedfsrs_card {
-		edges = append(edges, node.EdgeFsrsCard)
+	return oldValue.NewPerDay, nil
+}
+
+// AddNewPerDay adds i to the "new_per_day" field.
+func (m *SchedulerPresetMutation) AddNewPerDay(i int) {
+	if m.addnew_per_day != nil {
+		*m.addnew_per_day += i
+	} else {
+		m.addnew_per_day = &i
 	}
-	if m.clearederror_resolutions {
-		edges = append(edges, node.EdgeErrorResolutions)
+}
+
+// AddedNewPerDay returns the value that was added to the "new_per_day" field in this mutation.
+func (m *SchedulerPresetMutation) AddedNewPerDay() (r int, exists bool) {
+	v := m.addnew_per_day
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NodeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case node.EdgeParent:
-		return m.clearedparent
-	case node.EdgeChildren:
-		return m.clearedchildren
-	case node.EdgeChildClosures:
-		return m.clearedchild_closures
-	case node.EdgeParentClosures:
-		return m.clearedparent_closures
-	case node.EdgeOutgoingAssociations:
-		return m.clearedoutgoing_associations
-	case node.EdgeIncomingAssociations:
-		return m.clearedincoming_associations
-	case node.EdgeFsrsCard:
-		return m.clearedfsrs_card
-	case node.EdgeErrorResolutions:
-		return m.clearederror_resolutions
+// ResetNewPerDay resets all changes to the "new_per_day" field.
+func (m *SchedulerPresetMutation) ResetNewPerDay() {
+	m.new_per_day = nil
+	m.addnew_per_day = nil
+}
+
+// SetReviewsPerDay sets the "reviews_per_day" field.
+func (m *SchedulerPresetMutation) SetReviewsPerDay(i int) {
+	m.reviews_per_day = &i
+	m.addreviews_per_day = nil
+}
+
+// ReviewsPerDay returns the value of the "reviews_per_day" field in the mutation.
+func (m *SchedulerPresetMutation) ReviewsPerDay() (r int, exists bool) {
+	v := m.reviews_per_day
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NodeMutation) ClearEdge(name string) error {
-	switch name {
-	case node.EdgeParent:
-		m.ClearParent()
-		return nil
-	case node.EdgeFsrsCard:
-		m.ClearFsrsCard()
-		return nil
+// OldReviewsPerDay returns the old "reviews_per_day" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldReviewsPerDay(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewsPerDay is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Node unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewsPerDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewsPerDay: %w", err)
+	}
+	return oldValue.ReviewsPerDay, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NodeMutation) ResetEdge(name string) error {
-	switch name {
-	case node.EdgeParent:
-		m.ResetParent()
-		return nil
-	case node.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case node.EdgeChildClosures:
-		m.ResetChildClosures()
-		return nil
-	case node.EdgeParentClosures:
-		m.ResetParentClosures()
-		return nil
-	case node.EdgeOutgoingAssociations:
-		m.ResetOutgoingAssociations()
-		return nil
-	case node.EdgeIncomingAssociations:
-		m.ResetIncomingAssociations()
-		return nil
-	case node.EdgeFsrsCard:
-		m.ResetFsrsCard()
-		return nil
-	case node.EdgeErrorResolutions:
-		m.ResetErrorResolutions()
-		return nil
+// AddReviewsPerDay adds i to the "reviews_per_day" field.
+func (m *SchedulerPresetMutation) AddReviewsPerDay(i int) {
+	if m.addreviews_per_day != nil {
+		*m.addreviews_per_day += i
+	} else {
+		m.addreviews_per_day = &i
 	}
-	return fmt.Errorf("unknown Node edge %s", name)
 }
 
-// NodeAssociationMutation represents an operation that mutates the NodeAssociation nodes in the graph.
-type NodeAssociationMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	rel_type      *nodeassociation.RelType
-	clearedFields map[string]struct{}
-	source        *uuid.UUID
-	clearedsource bool
-	target        *uuid.UUID
-	clearedtarget bool
-	done          bool
-	oldValue      func(context.Context) (*NodeAssociation, error)
-	predicates    []predicate.NodeAssociation
+// AddedReviewsPerDay returns the value that was added to the "reviews_per_day" field in this mutation.
+func (m *SchedulerPresetMutation) AddedReviewsPerDay() (r int, exists bool) {
+	v := m.addreviews_per_day
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-var _ ent.Mutation = (*NodeAssociationMutation)(nil)
+// ResetReviewsPerDay resets all changes to the "reviews_per_day" field.
+func (m *SchedulerPresetMutation) ResetReviewsPerDay() {
+	m.reviews_per_day = nil
+	m.addreviews_per_day = nil
+}
 
-// nodeassociationOption allows management of the mutation configuration using functional options.
-type nodeassociationOption func(*NodeAssociationMutation)
+// SetLearnAheadMinutes sets the "learn_ahead_minutes" field.
+func (m *SchedulerPresetMutation) SetLearnAheadMinutes(i int) {
+	m.learn_ahead_minutes = &i
+	m.addlearn_ahead_minutes = nil
+}
 
-// newNodeAssociationMutation creates new mutation for the NodeAssociation entity.
-func newNodeAssociationMutation(c config, op Op, opts ...nodeassociationOption) *NodeAssociationMutation {
-	m := &NodeAssociationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeNodeAssociation,
-		clearedFields: make(map[string]struct{}),
+// LearnAheadMinutes returns the value of the "learn_ahead_minutes" field in the mutation.
+func (m *SchedulerPresetMutation) LearnAheadMinutes() (r int, exists bool) {
+	v := m.learn_ahead_minutes
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldLearnAheadMinutes returns the old "learn_ahead_minutes" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldLearnAheadMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLearnAheadMinutes is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLearnAheadMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLearnAheadMinutes: %w", err)
+	}
+	return oldValue.LearnAheadMinutes, nil
 }
 
-// withNodeAssociationID sets the ID field of the mutation.
-func withNodeAssociationID(id int) nodeassociationOption {
-	return func(m *NodeAssociationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *NodeAssociation
-		)
-		m.oldValue = func(ctx context.Context) (*NodeAssociation, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().NodeAssociation.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddLearnAheadMinutes adds i to the "learn_ahead_minutes" field.
+func (m *SchedulerPresetMutation) AddLearnAheadMinutes(i int) {
+	if m.addlearn_ahead_minutes != nil {
+		*m.addlearn_ahead_minutes += i
+	} else {
+		m.addlearn_ahead_minutes = &i
 	}
 }
 
-// withNodeAssociation sets the old NodeAssociation of the mutation.
-func withNodeAssociation(node *NodeAssociation) nodeassociationOption {
-	return func(m *NodeAssociationMutation) {
-		m.oldValue = func(context.Context) (*NodeAssociation, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedLearnAheadMinutes returns the value that was added to the "learn_ahead_minutes" field in this mutation.
+func (m *SchedulerPresetMutation) AddedLearnAheadMinutes() (r int, exists bool) {
+	v := m.addlearn_ahead_minutes
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NodeAssociationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetLearnAheadMinutes resets all changes to the "learn_ahead_minutes" field.
+func (m *SchedulerPresetMutation) ResetLearnAheadMinutes() {
+	m.learn_ahead_minutes = nil
+	m.addlearn_ahead_minutes = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m NodeAssociationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetQueueOrder sets the "queue_order" field.
+func (m *SchedulerPresetMutation) SetQueueOrder(so schedulerpreset.QueueOrder) {
+	m.queue_order = &so
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *NodeAssociationMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// QueueOrder returns the value of the "queue_order" field in the mutation.
+func (m *SchedulerPresetMutation) QueueOrder() (r schedulerpreset.QueueOrder, exists bool) {
+	v := m.queue_order
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *NodeAssociationMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NodeAssociation.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldQueueOrder returns the old "queue_order" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchedulerPresetMutation) OldQueueOrder(ctx context.Context) (v schedulerpreset.QueueOrder, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueueOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueueOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueueOrder: %w", err)
 	}
+	return oldValue.QueueOrder, nil
+}
+
+// ResetQueueOrder resets all changes to the "queue_order" field.
+func (m *SchedulerPresetMutation) ResetQueueOrder() {
+	m.queue_order = nil
 }
 
-// SetSourceID sets the "source_id" field.
-func (m *NodeAssociationMutation) SetSourceID(u uuid.UUID) {
-	m.source = &u
+// SetIsDefault sets the "is_default" field.
+func (m *SchedulerPresetMutation) SetIsDefault(b bool) {
+	m.is_default = &b
 }
 
-// SourceID returns the value of the "source_id" field in the mutation.
-func (m *NodeAssociationMutation) SourceID() (r uuid.UUID, exists bool) {
-	v := m.source
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *SchedulerPresetMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceID returns the old "source_id" field's value of the NodeAssociation entity.
-// If the NodeAssociation object wasn't provided to the builder, the object is fetched from the database.
+// OldIsDefault returns the old "is_default" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeAssociationMutation) OldSourceID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SchedulerPresetMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceID requires an ID field in the mutation")
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
 	}
-	return oldValue.SourceID, nil
+	return oldValue.IsDefault, nil
 }
 
-// ResetSourceID resets all changes to the "source_id" field.
-func (m *NodeAssociationMutation) ResetSourceID() {
-	m.source = nil
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *SchedulerPresetMutation) ResetIsDefault() {
+	m.is_default = nil
 }
 
-// SetTargetID sets the "target_id" field.
-func (m *NodeAssociationMutation) SetTargetID(u uuid.UUID) {
-	m.target = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *SchedulerPresetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// TargetID returns the value of the "target_id" field in the mutation.
-func (m *NodeAssociationMutation) TargetID() (r uuid.UUID, exists bool) {
-	v := m.target
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SchedulerPresetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetID returns the old "target_id" field's value of the NodeAssociation entity.
-// If the NodeAssociation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeAssociationMutation) OldTargetID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SchedulerPresetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.TargetID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTargetID resets all changes to the "target_id" field.
-func (m *NodeAssociationMutation) ResetTargetID() {
-	m.target = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SchedulerPresetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRelType sets the "rel_type" field.
-func (m *NodeAssociationMutation) SetRelType(nt nodeassociation.RelType) {
-	m.rel_type = &nt
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SchedulerPresetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RelType returns the value of the "rel_type" field in the mutation.
-func (m *NodeAssociationMutation) RelType() (r nodeassociation.RelType, exists bool) {
-	v := m.rel_type
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SchedulerPresetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRelType returns the old "rel_type" field's value of the NodeAssociation entity.
-// If the NodeAssociation object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SchedulerPreset entity.
+// If the SchedulerPreset object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeAssociationMutation) OldRelType(ctx context.Context) (v nodeassociation.RelType, err error) {
+func (m *SchedulerPresetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRelType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRelType requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRelType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.RelType, nil
-}
-
-// ResetRelType resets all changes to the "rel_type" field.
-func (m *NodeAssociationMutation) ResetRelType() {
-	m.rel_type = nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearSource clears the "source" edge to the Node entity.
-func (m *NodeAssociationMutation) ClearSource() {
-	m.clearedsource = true
-	m.clearedFields[nodeassociation.FieldSourceID] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SchedulerPresetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SourceCleared reports if the "source" edge to the Node entity was cleared.
-func (m *NodeAssociationMutation) SourceCleared() bool {
-	return m.clearedsource
+// AddNodeIDs adds the "nodes" edge to the Node entity by ids.
+func (m *SchedulerPresetMutation) AddNodeIDs(ids ...uuid.UUID) {
+	if m.nodes == nil {
+		m.nodes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.nodes[ids[i]] = struct{}{}
+	}
 }
 
-// SourceIDs returns the "source" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SourceID instead. It exists only for internal usage by the builders.
-func (m *NodeAssociationMutation) SourceIDs() (ids []uuid.UUID) {
-	if id := m.source; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearNodes clears the "nodes" edge to the Node entity.
+func (m *SchedulerPresetMutation) ClearNodes() {
+	m.clearednodes = true
 }
 
-// ResetSource resets all changes to the "source" edge.
-func (m *NodeAssociationMutation) ResetSource() {
-	m.source = nil
-	m.clearedsource = false
+// NodesCleared reports if the "nodes" edge to the Node entity was cleared.
+func (m *SchedulerPresetMutation) NodesCleared() bool {
+	return m.clearednodes
 }
 
-// ClearTarget clears the "target" edge to the Node entity.
-func (m *NodeAssociationMutation) ClearTarget() {
-	m.clearedtarget = true
-	m.clearedFields[nodeassociation.FieldTargetID] = struct{}{}
+// RemoveNodeIDs removes the "nodes" edge to the Node entity by IDs.
+func (m *SchedulerPresetMutation) RemoveNodeIDs(ids ...uuid.UUID) {
+	if m.removednodes == nil {
+		m.removednodes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.nodes, ids[i])
+		m.removednodes[ids[i]] = struct{}{}
+	}
 }
 
-// TargetCleared reports if the "target" edge to the Node entity was cleared.
-func (m *NodeAssociationMutation) TargetCleared() bool {
-	return m.clearedtarget
+// RemovedNodes returns the removed IDs of the "nodes" edge to the Node entity.
+func (m *SchedulerPresetMutation) RemovedNodesIDs() (ids []uuid.UUID) {
+	for id := range m.removednodes {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// TargetIDs returns the "target" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TargetID instead. It exists only for internal usage by the builders.
-func (m *NodeAssociationMutation) TargetIDs() (ids []uuid.UUID) {
-	if id := m.target; id != nil {
-		ids = append(ids, *id)
+// NodesIDs returns the "nodes" edge IDs in the mutation.
+func (m *SchedulerPresetMutation) NodesIDs() (ids []uuid.UUID) {
+	for id := range m.nodes {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTarget resets all changes to the "target" edge.
-func (m *NodeAssociationMutation) ResetTarget() {
-	m.target = nil
-	m.clearedtarget = false
+// ResetNodes resets all changes to the "nodes" edge.
+func (m *SchedulerPresetMutation) ResetNodes() {
+	m.nodes = nil
+	m.clearednodes = false
+	m.removednodes = nil
 }
 
-// Where appends a list predicates to the NodeAssociationMutation builder.
-func (m *NodeAssociationMutation) Where(ps ...predicate.NodeAssociation) {
+// Where appends a list predicates to the SchedulerPresetMutation builder.
+func (m *SchedulerPresetMutation) Where(ps ...predicate.SchedulerPreset) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NodeAssociationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SchedulerPresetMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NodeAssociationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NodeAssociation, len(ps))
+func (m *SchedulerPresetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SchedulerPreset, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5519,33 +9867,108 @@ func (m *NodeAssociationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NodeAssociationMutation) Op() Op {
+func (m *SchedulerPresetMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NodeAssociationMutation) SetOp(op Op) {
+func (m *SchedulerPresetMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NodeAssociation).
-func (m *NodeAssociationMutation) Type() string {
+// Type returns the node type of this mutation (SchedulerPreset).
+func (m *SchedulerPresetMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NodeAssociationMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.source != nil {
-		fields = append(fields, nodeassociation.FieldSourceID)
+func (m *SchedulerPresetMutation) Fields() []string {
+	fields := make([]string, 0, 28)
+	if m.name != nil {
+		fields = append(fields, schedulerpreset.FieldName)
 	}
-	if m.target != nil {
-		fields = append(fields, nodeassociation.FieldTargetID)
+	if m.scheduler != nil {
+		fields = append(fields, schedulerpreset.FieldScheduler)
 	}
-	if m.rel_type != nil {
-		fields = append(fields, nodeassociation.FieldRelType)
+	if m.leitner_intervals != nil {
+		fields = append(fields, schedulerpreset.FieldLeitnerIntervals)
+	}
+	if m.algorithm_version != nil {
+		fields = append(fields, schedulerpreset.FieldAlgorithmVersion)
+	}
+	if m.weights != nil {
+		fields = append(fields, schedulerpreset.FieldWeights)
+	}
+	if m.desired_retention != nil {
+		fields = append(fields, schedulerpreset.FieldDesiredRetention)
+	}
+	if m.max_interval != nil {
+		fields = append(fields, schedulerpreset.FieldMaxInterval)
+	}
+	if m.enable_fuzz != nil {
+		fields = append(fields, schedulerpreset.FieldEnableFuzz)
+	}
+	if m.enable_load_balance != nil {
+		fields = append(fields, schedulerpreset.FieldEnableLoadBalance)
+	}
+	if m.learning_steps != nil {
+		fields = append(fields, schedulerpreset.FieldLearningSteps)
+	}
+	if m.relearning_steps != nil {
+		fields = append(fields, schedulerpreset.FieldRelearningSteps)
+	}
+	if m.graduating_interval != nil {
+		fields = append(fields, schedulerpreset.FieldGraduatingInterval)
+	}
+	if m.easy_interval != nil {
+		fields = append(fields, schedulerpreset.FieldEasyInterval)
+	}
+	if m.leech_threshold != nil {
+		fields = append(fields, schedulerpreset.FieldLeechThreshold)
+	}
+	if m.leech_suspend != nil {
+		fields = append(fields, schedulerpreset.FieldLeechSuspend)
+	}
+	if m.bury_translations != nil {
+		fields = append(fields, schedulerpreset.FieldBuryTranslations)
+	}
+	if m.implicit_credit != nil {
+		fields = append(fields, schedulerpreset.FieldImplicitCredit)
+	}
+	if m.implicit_lapse_pull != nil {
+		fields = append(fields, schedulerpreset.FieldImplicitLapsePull)
+	}
+	if m.prerequisite_gate != nil {
+		fields = append(fields, schedulerpreset.FieldPrerequisiteGate)
+	}
+	if m.prerequisite_min_stability != nil {
+		fields = append(fields, schedulerpreset.FieldPrerequisiteMinStability)
+	}
+	if m.error_resolve_after != nil {
+		fields = append(fields, schedulerpreset.FieldErrorResolveAfter)
+	}
+	if m.new_per_day != nil {
+		fields = append(fields, schedulerpreset.FieldNewPerDay)
+	}
+	if m.reviews_per_day != nil {
+		fields = append(fields, schedulerpreset.FieldReviewsPerDay)
+	}
+	if m.learn_ahead_minutes != nil {
+		fields = append(fields, schedulerpreset.FieldLearnAheadMinutes)
+	}
+	if m.queue_order != nil {
+		fields = append(fields, schedulerpreset.FieldQueueOrder)
+	}
+	if m.is_default != nil {
+		fields = append(fields, schedulerpreset.FieldIsDefault)
+	}
+	if m.created_at != nil {
+		fields = append(fields, schedulerpreset.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, schedulerpreset.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5553,14 +9976,64 @@ func (m *NodeAssociationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NodeAssociationMutation) Field(name string) (ent.Value, bool) {
+func (m *SchedulerPresetMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case nodeassociation.FieldSourceID:
-		return m.SourceID()
-	case nodeassociation.FieldTargetID:
-		return m.TargetID()
-	case nodeassociation.FieldRelType:
-		return m.RelType()
+	case schedulerpreset.FieldName:
+		return m.Name()
+	case schedulerpreset.FieldScheduler:
+		return m.Scheduler()
+	case schedulerpreset.FieldLeitnerIntervals:
+		return m.LeitnerIntervals()
+	case schedulerpreset.FieldAlgorithmVersion:
+		return m.AlgorithmVersion()
+	case schedulerpreset.FieldWeights:
+		return m.Weights()
+	case schedulerpreset.FieldDesiredRetention:
+		return m.DesiredRetention()
+	case schedulerpreset.FieldMaxInterval:
+		return m.MaxInterval()
+	case schedulerpreset.FieldEnableFuzz:
+		return m.EnableFuzz()
+	case schedulerpreset.FieldEnableLoadBalance:
+		return m.EnableLoadBalance()
+	case schedulerpreset.FieldLearningSteps:
+		return m.LearningSteps()
+	case schedulerpreset.FieldRelearningSteps:
+		return m.RelearningSteps()
+	case schedulerpreset.FieldGraduatingInterval:
+		return m.GraduatingInterval()
+	case schedulerpreset.FieldEasyInterval:
+		return m.EasyInterval()
+	case schedulerpreset.FieldLeechThreshold:
+		return m.LeechThreshold()
+	case schedulerpreset.FieldLeechSuspend:
+		return m.LeechSuspend()
+	case schedulerpreset.FieldBuryTranslations:
+		return m.BuryTranslations()
+	case schedulerpreset.FieldImplicitCredit:
+		return m.ImplicitCredit()
+	case schedulerpreset.FieldImplicitLapsePull:
+		return m.ImplicitLapsePull()
+	case schedulerpreset.FieldPrerequisiteGate:
+		return m.PrerequisiteGate()
+	case schedulerpreset.FieldPrerequisiteMinStability:
+		return m.PrerequisiteMinStability()
+	case schedulerpreset.FieldErrorResolveAfter:
+		return m.ErrorResolveAfter()
+	case schedulerpreset.FieldNewPerDay:
+		return m.NewPerDay()
+	case schedulerpreset.FieldReviewsPerDay:
+		return m.ReviewsPerDay()
+	case schedulerpreset.FieldLearnAheadMinutes:
+		return m.LearnAheadMinutes()
+	case schedulerpreset.FieldQueueOrder:
+		return m.QueueOrder()
+	case schedulerpreset.FieldIsDefault:
+		return m.IsDefault()
+	case schedulerpreset.FieldCreatedAt:
+		return m.CreatedAt()
+	case schedulerpreset.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5568,227 +10041,673 @@ func (m *NodeAssociationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NodeAssociationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SchedulerPresetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case nodeassociation.FieldSourceID:
-		return m.OldSourceID(ctx)
-	case nodeassociation.FieldTargetID:
-		return m.OldTargetID(ctx)
-	case nodeassociation.FieldRelType:
-		return m.OldRelType(ctx)
+	case schedulerpreset.FieldName:
+		return m.OldName(ctx)
+	case schedulerpreset.FieldScheduler:
+		return m.OldScheduler(ctx)
+	case schedulerpreset.FieldLeitnerIntervals:
+		return m.OldLeitnerIntervals(ctx)
+	case schedulerpreset.FieldAlgorithmVersion:
+		return m.OldAlgorithmVersion(ctx)
+	case schedulerpreset.FieldWeights:
+		return m.OldWeights(ctx)
+	case schedulerpreset.FieldDesiredRetention:
+		return m.OldDesiredRetention(ctx)
+	case schedulerpreset.FieldMaxInterval:
+		return m.OldMaxInterval(ctx)
+	case schedulerpreset.FieldEnableFuzz:
+		return m.OldEnableFuzz(ctx)
+	case schedulerpreset.FieldEnableLoadBalance:
+		return m.OldEnableLoadBalance(ctx)
+	case schedulerpreset.FieldLearningSteps:
+		return m.OldLearningSteps(ctx)
+	case schedulerpreset.FieldRelearningSteps:
+		return m.OldRelearningSteps(ctx)
+	case schedulerpreset.FieldGraduatingInterval:
+		return m.OldGraduatingInterval(ctx)
+	case schedulerpreset.FieldEasyInterval:
+		return m.OldEasyInterval(ctx)
+	case schedulerpreset.FieldLeechThreshold:
+		return m.OldLeechThreshold(ctx)
+	case schedulerpreset.FieldLeechSuspend:
+		return m.OldLeechSuspend(ctx)
+	case schedulerpreset.FieldBuryTranslations:
+		return m.OldBuryTranslations(ctx)
+	case schedulerpreset.FieldImplicitCredit:
+		return m.OldImplicitCredit(ctx)
+	case schedulerpreset.FieldImplicitLapsePull:
+		return m.OldImplicitLapsePull(ctx)
+	case schedulerpreset.FieldPrerequisiteGate:
+		return m.OldPrerequisiteGate(ctx)
+	case schedulerpreset.FieldPrerequisiteMinStability:
+		return m.OldPrerequisiteMinStability(ctx)
+	case schedulerpreset.FieldErrorResolveAfter:
+		return m.OldErrorResolveAfter(ctx)
+	case schedulerpreset.FieldNewPerDay:
+		return m.OldNewPerDay(ctx)
+	case schedulerpreset.FieldReviewsPerDay:
+		return m.OldReviewsPerDay(ctx)
+	case schedulerpreset.FieldLearnAheadMinutes:
+		return m.OldLearnAheadMinutes(ctx)
+	case schedulerpreset.FieldQueueOrder:
+		return m.OldQueueOrder(ctx)
+	case schedulerpreset.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case schedulerpreset.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case schedulerpreset.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown NodeAssociation field %s", name)
+	return nil, fmt.Errorf("unknown SchedulerPreset field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeAssociationMutation) SetField(name string, value ent.Value) error {
+func (m *SchedulerPresetMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case nodeassociation.FieldSourceID:
-		v, ok := value.(uuid.UUID)
+	case schedulerpreset.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case schedulerpreset.FieldScheduler:
+		v, ok := value.(schedulerpreset.Scheduler)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduler(v)
+		return nil
+	case schedulerpreset.FieldLeitnerIntervals:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeitnerIntervals(v)
+		return nil
+	case schedulerpreset.FieldAlgorithmVersion:
+		v, ok := value.(schedulerpreset.AlgorithmVersion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithmVersion(v)
+		return nil
+	case schedulerpreset.FieldWeights:
+		v, ok := value.([]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeights(v)
+		return nil
+	case schedulerpreset.FieldDesiredRetention:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesiredRetention(v)
+		return nil
+	case schedulerpreset.FieldMaxInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxInterval(v)
+		return nil
+	case schedulerpreset.FieldEnableFuzz:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableFuzz(v)
+		return nil
+	case schedulerpreset.FieldEnableLoadBalance:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableLoadBalance(v)
+		return nil
+	case schedulerpreset.FieldLearningSteps:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLearningSteps(v)
+		return nil
+	case schedulerpreset.FieldRelearningSteps:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelearningSteps(v)
+		return nil
+	case schedulerpreset.FieldGraduatingInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGraduatingInterval(v)
+		return nil
+	case schedulerpreset.FieldEasyInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEasyInterval(v)
+		return nil
+	case schedulerpreset.FieldLeechThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeechThreshold(v)
+		return nil
+	case schedulerpreset.FieldLeechSuspend:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeechSuspend(v)
+		return nil
+	case schedulerpreset.FieldBuryTranslations:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuryTranslations(v)
+		return nil
+	case schedulerpreset.FieldImplicitCredit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImplicitCredit(v)
+		return nil
+	case schedulerpreset.FieldImplicitLapsePull:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImplicitLapsePull(v)
+		return nil
+	case schedulerpreset.FieldPrerequisiteGate:
+		v, ok := value.(schedulerpreset.PrerequisiteGate)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrerequisiteGate(v)
+		return nil
+	case schedulerpreset.FieldPrerequisiteMinStability:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceID(v)
+		m.SetPrerequisiteMinStability(v)
 		return nil
-	case nodeassociation.FieldTargetID:
-		v, ok := value.(uuid.UUID)
+	case schedulerpreset.FieldErrorResolveAfter:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetID(v)
+		m.SetErrorResolveAfter(v)
 		return nil
-	case nodeassociation.FieldRelType:
-		v, ok := value.(nodeassociation.RelType)
+	case schedulerpreset.FieldNewPerDay:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRelType(v)
+		m.SetNewPerDay(v)
+		return nil
+	case schedulerpreset.FieldReviewsPerDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewsPerDay(v)
+		return nil
+	case schedulerpreset.FieldLearnAheadMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLearnAheadMinutes(v)
+		return nil
+	case schedulerpreset.FieldQueueOrder:
+		v, ok := value.(schedulerpreset.QueueOrder)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueueOrder(v)
+		return nil
+	case schedulerpreset.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case schedulerpreset.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case schedulerpreset.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NodeAssociation field %s", name)
+	return fmt.Errorf("unknown SchedulerPreset field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NodeAssociationMutation) AddedFields() []string {
-	return nil
+func (m *SchedulerPresetMutation) AddedFields() []string {
+	var fields []string
+	if m.adddesired_retention != nil {
+		fields = append(fields, schedulerpreset.FieldDesiredRetention)
+	}
+	if m.addmax_interval != nil {
+		fields = append(fields, schedulerpreset.FieldMaxInterval)
+	}
+	if m.addgraduating_interval != nil {
+		fields = append(fields, schedulerpreset.FieldGraduatingInterval)
+	}
+	if m.addeasy_interval != nil {
+		fields = append(fields, schedulerpreset.FieldEasyInterval)
+	}
+	if m.addleech_threshold != nil {
+		fields = append(fields, schedulerpreset.FieldLeechThreshold)
+	}
+	if m.addimplicit_credit != nil {
+		fields = append(fields, schedulerpreset.FieldImplicitCredit)
+	}
+	if m.addimplicit_lapse_pull != nil {
+		fields = append(fields, schedulerpreset.FieldImplicitLapsePull)
+	}
+	if m.addprerequisite_min_stability != nil {
+		fields = append(fields, schedulerpreset.FieldPrerequisiteMinStability)
+	}
+	if m.adderror_resolve_after != nil {
+		fields = append(fields, schedulerpreset.FieldErrorResolveAfter)
+	}
+	if m.addnew_per_day != nil {
+		fields = append(fields, schedulerpreset.FieldNewPerDay)
+	}
+	if m.addreviews_per_day != nil {
+		fields = append(fields, schedulerpreset.FieldReviewsPerDay)
+	}
+	if m.addlearn_ahead_minutes != nil {
+		fields = append(fields, schedulerpreset.FieldLearnAheadMinutes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NodeAssociationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SchedulerPresetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case schedulerpreset.FieldDesiredRetention:
+		return m.AddedDesiredRetention()
+	case schedulerpreset.FieldMaxInterval:
+		return m.AddedMaxInterval()
+	case schedulerpreset.FieldGraduatingInterval:
+		return m.AddedGraduatingInterval()
+	case schedulerpreset.FieldEasyInterval:
+		return m.AddedEasyInterval()
+	case schedulerpreset.FieldLeechThreshold:
+		return m.AddedLeechThreshold()
+	case schedulerpreset.FieldImplicitCredit:
+		return m.AddedImplicitCredit()
+	case schedulerpreset.FieldImplicitLapsePull:
+		return m.AddedImplicitLapsePull()
+	case schedulerpreset.FieldPrerequisiteMinStability:
+		return m.AddedPrerequisiteMinStability()
+	case schedulerpreset.FieldErrorResolveAfter:
+		return m.AddedErrorResolveAfter()
+	case schedulerpreset.FieldNewPerDay:
+		return m.AddedNewPerDay()
+	case schedulerpreset.FieldReviewsPerDay:
+		return m.AddedReviewsPerDay()
+	case schedulerpreset.FieldLearnAheadMinutes:
+		return m.AddedLearnAheadMinutes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeAssociationMutation) AddField(name string, value ent.Value) error {
+func (m *SchedulerPresetMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case schedulerpreset.FieldDesiredRetention:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDesiredRetention(v)
+		return nil
+	case schedulerpreset.FieldMaxInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxInterval(v)
+		return nil
+	case schedulerpreset.FieldGraduatingInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGraduatingInterval(v)
+		return nil
+	case schedulerpreset.FieldEasyInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEasyInterval(v)
+		return nil
+	case schedulerpreset.FieldLeechThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeechThreshold(v)
+		return nil
+	case schedulerpreset.FieldImplicitCredit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImplicitCredit(v)
+		return nil
+	case schedulerpreset.FieldImplicitLapsePull:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImplicitLapsePull(v)
+		return nil
+	case schedulerpreset.FieldPrerequisiteMinStability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrerequisiteMinStability(v)
+		return nil
+	case schedulerpreset.FieldErrorResolveAfter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrorResolveAfter(v)
+		return nil
+	case schedulerpreset.FieldNewPerDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNewPerDay(v)
+		return nil
+	case schedulerpreset.FieldReviewsPerDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewsPerDay(v)
+		return nil
+	case schedulerpreset.FieldLearnAheadMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLearnAheadMinutes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NodeAssociation numeric field %s", name)
+	return fmt.Errorf("unknown SchedulerPreset numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NodeAssociationMutation) ClearedFields() []string {
-	return nil
+func (m *SchedulerPresetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schedulerpreset.FieldLeitnerIntervals) {
+		fields = append(fields, schedulerpreset.FieldLeitnerIntervals)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NodeAssociationMutation) FieldCleared(name string) bool {
+func (m *SchedulerPresetMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NodeAssociationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown NodeAssociation nullable field %s", name)
+func (m *SchedulerPresetMutation) ClearField(name string) error {
+	switch name {
+	case schedulerpreset.FieldLeitnerIntervals:
+		m.ClearLeitnerIntervals()
+		return nil
+	}
+	return fmt.Errorf("unknown SchedulerPreset nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NodeAssociationMutation) ResetField(name string) error {
+func (m *SchedulerPresetMutation) ResetField(name string) error {
 	switch name {
-	case nodeassociation.FieldSourceID:
-		m.ResetSourceID()
+	case schedulerpreset.FieldName:
+		m.ResetName()
 		return nil
-	case nodeassociation.FieldTargetID:
-		m.ResetTargetID()
+	case schedulerpreset.FieldScheduler:
+		m.ResetScheduler()
 		return nil
-	case nodeassociation.FieldRelType:
-		m.ResetRelType()
+	case schedulerpreset.FieldLeitnerIntervals:
+		m.ResetLeitnerIntervals()
+		return nil
+	case schedulerpreset.FieldAlgorithmVersion:
+		m.ResetAlgorithmVersion()
+		return nil
+	case schedulerpreset.FieldWeights:
+		m.ResetWeights()
+		return nil
+	case schedulerpreset.FieldDesiredRetention:
+		m.ResetDesiredRetention()
+		return nil
+	case schedulerpreset.FieldMaxInterval:
+		m.ResetMaxInterval()
+		return nil
+	case schedulerpreset.FieldEnableFuzz:
+		m.ResetEnableFuzz()
+		return nil
+	case schedulerpreset.FieldEnableLoadBalance:
+		m.ResetEnableLoadBalance()
+		return nil
+	case schedulerpreset.FieldLearningSteps:
+		m.ResetLearningSteps()
+		return nil
+	case schedulerpreset.FieldRelearningSteps:
+		m.ResetRelearningSteps()
+		return nil
+	case schedulerpreset.FieldGraduatingInterval:
+		m.ResetGraduatingInterval()
+		return nil
+	case schedulerpreset.FieldEasyInterval:
+		m.ResetEasyInterval()
+		return nil
+	case schedulerpreset.FieldLeechThreshold:
+		m.ResetLeechThreshold()
+		return nil
+	case schedulerpreset.FieldLeechSuspend:
+		m.ResetLeechSuspend()
+		return nil
+	case schedulerpreset.FieldBuryTranslations:
+		m.ResetBuryTranslations()
+		return nil
+	case schedulerpreset.FieldImplicitCredit:
+		m.ResetImplicitCredit()
+		return nil
+	case schedulerpreset.FieldImplicitLapsePull:
+		m.ResetImplicitLapsePull()
+		return nil
+	case schedulerpreset.FieldPrerequisiteGate:
+		m.ResetPrerequisiteGate()
+		return nil
+	case schedulerpreset.FieldPrerequisiteMinStability:
+		m.ResetPrerequisiteMinStability()
+		return nil
+	case schedulerpreset.FieldErrorResolveAfter:
+		m.ResetErrorResolveAfter()
+		return nil
+	case schedulerpreset.FieldNewPerDay:
+		m.ResetNewPerDay()
+		return nil
+	case schedulerpreset.FieldReviewsPerDay:
+		m.ResetReviewsPerDay()
+		return nil
+	case schedulerpreset.FieldLearnAheadMinutes:
+		m.ResetLearnAheadMinutes()
+		return nil
+	case schedulerpreset.FieldQueueOrder:
+		m.ResetQueueOrder()
+		return nil
+	case schedulerpreset.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case schedulerpreset.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case schedulerpreset.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown NodeAssociation field %s", name)
+	return fmt.Errorf("unknown SchedulerPreset field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NodeAssociationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.source != nil {
-		edges = append(edges, nodeassociation.EdgeSource)
-	}
-	if m.target != nil {
-		edges = append(edges, nodeassociation.EdgeTarget)
+func (m *SchedulerPresetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.nodes != nil {
+		edges = append(edges, schedulerpreset.EdgeNodes)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NodeAssociationMutation) AddedIDs(name string) []ent.Value {
+func (m *SchedulerPresetMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case nodeassociation.EdgeSource:
-		if id := m.source; id != nil {
-			return []ent.Value{*id}
-		}
-	case nodeassociation.EdgeTarget:
-		if id := m.target; id != nil {
-			return []ent.Value{*id}
+	case schedulerpreset.EdgeNodes:
+		ids := make([]ent.Value, 0, len(m.nodes))
+		for id := range m.nodes {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NodeAssociationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SchedulerPresetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removednodes != nil {
+		edges = append(edges, schedulerpreset.EdgeNodes)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NodeAssociationMutation) RemovedIDs(name string) []ent.Value {
+func (m *SchedulerPresetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case schedulerpreset.EdgeNodes:
+		ids := make([]ent.Value, 0, len(m.removednodes))
+		for id := range m.removednodes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NodeAssociationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedsource {
-		edges = append(edges, nodeassociation.EdgeSource)
-	}
-	if m.clearedtarget {
-		edges = append(edges, nodeassociation.EdgeTarget)
+func (m *SchedulerPresetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednodes {
+		edges = append(edges, schedulerpreset.EdgeNodes)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NodeAssociationMutation) EdgeCleared(name string) bool {
+func (m *SchedulerPresetMutation) EdgeCleared(name string) bool {
 	switch name {
-	case nodeassociation.EdgeSource:
-		return m.clearedsource
-	case nodeassociation.EdgeTarget:
-		return m.clearedtarget
+	case schedulerpreset.EdgeNodes:
+		return m.clearednodes
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NodeAssociationMutation) ClearEdge(name string) error {
+func (m *SchedulerPresetMutation) ClearEdge(name string) error {
 	switch name {
-	case nodeassociation.EdgeSource:
-		m.ClearSource()
-		return nil
-	case nodeassociation.EdgeTarget:
-		m.ClearTarget()
-		return nil
 	}
-	return fmt.Errorf("unknown NodeAssociation unique edge %s", name)
+	return fmt.Errorf("unknown SchedulerPreset unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NodeAssociationMutation) ResetEdge(name string) error {
+func (m *SchedulerPresetMutation) ResetEdge(name string) error {
 	switch name {
-	case nodeassociation.EdgeSource:
-		m.ResetSource()
-		return nil
-	case nodeassociation.EdgeTarget:
-		m.ResetTarget()
+	case schedulerpreset.EdgeNodes:
+		m.ResetNodes()
 		return nil
 	}
-	return fmt.Errorf("unknown NodeAssociation edge %s", name)
+	return fmt.Errorf("unknown SchedulerPreset edge %s", name)
 }
 
-// NodeClosureMutation represents an operation that mutates the NodeClosure nodes in the graph.
-type NodeClosureMutation struct {
+// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
+type SettingsMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
-	depth             *int
-	adddepth          *int
+	day_start_hour    *int
+	addday_start_hour *int
+	timezone          *string
+	updated_at        *time.Time
 	clearedFields     map[string]struct{}
-	ancestor          *uuid.UUID
-	clearedancestor   bool
-	descendant        *uuid.UUID
-	cleareddescendant bool
 	done              bool
-	oldValue          func(context.Context) (*NodeClosure, error)
-	predicates        []predicate.NodeClosure
+	oldValue          func(context.Context) (*Settings, error)
+	predicates        []predicate.Settings
 }
 
-var _ ent.Mutation = (*NodeClosureMutation)(nil)
+var _ ent.Mutation = (*SettingsMutation)(nil)
 
-// nodeclosureOption allows management of the mutation configuration using functional options.
-type nodeclosureOption func(*NodeClosureMutation)
+// settingsOption allows management of the mutation configuration using functional options.
+type settingsOption func(*SettingsMutation)
 
-// newNodeClosureMutation creates new mutation for the NodeClosure entity.
-func newNodeClosureMutation(c config, op Op, opts ...nodeclosureOption) *NodeClosureMutation {
-	m := &NodeClosureMutation{
+// newSettingsMutation creates new mutation for the Settings entity.
+func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
+	m := &SettingsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNodeClosure,
+		typ:           TypeSettings,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5797,20 +10716,20 @@ func newNodeClosureMutation(c config, op Op, opts ...nodeclosureOption) *NodeClo
 	return m
 }
 
-// withNodeClosureID sets the ID field of the mutation.
-func withNodeClosureID(id int) nodeclosureOption {
-	return func(m *NodeClosureMutation) {
+// withSettingsID sets the ID field of the mutation.
+func withSettingsID(id int) settingsOption {
+	return func(m *SettingsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NodeClosure
+			value *Settings
 		)
-		m.oldValue = func(ctx context.Context) (*NodeClosure, error) {
+		m.oldValue = func(ctx context.Context) (*Settings, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NodeClosure.Get(ctx, id)
+					value, err = m.Client().Settings.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5819,10 +10738,10 @@ func withNodeClosureID(id int) nodeclosureOption {
 	}
 }
 
-// withNodeClosure sets the old NodeClosure of the mutation.
-func withNodeClosure(node *NodeClosure) nodeclosureOption {
-	return func(m *NodeClosureMutation) {
-		m.oldValue = func(context.Context) (*NodeClosure, error) {
+// withSettings sets the old Settings of the mutation.
+func withSettings(node *Settings) settingsOption {
+	return func(m *SettingsMutation) {
+		m.oldValue = func(context.Context) (*Settings, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5831,7 +10750,7 @@ func withNodeClosure(node *NodeClosure) nodeclosureOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NodeClosureMutation) Client() *Client {
+func (m SettingsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5839,7 +10758,7 @@ func (m NodeClosureMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NodeClosureMutation) Tx() (*Tx, error) {
+func (m SettingsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5850,7 +10769,7 @@ func (m NodeClosureMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NodeClosureMutation) ID() (id int, exists bool) {
+func (m *SettingsMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5861,7 +10780,7 @@ func (m *NodeClosureMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NodeClosureMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5870,203 +10789,149 @@ func (m *NodeClosureMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NodeClosure.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAncestorID sets the "ancestor_id" field.
-func (m *NodeClosureMutation) SetAncestorID(u uuid.UUID) {
-	m.ancestor = &u
+// SetDayStartHour sets the "day_start_hour" field.
+func (m *SettingsMutation) SetDayStartHour(i int) {
+	m.day_start_hour = &i
+	m.addday_start_hour = nil
 }
 
-// AncestorID returns the value of the "ancestor_id" field in the mutation.
-func (m *NodeClosureMutation) AncestorID() (r uuid.UUID, exists bool) {
-	v := m.ancestor
+// DayStartHour returns the value of the "day_start_hour" field in the mutation.
+func (m *SettingsMutation) DayStartHour() (r int, exists bool) {
+	v := m.day_start_hour
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAncestorID returns the old "ancestor_id" field's value of the NodeClosure entity.
-// If the NodeClosure object wasn't provided to the builder, the object is fetched from the database.
+// OldDayStartHour returns the old "day_start_hour" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeClosureMutation) OldAncestorID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SettingsMutation) OldDayStartHour(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAncestorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDayStartHour is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAncestorID requires an ID field in the mutation")
+		return v, errors.New("OldDayStartHour requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAncestorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDayStartHour: %w", err)
 	}
-	return oldValue.AncestorID, nil
-}
-
-// ResetAncestorID resets all changes to the "ancestor_id" field.
-func (m *NodeClosureMutation) ResetAncestorID() {
-	m.ancestor = nil
+	return oldValue.DayStartHour, nil
 }
 
-// SetDescendantID sets the "descendant_id" field.
-func (m *NodeClosureMutation) SetDescendantID(u uuid.UUID) {
-	m.descendant = &u
+// AddDayStartHour adds i to the "day_start_hour" field.
+func (m *SettingsMutation) AddDayStartHour(i int) {
+	if m.addday_start_hour != nil {
+		*m.addday_start_hour += i
+	} else {
+		m.addday_start_hour = &i
+	}
 }
 
-// DescendantID returns the value of the "descendant_id" field in the mutation.
-func (m *NodeClosureMutation) DescendantID() (r uuid.UUID, exists bool) {
-	v := m.descendant
+// AddedDayStartHour returns the value that was added to the "day_start_hour" field in this mutation.
+func (m *SettingsMutation) AddedDayStartHour() (r int, exists bool) {
+	v := m.addday_start_hour
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescendantID returns the old "descendant_id" field's value of the NodeClosure entity.
-// If the NodeClosure object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeClosureMutation) OldDescendantID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescendantID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescendantID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescendantID: %w", err)
-	}
-	return oldValue.DescendantID, nil
-}
-
-// ResetDescendantID resets all changes to the "descendant_id" field.
-func (m *NodeClosureMutation) ResetDescendantID() {
-	m.descendant = nil
+// ResetDayStartHour resets all changes to the "day_start_hour" field.
+func (m *SettingsMutation) ResetDayStartHour() {
+	m.day_start_hour = nil
+	m.addday_start_hour = nil
 }
 
-// SetDepth sets the "depth" field.
-func (m *NodeClosureMutation) SetDepth(i int) {
-	m.depth = &i
-	m.adddepth = nil
+// SetTimezone sets the "timezone" field.
+func (m *SettingsMutation) SetTimezone(s string) {
+	m.timezone = &s
 }
 
-// Depth returns the value of the "depth" field in the mutation.
-func (m *NodeClosureMutation) Depth() (r int, exists bool) {
-	v := m.depth
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *SettingsMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDepth returns the old "depth" field's value of the NodeClosure entity.
-// If the NodeClosure object wasn't provided to the builder, the object is fetched from the database.
+// OldTimezone returns the old "timezone" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeClosureMutation) OldDepth(ctx context.Context) (v int, err error) {
+func (m *SettingsMutation) OldTimezone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDepth is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDepth requires an ID field in the mutation")
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDepth: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
 	}
-	return oldValue.Depth, nil
+	return oldValue.Timezone, nil
 }
 
-// AddDepth adds i to the "depth" field.
-func (m *NodeClosureMutation) AddDepth(i int) {
-	if m.adddepth != nil {
-		*m.adddepth += i
-	} else {
-		m.adddepth = &i
-	}
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *SettingsMutation) ResetTimezone() {
+	m.timezone = nil
 }
 
-// AddedDepth returns the value that was added to the "depth" field in this mutation.
-func (m *NodeClosureMutation) AddedDepth() (r int, exists bool) {
-	v := m.adddepth
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDepth resets all changes to the "depth" field.
-func (m *NodeClosureMutation) ResetDepth() {
-	m.depth = nil
-	m.adddepth = nil
-}
-
-// ClearAncestor clears the "ancestor" edge to the Node entity.
-func (m *NodeClosureMutation) ClearAncestor() {
-	m.clearedancestor = true
-	m.clearedFields[nodeclosure.FieldAncestorID] = struct{}{}
-}
-
-// AncestorCleared reports if the "ancestor" edge to the Node entity was cleared.
-func (m *NodeClosureMutation) AncestorCleared() bool {
-	return m.clearedancestor
-}
-
-// AncestorIDs returns the "ancestor" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AncestorID instead. It exists only for internal usage by the builders.
-func (m *NodeClosureMutation) AncestorIDs() (ids []uuid.UUID) {
-	if id := m.ancestor; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetAncestor resets all changes to the "ancestor" edge.
-func (m *NodeClosureMutation) ResetAncestor() {
-	m.ancestor = nil
-	m.clearedancestor = false
-}
-
-// ClearDescendant clears the "descendant" edge to the Node entity.
-func (m *NodeClosureMutation) ClearDescendant() {
-	m.cleareddescendant = true
-	m.clearedFields[nodeclosure.FieldDescendantID] = struct{}{}
-}
-
-// DescendantCleared reports if the "descendant" edge to the Node entity was cleared.
-func (m *NodeClosureMutation) DescendantCleared() bool {
-	return m.cleareddescendant
-}
-
-// DescendantIDs returns the "descendant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DescendantID instead. It exists only for internal usage by the builders.
-func (m *NodeClosureMutation) DescendantIDs() (ids []uuid.UUID) {
-	if id := m.descendant; id != nil {
-		ids = append(ids, *id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetDescendant resets all changes to the "descendant" edge.
-func (m *NodeClosureMutation) ResetDescendant() {
-	m.descendant = nil
-	m.cleareddescendant = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the NodeClosureMutation builder.
-func (m *NodeClosureMutation) Where(ps ...predicate.NodeClosure) {
+// Where appends a list predicates to the SettingsMutation builder.
+func (m *SettingsMutation) Where(ps ...predicate.Settings) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NodeClosureMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NodeClosureMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NodeClosure, len(ps))
+func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settings, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6074,33 +10939,33 @@ func (m *NodeClosureMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NodeClosureMutation) Op() Op {
+func (m *SettingsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NodeClosureMutation) SetOp(op Op) {
+func (m *SettingsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NodeClosure).
-func (m *NodeClosureMutation) Type() string {
+// Type returns the node type of this mutation (Settings).
+func (m *SettingsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NodeClosureMutation) Fields() []string {
+func (m *SettingsMutation) Fields() []string {
 	fields := make([]string, 0, 3)
-	if m.ancestor != nil {
-		fields = append(fields, nodeclosure.FieldAncestorID)
+	if m.day_start_hour != nil {
+		fields = append(fields, settings.FieldDayStartHour)
 	}
-	if m.descendant != nil {
-		fields = append(fields, nodeclosure.FieldDescendantID)
+	if m.timezone != nil {
+		fields = append(fields, settings.FieldTimezone)
 	}
-	if m.depth != nil {
-		fields = append(fields, nodeclosure.FieldDepth)
+	if m.updated_at != nil {
+		fields = append(fields, settings.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6108,14 +10973,14 @@ func (m *NodeClosureMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NodeClosureMutation) Field(name string) (ent.Value, bool) {
+func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case nodeclosure.FieldAncestorID:
-		return m.AncestorID()
-	case nodeclosure.FieldDescendantID:
-		return m.DescendantID()
-	case nodeclosure.FieldDepth:
-		return m.Depth()
+	case settings.FieldDayStartHour:
+		return m.DayStartHour()
+	case settings.FieldTimezone:
+		return m.Timezone()
+	case settings.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6123,54 +10988,54 @@ func (m *NodeClosureMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NodeClosureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case nodeclosure.FieldAncestorID:
-		return m.OldAncestorID(ctx)
-	case nodeclosure.FieldDescendantID:
-		return m.OldDescendantID(ctx)
-	case nodeclosure.FieldDepth:
-		return m.OldDepth(ctx)
+	case settings.FieldDayStartHour:
+		return m.OldDayStartHour(ctx)
+	case settings.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case settings.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown NodeClosure field %s", name)
+	return nil, fmt.Errorf("unknown Settings field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeClosureMutation) SetField(name string, value ent.Value) error {
+func (m *SettingsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case nodeclosure.FieldAncestorID:
-		v, ok := value.(uuid.UUID)
+	case settings.FieldDayStartHour:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAncestorID(v)
+		m.SetDayStartHour(v)
 		return nil
-	case nodeclosure.FieldDescendantID:
-		v, ok := value.(uuid.UUID)
+	case settings.FieldTimezone:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescendantID(v)
+		m.SetTimezone(v)
 		return nil
-	case nodeclosure.FieldDepth:
-		v, ok := value.(int)
+	case settings.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDepth(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NodeClosure field %s", name)
+	return fmt.Errorf("unknown Settings field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NodeClosureMutation) AddedFields() []string {
+func (m *SettingsMutation) AddedFields() []string {
 	var fields []string
-	if m.adddepth != nil {
-		fields = append(fields, nodeclosure.FieldDepth)
+	if m.addday_start_hour != nil {
+		fields = append(fields, settings.FieldDayStartHour)
 	}
 	return fields
 }
@@ -6178,10 +11043,10 @@ func (m *NodeClosureMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NodeClosureMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case nodeclosure.FieldDepth:
-		return m.AddedDepth()
+	case settings.FieldDayStartHour:
+		return m.AddedDayStartHour()
 	}
 	return nil, false
 }
@@ -6189,143 +11054,99 @@ func (m *NodeClosureMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeClosureMutation) AddField(name string, value ent.Value) error {
+func (m *SettingsMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case nodeclosure.FieldDepth:
+	case settings.FieldDayStartHour:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDepth(v)
+		m.AddDayStartHour(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NodeClosure numeric field %s", name)
+	return fmt.Errorf("unknown Settings numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NodeClosureMutation) ClearedFields() []string {
+func (m *SettingsMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NodeClosureMutation) FieldCleared(name string) bool {
+func (m *SettingsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NodeClosureMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown NodeClosure nullable field %s", name)
+func (m *SettingsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Settings nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NodeClosureMutation) ResetField(name string) error {
+func (m *SettingsMutation) ResetField(name string) error {
 	switch name {
-	case nodeclosure.FieldAncestorID:
-		m.ResetAncestorID()
+	case settings.FieldDayStartHour:
+		m.ResetDayStartHour()
 		return nil
-	case nodeclosure.FieldDescendantID:
-		m.ResetDescendantID()
+	case settings.FieldTimezone:
+		m.ResetTimezone()
 		return nil
-	case nodeclosure.FieldDepth:
-		m.ResetDepth()
+	case settings.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown NodeClosure field %s", name)
+	return fmt.Errorf("unknown Settings field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NodeClosureMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.ancestor != nil {
-		edges = append(edges, nodeclosure.EdgeAncestor)
-	}
-	if m.descendant != nil {
-		edges = append(edges, nodeclosure.EdgeDescendant)
-	}
+func (m *SettingsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NodeClosureMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case nodeclosure.EdgeAncestor:
-		if id := m.ancestor; id != nil {
-			return []ent.Value{*id}
-		}
-	case nodeclosure.EdgeDescendant:
-		if id := m.descendant; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NodeClosureMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SettingsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NodeClosureMutation) RemovedIDs(name string) []ent.Value {
+func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NodeClosureMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedancestor {
-		edges = append(edges, nodeclosure.EdgeAncestor)
-	}
-	if m.cleareddescendant {
-		edges = append(edges, nodeclosure.EdgeDescendant)
-	}
+func (m *SettingsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NodeClosureMutation) EdgeCleared(name string) bool {
-	switch name {
-	case nodeclosure.EdgeAncestor:
-		return m.clearedancestor
-	case nodeclosure.EdgeDescendant:
-		return m.cleareddescendant
-	}
+func (m *SettingsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NodeClosureMutation) ClearEdge(name string) error {
-	switch name {
-	case nodeclosure.EdgeAncestor:
-		m.ClearAncestor()
-		return nil
-	case nodeclosure.EdgeDescendant:
-		m.ClearDescendant()
-		return nil
-	}
-	return fmt.Errorf("unknown NodeClosure unique edge %s", name)
+func (m *SettingsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Settings unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NodeClosureMutation) ResetEdge(name string) error {
-	switch name {
-	case nodeclosure.EdgeAncestor:
-		m.ResetAncestor()
-		return nil
-	case nodeclosure.EdgeDescendant:
-		m.ResetDescendant()
-		return nil
-	}
-	return fmt.Errorf("unknown NodeClosure edge %s", name)
+func (m *SettingsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Settings edge %s", name)
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/schedulerpreset"
 	"strings"
 	"time"
 
@@ -32,6 +33,8 @@ type Node struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// ParentID holds the value of the "parent_id" field.
 	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	// PresetID holds the value of the "preset_id" field.
+	PresetID *uuid.UUID `json:"preset_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NodeQuery when eager-loading is set.
 	Edges        NodeEdges `json:"edges"`
@@ -56,9 +59,11 @@ type NodeEdges struct {
 	FsrsCard *FsrsCard `json:"fsrs_card,omitempty"`
 	// ErrorResolutions holds the value of the error_resolutions edge.
 	ErrorResolutions []*ErrorResolution `json:"error_resolutions,omitempty"`
+	// Preset holds the value of the preset edge.
+	Preset *SchedulerPreset `json:"preset,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [9]bool
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -137,12 +142,23 @@ func (e NodeEdges) ErrorResolutionsOrErr() ([]*ErrorResolution, error) {
 	return nil, &NotLoadedError{edge: "error_resolutions"}
 }
 
+// PresetOrErr returns the Preset value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NodeEdges) PresetOrErr() (*SchedulerPreset, error) {
+	if e.Preset != nil {
+		return e.Preset, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: schedulerpreset.Label}
+	}
+	return nil, &NotLoadedError{edge: "preset"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Node) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case node.FieldParentID:
+		case node.FieldParentID, node.FieldPresetID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case node.FieldMetadata:
 			values[i] = new([]byte)
@@ -212,6 +228,13 @@ func (_m *Node) assignValues(columns []string, values []any) error {
 				_m.ParentID = new(uuid.UUID)
 				*_m.ParentID = *value.S.(*uuid.UUID)
 			}
+		case node.FieldPresetID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field preset_id", values[i])
+			} else if value.Valid {
+				_m.PresetID = new(uuid.UUID)
+				*_m.PresetID = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -265,6 +288,11 @@ func (_m *Node) QueryErrorResolutions() *ErrorResolutionQuery {
 	return NewNodeClient(_m.config).QueryErrorResolutions(_m)
 }
 
+// QueryPreset queries the "preset" edge of the Node entity.
+func (_m *Node) QueryPreset() *SchedulerPresetQuery {
+	return NewNodeClient(_m.config).QueryPreset(_m)
+}
+
 // Update returns a builder for updating this Node.
 // Note that you need to call Node.Unwrap() before calling this method if this Node
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -307,6 +335,11 @@ func (_m *Node) String() string {
 		builder.WriteString("parent_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.PresetID; v != nil {
+		builder.WriteString("preset_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
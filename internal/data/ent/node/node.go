@@ -28,6 +28,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
+	// FieldPresetID holds the string denoting the preset_id field in the database.
+	FieldPresetID = "preset_id"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -44,6 +46,8 @@ const (
 	EdgeFsrsCard = "fsrs_card"
 	// EdgeErrorResolutions holds the string denoting the error_resolutions edge name in mutations.
 	EdgeErrorResolutions = "error_resolutions"
+	// EdgePreset holds the string denoting the preset edge name in mutations.
+	EdgePreset = "preset"
 	// NodeClosureFieldID holds the string denoting the ID field of the NodeClosure.
 	NodeClosureFieldID = "id"
 	// NodeAssociationFieldID holds the string denoting the ID field of the NodeAssociation.
@@ -52,6 +56,8 @@ const (
 	FsrsCardFieldID = "card_id"
 	// ErrorResolutionFieldID holds the string denoting the ID field of the ErrorResolution.
 	ErrorResolutionFieldID = "resolution_id"
+	// SchedulerPresetFieldID holds the string denoting the ID field of the SchedulerPreset.
+	SchedulerPresetFieldID = "preset_id"
 	// Table holds the table name of the node in the database.
 	Table = "nodes"
 	// ParentTable is the table that holds the parent relation/edge.
@@ -104,6 +110,13 @@ const (
 	ErrorResolutionsInverseTable = "error_resolutions"
 	// ErrorResolutionsColumn is the table column denoting the error_resolutions relation/edge.
 	ErrorResolutionsColumn = "node_id"
+	// PresetTable is the table that holds the preset relation/edge.
+	PresetTable = "nodes"
+	// PresetInverseTable is the table name for the SchedulerPreset entity.
+	// It exists in this package in order to avoid circular dependency with the "schedulerpreset" package.
+	PresetInverseTable = "scheduler_presets"
+	// PresetColumn is the table column denoting the preset relation/edge.
+	PresetColumn = "preset_id"
 )
 
 // Columns holds all SQL columns for node fields.
@@ -115,6 +128,7 @@ var Columns = []string{
 	FieldMetadata,
 	FieldCreatedAt,
 	FieldParentID,
+	FieldPresetID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -195,6 +209,11 @@ func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
 }
 
+// ByPresetID orders the results by the preset_id field.
+func ByPresetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPresetID, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -292,6 +311,13 @@ func ByErrorResolutions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newErrorResolutionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPresetField orders the results by preset field.
+func ByPresetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPresetStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -348,3 +374,10 @@ func newErrorResolutionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ErrorResolutionsTable, ErrorResolutionsColumn),
 	)
 }
+func newPresetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PresetInverseTable, SchedulerPresetFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PresetTable, PresetColumn),
+	)
+}
@@ -76,6 +76,11 @@ func ParentID(v uuid.UUID) predicate.Node {
 	return predicate.Node(sql.FieldEQ(FieldParentID, v))
 }
 
+// PresetID applies equality check predicate on the "preset_id" field. It's identical to PresetIDEQ.
+func PresetID(v uuid.UUID) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldPresetID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Node {
 	return predicate.Node(sql.FieldEQ(FieldTitle, v))
@@ -316,6 +321,36 @@ func ParentIDNotNil() predicate.Node {
 	return predicate.Node(sql.FieldNotNull(FieldParentID))
 }
 
+// PresetIDEQ applies the EQ predicate on the "preset_id" field.
+func PresetIDEQ(v uuid.UUID) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldPresetID, v))
+}
+
+// PresetIDNEQ applies the NEQ predicate on the "preset_id" field.
+func PresetIDNEQ(v uuid.UUID) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldPresetID, v))
+}
+
+// PresetIDIn applies the In predicate on the "preset_id" field.
+func PresetIDIn(vs ...uuid.UUID) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldPresetID, vs...))
+}
+
+// PresetIDNotIn applies the NotIn predicate on the "preset_id" field.
+func PresetIDNotIn(vs ...uuid.UUID) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldPresetID, vs...))
+}
+
+// PresetIDIsNil applies the IsNil predicate on the "preset_id" field.
+func PresetIDIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldPresetID))
+}
+
+// PresetIDNotNil applies the NotNil predicate on the "preset_id" field.
+func PresetIDNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldPresetID))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Node {
 	return predicate.Node(func(s *sql.Selector) {
@@ -500,6 +535,29 @@ func HasErrorResolutionsWith(preds ...predicate.ErrorResolution) predicate.Node
 	})
 }
 
+// HasPreset applies the HasEdge predicate on the "preset" edge.
+func HasPreset() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PresetTable, PresetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPresetWith applies the HasEdge predicate on the "preset" edge with a given conditions (other predicates).
+func HasPresetWith(preds ...predicate.SchedulerPreset) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		step := newPresetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Node) predicate.Node {
 	return predicate.Node(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"profen/internal/data/ent/node"
 	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/schedulerpreset"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -93,6 +94,20 @@ func (_c *NodeCreate) SetNillableParentID(v *uuid.UUID) *NodeCreate {
 	return _c
 }
 
+// SetPresetID sets the "preset_id" field.
+func (_c *NodeCreate) SetPresetID(v uuid.UUID) *NodeCreate {
+	_c.mutation.SetPresetID(v)
+	return _c
+}
+
+// SetNillablePresetID sets the "preset_id" field if the given value is not nil.
+func (_c *NodeCreate) SetNillablePresetID(v *uuid.UUID) *NodeCreate {
+	if v != nil {
+		_c.SetPresetID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *NodeCreate) SetID(v uuid.UUID) *NodeCreate {
 	_c.mutation.SetID(v)
@@ -221,6 +236,11 @@ func (_c *NodeCreate) AddErrorResolutions(v ...*ErrorResolution) *NodeCreate {
 	return _c.AddErrorResolutionIDs(ids...)
 }
 
+// SetPreset sets the "preset" edge to the SchedulerPreset entity.
+func (_c *NodeCreate) SetPreset(v *SchedulerPreset) *NodeCreate {
+	return _c.SetPresetID(v.ID)
+}
+
 // Mutation returns the NodeMutation object of the builder.
 func (_c *NodeCreate) Mutation() *NodeMutation {
 	return _c.mutation
@@ -470,6 +490,23 @@ func (_c *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PresetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   node.PresetTable,
+			Columns: []string{node.PresetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PresetID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
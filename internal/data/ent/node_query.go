@@ -13,6 +13,7 @@ import (
 	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
 	"profen/internal/data/ent/predicate"
+	"profen/internal/data/ent/schedulerpreset"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -36,6 +37,7 @@ type NodeQuery struct {
 	withIncomingAssociations *NodeAssociationQuery
 	withFsrsCard             *FsrsCardQuery
 	withErrorResolutions     *ErrorResolutionQuery
+	withPreset               *SchedulerPresetQuery
 	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -249,6 +251,28 @@ func (_q *NodeQuery) QueryErrorResolutions() *ErrorResolutionQuery {
 	return query
 }
 
+// QueryPreset chains the current query on the "preset" edge.
+func (_q *NodeQuery) QueryPreset() *SchedulerPresetQuery {
+	query := (&SchedulerPresetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, selector),
+			sqlgraph.To(schedulerpreset.Table, schedulerpreset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, node.PresetTable, node.PresetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Node entity from the query.
 // Returns a *NotFoundError when no Node was found.
 func (_q *NodeQuery) First(ctx context.Context) (*Node, error) {
@@ -449,6 +473,7 @@ func (_q *NodeQuery) Clone() *NodeQuery {
 		withIncomingAssociations: _q.withIncomingAssociations.Clone(),
 		withFsrsCard:             _q.withFsrsCard.Clone(),
 		withErrorResolutions:     _q.withErrorResolutions.Clone(),
+		withPreset:               _q.withPreset.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -544,6 +569,17 @@ func (_q *NodeQuery) WithErrorResolutions(opts ...func(*ErrorResolutionQuery)) *
 	return _q
 }
 
+// WithPreset tells the query-builder to eager-load the nodes that are connected to
+// the "preset" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NodeQuery) WithPreset(opts ...func(*SchedulerPresetQuery)) *NodeQuery {
+	query := (&SchedulerPresetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPreset = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -622,7 +658,7 @@ func (_q *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 	var (
 		nodes       = []*Node{}
 		_spec       = _q.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [9]bool{
 			_q.withParent != nil,
 			_q.withChildren != nil,
 			_q.withChildClosures != nil,
@@ -631,6 +667,7 @@ func (_q *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 			_q.withIncomingAssociations != nil,
 			_q.withFsrsCard != nil,
 			_q.withErrorResolutions != nil,
+			_q.withPreset != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -712,6 +749,12 @@ func (_q *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 			return nil, err
 		}
 	}
+	if query := _q.withPreset; query != nil {
+		if err := _q.loadPreset(ctx, query, nodes, nil,
+			func(n *Node, e *SchedulerPreset) { n.Edges.Preset = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -957,6 +1000,38 @@ func (_q *NodeQuery) loadErrorResolutions(ctx context.Context, query *ErrorResol
 	}
 	return nil
 }
+func (_q *NodeQuery) loadPreset(ctx context.Context, query *SchedulerPresetQuery, nodes []*Node, init func(*Node), assign func(*Node, *SchedulerPreset)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Node)
+	for i := range nodes {
+		if nodes[i].PresetID == nil {
+			continue
+		}
+		fk := *nodes[i].PresetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(schedulerpreset.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "preset_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -989,6 +1064,9 @@ func (_q *NodeQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withParent != nil {
 			_spec.Node.AddColumnOnce(node.FieldParentID)
 		}
+		if _q.withPreset != nil {
+			_spec.Node.AddColumnOnce(node.FieldPresetID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
 	"profen/internal/data/ent/predicate"
+	"profen/internal/data/ent/schedulerpreset"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -113,6 +114,26 @@ func (_u *NodeUpdate) ClearParentID() *NodeUpdate {
 	return _u
 }
 
+// SetPresetID sets the "preset_id" field.
+func (_u *NodeUpdate) SetPresetID(v uuid.UUID) *NodeUpdate {
+	_u.mutation.SetPresetID(v)
+	return _u
+}
+
+// SetNillablePresetID sets the "preset_id" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillablePresetID(v *uuid.UUID) *NodeUpdate {
+	if v != nil {
+		_u.SetPresetID(*v)
+	}
+	return _u
+}
+
+// ClearPresetID clears the value of the "preset_id" field.
+func (_u *NodeUpdate) ClearPresetID() *NodeUpdate {
+	_u.mutation.ClearPresetID()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Node entity.
 func (_u *NodeUpdate) SetParent(v *Node) *NodeUpdate {
 	return _u.SetParentID(v.ID)
@@ -227,6 +248,11 @@ func (_u *NodeUpdate) AddErrorResolutions(v ...*ErrorResolution) *NodeUpdate {
 	return _u.AddErrorResolutionIDs(ids...)
 }
 
+// SetPreset sets the "preset" edge to the SchedulerPreset entity.
+func (_u *NodeUpdate) SetPreset(v *SchedulerPreset) *NodeUpdate {
+	return _u.SetPresetID(v.ID)
+}
+
 // Mutation returns the NodeMutation object of the builder.
 func (_u *NodeUpdate) Mutation() *NodeMutation {
 	return _u.mutation
@@ -370,6 +396,12 @@ func (_u *NodeUpdate) RemoveErrorResolutions(v ...*ErrorResolution) *NodeUpdate
 	return _u.RemoveErrorResolutionIDs(ids...)
 }
 
+// ClearPreset clears the "preset" edge to the SchedulerPreset entity.
+func (_u *NodeUpdate) ClearPreset() *NodeUpdate {
+	_u.mutation.ClearPreset()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *NodeUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -771,6 +803,35 @@ func (_u *NodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PresetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   node.PresetTable,
+			Columns: []string{node.PresetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PresetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   node.PresetTable,
+			Columns: []string{node.PresetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -873,6 +934,26 @@ func (_u *NodeUpdateOne) ClearParentID() *NodeUpdateOne {
 	return _u
 }
 
+// SetPresetID sets the "preset_id" field.
+func (_u *NodeUpdateOne) SetPresetID(v uuid.UUID) *NodeUpdateOne {
+	_u.mutation.SetPresetID(v)
+	return _u
+}
+
+// SetNillablePresetID sets the "preset_id" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillablePresetID(v *uuid.UUID) *NodeUpdateOne {
+	if v != nil {
+		_u.SetPresetID(*v)
+	}
+	return _u
+}
+
+// ClearPresetID clears the value of the "preset_id" field.
+func (_u *NodeUpdateOne) ClearPresetID() *NodeUpdateOne {
+	_u.mutation.ClearPresetID()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Node entity.
 func (_u *NodeUpdateOne) SetParent(v *Node) *NodeUpdateOne {
 	return _u.SetParentID(v.ID)
@@ -987,6 +1068,11 @@ func (_u *NodeUpdateOne) AddErrorResolutions(v ...*ErrorResolution) *NodeUpdateO
 	return _u.AddErrorResolutionIDs(ids...)
 }
 
+// SetPreset sets the "preset" edge to the SchedulerPreset entity.
+func (_u *NodeUpdateOne) SetPreset(v *SchedulerPreset) *NodeUpdateOne {
+	return _u.SetPresetID(v.ID)
+}
+
 // Mutation returns the NodeMutation object of the builder.
 func (_u *NodeUpdateOne) Mutation() *NodeMutation {
 	return _u.mutation
@@ -1130,6 +1216,12 @@ func (_u *NodeUpdateOne) RemoveErrorResolutions(v ...*ErrorResolution) *NodeUpda
 	return _u.RemoveErrorResolutionIDs(ids...)
 }
 
+// ClearPreset clears the "preset" edge to the SchedulerPreset entity.
+func (_u *NodeUpdateOne) ClearPreset() *NodeUpdateOne {
+	_u.mutation.ClearPreset()
+	return _u
+}
+
 // Where appends a list predicates to the NodeUpdate builder.
 func (_u *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1561,6 +1653,35 @@ func (_u *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PresetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   node.PresetTable,
+			Columns: []string{node.PresetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PresetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   node.PresetTable,
+			Columns: []string{node.PresetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Node{config: _u.config}
 	_spec.Assign = _node.assignValues
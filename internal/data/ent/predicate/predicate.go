@@ -9,6 +9,9 @@ import (
 // Attempt is the predicate function for attempt builders.
 type Attempt func(*sql.Selector)
 
+// AttemptError is the predicate function for attempterror builders.
+type AttemptError func(*sql.Selector)
+
 // ErrorDefinition is the predicate function for errordefinition builders.
 type ErrorDefinition func(*sql.Selector)
 
@@ -26,3 +29,9 @@ type NodeAssociation func(*sql.Selector)
 
 // NodeClosure is the predicate function for nodeclosure builders.
 type NodeClosure func(*sql.Selector)
+
+// SchedulerPreset is the predicate function for schedulerpreset builders.
+type SchedulerPreset func(*sql.Selector)
+
+// Settings is the predicate function for settings builders.
+type Settings func(*sql.Selector)
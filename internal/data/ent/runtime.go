@@ -4,11 +4,14 @@ package ent
 
 import (
 	"profen/internal/data/ent/attempt"
+	"profen/internal/data/ent/attempterror"
 	"profen/internal/data/ent/errordefinition"
 	"profen/internal/data/ent/errorresolution"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/schedulerpreset"
 	"profen/internal/data/ent/schema"
+	"profen/internal/data/ent/settings"
 	"time"
 
 	"github.com/google/uuid"
@@ -32,20 +35,30 @@ func init() {
 	attemptDescID := attemptFields[0].Descriptor()
 	// attempt.DefaultID holds the default value on creation for the id field.
 	attempt.DefaultID = attemptDescID.Default.(func() uuid.UUID)
+	attempterrorFields := schema.AttemptError{}.Fields()
+	_ = attempterrorFields
+	// attempterrorDescID is the schema descriptor for id field.
+	attempterrorDescID := attempterrorFields[0].Descriptor()
+	// attempterror.DefaultID holds the default value on creation for the id field.
+	attempterror.DefaultID = attempterrorDescID.Default.(func() uuid.UUID)
 	errordefinitionFields := schema.ErrorDefinition{}.Fields()
 	_ = errordefinitionFields
 	// errordefinitionDescLabel is the schema descriptor for label field.
-	errordefinitionDescLabel := errordefinitionFields[1].Descriptor()
+	errordefinitionDescLabel := errordefinitionFields[2].Descriptor()
 	// errordefinition.LabelValidator is a validator for the "label" field. It is called by the builders before save.
 	errordefinition.LabelValidator = errordefinitionDescLabel.Validators[0].(func(string) error)
 	// errordefinitionDescBaseWeight is the schema descriptor for base_weight field.
-	errordefinitionDescBaseWeight := errordefinitionFields[2].Descriptor()
+	errordefinitionDescBaseWeight := errordefinitionFields[5].Descriptor()
 	// errordefinition.DefaultBaseWeight holds the default value on creation for the base_weight field.
 	errordefinition.DefaultBaseWeight = errordefinitionDescBaseWeight.Default.(float64)
 	// errordefinitionDescIsSystem is the schema descriptor for is_system field.
-	errordefinitionDescIsSystem := errordefinitionFields[3].Descriptor()
+	errordefinitionDescIsSystem := errordefinitionFields[6].Descriptor()
 	// errordefinition.DefaultIsSystem holds the default value on creation for the is_system field.
 	errordefinition.DefaultIsSystem = errordefinitionDescIsSystem.Default.(bool)
+	// errordefinitionDescIsArchived is the schema descriptor for is_archived field.
+	errordefinitionDescIsArchived := errordefinitionFields[7].Descriptor()
+	// errordefinition.DefaultIsArchived holds the default value on creation for the is_archived field.
+	errordefinition.DefaultIsArchived = errordefinitionDescIsArchived.Default.(bool)
 	// errordefinitionDescID is the schema descriptor for id field.
 	errordefinitionDescID := errordefinitionFields[0].Descriptor()
 	// errordefinition.DefaultID holds the default value on creation for the id field.
@@ -60,8 +73,12 @@ func init() {
 	errorresolutionDescIsResolved := errorresolutionFields[4].Descriptor()
 	// errorresolution.DefaultIsResolved holds the default value on creation for the is_resolved field.
 	errorresolution.DefaultIsResolved = errorresolutionDescIsResolved.Default.(bool)
+	// errorresolutionDescOccurrences is the schema descriptor for occurrences field.
+	errorresolutionDescOccurrences := errorresolutionFields[6].Descriptor()
+	// errorresolution.DefaultOccurrences holds the default value on creation for the occurrences field.
+	errorresolution.DefaultOccurrences = errorresolutionDescOccurrences.Default.(int)
 	// errorresolutionDescCreatedAt is the schema descriptor for created_at field.
-	errorresolutionDescCreatedAt := errorresolutionFields[6].Descriptor()
+	errorresolutionDescCreatedAt := errorresolutionFields[7].Descriptor()
 	// errorresolution.DefaultCreatedAt holds the default value on creation for the created_at field.
 	errorresolution.DefaultCreatedAt = errorresolutionDescCreatedAt.Default.(func() time.Time)
 	// errorresolutionDescID is the schema descriptor for id field.
@@ -98,18 +115,30 @@ func init() {
 	fsrscardDescDue := fsrscardFields[9].Descriptor()
 	// fsrscard.DefaultDue holds the default value on creation for the due field.
 	fsrscard.DefaultDue = fsrscardDescDue.Default.(func() time.Time)
-	// fsrscardDescCardState is the schema descriptor for card_state field.
-	fsrscardDescCardState := fsrscardFields[11].Descriptor()
-	// fsrscard.DefaultCardState holds the default value on creation for the card_state field.
-	fsrscard.DefaultCardState = fsrscardDescCardState.Default.(string)
 	// fsrscardDescCurrentStep is the schema descriptor for current_step field.
-	fsrscardDescCurrentStep := fsrscardFields[12].Descriptor()
+	fsrscardDescCurrentStep := fsrscardFields[11].Descriptor()
 	// fsrscard.DefaultCurrentStep holds the default value on creation for the current_step field.
 	fsrscard.DefaultCurrentStep = fsrscardDescCurrentStep.Default.(int)
-	// fsrscardDescNextReview is the schema descriptor for next_review field.
-	fsrscardDescNextReview := fsrscardFields[13].Descriptor()
-	// fsrscard.DefaultNextReview holds the default value on creation for the next_review field.
-	fsrscard.DefaultNextReview = fsrscardDescNextReview.Default.(func() time.Time)
+	// fsrscardDescEaseFactor is the schema descriptor for ease_factor field.
+	fsrscardDescEaseFactor := fsrscardFields[12].Descriptor()
+	// fsrscard.DefaultEaseFactor holds the default value on creation for the ease_factor field.
+	fsrscard.DefaultEaseFactor = fsrscardDescEaseFactor.Default.(float64)
+	// fsrscardDescLeitnerBox is the schema descriptor for leitner_box field.
+	fsrscardDescLeitnerBox := fsrscardFields[13].Descriptor()
+	// fsrscard.DefaultLeitnerBox holds the default value on creation for the leitner_box field.
+	fsrscard.DefaultLeitnerBox = fsrscardDescLeitnerBox.Default.(int)
+	// fsrscardDescIsLeech is the schema descriptor for is_leech field.
+	fsrscardDescIsLeech := fsrscardFields[14].Descriptor()
+	// fsrscard.DefaultIsLeech holds the default value on creation for the is_leech field.
+	fsrscard.DefaultIsLeech = fsrscardDescIsLeech.Default.(bool)
+	// fsrscardDescIsSuspended is the schema descriptor for is_suspended field.
+	fsrscardDescIsSuspended := fsrscardFields[15].Descriptor()
+	// fsrscard.DefaultIsSuspended holds the default value on creation for the is_suspended field.
+	fsrscard.DefaultIsSuspended = fsrscardDescIsSuspended.Default.(bool)
+	// fsrscardDescVersion is the schema descriptor for version field.
+	fsrscardDescVersion := fsrscardFields[17].Descriptor()
+	// fsrscard.DefaultVersion holds the default value on creation for the version field.
+	fsrscard.DefaultVersion = fsrscardDescVersion.Default.(int)
 	// fsrscardDescID is the schema descriptor for id field.
 	fsrscardDescID := fsrscardFields[0].Descriptor()
 	// fsrscard.DefaultID holds the default value on creation for the id field.
@@ -128,4 +157,110 @@ func init() {
 	nodeDescID := nodeFields[0].Descriptor()
 	// node.DefaultID holds the default value on creation for the id field.
 	node.DefaultID = nodeDescID.Default.(func() uuid.UUID)
+	schedulerpresetFields := schema.SchedulerPreset{}.Fields()
+	_ = schedulerpresetFields
+	// schedulerpresetDescName is the schema descriptor for name field.
+	schedulerpresetDescName := schedulerpresetFields[1].Descriptor()
+	// schedulerpreset.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schedulerpreset.NameValidator = schedulerpresetDescName.Validators[0].(func(string) error)
+	// schedulerpresetDescDesiredRetention is the schema descriptor for desired_retention field.
+	schedulerpresetDescDesiredRetention := schedulerpresetFields[6].Descriptor()
+	// schedulerpreset.DefaultDesiredRetention holds the default value on creation for the desired_retention field.
+	schedulerpreset.DefaultDesiredRetention = schedulerpresetDescDesiredRetention.Default.(float64)
+	// schedulerpresetDescMaxInterval is the schema descriptor for max_interval field.
+	schedulerpresetDescMaxInterval := schedulerpresetFields[7].Descriptor()
+	// schedulerpreset.DefaultMaxInterval holds the default value on creation for the max_interval field.
+	schedulerpreset.DefaultMaxInterval = schedulerpresetDescMaxInterval.Default.(int)
+	// schedulerpresetDescEnableFuzz is the schema descriptor for enable_fuzz field.
+	schedulerpresetDescEnableFuzz := schedulerpresetFields[8].Descriptor()
+	// schedulerpreset.DefaultEnableFuzz holds the default value on creation for the enable_fuzz field.
+	schedulerpreset.DefaultEnableFuzz = schedulerpresetDescEnableFuzz.Default.(bool)
+	// schedulerpresetDescEnableLoadBalance is the schema descriptor for enable_load_balance field.
+	schedulerpresetDescEnableLoadBalance := schedulerpresetFields[9].Descriptor()
+	// schedulerpreset.DefaultEnableLoadBalance holds the default value on creation for the enable_load_balance field.
+	schedulerpreset.DefaultEnableLoadBalance = schedulerpresetDescEnableLoadBalance.Default.(bool)
+	// schedulerpresetDescGraduatingInterval is the schema descriptor for graduating_interval field.
+	schedulerpresetDescGraduatingInterval := schedulerpresetFields[12].Descriptor()
+	// schedulerpreset.DefaultGraduatingInterval holds the default value on creation for the graduating_interval field.
+	schedulerpreset.DefaultGraduatingInterval = schedulerpresetDescGraduatingInterval.Default.(int)
+	// schedulerpresetDescEasyInterval is the schema descriptor for easy_interval field.
+	schedulerpresetDescEasyInterval := schedulerpresetFields[13].Descriptor()
+	// schedulerpreset.DefaultEasyInterval holds the default value on creation for the easy_interval field.
+	schedulerpreset.DefaultEasyInterval = schedulerpresetDescEasyInterval.Default.(int)
+	// schedulerpresetDescLeechThreshold is the schema descriptor for leech_threshold field.
+	schedulerpresetDescLeechThreshold := schedulerpresetFields[14].Descriptor()
+	// schedulerpreset.DefaultLeechThreshold holds the default value on creation for the leech_threshold field.
+	schedulerpreset.DefaultLeechThreshold = schedulerpresetDescLeechThreshold.Default.(int)
+	// schedulerpresetDescLeechSuspend is the schema descriptor for leech_suspend field.
+	schedulerpresetDescLeechSuspend := schedulerpresetFields[15].Descriptor()
+	// schedulerpreset.DefaultLeechSuspend holds the default value on creation for the leech_suspend field.
+	schedulerpreset.DefaultLeechSuspend = schedulerpresetDescLeechSuspend.Default.(bool)
+	// schedulerpresetDescBuryTranslations is the schema descriptor for bury_translations field.
+	schedulerpresetDescBuryTranslations := schedulerpresetFields[16].Descriptor()
+	// schedulerpreset.DefaultBuryTranslations holds the default value on creation for the bury_translations field.
+	schedulerpreset.DefaultBuryTranslations = schedulerpresetDescBuryTranslations.Default.(bool)
+	// schedulerpresetDescImplicitCredit is the schema descriptor for implicit_credit field.
+	schedulerpresetDescImplicitCredit := schedulerpresetFields[17].Descriptor()
+	// schedulerpreset.DefaultImplicitCredit holds the default value on creation for the implicit_credit field.
+	schedulerpreset.DefaultImplicitCredit = schedulerpresetDescImplicitCredit.Default.(float64)
+	// schedulerpresetDescImplicitLapsePull is the schema descriptor for implicit_lapse_pull field.
+	schedulerpresetDescImplicitLapsePull := schedulerpresetFields[18].Descriptor()
+	// schedulerpreset.DefaultImplicitLapsePull holds the default value on creation for the implicit_lapse_pull field.
+	schedulerpreset.DefaultImplicitLapsePull = schedulerpresetDescImplicitLapsePull.Default.(float64)
+	// schedulerpresetDescPrerequisiteMinStability is the schema descriptor for prerequisite_min_stability field.
+	schedulerpresetDescPrerequisiteMinStability := schedulerpresetFields[20].Descriptor()
+	// schedulerpreset.DefaultPrerequisiteMinStability holds the default value on creation for the prerequisite_min_stability field.
+	schedulerpreset.DefaultPrerequisiteMinStability = schedulerpresetDescPrerequisiteMinStability.Default.(float64)
+	// schedulerpresetDescErrorResolveAfter is the schema descriptor for error_resolve_after field.
+	schedulerpresetDescErrorResolveAfter := schedulerpresetFields[21].Descriptor()
+	// schedulerpreset.DefaultErrorResolveAfter holds the default value on creation for the error_resolve_after field.
+	schedulerpreset.DefaultErrorResolveAfter = schedulerpresetDescErrorResolveAfter.Default.(int)
+	// schedulerpresetDescNewPerDay is the schema descriptor for new_per_day field.
+	schedulerpresetDescNewPerDay := schedulerpresetFields[22].Descriptor()
+	// schedulerpreset.DefaultNewPerDay holds the default value on creation for the new_per_day field.
+	schedulerpreset.DefaultNewPerDay = schedulerpresetDescNewPerDay.Default.(int)
+	// schedulerpresetDescReviewsPerDay is the schema descriptor for reviews_per_day field.
+	schedulerpresetDescReviewsPerDay := schedulerpresetFields[23].Descriptor()
+	// schedulerpreset.DefaultReviewsPerDay holds the default value on creation for the reviews_per_day field.
+	schedulerpreset.DefaultReviewsPerDay = schedulerpresetDescReviewsPerDay.Default.(int)
+	// schedulerpresetDescLearnAheadMinutes is the schema descriptor for learn_ahead_minutes field.
+	schedulerpresetDescLearnAheadMinutes := schedulerpresetFields[24].Descriptor()
+	// schedulerpreset.DefaultLearnAheadMinutes holds the default value on creation for the learn_ahead_minutes field.
+	schedulerpreset.DefaultLearnAheadMinutes = schedulerpresetDescLearnAheadMinutes.Default.(int)
+	// schedulerpresetDescIsDefault is the schema descriptor for is_default field.
+	schedulerpresetDescIsDefault := schedulerpresetFields[26].Descriptor()
+	// schedulerpreset.DefaultIsDefault holds the default value on creation for the is_default field.
+	schedulerpreset.DefaultIsDefault = schedulerpresetDescIsDefault.Default.(bool)
+	// schedulerpresetDescCreatedAt is the schema descriptor for created_at field.
+	schedulerpresetDescCreatedAt := schedulerpresetFields[27].Descriptor()
+	// schedulerpreset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedulerpreset.DefaultCreatedAt = schedulerpresetDescCreatedAt.Default.(func() time.Time)
+	// schedulerpresetDescUpdatedAt is the schema descriptor for updated_at field.
+	schedulerpresetDescUpdatedAt := schedulerpresetFields[28].Descriptor()
+	// schedulerpreset.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schedulerpreset.DefaultUpdatedAt = schedulerpresetDescUpdatedAt.Default.(func() time.Time)
+	// schedulerpreset.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	schedulerpreset.UpdateDefaultUpdatedAt = schedulerpresetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// schedulerpresetDescID is the schema descriptor for id field.
+	schedulerpresetDescID := schedulerpresetFields[0].Descriptor()
+	// schedulerpreset.DefaultID holds the default value on creation for the id field.
+	schedulerpreset.DefaultID = schedulerpresetDescID.Default.(func() uuid.UUID)
+	settingsFields := schema.Settings{}.Fields()
+	_ = settingsFields
+	// settingsDescDayStartHour is the schema descriptor for day_start_hour field.
+	settingsDescDayStartHour := settingsFields[0].Descriptor()
+	// settings.DefaultDayStartHour holds the default value on creation for the day_start_hour field.
+	settings.DefaultDayStartHour = settingsDescDayStartHour.Default.(int)
+	// settings.DayStartHourValidator is a validator for the "day_start_hour" field. It is called by the builders before save.
+	settings.DayStartHourValidator = settingsDescDayStartHour.Validators[0].(func(int) error)
+	// settingsDescTimezone is the schema descriptor for timezone field.
+	settingsDescTimezone := settingsFields[1].Descriptor()
+	// settings.DefaultTimezone holds the default value on creation for the timezone field.
+	settings.DefaultTimezone = settingsDescTimezone.Default.(string)
+	// settingsDescUpdatedAt is the schema descriptor for updated_at field.
+	settingsDescUpdatedAt := settingsFields[2].Descriptor()
+	// settings.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	settings.DefaultUpdatedAt = settingsDescUpdatedAt.Default.(func() time.Time)
+	// settings.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	settings.UpdateDefaultUpdatedAt = settingsDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"profen/internal/data/ent/schedulerpreset"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SchedulerPreset is the model entity for the SchedulerPreset schema.
+type SchedulerPreset struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Algorithm that schedules cards after learning steps
+	Scheduler schedulerpreset.Scheduler `json:"scheduler,omitempty"`
+	// Days between reviews for each Leitner box
+	LeitnerIntervals []int `json:"leitner_intervals,omitempty"`
+	// FSRS formula version; determines the expected weight count
+	AlgorithmVersion schedulerpreset.AlgorithmVersion `json:"algorithm_version,omitempty"`
+	// FSRS weights (W): 17 for v4, 19 for v5, 21 for v6
+	Weights []float64 `json:"weights,omitempty"`
+	// Target probability of recall at the due date
+	DesiredRetention float64 `json:"desired_retention,omitempty"`
+	// Upper bound on scheduled interval in days
+	MaxInterval int `json:"max_interval,omitempty"`
+	// Spread review intervals within the FSRS fuzz ranges
+	EnableFuzz bool `json:"enable_fuzz,omitempty"`
+	// Move reviews to the least loaded day in the fuzz window
+	EnableLoadBalance bool `json:"enable_load_balance,omitempty"`
+	// Minutes between learning steps for new cards
+	LearningSteps []int `json:"learning_steps,omitempty"`
+	// Minutes between relearning steps after a lapse
+	RelearningSteps []int `json:"relearning_steps,omitempty"`
+	// Days until first review after the last learning step
+	GraduatingInterval int `json:"graduating_interval,omitempty"`
+	// Days until first review when a learning card is rated Easy
+	EasyInterval int `json:"easy_interval,omitempty"`
+	// Lapses after which a card is flagged as a leech
+	LeechThreshold int `json:"leech_threshold,omitempty"`
+	// Suspend cards when they become leeches
+	LeechSuspend bool `json:"leech_suspend,omitempty"`
+	// Bury translation siblings until tomorrow after one of them is reviewed
+	BuryTranslations bool `json:"bury_translations,omitempty"`
+	// Fraction of a repetition given to linked theory when a problem is solved; 0 disables
+	ImplicitCredit float64 `json:"implicit_credit,omitempty"`
+	// Fraction of the remaining interval removed from linked theory when a problem lapses; 0 disables
+	ImplicitLapsePull float64 `json:"implicit_lapse_pull,omitempty"`
+	// What a prerequisite must reach before new cards that depend on it are introduced
+	PrerequisiteGate schedulerpreset.PrerequisiteGate `json:"prerequisite_gate,omitempty"`
+	// Stability in days each prerequisite card needs when gating by stability
+	PrerequisiteMinStability float64 `json:"prerequisite_min_stability,omitempty"`
+	// Consecutive correct answers that close an open error; 0 leaves errors open until resolved by hand
+	ErrorResolveAfter int `json:"error_resolve_after,omitempty"`
+	// New cards introduced per day
+	NewPerDay int `json:"new_per_day,omitempty"`
+	// Review cards shown per day
+	ReviewsPerDay int `json:"reviews_per_day,omitempty"`
+	// Learning cards due within this window are shown early
+	LearnAheadMinutes int `json:"learn_ahead_minutes,omitempty"`
+	// How new cards are interleaved with reviews
+	QueueOrder schedulerpreset.QueueOrder `json:"queue_order,omitempty"`
+	// Used for nodes with no preset on any ancestor
+	IsDefault bool `json:"is_default,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SchedulerPresetQuery when eager-loading is set.
+	Edges        SchedulerPresetEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SchedulerPresetEdges holds the relations/edges for other nodes in the graph.
+type SchedulerPresetEdges struct {
+	// Nodes holds the value of the nodes edge.
+	Nodes []*Node `json:"nodes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NodesOrErr returns the Nodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e SchedulerPresetEdges) NodesOrErr() ([]*Node, error) {
+	if e.loadedTypes[0] {
+		return e.Nodes, nil
+	}
+	return nil, &NotLoadedError{edge: "nodes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SchedulerPreset) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedulerpreset.FieldLeitnerIntervals, schedulerpreset.FieldWeights, schedulerpreset.FieldLearningSteps, schedulerpreset.FieldRelearningSteps:
+			values[i] = new([]byte)
+		case schedulerpreset.FieldEnableFuzz, schedulerpreset.FieldEnableLoadBalance, schedulerpreset.FieldLeechSuspend, schedulerpreset.FieldBuryTranslations, schedulerpreset.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case schedulerpreset.FieldDesiredRetention, schedulerpreset.FieldImplicitCredit, schedulerpreset.FieldImplicitLapsePull, schedulerpreset.FieldPrerequisiteMinStability:
+			values[i] = new(sql.NullFloat64)
+		case schedulerpreset.FieldMaxInterval, schedulerpreset.FieldGraduatingInterval, schedulerpreset.FieldEasyInterval, schedulerpreset.FieldLeechThreshold, schedulerpreset.FieldErrorResolveAfter, schedulerpreset.FieldNewPerDay, schedulerpreset.FieldReviewsPerDay, schedulerpreset.FieldLearnAheadMinutes:
+			values[i] = new(sql.NullInt64)
+		case schedulerpreset.FieldName, schedulerpreset.FieldScheduler, schedulerpreset.FieldAlgorithmVersion, schedulerpreset.FieldPrerequisiteGate, schedulerpreset.FieldQueueOrder:
+			values[i] = new(sql.NullString)
+		case schedulerpreset.FieldCreatedAt, schedulerpreset.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case schedulerpreset.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SchedulerPreset fields.
+func (_m *SchedulerPreset) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedulerpreset.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case schedulerpreset.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case schedulerpreset.FieldScheduler:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduler", values[i])
+			} else if value.Valid {
+				_m.Scheduler = schedulerpreset.Scheduler(value.String)
+			}
+		case schedulerpreset.FieldLeitnerIntervals:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field leitner_intervals", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LeitnerIntervals); err != nil {
+					return fmt.Errorf("unmarshal field leitner_intervals: %w", err)
+				}
+			}
+		case schedulerpreset.FieldAlgorithmVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm_version", values[i])
+			} else if value.Valid {
+				_m.AlgorithmVersion = schedulerpreset.AlgorithmVersion(value.String)
+			}
+		case schedulerpreset.FieldWeights:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field weights", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Weights); err != nil {
+					return fmt.Errorf("unmarshal field weights: %w", err)
+				}
+			}
+		case schedulerpreset.FieldDesiredRetention:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field desired_retention", values[i])
+			} else if value.Valid {
+				_m.DesiredRetention = value.Float64
+			}
+		case schedulerpreset.FieldMaxInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_interval", values[i])
+			} else if value.Valid {
+				_m.MaxInterval = int(value.Int64)
+			}
+		case schedulerpreset.FieldEnableFuzz:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enable_fuzz", values[i])
+			} else if value.Valid {
+				_m.EnableFuzz = value.Bool
+			}
+		case schedulerpreset.FieldEnableLoadBalance:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enable_load_balance", values[i])
+			} else if value.Valid {
+				_m.EnableLoadBalance = value.Bool
+			}
+		case schedulerpreset.FieldLearningSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field learning_steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LearningSteps); err != nil {
+					return fmt.Errorf("unmarshal field learning_steps: %w", err)
+				}
+			}
+		case schedulerpreset.FieldRelearningSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field relearning_steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RelearningSteps); err != nil {
+					return fmt.Errorf("unmarshal field relearning_steps: %w", err)
+				}
+			}
+		case schedulerpreset.FieldGraduatingInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field graduating_interval", values[i])
+			} else if value.Valid {
+				_m.GraduatingInterval = int(value.Int64)
+			}
+		case schedulerpreset.FieldEasyInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field easy_interval", values[i])
+			} else if value.Valid {
+				_m.EasyInterval = int(value.Int64)
+			}
+		case schedulerpreset.FieldLeechThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leech_threshold", values[i])
+			} else if value.Valid {
+				_m.LeechThreshold = int(value.Int64)
+			}
+		case schedulerpreset.FieldLeechSuspend:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field leech_suspend", values[i])
+			} else if value.Valid {
+				_m.LeechSuspend = value.Bool
+			}
+		case schedulerpreset.FieldBuryTranslations:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bury_translations", values[i])
+			} else if value.Valid {
+				_m.BuryTranslations = value.Bool
+			}
+		case schedulerpreset.FieldImplicitCredit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field implicit_credit", values[i])
+			} else if value.Valid {
+				_m.ImplicitCredit = value.Float64
+			}
+		case schedulerpreset.FieldImplicitLapsePull:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field implicit_lapse_pull", values[i])
+			} else if value.Valid {
+				_m.ImplicitLapsePull = value.Float64
+			}
+		case schedulerpreset.FieldPrerequisiteGate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prerequisite_gate", values[i])
+			} else if value.Valid {
+				_m.PrerequisiteGate = schedulerpreset.PrerequisiteGate(value.String)
+			}
+		case schedulerpreset.FieldPrerequisiteMinStability:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field prerequisite_min_stability", values[i])
+			} else if value.Valid {
+				_m.PrerequisiteMinStability = value.Float64
+			}
+		case schedulerpreset.FieldErrorResolveAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field error_resolve_after", values[i])
+			} else if value.Valid {
+				_m.ErrorResolveAfter = int(value.Int64)
+			}
+		case schedulerpreset.FieldNewPerDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field new_per_day", values[i])
+			} else if value.Valid {
+				_m.NewPerDay = int(value.Int64)
+			}
+		case schedulerpreset.FieldReviewsPerDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviews_per_day", values[i])
+			} else if value.Valid {
+				_m.ReviewsPerDay = int(value.Int64)
+			}
+		case schedulerpreset.FieldLearnAheadMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field learn_ahead_minutes", values[i])
+			} else if value.Valid {
+				_m.LearnAheadMinutes = int(value.Int64)
+			}
+		case schedulerpreset.FieldQueueOrder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue_order", values[i])
+			} else if value.Valid {
+				_m.QueueOrder = schedulerpreset.QueueOrder(value.String)
+			}
+		case schedulerpreset.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case schedulerpreset.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case schedulerpreset.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SchedulerPreset.
+// This includes values selected through modifiers, order, etc.
+func (_m *SchedulerPreset) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryNodes queries the "nodes" edge of the SchedulerPreset entity.
+func (_m *SchedulerPreset) QueryNodes() *NodeQuery {
+	return NewSchedulerPresetClient(_m.config).QueryNodes(_m)
+}
+
+// Update returns a builder for updating this SchedulerPreset.
+// Note that you need to call SchedulerPreset.Unwrap() before calling this method if this SchedulerPreset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SchedulerPreset) Update() *SchedulerPresetUpdateOne {
+	return NewSchedulerPresetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SchedulerPreset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SchedulerPreset) Unwrap() *SchedulerPreset {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SchedulerPreset is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SchedulerPreset) String() string {
+	var builder strings.Builder
+	builder.WriteString("SchedulerPreset(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("scheduler=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scheduler))
+	builder.WriteString(", ")
+	builder.WriteString("leitner_intervals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeitnerIntervals))
+	builder.WriteString(", ")
+	builder.WriteString("algorithm_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlgorithmVersion))
+	builder.WriteString(", ")
+	builder.WriteString("weights=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weights))
+	builder.WriteString(", ")
+	builder.WriteString("desired_retention=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DesiredRetention))
+	builder.WriteString(", ")
+	builder.WriteString("max_interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxInterval))
+	builder.WriteString(", ")
+	builder.WriteString("enable_fuzz=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnableFuzz))
+	builder.WriteString(", ")
+	builder.WriteString("enable_load_balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnableLoadBalance))
+	builder.WriteString(", ")
+	builder.WriteString("learning_steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LearningSteps))
+	builder.WriteString(", ")
+	builder.WriteString("relearning_steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RelearningSteps))
+	builder.WriteString(", ")
+	builder.WriteString("graduating_interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GraduatingInterval))
+	builder.WriteString(", ")
+	builder.WriteString("easy_interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EasyInterval))
+	builder.WriteString(", ")
+	builder.WriteString("leech_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeechThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("leech_suspend=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeechSuspend))
+	builder.WriteString(", ")
+	builder.WriteString("bury_translations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BuryTranslations))
+	builder.WriteString(", ")
+	builder.WriteString("implicit_credit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImplicitCredit))
+	builder.WriteString(", ")
+	builder.WriteString("implicit_lapse_pull=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImplicitLapsePull))
+	builder.WriteString(", ")
+	builder.WriteString("prerequisite_gate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PrerequisiteGate))
+	builder.WriteString(", ")
+	builder.WriteString("prerequisite_min_stability=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PrerequisiteMinStability))
+	builder.WriteString(", ")
+	builder.WriteString("error_resolve_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ErrorResolveAfter))
+	builder.WriteString(", ")
+	builder.WriteString("new_per_day=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NewPerDay))
+	builder.WriteString(", ")
+	builder.WriteString("reviews_per_day=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReviewsPerDay))
+	builder.WriteString(", ")
+	builder.WriteString("learn_ahead_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LearnAheadMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("queue_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QueueOrder))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SchedulerPresets is a parsable slice of SchedulerPreset.
+type SchedulerPresets []*SchedulerPreset
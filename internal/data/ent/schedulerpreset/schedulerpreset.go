@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedulerpreset
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the schedulerpreset type in the database.
+	Label = "scheduler_preset"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "preset_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldScheduler holds the string denoting the scheduler field in the database.
+	FieldScheduler = "scheduler"
+	// FieldLeitnerIntervals holds the string denoting the leitner_intervals field in the database.
+	FieldLeitnerIntervals = "leitner_intervals"
+	// FieldAlgorithmVersion holds the string denoting the algorithm_version field in the database.
+	FieldAlgorithmVersion = "algorithm_version"
+	// FieldWeights holds the string denoting the weights field in the database.
+	FieldWeights = "weights"
+	// FieldDesiredRetention holds the string denoting the desired_retention field in the database.
+	FieldDesiredRetention = "desired_retention"
+	// FieldMaxInterval holds the string denoting the max_interval field in the database.
+	FieldMaxInterval = "max_interval"
+	// FieldEnableFuzz holds the string denoting the enable_fuzz field in the database.
+	FieldEnableFuzz = "enable_fuzz"
+	// FieldEnableLoadBalance holds the string denoting the enable_load_balance field in the database.
+	FieldEnableLoadBalance = "enable_load_balance"
+	// FieldLearningSteps holds the string denoting the learning_steps field in the database.
+	FieldLearningSteps = "learning_steps"
+	// FieldRelearningSteps holds the string denoting the relearning_steps field in the database.
+	FieldRelearningSteps = "relearning_steps"
+	// FieldGraduatingInterval holds the string denoting the graduating_interval field in the database.
+	FieldGraduatingInterval = "graduating_interval"
+	// FieldEasyInterval holds the string denoting the easy_interval field in the database.
+	FieldEasyInterval = "easy_interval"
+	// FieldLeechThreshold holds the string denoting the leech_threshold field in the database.
+	FieldLeechThreshold = "leech_threshold"
+	// FieldLeechSuspend holds the string denoting the leech_suspend field in the database.
+	FieldLeechSuspend = "leech_suspend"
+	// FieldBuryTranslations holds the string denoting the bury_translations field in the database.
+	FieldBuryTranslations = "bury_translations"
+	// FieldImplicitCredit holds the string denoting the implicit_credit field in the database.
+	FieldImplicitCredit = "implicit_credit"
+	// FieldImplicitLapsePull holds the string denoting the implicit_lapse_pull field in the database.
+	FieldImplicitLapsePull = "implicit_lapse_pull"
+	// FieldPrerequisiteGate holds the string denoting the prerequisite_gate field in the database.
+	FieldPrerequisiteGate = "prerequisite_gate"
+	// FieldPrerequisiteMinStability holds the string denoting the prerequisite_min_stability field in the database.
+	FieldPrerequisiteMinStability = "prerequisite_min_stability"
+	// FieldErrorResolveAfter holds the string denoting the error_resolve_after field in the database.
+	FieldErrorResolveAfter = "error_resolve_after"
+	// FieldNewPerDay holds the string denoting the new_per_day field in the database.
+	FieldNewPerDay = "new_per_day"
+	// FieldReviewsPerDay holds the string denoting the reviews_per_day field in the database.
+	FieldReviewsPerDay = "reviews_per_day"
+	// FieldLearnAheadMinutes holds the string denoting the learn_ahead_minutes field in the database.
+	FieldLearnAheadMinutes = "learn_ahead_minutes"
+	// FieldQueueOrder holds the string denoting the queue_order field in the database.
+	FieldQueueOrder = "queue_order"
+	// FieldIsDefault holds the string denoting the is_default field in the database.
+	FieldIsDefault = "is_default"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeNodes holds the string denoting the nodes edge name in mutations.
+	EdgeNodes = "nodes"
+	// NodeFieldID holds the string denoting the ID field of the Node.
+	NodeFieldID = "node_id"
+	// Table holds the table name of the schedulerpreset in the database.
+	Table = "scheduler_presets"
+	// NodesTable is the table that holds the nodes relation/edge.
+	NodesTable = "nodes"
+	// NodesInverseTable is the table name for the Node entity.
+	// It exists in this package in order to avoid circular dependency with the "node" package.
+	NodesInverseTable = "nodes"
+	// NodesColumn is the table column denoting the nodes relation/edge.
+	NodesColumn = "preset_id"
+)
+
+// Columns holds all SQL columns for schedulerpreset fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldScheduler,
+	FieldLeitnerIntervals,
+	FieldAlgorithmVersion,
+	FieldWeights,
+	FieldDesiredRetention,
+	FieldMaxInterval,
+	FieldEnableFuzz,
+	FieldEnableLoadBalance,
+	FieldLearningSteps,
+	FieldRelearningSteps,
+	FieldGraduatingInterval,
+	FieldEasyInterval,
+	FieldLeechThreshold,
+	FieldLeechSuspend,
+	FieldBuryTranslations,
+	FieldImplicitCredit,
+	FieldImplicitLapsePull,
+	FieldPrerequisiteGate,
+	FieldPrerequisiteMinStability,
+	FieldErrorResolveAfter,
+	FieldNewPerDay,
+	FieldReviewsPerDay,
+	FieldLearnAheadMinutes,
+	FieldQueueOrder,
+	FieldIsDefault,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultDesiredRetention holds the default value on creation for the "desired_retention" field.
+	DefaultDesiredRetention float64
+	// DefaultMaxInterval holds the default value on creation for the "max_interval" field.
+	DefaultMaxInterval int
+	// DefaultEnableFuzz holds the default value on creation for the "enable_fuzz" field.
+	DefaultEnableFuzz bool
+	// DefaultEnableLoadBalance holds the default value on creation for the "enable_load_balance" field.
+	DefaultEnableLoadBalance bool
+	// DefaultGraduatingInterval holds the default value on creation for the "graduating_interval" field.
+	DefaultGraduatingInterval int
+	// DefaultEasyInterval holds the default value on creation for the "easy_interval" field.
+	DefaultEasyInterval int
+	// DefaultLeechThreshold holds the default value on creation for the "leech_threshold" field.
+	DefaultLeechThreshold int
+	// DefaultLeechSuspend holds the default value on creation for the "leech_suspend" field.
+	DefaultLeechSuspend bool
+	// DefaultBuryTranslations holds the default value on creation for the "bury_translations" field.
+	DefaultBuryTranslations bool
+	// DefaultImplicitCredit holds the default value on creation for the "implicit_credit" field.
+	DefaultImplicitCredit float64
+	// DefaultImplicitLapsePull holds the default value on creation for the "implicit_lapse_pull" field.
+	DefaultImplicitLapsePull float64
+	// DefaultPrerequisiteMinStability holds the default value on creation for the "prerequisite_min_stability" field.
+	DefaultPrerequisiteMinStability float64
+	// DefaultErrorResolveAfter holds the default value on creation for the "error_resolve_after" field.
+	DefaultErrorResolveAfter int
+	// DefaultNewPerDay holds the default value on creation for the "new_per_day" field.
+	DefaultNewPerDay int
+	// DefaultReviewsPerDay holds the default value on creation for the "reviews_per_day" field.
+	DefaultReviewsPerDay int
+	// DefaultLearnAheadMinutes holds the default value on creation for the "learn_ahead_minutes" field.
+	DefaultLearnAheadMinutes int
+	// DefaultIsDefault holds the default value on creation for the "is_default" field.
+	DefaultIsDefault bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Scheduler defines the type for the "scheduler" enum field.
+type Scheduler string
+
+// SchedulerFsrs is the default value of the Scheduler enum.
+const DefaultScheduler = SchedulerFsrs
+
+// Scheduler values.
+const (
+	SchedulerFsrs    Scheduler = "fsrs"
+	SchedulerSm2     Scheduler = "sm2"
+	SchedulerLeitner Scheduler = "leitner"
+)
+
+func (s Scheduler) String() string {
+	return string(s)
+}
+
+// SchedulerValidator is a validator for the "scheduler" field enum values. It is called by the builders before save.
+func SchedulerValidator(s Scheduler) error {
+	switch s {
+	case SchedulerFsrs, SchedulerSm2, SchedulerLeitner:
+		return nil
+	default:
+		return fmt.Errorf("schedulerpreset: invalid enum value for scheduler field: %q", s)
+	}
+}
+
+// AlgorithmVersion defines the type for the "algorithm_version" enum field.
+type AlgorithmVersion string
+
+// AlgorithmVersionV4 is the default value of the AlgorithmVersion enum.
+const DefaultAlgorithmVersion = AlgorithmVersionV4
+
+// AlgorithmVersion values.
+const (
+	AlgorithmVersionV4 AlgorithmVersion = "v4"
+	AlgorithmVersionV5 AlgorithmVersion = "v5"
+	AlgorithmVersionV6 AlgorithmVersion = "v6"
+)
+
+func (av AlgorithmVersion) String() string {
+	return string(av)
+}
+
+// AlgorithmVersionValidator is a validator for the "algorithm_version" field enum values. It is called by the builders before save.
+func AlgorithmVersionValidator(av AlgorithmVersion) error {
+	switch av {
+	case AlgorithmVersionV4, AlgorithmVersionV5, AlgorithmVersionV6:
+		return nil
+	default:
+		return fmt.Errorf("schedulerpreset: invalid enum value for algorithm_version field: %q", av)
+	}
+}
+
+// PrerequisiteGate defines the type for the "prerequisite_gate" enum field.
+type PrerequisiteGate string
+
+// PrerequisiteGateOff is the default value of the PrerequisiteGate enum.
+const DefaultPrerequisiteGate = PrerequisiteGateOff
+
+// PrerequisiteGate values.
+const (
+	PrerequisiteGateOff       PrerequisiteGate = "off"
+	PrerequisiteGateStability PrerequisiteGate = "stability"
+	PrerequisiteGateMastery   PrerequisiteGate = "mastery"
+)
+
+func (pg PrerequisiteGate) String() string {
+	return string(pg)
+}
+
+// PrerequisiteGateValidator is a validator for the "prerequisite_gate" field enum values. It is called by the builders before save.
+func PrerequisiteGateValidator(pg PrerequisiteGate) error {
+	switch pg {
+	case PrerequisiteGateOff, PrerequisiteGateStability, PrerequisiteGateMastery:
+		return nil
+	default:
+		return fmt.Errorf("schedulerpreset: invalid enum value for prerequisite_gate field: %q", pg)
+	}
+}
+
+// QueueOrder defines the type for the "queue_order" enum field.
+type QueueOrder string
+
+// QueueOrderMixed is the default value of the QueueOrder enum.
+const DefaultQueueOrder = QueueOrderMixed
+
+// QueueOrder values.
+const (
+	QueueOrderMixed        QueueOrder = "mixed"
+	QueueOrderNewFirst     QueueOrder = "new_first"
+	QueueOrderReviewsFirst QueueOrder = "reviews_first"
+)
+
+func (qo QueueOrder) String() string {
+	return string(qo)
+}
+
+// QueueOrderValidator is a validator for the "queue_order" field enum values. It is called by the builders before save.
+func QueueOrderValidator(qo QueueOrder) error {
+	switch qo {
+	case QueueOrderMixed, QueueOrderNewFirst, QueueOrderReviewsFirst:
+		return nil
+	default:
+		return fmt.Errorf("schedulerpreset: invalid enum value for queue_order field: %q", qo)
+	}
+}
+
+// OrderOption defines the ordering options for the SchedulerPreset queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByScheduler orders the results by the scheduler field.
+func ByScheduler(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduler, opts...).ToFunc()
+}
+
+// ByAlgorithmVersion orders the results by the algorithm_version field.
+func ByAlgorithmVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlgorithmVersion, opts...).ToFunc()
+}
+
+// ByDesiredRetention orders the results by the desired_retention field.
+func ByDesiredRetention(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDesiredRetention, opts...).ToFunc()
+}
+
+// ByMaxInterval orders the results by the max_interval field.
+func ByMaxInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxInterval, opts...).ToFunc()
+}
+
+// ByEnableFuzz orders the results by the enable_fuzz field.
+func ByEnableFuzz(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnableFuzz, opts...).ToFunc()
+}
+
+// ByEnableLoadBalance orders the results by the enable_load_balance field.
+func ByEnableLoadBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnableLoadBalance, opts...).ToFunc()
+}
+
+// ByGraduatingInterval orders the results by the graduating_interval field.
+func ByGraduatingInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraduatingInterval, opts...).ToFunc()
+}
+
+// ByEasyInterval orders the results by the easy_interval field.
+func ByEasyInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEasyInterval, opts...).ToFunc()
+}
+
+// ByLeechThreshold orders the results by the leech_threshold field.
+func ByLeechThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeechThreshold, opts...).ToFunc()
+}
+
+// ByLeechSuspend orders the results by the leech_suspend field.
+func ByLeechSuspend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeechSuspend, opts...).ToFunc()
+}
+
+// ByBuryTranslations orders the results by the bury_translations field.
+func ByBuryTranslations(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuryTranslations, opts...).ToFunc()
+}
+
+// ByImplicitCredit orders the results by the implicit_credit field.
+func ByImplicitCredit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImplicitCredit, opts...).ToFunc()
+}
+
+// ByImplicitLapsePull orders the results by the implicit_lapse_pull field.
+func ByImplicitLapsePull(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImplicitLapsePull, opts...).ToFunc()
+}
+
+// ByPrerequisiteGate orders the results by the prerequisite_gate field.
+func ByPrerequisiteGate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrerequisiteGate, opts...).ToFunc()
+}
+
+// ByPrerequisiteMinStability orders the results by the prerequisite_min_stability field.
+func ByPrerequisiteMinStability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrerequisiteMinStability, opts...).ToFunc()
+}
+
+// ByErrorResolveAfter orders the results by the error_resolve_after field.
+func ByErrorResolveAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorResolveAfter, opts...).ToFunc()
+}
+
+// ByNewPerDay orders the results by the new_per_day field.
+func ByNewPerDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewPerDay, opts...).ToFunc()
+}
+
+// ByReviewsPerDay orders the results by the reviews_per_day field.
+func ByReviewsPerDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewsPerDay, opts...).ToFunc()
+}
+
+// ByLearnAheadMinutes orders the results by the learn_ahead_minutes field.
+func ByLearnAheadMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLearnAheadMinutes, opts...).ToFunc()
+}
+
+// ByQueueOrder orders the results by the queue_order field.
+func ByQueueOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueueOrder, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the is_default field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNodesCount orders the results by nodes count.
+func ByNodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNodesStep(), opts...)
+	}
+}
+
+// ByNodes orders the results by nodes terms.
+func ByNodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newNodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NodesInverseTable, NodeFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NodesTable, NodesColumn),
+	)
+}
@@ -0,0 +1,960 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedulerpreset
+
+import (
+	"profen/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldName, v))
+}
+
+// DesiredRetention applies equality check predicate on the "desired_retention" field. It's identical to DesiredRetentionEQ.
+func DesiredRetention(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldDesiredRetention, v))
+}
+
+// MaxInterval applies equality check predicate on the "max_interval" field. It's identical to MaxIntervalEQ.
+func MaxInterval(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldMaxInterval, v))
+}
+
+// EnableFuzz applies equality check predicate on the "enable_fuzz" field. It's identical to EnableFuzzEQ.
+func EnableFuzz(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldEnableFuzz, v))
+}
+
+// EnableLoadBalance applies equality check predicate on the "enable_load_balance" field. It's identical to EnableLoadBalanceEQ.
+func EnableLoadBalance(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldEnableLoadBalance, v))
+}
+
+// GraduatingInterval applies equality check predicate on the "graduating_interval" field. It's identical to GraduatingIntervalEQ.
+func GraduatingInterval(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldGraduatingInterval, v))
+}
+
+// EasyInterval applies equality check predicate on the "easy_interval" field. It's identical to EasyIntervalEQ.
+func EasyInterval(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldEasyInterval, v))
+}
+
+// LeechThreshold applies equality check predicate on the "leech_threshold" field. It's identical to LeechThresholdEQ.
+func LeechThreshold(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldLeechThreshold, v))
+}
+
+// LeechSuspend applies equality check predicate on the "leech_suspend" field. It's identical to LeechSuspendEQ.
+func LeechSuspend(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldLeechSuspend, v))
+}
+
+// BuryTranslations applies equality check predicate on the "bury_translations" field. It's identical to BuryTranslationsEQ.
+func BuryTranslations(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldBuryTranslations, v))
+}
+
+// ImplicitCredit applies equality check predicate on the "implicit_credit" field. It's identical to ImplicitCreditEQ.
+func ImplicitCredit(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldImplicitCredit, v))
+}
+
+// ImplicitLapsePull applies equality check predicate on the "implicit_lapse_pull" field. It's identical to ImplicitLapsePullEQ.
+func ImplicitLapsePull(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldImplicitLapsePull, v))
+}
+
+// PrerequisiteMinStability applies equality check predicate on the "prerequisite_min_stability" field. It's identical to PrerequisiteMinStabilityEQ.
+func PrerequisiteMinStability(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldPrerequisiteMinStability, v))
+}
+
+// ErrorResolveAfter applies equality check predicate on the "error_resolve_after" field. It's identical to ErrorResolveAfterEQ.
+func ErrorResolveAfter(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldErrorResolveAfter, v))
+}
+
+// NewPerDay applies equality check predicate on the "new_per_day" field. It's identical to NewPerDayEQ.
+func NewPerDay(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldNewPerDay, v))
+}
+
+// ReviewsPerDay applies equality check predicate on the "reviews_per_day" field. It's identical to ReviewsPerDayEQ.
+func ReviewsPerDay(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldReviewsPerDay, v))
+}
+
+// LearnAheadMinutes applies equality check predicate on the "learn_ahead_minutes" field. It's identical to LearnAheadMinutesEQ.
+func LearnAheadMinutes(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldLearnAheadMinutes, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldContainsFold(FieldName, v))
+}
+
+// SchedulerEQ applies the EQ predicate on the "scheduler" field.
+func SchedulerEQ(v Scheduler) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldScheduler, v))
+}
+
+// SchedulerNEQ applies the NEQ predicate on the "scheduler" field.
+func SchedulerNEQ(v Scheduler) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldScheduler, v))
+}
+
+// SchedulerIn applies the In predicate on the "scheduler" field.
+func SchedulerIn(vs ...Scheduler) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldScheduler, vs...))
+}
+
+// SchedulerNotIn applies the NotIn predicate on the "scheduler" field.
+func SchedulerNotIn(vs ...Scheduler) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldScheduler, vs...))
+}
+
+// LeitnerIntervalsIsNil applies the IsNil predicate on the "leitner_intervals" field.
+func LeitnerIntervalsIsNil() predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIsNull(FieldLeitnerIntervals))
+}
+
+// LeitnerIntervalsNotNil applies the NotNil predicate on the "leitner_intervals" field.
+func LeitnerIntervalsNotNil() predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotNull(FieldLeitnerIntervals))
+}
+
+// AlgorithmVersionEQ applies the EQ predicate on the "algorithm_version" field.
+func AlgorithmVersionEQ(v AlgorithmVersion) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldAlgorithmVersion, v))
+}
+
+// AlgorithmVersionNEQ applies the NEQ predicate on the "algorithm_version" field.
+func AlgorithmVersionNEQ(v AlgorithmVersion) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldAlgorithmVersion, v))
+}
+
+// AlgorithmVersionIn applies the In predicate on the "algorithm_version" field.
+func AlgorithmVersionIn(vs ...AlgorithmVersion) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldAlgorithmVersion, vs...))
+}
+
+// AlgorithmVersionNotIn applies the NotIn predicate on the "algorithm_version" field.
+func AlgorithmVersionNotIn(vs ...AlgorithmVersion) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldAlgorithmVersion, vs...))
+}
+
+// DesiredRetentionEQ applies the EQ predicate on the "desired_retention" field.
+func DesiredRetentionEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldDesiredRetention, v))
+}
+
+// DesiredRetentionNEQ applies the NEQ predicate on the "desired_retention" field.
+func DesiredRetentionNEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldDesiredRetention, v))
+}
+
+// DesiredRetentionIn applies the In predicate on the "desired_retention" field.
+func DesiredRetentionIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldDesiredRetention, vs...))
+}
+
+// DesiredRetentionNotIn applies the NotIn predicate on the "desired_retention" field.
+func DesiredRetentionNotIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldDesiredRetention, vs...))
+}
+
+// DesiredRetentionGT applies the GT predicate on the "desired_retention" field.
+func DesiredRetentionGT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldDesiredRetention, v))
+}
+
+// DesiredRetentionGTE applies the GTE predicate on the "desired_retention" field.
+func DesiredRetentionGTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldDesiredRetention, v))
+}
+
+// DesiredRetentionLT applies the LT predicate on the "desired_retention" field.
+func DesiredRetentionLT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldDesiredRetention, v))
+}
+
+// DesiredRetentionLTE applies the LTE predicate on the "desired_retention" field.
+func DesiredRetentionLTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldDesiredRetention, v))
+}
+
+// MaxIntervalEQ applies the EQ predicate on the "max_interval" field.
+func MaxIntervalEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldMaxInterval, v))
+}
+
+// MaxIntervalNEQ applies the NEQ predicate on the "max_interval" field.
+func MaxIntervalNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldMaxInterval, v))
+}
+
+// MaxIntervalIn applies the In predicate on the "max_interval" field.
+func MaxIntervalIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldMaxInterval, vs...))
+}
+
+// MaxIntervalNotIn applies the NotIn predicate on the "max_interval" field.
+func MaxIntervalNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldMaxInterval, vs...))
+}
+
+// MaxIntervalGT applies the GT predicate on the "max_interval" field.
+func MaxIntervalGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldMaxInterval, v))
+}
+
+// MaxIntervalGTE applies the GTE predicate on the "max_interval" field.
+func MaxIntervalGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldMaxInterval, v))
+}
+
+// MaxIntervalLT applies the LT predicate on the "max_interval" field.
+func MaxIntervalLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldMaxInterval, v))
+}
+
+// MaxIntervalLTE applies the LTE predicate on the "max_interval" field.
+func MaxIntervalLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldMaxInterval, v))
+}
+
+// EnableFuzzEQ applies the EQ predicate on the "enable_fuzz" field.
+func EnableFuzzEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldEnableFuzz, v))
+}
+
+// EnableFuzzNEQ applies the NEQ predicate on the "enable_fuzz" field.
+func EnableFuzzNEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldEnableFuzz, v))
+}
+
+// EnableLoadBalanceEQ applies the EQ predicate on the "enable_load_balance" field.
+func EnableLoadBalanceEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldEnableLoadBalance, v))
+}
+
+// EnableLoadBalanceNEQ applies the NEQ predicate on the "enable_load_balance" field.
+func EnableLoadBalanceNEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldEnableLoadBalance, v))
+}
+
+// GraduatingIntervalEQ applies the EQ predicate on the "graduating_interval" field.
+func GraduatingIntervalEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldGraduatingInterval, v))
+}
+
+// GraduatingIntervalNEQ applies the NEQ predicate on the "graduating_interval" field.
+func GraduatingIntervalNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldGraduatingInterval, v))
+}
+
+// GraduatingIntervalIn applies the In predicate on the "graduating_interval" field.
+func GraduatingIntervalIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldGraduatingInterval, vs...))
+}
+
+// GraduatingIntervalNotIn applies the NotIn predicate on the "graduating_interval" field.
+func GraduatingIntervalNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldGraduatingInterval, vs...))
+}
+
+// GraduatingIntervalGT applies the GT predicate on the "graduating_interval" field.
+func GraduatingIntervalGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldGraduatingInterval, v))
+}
+
+// GraduatingIntervalGTE applies the GTE predicate on the "graduating_interval" field.
+func GraduatingIntervalGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldGraduatingInterval, v))
+}
+
+// GraduatingIntervalLT applies the LT predicate on the "graduating_interval" field.
+func GraduatingIntervalLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldGraduatingInterval, v))
+}
+
+// GraduatingIntervalLTE applies the LTE predicate on the "graduating_interval" field.
+func GraduatingIntervalLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldGraduatingInterval, v))
+}
+
+// EasyIntervalEQ applies the EQ predicate on the "easy_interval" field.
+func EasyIntervalEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldEasyInterval, v))
+}
+
+// EasyIntervalNEQ applies the NEQ predicate on the "easy_interval" field.
+func EasyIntervalNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldEasyInterval, v))
+}
+
+// EasyIntervalIn applies the In predicate on the "easy_interval" field.
+func EasyIntervalIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldEasyInterval, vs...))
+}
+
+// EasyIntervalNotIn applies the NotIn predicate on the "easy_interval" field.
+func EasyIntervalNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldEasyInterval, vs...))
+}
+
+// EasyIntervalGT applies the GT predicate on the "easy_interval" field.
+func EasyIntervalGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldEasyInterval, v))
+}
+
+// EasyIntervalGTE applies the GTE predicate on the "easy_interval" field.
+func EasyIntervalGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldEasyInterval, v))
+}
+
+// EasyIntervalLT applies the LT predicate on the "easy_interval" field.
+func EasyIntervalLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldEasyInterval, v))
+}
+
+// EasyIntervalLTE applies the LTE predicate on the "easy_interval" field.
+func EasyIntervalLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldEasyInterval, v))
+}
+
+// LeechThresholdEQ applies the EQ predicate on the "leech_threshold" field.
+func LeechThresholdEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldLeechThreshold, v))
+}
+
+// LeechThresholdNEQ applies the NEQ predicate on the "leech_threshold" field.
+func LeechThresholdNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldLeechThreshold, v))
+}
+
+// LeechThresholdIn applies the In predicate on the "leech_threshold" field.
+func LeechThresholdIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldLeechThreshold, vs...))
+}
+
+// LeechThresholdNotIn applies the NotIn predicate on the "leech_threshold" field.
+func LeechThresholdNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldLeechThreshold, vs...))
+}
+
+// LeechThresholdGT applies the GT predicate on the "leech_threshold" field.
+func LeechThresholdGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldLeechThreshold, v))
+}
+
+// LeechThresholdGTE applies the GTE predicate on the "leech_threshold" field.
+func LeechThresholdGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldLeechThreshold, v))
+}
+
+// LeechThresholdLT applies the LT predicate on the "leech_threshold" field.
+func LeechThresholdLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldLeechThreshold, v))
+}
+
+// LeechThresholdLTE applies the LTE predicate on the "leech_threshold" field.
+func LeechThresholdLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldLeechThreshold, v))
+}
+
+// LeechSuspendEQ applies the EQ predicate on the "leech_suspend" field.
+func LeechSuspendEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldLeechSuspend, v))
+}
+
+// LeechSuspendNEQ applies the NEQ predicate on the "leech_suspend" field.
+func LeechSuspendNEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldLeechSuspend, v))
+}
+
+// BuryTranslationsEQ applies the EQ predicate on the "bury_translations" field.
+func BuryTranslationsEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldBuryTranslations, v))
+}
+
+// BuryTranslationsNEQ applies the NEQ predicate on the "bury_translations" field.
+func BuryTranslationsNEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldBuryTranslations, v))
+}
+
+// ImplicitCreditEQ applies the EQ predicate on the "implicit_credit" field.
+func ImplicitCreditEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldImplicitCredit, v))
+}
+
+// ImplicitCreditNEQ applies the NEQ predicate on the "implicit_credit" field.
+func ImplicitCreditNEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldImplicitCredit, v))
+}
+
+// ImplicitCreditIn applies the In predicate on the "implicit_credit" field.
+func ImplicitCreditIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldImplicitCredit, vs...))
+}
+
+// ImplicitCreditNotIn applies the NotIn predicate on the "implicit_credit" field.
+func ImplicitCreditNotIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldImplicitCredit, vs...))
+}
+
+// ImplicitCreditGT applies the GT predicate on the "implicit_credit" field.
+func ImplicitCreditGT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldImplicitCredit, v))
+}
+
+// ImplicitCreditGTE applies the GTE predicate on the "implicit_credit" field.
+func ImplicitCreditGTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldImplicitCredit, v))
+}
+
+// ImplicitCreditLT applies the LT predicate on the "implicit_credit" field.
+func ImplicitCreditLT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldImplicitCredit, v))
+}
+
+// ImplicitCreditLTE applies the LTE predicate on the "implicit_credit" field.
+func ImplicitCreditLTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldImplicitCredit, v))
+}
+
+// ImplicitLapsePullEQ applies the EQ predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldImplicitLapsePull, v))
+}
+
+// ImplicitLapsePullNEQ applies the NEQ predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullNEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldImplicitLapsePull, v))
+}
+
+// ImplicitLapsePullIn applies the In predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldImplicitLapsePull, vs...))
+}
+
+// ImplicitLapsePullNotIn applies the NotIn predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullNotIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldImplicitLapsePull, vs...))
+}
+
+// ImplicitLapsePullGT applies the GT predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullGT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldImplicitLapsePull, v))
+}
+
+// ImplicitLapsePullGTE applies the GTE predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullGTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldImplicitLapsePull, v))
+}
+
+// ImplicitLapsePullLT applies the LT predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullLT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldImplicitLapsePull, v))
+}
+
+// ImplicitLapsePullLTE applies the LTE predicate on the "implicit_lapse_pull" field.
+func ImplicitLapsePullLTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldImplicitLapsePull, v))
+}
+
+// PrerequisiteGateEQ applies the EQ predicate on the "prerequisite_gate" field.
+func PrerequisiteGateEQ(v PrerequisiteGate) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldPrerequisiteGate, v))
+}
+
+// PrerequisiteGateNEQ applies the NEQ predicate on the "prerequisite_gate" field.
+func PrerequisiteGateNEQ(v PrerequisiteGate) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldPrerequisiteGate, v))
+}
+
+// PrerequisiteGateIn applies the In predicate on the "prerequisite_gate" field.
+func PrerequisiteGateIn(vs ...PrerequisiteGate) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldPrerequisiteGate, vs...))
+}
+
+// PrerequisiteGateNotIn applies the NotIn predicate on the "prerequisite_gate" field.
+func PrerequisiteGateNotIn(vs ...PrerequisiteGate) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldPrerequisiteGate, vs...))
+}
+
+// PrerequisiteMinStabilityEQ applies the EQ predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldPrerequisiteMinStability, v))
+}
+
+// PrerequisiteMinStabilityNEQ applies the NEQ predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityNEQ(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldPrerequisiteMinStability, v))
+}
+
+// PrerequisiteMinStabilityIn applies the In predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldPrerequisiteMinStability, vs...))
+}
+
+// PrerequisiteMinStabilityNotIn applies the NotIn predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityNotIn(vs ...float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldPrerequisiteMinStability, vs...))
+}
+
+// PrerequisiteMinStabilityGT applies the GT predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityGT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldPrerequisiteMinStability, v))
+}
+
+// PrerequisiteMinStabilityGTE applies the GTE predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityGTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldPrerequisiteMinStability, v))
+}
+
+// PrerequisiteMinStabilityLT applies the LT predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityLT(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldPrerequisiteMinStability, v))
+}
+
+// PrerequisiteMinStabilityLTE applies the LTE predicate on the "prerequisite_min_stability" field.
+func PrerequisiteMinStabilityLTE(v float64) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldPrerequisiteMinStability, v))
+}
+
+// ErrorResolveAfterEQ applies the EQ predicate on the "error_resolve_after" field.
+func ErrorResolveAfterEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldErrorResolveAfter, v))
+}
+
+// ErrorResolveAfterNEQ applies the NEQ predicate on the "error_resolve_after" field.
+func ErrorResolveAfterNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldErrorResolveAfter, v))
+}
+
+// ErrorResolveAfterIn applies the In predicate on the "error_resolve_after" field.
+func ErrorResolveAfterIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldErrorResolveAfter, vs...))
+}
+
+// ErrorResolveAfterNotIn applies the NotIn predicate on the "error_resolve_after" field.
+func ErrorResolveAfterNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldErrorResolveAfter, vs...))
+}
+
+// ErrorResolveAfterGT applies the GT predicate on the "error_resolve_after" field.
+func ErrorResolveAfterGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldErrorResolveAfter, v))
+}
+
+// ErrorResolveAfterGTE applies the GTE predicate on the "error_resolve_after" field.
+func ErrorResolveAfterGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldErrorResolveAfter, v))
+}
+
+// ErrorResolveAfterLT applies the LT predicate on the "error_resolve_after" field.
+func ErrorResolveAfterLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldErrorResolveAfter, v))
+}
+
+// ErrorResolveAfterLTE applies the LTE predicate on the "error_resolve_after" field.
+func ErrorResolveAfterLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldErrorResolveAfter, v))
+}
+
+// NewPerDayEQ applies the EQ predicate on the "new_per_day" field.
+func NewPerDayEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldNewPerDay, v))
+}
+
+// NewPerDayNEQ applies the NEQ predicate on the "new_per_day" field.
+func NewPerDayNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldNewPerDay, v))
+}
+
+// NewPerDayIn applies the In predicate on the "new_per_day" field.
+func NewPerDayIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldNewPerDay, vs...))
+}
+
+// NewPerDayNotIn applies the NotIn predicate on the "new_per_day" field.
+func NewPerDayNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldNewPerDay, vs...))
+}
+
+// NewPerDayGT applies the GT predicate on the "new_per_day" field.
+func NewPerDayGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldNewPerDay, v))
+}
+
+// NewPerDayGTE applies the GTE predicate on the "new_per_day" field.
+func NewPerDayGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldNewPerDay, v))
+}
+
+// NewPerDayLT applies the LT predicate on the "new_per_day" field.
+func NewPerDayLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldNewPerDay, v))
+}
+
+// NewPerDayLTE applies the LTE predicate on the "new_per_day" field.
+func NewPerDayLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldNewPerDay, v))
+}
+
+// ReviewsPerDayEQ applies the EQ predicate on the "reviews_per_day" field.
+func ReviewsPerDayEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldReviewsPerDay, v))
+}
+
+// ReviewsPerDayNEQ applies the NEQ predicate on the "reviews_per_day" field.
+func ReviewsPerDayNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldReviewsPerDay, v))
+}
+
+// ReviewsPerDayIn applies the In predicate on the "reviews_per_day" field.
+func ReviewsPerDayIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldReviewsPerDay, vs...))
+}
+
+// ReviewsPerDayNotIn applies the NotIn predicate on the "reviews_per_day" field.
+func ReviewsPerDayNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldReviewsPerDay, vs...))
+}
+
+// ReviewsPerDayGT applies the GT predicate on the "reviews_per_day" field.
+func ReviewsPerDayGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldReviewsPerDay, v))
+}
+
+// ReviewsPerDayGTE applies the GTE predicate on the "reviews_per_day" field.
+func ReviewsPerDayGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldReviewsPerDay, v))
+}
+
+// ReviewsPerDayLT applies the LT predicate on the "reviews_per_day" field.
+func ReviewsPerDayLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldReviewsPerDay, v))
+}
+
+// ReviewsPerDayLTE applies the LTE predicate on the "reviews_per_day" field.
+func ReviewsPerDayLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldReviewsPerDay, v))
+}
+
+// LearnAheadMinutesEQ applies the EQ predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldLearnAheadMinutes, v))
+}
+
+// LearnAheadMinutesNEQ applies the NEQ predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesNEQ(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldLearnAheadMinutes, v))
+}
+
+// LearnAheadMinutesIn applies the In predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldLearnAheadMinutes, vs...))
+}
+
+// LearnAheadMinutesNotIn applies the NotIn predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesNotIn(vs ...int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldLearnAheadMinutes, vs...))
+}
+
+// LearnAheadMinutesGT applies the GT predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesGT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldLearnAheadMinutes, v))
+}
+
+// LearnAheadMinutesGTE applies the GTE predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesGTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldLearnAheadMinutes, v))
+}
+
+// LearnAheadMinutesLT applies the LT predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesLT(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldLearnAheadMinutes, v))
+}
+
+// LearnAheadMinutesLTE applies the LTE predicate on the "learn_ahead_minutes" field.
+func LearnAheadMinutesLTE(v int) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldLearnAheadMinutes, v))
+}
+
+// QueueOrderEQ applies the EQ predicate on the "queue_order" field.
+func QueueOrderEQ(v QueueOrder) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldQueueOrder, v))
+}
+
+// QueueOrderNEQ applies the NEQ predicate on the "queue_order" field.
+func QueueOrderNEQ(v QueueOrder) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldQueueOrder, v))
+}
+
+// QueueOrderIn applies the In predicate on the "queue_order" field.
+func QueueOrderIn(vs ...QueueOrder) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldQueueOrder, vs...))
+}
+
+// QueueOrderNotIn applies the NotIn predicate on the "queue_order" field.
+func QueueOrderNotIn(vs ...QueueOrder) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldQueueOrder, vs...))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasNodes applies the HasEdge predicate on the "nodes" edge.
+func HasNodes() predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NodesTable, NodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNodesWith applies the HasEdge predicate on the "nodes" edge with a given conditions (other predicates).
+func HasNodesWith(preds ...predicate.Node) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(func(s *sql.Selector) {
+		step := newNodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SchedulerPreset) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SchedulerPreset) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SchedulerPreset) predicate.SchedulerPreset {
+	return predicate.SchedulerPreset(sql.NotPredicates(p))
+}
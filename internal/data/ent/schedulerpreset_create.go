@@ -0,0 +1,895 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/schedulerpreset"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SchedulerPresetCreate is the builder for creating a SchedulerPreset entity.
+type SchedulerPresetCreate struct {
+	config
+	mutation *SchedulerPresetMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *SchedulerPresetCreate) SetName(v string) *SchedulerPresetCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetScheduler sets the "scheduler" field.
+func (_c *SchedulerPresetCreate) SetScheduler(v schedulerpreset.Scheduler) *SchedulerPresetCreate {
+	_c.mutation.SetScheduler(v)
+	return _c
+}
+
+// SetNillableScheduler sets the "scheduler" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableScheduler(v *schedulerpreset.Scheduler) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetScheduler(*v)
+	}
+	return _c
+}
+
+// SetLeitnerIntervals sets the "leitner_intervals" field.
+func (_c *SchedulerPresetCreate) SetLeitnerIntervals(v []int) *SchedulerPresetCreate {
+	_c.mutation.SetLeitnerIntervals(v)
+	return _c
+}
+
+// SetAlgorithmVersion sets the "algorithm_version" field.
+func (_c *SchedulerPresetCreate) SetAlgorithmVersion(v schedulerpreset.AlgorithmVersion) *SchedulerPresetCreate {
+	_c.mutation.SetAlgorithmVersion(v)
+	return _c
+}
+
+// SetNillableAlgorithmVersion sets the "algorithm_version" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableAlgorithmVersion(v *schedulerpreset.AlgorithmVersion) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetAlgorithmVersion(*v)
+	}
+	return _c
+}
+
+// SetWeights sets the "weights" field.
+func (_c *SchedulerPresetCreate) SetWeights(v []float64) *SchedulerPresetCreate {
+	_c.mutation.SetWeights(v)
+	return _c
+}
+
+// SetDesiredRetention sets the "desired_retention" field.
+func (_c *SchedulerPresetCreate) SetDesiredRetention(v float64) *SchedulerPresetCreate {
+	_c.mutation.SetDesiredRetention(v)
+	return _c
+}
+
+// SetNillableDesiredRetention sets the "desired_retention" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableDesiredRetention(v *float64) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetDesiredRetention(*v)
+	}
+	return _c
+}
+
+// SetMaxInterval sets the "max_interval" field.
+func (_c *SchedulerPresetCreate) SetMaxInterval(v int) *SchedulerPresetCreate {
+	_c.mutation.SetMaxInterval(v)
+	return _c
+}
+
+// SetNillableMaxInterval sets the "max_interval" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableMaxInterval(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetMaxInterval(*v)
+	}
+	return _c
+}
+
+// SetEnableFuzz sets the "enable_fuzz" field.
+func (_c *SchedulerPresetCreate) SetEnableFuzz(v bool) *SchedulerPresetCreate {
+	_c.mutation.SetEnableFuzz(v)
+	return _c
+}
+
+// SetNillableEnableFuzz sets the "enable_fuzz" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableEnableFuzz(v *bool) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetEnableFuzz(*v)
+	}
+	return _c
+}
+
+// SetEnableLoadBalance sets the "enable_load_balance" field.
+func (_c *SchedulerPresetCreate) SetEnableLoadBalance(v bool) *SchedulerPresetCreate {
+	_c.mutation.SetEnableLoadBalance(v)
+	return _c
+}
+
+// SetNillableEnableLoadBalance sets the "enable_load_balance" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableEnableLoadBalance(v *bool) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetEnableLoadBalance(*v)
+	}
+	return _c
+}
+
+// SetLearningSteps sets the "learning_steps" field.
+func (_c *SchedulerPresetCreate) SetLearningSteps(v []int) *SchedulerPresetCreate {
+	_c.mutation.SetLearningSteps(v)
+	return _c
+}
+
+// SetRelearningSteps sets the "relearning_steps" field.
+func (_c *SchedulerPresetCreate) SetRelearningSteps(v []int) *SchedulerPresetCreate {
+	_c.mutation.SetRelearningSteps(v)
+	return _c
+}
+
+// SetGraduatingInterval sets the "graduating_interval" field.
+func (_c *SchedulerPresetCreate) SetGraduatingInterval(v int) *SchedulerPresetCreate {
+	_c.mutation.SetGraduatingInterval(v)
+	return _c
+}
+
+// SetNillableGraduatingInterval sets the "graduating_interval" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableGraduatingInterval(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetGraduatingInterval(*v)
+	}
+	return _c
+}
+
+// SetEasyInterval sets the "easy_interval" field.
+func (_c *SchedulerPresetCreate) SetEasyInterval(v int) *SchedulerPresetCreate {
+	_c.mutation.SetEasyInterval(v)
+	return _c
+}
+
+// SetNillableEasyInterval sets the "easy_interval" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableEasyInterval(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetEasyInterval(*v)
+	}
+	return _c
+}
+
+// SetLeechThreshold sets the "leech_threshold" field.
+func (_c *SchedulerPresetCreate) SetLeechThreshold(v int) *SchedulerPresetCreate {
+	_c.mutation.SetLeechThreshold(v)
+	return _c
+}
+
+// SetNillableLeechThreshold sets the "leech_threshold" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableLeechThreshold(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetLeechThreshold(*v)
+	}
+	return _c
+}
+
+// SetLeechSuspend sets the "leech_suspend" field.
+func (_c *SchedulerPresetCreate) SetLeechSuspend(v bool) *SchedulerPresetCreate {
+	_c.mutation.SetLeechSuspend(v)
+	return _c
+}
+
+// SetNillableLeechSuspend sets the "leech_suspend" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableLeechSuspend(v *bool) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetLeechSuspend(*v)
+	}
+	return _c
+}
+
+// SetBuryTranslations sets the "bury_translations" field.
+func (_c *SchedulerPresetCreate) SetBuryTranslations(v bool) *SchedulerPresetCreate {
+	_c.mutation.SetBuryTranslations(v)
+	return _c
+}
+
+// SetNillableBuryTranslations sets the "bury_translations" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableBuryTranslations(v *bool) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetBuryTranslations(*v)
+	}
+	return _c
+}
+
+// SetImplicitCredit sets the "implicit_credit" field.
+func (_c *SchedulerPresetCreate) SetImplicitCredit(v float64) *SchedulerPresetCreate {
+	_c.mutation.SetImplicitCredit(v)
+	return _c
+}
+
+// SetNillableImplicitCredit sets the "implicit_credit" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableImplicitCredit(v *float64) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetImplicitCredit(*v)
+	}
+	return _c
+}
+
+// SetImplicitLapsePull sets the "implicit_lapse_pull" field.
+func (_c *SchedulerPresetCreate) SetImplicitLapsePull(v float64) *SchedulerPresetCreate {
+	_c.mutation.SetImplicitLapsePull(v)
+	return _c
+}
+
+// SetNillableImplicitLapsePull sets the "implicit_lapse_pull" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableImplicitLapsePull(v *float64) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetImplicitLapsePull(*v)
+	}
+	return _c
+}
+
+// SetPrerequisiteGate sets the "prerequisite_gate" field.
+func (_c *SchedulerPresetCreate) SetPrerequisiteGate(v schedulerpreset.PrerequisiteGate) *SchedulerPresetCreate {
+	_c.mutation.SetPrerequisiteGate(v)
+	return _c
+}
+
+// SetNillablePrerequisiteGate sets the "prerequisite_gate" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillablePrerequisiteGate(v *schedulerpreset.PrerequisiteGate) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetPrerequisiteGate(*v)
+	}
+	return _c
+}
+
+// SetPrerequisiteMinStability sets the "prerequisite_min_stability" field.
+func (_c *SchedulerPresetCreate) SetPrerequisiteMinStability(v float64) *SchedulerPresetCreate {
+	_c.mutation.SetPrerequisiteMinStability(v)
+	return _c
+}
+
+// SetNillablePrerequisiteMinStability sets the "prerequisite_min_stability" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillablePrerequisiteMinStability(v *float64) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetPrerequisiteMinStability(*v)
+	}
+	return _c
+}
+
+// SetErrorResolveAfter sets the "error_resolve_after" field.
+func (_c *SchedulerPresetCreate) SetErrorResolveAfter(v int) *SchedulerPresetCreate {
+	_c.mutation.SetErrorResolveAfter(v)
+	return _c
+}
+
+// SetNillableErrorResolveAfter sets the "error_resolve_after" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableErrorResolveAfter(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetErrorResolveAfter(*v)
+	}
+	return _c
+}
+
+// SetNewPerDay sets the "new_per_day" field.
+func (_c *SchedulerPresetCreate) SetNewPerDay(v int) *SchedulerPresetCreate {
+	_c.mutation.SetNewPerDay(v)
+	return _c
+}
+
+// SetNillableNewPerDay sets the "new_per_day" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableNewPerDay(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetNewPerDay(*v)
+	}
+	return _c
+}
+
+// SetReviewsPerDay sets the "reviews_per_day" field.
+func (_c *SchedulerPresetCreate) SetReviewsPerDay(v int) *SchedulerPresetCreate {
+	_c.mutation.SetReviewsPerDay(v)
+	return _c
+}
+
+// SetNillableReviewsPerDay sets the "reviews_per_day" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableReviewsPerDay(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetReviewsPerDay(*v)
+	}
+	return _c
+}
+
+// SetLearnAheadMinutes sets the "learn_ahead_minutes" field.
+func (_c *SchedulerPresetCreate) SetLearnAheadMinutes(v int) *SchedulerPresetCreate {
+	_c.mutation.SetLearnAheadMinutes(v)
+	return _c
+}
+
+// SetNillableLearnAheadMinutes sets the "learn_ahead_minutes" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableLearnAheadMinutes(v *int) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetLearnAheadMinutes(*v)
+	}
+	return _c
+}
+
+// SetQueueOrder sets the "queue_order" field.
+func (_c *SchedulerPresetCreate) SetQueueOrder(v schedulerpreset.QueueOrder) *SchedulerPresetCreate {
+	_c.mutation.SetQueueOrder(v)
+	return _c
+}
+
+// SetNillableQueueOrder sets the "queue_order" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableQueueOrder(v *schedulerpreset.QueueOrder) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetQueueOrder(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *SchedulerPresetCreate) SetIsDefault(v bool) *SchedulerPresetCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableIsDefault(v *bool) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SchedulerPresetCreate) SetCreatedAt(v time.Time) *SchedulerPresetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableCreatedAt(v *time.Time) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SchedulerPresetCreate) SetUpdatedAt(v time.Time) *SchedulerPresetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableUpdatedAt(v *time.Time) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SchedulerPresetCreate) SetID(v uuid.UUID) *SchedulerPresetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SchedulerPresetCreate) SetNillableID(v *uuid.UUID) *SchedulerPresetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
+func (_c *SchedulerPresetCreate) AddNodeIDs(ids ...uuid.UUID) *SchedulerPresetCreate {
+	_c.mutation.AddNodeIDs(ids...)
+	return _c
+}
+
+// AddNodes adds the "nodes" edges to the Node entity.
+func (_c *SchedulerPresetCreate) AddNodes(v ...*Node) *SchedulerPresetCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNodeIDs(ids...)
+}
+
+// Mutation returns the SchedulerPresetMutation object of the builder.
+func (_c *SchedulerPresetCreate) Mutation() *SchedulerPresetMutation {
+	return _c.mutation
+}
+
+// Save creates the SchedulerPreset in the database.
+func (_c *SchedulerPresetCreate) Save(ctx context.Context) (*SchedulerPreset, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SchedulerPresetCreate) SaveX(ctx context.Context) *SchedulerPreset {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchedulerPresetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchedulerPresetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SchedulerPresetCreate) defaults() {
+	if _, ok := _c.mutation.Scheduler(); !ok {
+		v := schedulerpreset.DefaultScheduler
+		_c.mutation.SetScheduler(v)
+	}
+	if _, ok := _c.mutation.AlgorithmVersion(); !ok {
+		v := schedulerpreset.DefaultAlgorithmVersion
+		_c.mutation.SetAlgorithmVersion(v)
+	}
+	if _, ok := _c.mutation.DesiredRetention(); !ok {
+		v := schedulerpreset.DefaultDesiredRetention
+		_c.mutation.SetDesiredRetention(v)
+	}
+	if _, ok := _c.mutation.MaxInterval(); !ok {
+		v := schedulerpreset.DefaultMaxInterval
+		_c.mutation.SetMaxInterval(v)
+	}
+	if _, ok := _c.mutation.EnableFuzz(); !ok {
+		v := schedulerpreset.DefaultEnableFuzz
+		_c.mutation.SetEnableFuzz(v)
+	}
+	if _, ok := _c.mutation.EnableLoadBalance(); !ok {
+		v := schedulerpreset.DefaultEnableLoadBalance
+		_c.mutation.SetEnableLoadBalance(v)
+	}
+	if _, ok := _c.mutation.GraduatingInterval(); !ok {
+		v := schedulerpreset.DefaultGraduatingInterval
+		_c.mutation.SetGraduatingInterval(v)
+	}
+	if _, ok := _c.mutation.EasyInterval(); !ok {
+		v := schedulerpreset.DefaultEasyInterval
+		_c.mutation.SetEasyInterval(v)
+	}
+	if _, ok := _c.mutation.LeechThreshold(); !ok {
+		v := schedulerpreset.DefaultLeechThreshold
+		_c.mutation.SetLeechThreshold(v)
+	}
+	if _, ok := _c.mutation.LeechSuspend(); !ok {
+		v := schedulerpreset.DefaultLeechSuspend
+		_c.mutation.SetLeechSuspend(v)
+	}
+	if _, ok := _c.mutation.BuryTranslations(); !ok {
+		v := schedulerpreset.DefaultBuryTranslations
+		_c.mutation.SetBuryTranslations(v)
+	}
+	if _, ok := _c.mutation.ImplicitCredit(); !ok {
+		v := schedulerpreset.DefaultImplicitCredit
+		_c.mutation.SetImplicitCredit(v)
+	}
+	if _, ok := _c.mutation.ImplicitLapsePull(); !ok {
+		v := schedulerpreset.DefaultImplicitLapsePull
+		_c.mutation.SetImplicitLapsePull(v)
+	}
+	if _, ok := _c.mutation.PrerequisiteGate(); !ok {
+		v := schedulerpreset.DefaultPrerequisiteGate
+		_c.mutation.SetPrerequisiteGate(v)
+	}
+	if _, ok := _c.mutation.PrerequisiteMinStability(); !ok {
+		v := schedulerpreset.DefaultPrerequisiteMinStability
+		_c.mutation.SetPrerequisiteMinStability(v)
+	}
+	if _, ok := _c.mutation.ErrorResolveAfter(); !ok {
+		v := schedulerpreset.DefaultErrorResolveAfter
+		_c.mutation.SetErrorResolveAfter(v)
+	}
+	if _, ok := _c.mutation.NewPerDay(); !ok {
+		v := schedulerpreset.DefaultNewPerDay
+		_c.mutation.SetNewPerDay(v)
+	}
+	if _, ok := _c.mutation.ReviewsPerDay(); !ok {
+		v := schedulerpreset.DefaultReviewsPerDay
+		_c.mutation.SetReviewsPerDay(v)
+	}
+	if _, ok := _c.mutation.LearnAheadMinutes(); !ok {
+		v := schedulerpreset.DefaultLearnAheadMinutes
+		_c.mutation.SetLearnAheadMinutes(v)
+	}
+	if _, ok := _c.mutation.QueueOrder(); !ok {
+		v := schedulerpreset.DefaultQueueOrder
+		_c.mutation.SetQueueOrder(v)
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := schedulerpreset.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := schedulerpreset.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := schedulerpreset.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := schedulerpreset.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SchedulerPresetCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SchedulerPreset.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := schedulerpreset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scheduler(); !ok {
+		return &ValidationError{Name: "scheduler", err: errors.New(`ent: missing required field "SchedulerPreset.scheduler"`)}
+	}
+	if v, ok := _c.mutation.Scheduler(); ok {
+		if err := schedulerpreset.SchedulerValidator(v); err != nil {
+			return &ValidationError{Name: "scheduler", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.scheduler": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AlgorithmVersion(); !ok {
+		return &ValidationError{Name: "algorithm_version", err: errors.New(`ent: missing required field "SchedulerPreset.algorithm_version"`)}
+	}
+	if v, ok := _c.mutation.AlgorithmVersion(); ok {
+		if err := schedulerpreset.AlgorithmVersionValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm_version", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.algorithm_version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Weights(); !ok {
+		return &ValidationError{Name: "weights", err: errors.New(`ent: missing required field "SchedulerPreset.weights"`)}
+	}
+	if _, ok := _c.mutation.DesiredRetention(); !ok {
+		return &ValidationError{Name: "desired_retention", err: errors.New(`ent: missing required field "SchedulerPreset.desired_retention"`)}
+	}
+	if _, ok := _c.mutation.MaxInterval(); !ok {
+		return &ValidationError{Name: "max_interval", err: errors.New(`ent: missing required field "SchedulerPreset.max_interval"`)}
+	}
+	if _, ok := _c.mutation.EnableFuzz(); !ok {
+		return &ValidationError{Name: "enable_fuzz", err: errors.New(`ent: missing required field "SchedulerPreset.enable_fuzz"`)}
+	}
+	if _, ok := _c.mutation.EnableLoadBalance(); !ok {
+		return &ValidationError{Name: "enable_load_balance", err: errors.New(`ent: missing required field "SchedulerPreset.enable_load_balance"`)}
+	}
+	if _, ok := _c.mutation.LearningSteps(); !ok {
+		return &ValidationError{Name: "learning_steps", err: errors.New(`ent: missing required field "SchedulerPreset.learning_steps"`)}
+	}
+	if _, ok := _c.mutation.RelearningSteps(); !ok {
+		return &ValidationError{Name: "relearning_steps", err: errors.New(`ent: missing required field "SchedulerPreset.relearning_steps"`)}
+	}
+	if _, ok := _c.mutation.GraduatingInterval(); !ok {
+		return &ValidationError{Name: "graduating_interval", err: errors.New(`ent: missing required field "SchedulerPreset.graduating_interval"`)}
+	}
+	if _, ok := _c.mutation.EasyInterval(); !ok {
+		return &ValidationError{Name: "easy_interval", err: errors.New(`ent: missing required field "SchedulerPreset.easy_interval"`)}
+	}
+	if _, ok := _c.mutation.LeechThreshold(); !ok {
+		return &ValidationError{Name: "leech_threshold", err: errors.New(`ent: missing required field "SchedulerPreset.leech_threshold"`)}
+	}
+	if _, ok := _c.mutation.LeechSuspend(); !ok {
+		return &ValidationError{Name: "leech_suspend", err: errors.New(`ent: missing required field "SchedulerPreset.leech_suspend"`)}
+	}
+	if _, ok := _c.mutation.BuryTranslations(); !ok {
+		return &ValidationError{Name: "bury_translations", err: errors.New(`ent: missing required field "SchedulerPreset.bury_translations"`)}
+	}
+	if _, ok := _c.mutation.ImplicitCredit(); !ok {
+		return &ValidationError{Name: "implicit_credit", err: errors.New(`ent: missing required field "SchedulerPreset.implicit_credit"`)}
+	}
+	if _, ok := _c.mutation.ImplicitLapsePull(); !ok {
+		return &ValidationError{Name: "implicit_lapse_pull", err: errors.New(`ent: missing required field "SchedulerPreset.implicit_lapse_pull"`)}
+	}
+	if _, ok := _c.mutation.PrerequisiteGate(); !ok {
+		return &ValidationError{Name: "prerequisite_gate", err: errors.New(`ent: missing required field "SchedulerPreset.prerequisite_gate"`)}
+	}
+	if v, ok := _c.mutation.PrerequisiteGate(); ok {
+		if err := schedulerpreset.PrerequisiteGateValidator(v); err != nil {
+			return &ValidationError{Name: "prerequisite_gate", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.prerequisite_gate": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PrerequisiteMinStability(); !ok {
+		return &ValidationError{Name: "prerequisite_min_stability", err: errors.New(`ent: missing required field "SchedulerPreset.prerequisite_min_stability"`)}
+	}
+	if _, ok := _c.mutation.ErrorResolveAfter(); !ok {
+		return &ValidationError{Name: "error_resolve_after", err: errors.New(`ent: missing required field "SchedulerPreset.error_resolve_after"`)}
+	}
+	if _, ok := _c.mutation.NewPerDay(); !ok {
+		return &ValidationError{Name: "new_per_day", err: errors.New(`ent: missing required field "SchedulerPreset.new_per_day"`)}
+	}
+	if _, ok := _c.mutation.ReviewsPerDay(); !ok {
+		return &ValidationError{Name: "reviews_per_day", err: errors.New(`ent: missing required field "SchedulerPreset.reviews_per_day"`)}
+	}
+	if _, ok := _c.mutation.LearnAheadMinutes(); !ok {
+		return &ValidationError{Name: "learn_ahead_minutes", err: errors.New(`ent: missing required field "SchedulerPreset.learn_ahead_minutes"`)}
+	}
+	if _, ok := _c.mutation.QueueOrder(); !ok {
+		return &ValidationError{Name: "queue_order", err: errors.New(`ent: missing required field "SchedulerPreset.queue_order"`)}
+	}
+	if v, ok := _c.mutation.QueueOrder(); ok {
+		if err := schedulerpreset.QueueOrderValidator(v); err != nil {
+			return &ValidationError{Name: "queue_order", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.queue_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "SchedulerPreset.is_default"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SchedulerPreset.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SchedulerPreset.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SchedulerPresetCreate) sqlSave(ctx context.Context) (*SchedulerPreset, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SchedulerPresetCreate) createSpec() (*SchedulerPreset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SchedulerPreset{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(schedulerpreset.Table, sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(schedulerpreset.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Scheduler(); ok {
+		_spec.SetField(schedulerpreset.FieldScheduler, field.TypeEnum, value)
+		_node.Scheduler = value
+	}
+	if value, ok := _c.mutation.LeitnerIntervals(); ok {
+		_spec.SetField(schedulerpreset.FieldLeitnerIntervals, field.TypeJSON, value)
+		_node.LeitnerIntervals = value
+	}
+	if value, ok := _c.mutation.AlgorithmVersion(); ok {
+		_spec.SetField(schedulerpreset.FieldAlgorithmVersion, field.TypeEnum, value)
+		_node.AlgorithmVersion = value
+	}
+	if value, ok := _c.mutation.Weights(); ok {
+		_spec.SetField(schedulerpreset.FieldWeights, field.TypeJSON, value)
+		_node.Weights = value
+	}
+	if value, ok := _c.mutation.DesiredRetention(); ok {
+		_spec.SetField(schedulerpreset.FieldDesiredRetention, field.TypeFloat64, value)
+		_node.DesiredRetention = value
+	}
+	if value, ok := _c.mutation.MaxInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldMaxInterval, field.TypeInt, value)
+		_node.MaxInterval = value
+	}
+	if value, ok := _c.mutation.EnableFuzz(); ok {
+		_spec.SetField(schedulerpreset.FieldEnableFuzz, field.TypeBool, value)
+		_node.EnableFuzz = value
+	}
+	if value, ok := _c.mutation.EnableLoadBalance(); ok {
+		_spec.SetField(schedulerpreset.FieldEnableLoadBalance, field.TypeBool, value)
+		_node.EnableLoadBalance = value
+	}
+	if value, ok := _c.mutation.LearningSteps(); ok {
+		_spec.SetField(schedulerpreset.FieldLearningSteps, field.TypeJSON, value)
+		_node.LearningSteps = value
+	}
+	if value, ok := _c.mutation.RelearningSteps(); ok {
+		_spec.SetField(schedulerpreset.FieldRelearningSteps, field.TypeJSON, value)
+		_node.RelearningSteps = value
+	}
+	if value, ok := _c.mutation.GraduatingInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldGraduatingInterval, field.TypeInt, value)
+		_node.GraduatingInterval = value
+	}
+	if value, ok := _c.mutation.EasyInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldEasyInterval, field.TypeInt, value)
+		_node.EasyInterval = value
+	}
+	if value, ok := _c.mutation.LeechThreshold(); ok {
+		_spec.SetField(schedulerpreset.FieldLeechThreshold, field.TypeInt, value)
+		_node.LeechThreshold = value
+	}
+	if value, ok := _c.mutation.LeechSuspend(); ok {
+		_spec.SetField(schedulerpreset.FieldLeechSuspend, field.TypeBool, value)
+		_node.LeechSuspend = value
+	}
+	if value, ok := _c.mutation.BuryTranslations(); ok {
+		_spec.SetField(schedulerpreset.FieldBuryTranslations, field.TypeBool, value)
+		_node.BuryTranslations = value
+	}
+	if value, ok := _c.mutation.ImplicitCredit(); ok {
+		_spec.SetField(schedulerpreset.FieldImplicitCredit, field.TypeFloat64, value)
+		_node.ImplicitCredit = value
+	}
+	if value, ok := _c.mutation.ImplicitLapsePull(); ok {
+		_spec.SetField(schedulerpreset.FieldImplicitLapsePull, field.TypeFloat64, value)
+		_node.ImplicitLapsePull = value
+	}
+	if value, ok := _c.mutation.PrerequisiteGate(); ok {
+		_spec.SetField(schedulerpreset.FieldPrerequisiteGate, field.TypeEnum, value)
+		_node.PrerequisiteGate = value
+	}
+	if value, ok := _c.mutation.PrerequisiteMinStability(); ok {
+		_spec.SetField(schedulerpreset.FieldPrerequisiteMinStability, field.TypeFloat64, value)
+		_node.PrerequisiteMinStability = value
+	}
+	if value, ok := _c.mutation.ErrorResolveAfter(); ok {
+		_spec.SetField(schedulerpreset.FieldErrorResolveAfter, field.TypeInt, value)
+		_node.ErrorResolveAfter = value
+	}
+	if value, ok := _c.mutation.NewPerDay(); ok {
+		_spec.SetField(schedulerpreset.FieldNewPerDay, field.TypeInt, value)
+		_node.NewPerDay = value
+	}
+	if value, ok := _c.mutation.ReviewsPerDay(); ok {
+		_spec.SetField(schedulerpreset.FieldReviewsPerDay, field.TypeInt, value)
+		_node.ReviewsPerDay = value
+	}
+	if value, ok := _c.mutation.LearnAheadMinutes(); ok {
+		_spec.SetField(schedulerpreset.FieldLearnAheadMinutes, field.TypeInt, value)
+		_node.LearnAheadMinutes = value
+	}
+	if value, ok := _c.mutation.QueueOrder(); ok {
+		_spec.SetField(schedulerpreset.FieldQueueOrder, field.TypeEnum, value)
+		_node.QueueOrder = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(schedulerpreset.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(schedulerpreset.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedulerpreset.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.NodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedulerpreset.NodesTable,
+			Columns: []string{schedulerpreset.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SchedulerPresetCreateBulk is the builder for creating many SchedulerPreset entities in bulk.
+type SchedulerPresetCreateBulk struct {
+	config
+	err      error
+	builders []*SchedulerPresetCreate
+}
+
+// Save creates the SchedulerPreset entities in the database.
+func (_c *SchedulerPresetCreateBulk) Save(ctx context.Context) ([]*SchedulerPreset, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SchedulerPreset, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SchedulerPresetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SchedulerPresetCreateBulk) SaveX(ctx context.Context) []*SchedulerPreset {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchedulerPresetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchedulerPresetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"profen/internal/data/ent/predicate"
+	"profen/internal/data/ent/schedulerpreset"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SchedulerPresetDelete is the builder for deleting a SchedulerPreset entity.
+type SchedulerPresetDelete struct {
+	config
+	hooks    []Hook
+	mutation *SchedulerPresetMutation
+}
+
+// Where appends a list predicates to the SchedulerPresetDelete builder.
+func (_d *SchedulerPresetDelete) Where(ps ...predicate.SchedulerPreset) *SchedulerPresetDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SchedulerPresetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SchedulerPresetDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SchedulerPresetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(schedulerpreset.Table, sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SchedulerPresetDeleteOne is the builder for deleting a single SchedulerPreset entity.
+type SchedulerPresetDeleteOne struct {
+	_d *SchedulerPresetDelete
+}
+
+// Where appends a list predicates to the SchedulerPresetDelete builder.
+func (_d *SchedulerPresetDeleteOne) Where(ps ...predicate.SchedulerPreset) *SchedulerPresetDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SchedulerPresetDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{schedulerpreset.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SchedulerPresetDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"profen/internal/data/ent/node"
+	"profen/internal/data/ent/predicate"
+	"profen/internal/data/ent/schedulerpreset"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SchedulerPresetUpdate is the builder for updating SchedulerPreset entities.
+type SchedulerPresetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SchedulerPresetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SchedulerPresetUpdate builder.
+func (_u *SchedulerPresetUpdate) Where(ps ...predicate.SchedulerPreset) *SchedulerPresetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SchedulerPresetUpdate) SetName(v string) *SchedulerPresetUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableName(v *string) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScheduler sets the "scheduler" field.
+func (_u *SchedulerPresetUpdate) SetScheduler(v schedulerpreset.Scheduler) *SchedulerPresetUpdate {
+	_u.mutation.SetScheduler(v)
+	return _u
+}
+
+// SetNillableScheduler sets the "scheduler" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableScheduler(v *schedulerpreset.Scheduler) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetScheduler(*v)
+	}
+	return _u
+}
+
+// SetLeitnerIntervals sets the "leitner_intervals" field.
+func (_u *SchedulerPresetUpdate) SetLeitnerIntervals(v []int) *SchedulerPresetUpdate {
+	_u.mutation.SetLeitnerIntervals(v)
+	return _u
+}
+
+// AppendLeitnerIntervals appends value to the "leitner_intervals" field.
+func (_u *SchedulerPresetUpdate) AppendLeitnerIntervals(v []int) *SchedulerPresetUpdate {
+	_u.mutation.AppendLeitnerIntervals(v)
+	return _u
+}
+
+// ClearLeitnerIntervals clears the value of the "leitner_intervals" field.
+func (_u *SchedulerPresetUpdate) ClearLeitnerIntervals() *SchedulerPresetUpdate {
+	_u.mutation.ClearLeitnerIntervals()
+	return _u
+}
+
+// SetAlgorithmVersion sets the "algorithm_version" field.
+func (_u *SchedulerPresetUpdate) SetAlgorithmVersion(v schedulerpreset.AlgorithmVersion) *SchedulerPresetUpdate {
+	_u.mutation.SetAlgorithmVersion(v)
+	return _u
+}
+
+// SetNillableAlgorithmVersion sets the "algorithm_version" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableAlgorithmVersion(v *schedulerpreset.AlgorithmVersion) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetAlgorithmVersion(*v)
+	}
+	return _u
+}
+
+// SetWeights sets the "weights" field.
+func (_u *SchedulerPresetUpdate) SetWeights(v []float64) *SchedulerPresetUpdate {
+	_u.mutation.SetWeights(v)
+	return _u
+}
+
+// AppendWeights appends value to the "weights" field.
+func (_u *SchedulerPresetUpdate) AppendWeights(v []float64) *SchedulerPresetUpdate {
+	_u.mutation.AppendWeights(v)
+	return _u
+}
+
+// SetDesiredRetention sets the "desired_retention" field.
+func (_u *SchedulerPresetUpdate) SetDesiredRetention(v float64) *SchedulerPresetUpdate {
+	_u.mutation.ResetDesiredRetention()
+	_u.mutation.SetDesiredRetention(v)
+	return _u
+}
+
+// SetNillableDesiredRetention sets the "desired_retention" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableDesiredRetention(v *float64) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetDesiredRetention(*v)
+	}
+	return _u
+}
+
+// AddDesiredRetention adds value to the "desired_retention" field.
+func (_u *SchedulerPresetUpdate) AddDesiredRetention(v float64) *SchedulerPresetUpdate {
+	_u.mutation.AddDesiredRetention(v)
+	return _u
+}
+
+// SetMaxInterval sets the "max_interval" field.
+func (_u *SchedulerPresetUpdate) SetMaxInterval(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetMaxInterval()
+	_u.mutation.SetMaxInterval(v)
+	return _u
+}
+
+// SetNillableMaxInterval sets the "max_interval" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableMaxInterval(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetMaxInterval(*v)
+	}
+	return _u
+}
+
+// AddMaxInterval adds value to the "max_interval" field.
+func (_u *SchedulerPresetUpdate) AddMaxInterval(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddMaxInterval(v)
+	return _u
+}
+
+// SetEnableFuzz sets the "enable_fuzz" field.
+func (_u *SchedulerPresetUpdate) SetEnableFuzz(v bool) *SchedulerPresetUpdate {
+	_u.mutation.SetEnableFuzz(v)
+	return _u
+}
+
+// SetNillableEnableFuzz sets the "enable_fuzz" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableEnableFuzz(v *bool) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetEnableFuzz(*v)
+	}
+	return _u
+}
+
+// SetEnableLoadBalance sets the "enable_load_balance" field.
+func (_u *SchedulerPresetUpdate) SetEnableLoadBalance(v bool) *SchedulerPresetUpdate {
+	_u.mutation.SetEnableLoadBalance(v)
+	return _u
+}
+
+// SetNillableEnableLoadBalance sets the "enable_load_balance" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableEnableLoadBalance(v *bool) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetEnableLoadBalance(*v)
+	}
+	return _u
+}
+
+// SetLearningSteps sets the "learning_steps" field.
+func (_u *SchedulerPresetUpdate) SetLearningSteps(v []int) *SchedulerPresetUpdate {
+	_u.mutation.SetLearningSteps(v)
+	return _u
+}
+
+// AppendLearningSteps appends value to the "learning_steps" field.
+func (_u *SchedulerPresetUpdate) AppendLearningSteps(v []int) *SchedulerPresetUpdate {
+	_u.mutation.AppendLearningSteps(v)
+	return _u
+}
+
+// SetRelearningSteps sets the "relearning_steps" field.
+func (_u *SchedulerPresetUpdate) SetRelearningSteps(v []int) *SchedulerPresetUpdate {
+	_u.mutation.SetRelearningSteps(v)
+	return _u
+}
+
+// AppendRelearningSteps appends value to the "relearning_steps" field.
+func (_u *SchedulerPresetUpdate) AppendRelearningSteps(v []int) *SchedulerPresetUpdate {
+	_u.mutation.AppendRelearningSteps(v)
+	return _u
+}
+
+// SetGraduatingInterval sets the "graduating_interval" field.
+func (_u *SchedulerPresetUpdate) SetGraduatingInterval(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetGraduatingInterval()
+	_u.mutation.SetGraduatingInterval(v)
+	return _u
+}
+
+// SetNillableGraduatingInterval sets the "graduating_interval" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableGraduatingInterval(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetGraduatingInterval(*v)
+	}
+	return _u
+}
+
+// AddGraduatingInterval adds value to the "graduating_interval" field.
+func (_u *SchedulerPresetUpdate) AddGraduatingInterval(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddGraduatingInterval(v)
+	return _u
+}
+
+// SetEasyInterval sets the "easy_interval" field.
+func (_u *SchedulerPresetUpdate) SetEasyInterval(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetEasyInterval()
+	_u.mutation.SetEasyInterval(v)
+	return _u
+}
+
+// SetNillableEasyInterval sets the "easy_interval" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableEasyInterval(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetEasyInterval(*v)
+	}
+	return _u
+}
+
+// AddEasyInterval adds value to the "easy_interval" field.
+func (_u *SchedulerPresetUpdate) AddEasyInterval(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddEasyInterval(v)
+	return _u
+}
+
+// SetLeechThreshold sets the "leech_threshold" field.
+func (_u *SchedulerPresetUpdate) SetLeechThreshold(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetLeechThreshold()
+	_u.mutation.SetLeechThreshold(v)
+	return _u
+}
+
+// SetNillableLeechThreshold sets the "leech_threshold" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableLeechThreshold(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetLeechThreshold(*v)
+	}
+	return _u
+}
+
+// AddLeechThreshold adds value to the "leech_threshold" field.
+func (_u *SchedulerPresetUpdate) AddLeechThreshold(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddLeechThreshold(v)
+	return _u
+}
+
+// SetLeechSuspend sets the "leech_suspend" field.
+func (_u *SchedulerPresetUpdate) SetLeechSuspend(v bool) *SchedulerPresetUpdate {
+	_u.mutation.SetLeechSuspend(v)
+	return _u
+}
+
+// SetNillableLeechSuspend sets the "leech_suspend" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableLeechSuspend(v *bool) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetLeechSuspend(*v)
+	}
+	return _u
+}
+
+// SetBuryTranslations sets the "bury_translations" field.
+func (_u *SchedulerPresetUpdate) SetBuryTranslations(v bool) *SchedulerPresetUpdate {
+	_u.mutation.SetBuryTranslations(v)
+	return _u
+}
+
+// SetNillableBuryTranslations sets the "bury_translations" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableBuryTranslations(v *bool) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetBuryTranslations(*v)
+	}
+	return _u
+}
+
+// SetImplicitCredit sets the "implicit_credit" field.
+func (_u *SchedulerPresetUpdate) SetImplicitCredit(v float64) *SchedulerPresetUpdate {
+	_u.mutation.ResetImplicitCredit()
+	_u.mutation.SetImplicitCredit(v)
+	return _u
+}
+
+// SetNillableImplicitCredit sets the "implicit_credit" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableImplicitCredit(v *float64) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetImplicitCredit(*v)
+	}
+	return _u
+}
+
+// AddImplicitCredit adds value to the "implicit_credit" field.
+func (_u *SchedulerPresetUpdate) AddImplicitCredit(v float64) *SchedulerPresetUpdate {
+	_u.mutation.AddImplicitCredit(v)
+	return _u
+}
+
+// SetImplicitLapsePull sets the "implicit_lapse_pull" field.
+func (_u *SchedulerPresetUpdate) SetImplicitLapsePull(v float64) *SchedulerPresetUpdate {
+	_u.mutation.ResetImplicitLapsePull()
+	_u.mutation.SetImplicitLapsePull(v)
+	return _u
+}
+
+// SetNillableImplicitLapsePull sets the "implicit_lapse_pull" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableImplicitLapsePull(v *float64) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetImplicitLapsePull(*v)
+	}
+	return _u
+}
+
+// AddImplicitLapsePull adds value to the "implicit_lapse_pull" field.
+func (_u *SchedulerPresetUpdate) AddImplicitLapsePull(v float64) *SchedulerPresetUpdate {
+	_u.mutation.AddImplicitLapsePull(v)
+	return _u
+}
+
+// SetPrerequisiteGate sets the "prerequisite_gate" field.
+func (_u *SchedulerPresetUpdate) SetPrerequisiteGate(v schedulerpreset.PrerequisiteGate) *SchedulerPresetUpdate {
+	_u.mutation.SetPrerequisiteGate(v)
+	return _u
+}
+
+// SetNillablePrerequisiteGate sets the "prerequisite_gate" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillablePrerequisiteGate(v *schedulerpreset.PrerequisiteGate) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetPrerequisiteGate(*v)
+	}
+	return _u
+}
+
+// SetPrerequisiteMinStability sets the "prerequisite_min_stability" field.
+func (_u *SchedulerPresetUpdate) SetPrerequisiteMinStability(v float64) *SchedulerPresetUpdate {
+	_u.mutation.ResetPrerequisiteMinStability()
+	_u.mutation.SetPrerequisiteMinStability(v)
+	return _u
+}
+
+// SetNillablePrerequisiteMinStability sets the "prerequisite_min_stability" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillablePrerequisiteMinStability(v *float64) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetPrerequisiteMinStability(*v)
+	}
+	return _u
+}
+
+// AddPrerequisiteMinStability adds value to the "prerequisite_min_stability" field.
+func (_u *SchedulerPresetUpdate) AddPrerequisiteMinStability(v float64) *SchedulerPresetUpdate {
+	_u.mutation.AddPrerequisiteMinStability(v)
+	return _u
+}
+
+// SetErrorResolveAfter sets the "error_resolve_after" field.
+func (_u *SchedulerPresetUpdate) SetErrorResolveAfter(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetErrorResolveAfter()
+	_u.mutation.SetErrorResolveAfter(v)
+	return _u
+}
+
+// SetNillableErrorResolveAfter sets the "error_resolve_after" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableErrorResolveAfter(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetErrorResolveAfter(*v)
+	}
+	return _u
+}
+
+// AddErrorResolveAfter adds value to the "error_resolve_after" field.
+func (_u *SchedulerPresetUpdate) AddErrorResolveAfter(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddErrorResolveAfter(v)
+	return _u
+}
+
+// SetNewPerDay sets the "new_per_day" field.
+func (_u *SchedulerPresetUpdate) SetNewPerDay(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetNewPerDay()
+	_u.mutation.SetNewPerDay(v)
+	return _u
+}
+
+// SetNillableNewPerDay sets the "new_per_day" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableNewPerDay(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetNewPerDay(*v)
+	}
+	return _u
+}
+
+// AddNewPerDay adds value to the "new_per_day" field.
+func (_u *SchedulerPresetUpdate) AddNewPerDay(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddNewPerDay(v)
+	return _u
+}
+
+// SetReviewsPerDay sets the "reviews_per_day" field.
+func (_u *SchedulerPresetUpdate) SetReviewsPerDay(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetReviewsPerDay()
+	_u.mutation.SetReviewsPerDay(v)
+	return _u
+}
+
+// SetNillableReviewsPerDay sets the "reviews_per_day" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableReviewsPerDay(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetReviewsPerDay(*v)
+	}
+	return _u
+}
+
+// AddReviewsPerDay adds value to the "reviews_per_day" field.
+func (_u *SchedulerPresetUpdate) AddReviewsPerDay(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddReviewsPerDay(v)
+	return _u
+}
+
+// SetLearnAheadMinutes sets the "learn_ahead_minutes" field.
+func (_u *SchedulerPresetUpdate) SetLearnAheadMinutes(v int) *SchedulerPresetUpdate {
+	_u.mutation.ResetLearnAheadMinutes()
+	_u.mutation.SetLearnAheadMinutes(v)
+	return _u
+}
+
+// SetNillableLearnAheadMinutes sets the "learn_ahead_minutes" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableLearnAheadMinutes(v *int) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetLearnAheadMinutes(*v)
+	}
+	return _u
+}
+
+// AddLearnAheadMinutes adds value to the "learn_ahead_minutes" field.
+func (_u *SchedulerPresetUpdate) AddLearnAheadMinutes(v int) *SchedulerPresetUpdate {
+	_u.mutation.AddLearnAheadMinutes(v)
+	return _u
+}
+
+// SetQueueOrder sets the "queue_order" field.
+func (_u *SchedulerPresetUpdate) SetQueueOrder(v schedulerpreset.QueueOrder) *SchedulerPresetUpdate {
+	_u.mutation.SetQueueOrder(v)
+	return _u
+}
+
+// SetNillableQueueOrder sets the "queue_order" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableQueueOrder(v *schedulerpreset.QueueOrder) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetQueueOrder(*v)
+	}
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *SchedulerPresetUpdate) SetIsDefault(v bool) *SchedulerPresetUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *SchedulerPresetUpdate) SetNillableIsDefault(v *bool) *SchedulerPresetUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SchedulerPresetUpdate) SetUpdatedAt(v time.Time) *SchedulerPresetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
+func (_u *SchedulerPresetUpdate) AddNodeIDs(ids ...uuid.UUID) *SchedulerPresetUpdate {
+	_u.mutation.AddNodeIDs(ids...)
+	return _u
+}
+
+// AddNodes adds the "nodes" edges to the Node entity.
+func (_u *SchedulerPresetUpdate) AddNodes(v ...*Node) *SchedulerPresetUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNodeIDs(ids...)
+}
+
+// Mutation returns the SchedulerPresetMutation object of the builder.
+func (_u *SchedulerPresetUpdate) Mutation() *SchedulerPresetMutation {
+	return _u.mutation
+}
+
+// ClearNodes clears all "nodes" edges to the Node entity.
+func (_u *SchedulerPresetUpdate) ClearNodes() *SchedulerPresetUpdate {
+	_u.mutation.ClearNodes()
+	return _u
+}
+
+// RemoveNodeIDs removes the "nodes" edge to Node entities by IDs.
+func (_u *SchedulerPresetUpdate) RemoveNodeIDs(ids ...uuid.UUID) *SchedulerPresetUpdate {
+	_u.mutation.RemoveNodeIDs(ids...)
+	return _u
+}
+
+// RemoveNodes removes "nodes" edges to Node entities.
+func (_u *SchedulerPresetUpdate) RemoveNodes(v ...*Node) *SchedulerPresetUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNodeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SchedulerPresetUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchedulerPresetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SchedulerPresetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchedulerPresetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SchedulerPresetUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := schedulerpreset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SchedulerPresetUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := schedulerpreset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scheduler(); ok {
+		if err := schedulerpreset.SchedulerValidator(v); err != nil {
+			return &ValidationError{Name: "scheduler", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.scheduler": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AlgorithmVersion(); ok {
+		if err := schedulerpreset.AlgorithmVersionValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm_version", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.algorithm_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PrerequisiteGate(); ok {
+		if err := schedulerpreset.PrerequisiteGateValidator(v); err != nil {
+			return &ValidationError{Name: "prerequisite_gate", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.prerequisite_gate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QueueOrder(); ok {
+		if err := schedulerpreset.QueueOrderValidator(v); err != nil {
+			return &ValidationError{Name: "queue_order", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.queue_order": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchedulerPresetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchedulerPresetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchedulerPresetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedulerpreset.Table, schedulerpreset.Columns, sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(schedulerpreset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scheduler(); ok {
+		_spec.SetField(schedulerpreset.FieldScheduler, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LeitnerIntervals(); ok {
+		_spec.SetField(schedulerpreset.FieldLeitnerIntervals, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLeitnerIntervals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldLeitnerIntervals, value)
+		})
+	}
+	if _u.mutation.LeitnerIntervalsCleared() {
+		_spec.ClearField(schedulerpreset.FieldLeitnerIntervals, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AlgorithmVersion(); ok {
+		_spec.SetField(schedulerpreset.FieldAlgorithmVersion, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Weights(); ok {
+		_spec.SetField(schedulerpreset.FieldWeights, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWeights(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldWeights, value)
+		})
+	}
+	if value, ok := _u.mutation.DesiredRetention(); ok {
+		_spec.SetField(schedulerpreset.FieldDesiredRetention, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDesiredRetention(); ok {
+		_spec.AddField(schedulerpreset.FieldDesiredRetention, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldMaxInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxInterval(); ok {
+		_spec.AddField(schedulerpreset.FieldMaxInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnableFuzz(); ok {
+		_spec.SetField(schedulerpreset.FieldEnableFuzz, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableLoadBalance(); ok {
+		_spec.SetField(schedulerpreset.FieldEnableLoadBalance, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LearningSteps(); ok {
+		_spec.SetField(schedulerpreset.FieldLearningSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLearningSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldLearningSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.RelearningSteps(); ok {
+		_spec.SetField(schedulerpreset.FieldRelearningSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRelearningSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldRelearningSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.GraduatingInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldGraduatingInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGraduatingInterval(); ok {
+		_spec.AddField(schedulerpreset.FieldGraduatingInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EasyInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldEasyInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEasyInterval(); ok {
+		_spec.AddField(schedulerpreset.FieldEasyInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeechThreshold(); ok {
+		_spec.SetField(schedulerpreset.FieldLeechThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeechThreshold(); ok {
+		_spec.AddField(schedulerpreset.FieldLeechThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeechSuspend(); ok {
+		_spec.SetField(schedulerpreset.FieldLeechSuspend, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BuryTranslations(); ok {
+		_spec.SetField(schedulerpreset.FieldBuryTranslations, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ImplicitCredit(); ok {
+		_spec.SetField(schedulerpreset.FieldImplicitCredit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImplicitCredit(); ok {
+		_spec.AddField(schedulerpreset.FieldImplicitCredit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ImplicitLapsePull(); ok {
+		_spec.SetField(schedulerpreset.FieldImplicitLapsePull, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImplicitLapsePull(); ok {
+		_spec.AddField(schedulerpreset.FieldImplicitLapsePull, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PrerequisiteGate(); ok {
+		_spec.SetField(schedulerpreset.FieldPrerequisiteGate, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PrerequisiteMinStability(); ok {
+		_spec.SetField(schedulerpreset.FieldPrerequisiteMinStability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrerequisiteMinStability(); ok {
+		_spec.AddField(schedulerpreset.FieldPrerequisiteMinStability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ErrorResolveAfter(); ok {
+		_spec.SetField(schedulerpreset.FieldErrorResolveAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedErrorResolveAfter(); ok {
+		_spec.AddField(schedulerpreset.FieldErrorResolveAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NewPerDay(); ok {
+		_spec.SetField(schedulerpreset.FieldNewPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNewPerDay(); ok {
+		_spec.AddField(schedulerpreset.FieldNewPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReviewsPerDay(); ok {
+		_spec.SetField(schedulerpreset.FieldReviewsPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReviewsPerDay(); ok {
+		_spec.AddField(schedulerpreset.FieldReviewsPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LearnAheadMinutes(); ok {
+		_spec.SetField(schedulerpreset.FieldLearnAheadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLearnAheadMinutes(); ok {
+		_spec.AddField(schedulerpreset.FieldLearnAheadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QueueOrder(); ok {
+		_spec.SetField(schedulerpreset.FieldQueueOrder, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(schedulerpreset.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedulerpreset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedulerpreset.NodesTable,
+			Columns: []string{schedulerpreset.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedulerpreset.NodesTable,
+			Columns: []string{schedulerpreset.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedulerpreset.NodesTable,
+			Columns: []string{schedulerpreset.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerpreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SchedulerPresetUpdateOne is the builder for updating a single SchedulerPreset entity.
+type SchedulerPresetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SchedulerPresetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *SchedulerPresetUpdateOne) SetName(v string) *SchedulerPresetUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableName(v *string) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScheduler sets the "scheduler" field.
+func (_u *SchedulerPresetUpdateOne) SetScheduler(v schedulerpreset.Scheduler) *SchedulerPresetUpdateOne {
+	_u.mutation.SetScheduler(v)
+	return _u
+}
+
+// SetNillableScheduler sets the "scheduler" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableScheduler(v *schedulerpreset.Scheduler) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetScheduler(*v)
+	}
+	return _u
+}
+
+// SetLeitnerIntervals sets the "leitner_intervals" field.
+func (_u *SchedulerPresetUpdateOne) SetLeitnerIntervals(v []int) *SchedulerPresetUpdateOne {
+	_u.mutation.SetLeitnerIntervals(v)
+	return _u
+}
+
+// AppendLeitnerIntervals appends value to the "leitner_intervals" field.
+func (_u *SchedulerPresetUpdateOne) AppendLeitnerIntervals(v []int) *SchedulerPresetUpdateOne {
+	_u.mutation.AppendLeitnerIntervals(v)
+	return _u
+}
+
+// ClearLeitnerIntervals clears the value of the "leitner_intervals" field.
+func (_u *SchedulerPresetUpdateOne) ClearLeitnerIntervals() *SchedulerPresetUpdateOne {
+	_u.mutation.ClearLeitnerIntervals()
+	return _u
+}
+
+// SetAlgorithmVersion sets the "algorithm_version" field.
+func (_u *SchedulerPresetUpdateOne) SetAlgorithmVersion(v schedulerpreset.AlgorithmVersion) *SchedulerPresetUpdateOne {
+	_u.mutation.SetAlgorithmVersion(v)
+	return _u
+}
+
+// SetNillableAlgorithmVersion sets the "algorithm_version" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableAlgorithmVersion(v *schedulerpreset.AlgorithmVersion) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetAlgorithmVersion(*v)
+	}
+	return _u
+}
+
+// SetWeights sets the "weights" field.
+func (_u *SchedulerPresetUpdateOne) SetWeights(v []float64) *SchedulerPresetUpdateOne {
+	_u.mutation.SetWeights(v)
+	return _u
+}
+
+// AppendWeights appends value to the "weights" field.
+func (_u *SchedulerPresetUpdateOne) AppendWeights(v []float64) *SchedulerPresetUpdateOne {
+	_u.mutation.AppendWeights(v)
+	return _u
+}
+
+// SetDesiredRetention sets the "desired_retention" field.
+func (_u *SchedulerPresetUpdateOne) SetDesiredRetention(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetDesiredRetention()
+	_u.mutation.SetDesiredRetention(v)
+	return _u
+}
+
+// SetNillableDesiredRetention sets the "desired_retention" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableDesiredRetention(v *float64) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetDesiredRetention(*v)
+	}
+	return _u
+}
+
+// AddDesiredRetention adds value to the "desired_retention" field.
+func (_u *SchedulerPresetUpdateOne) AddDesiredRetention(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.AddDesiredRetention(v)
+	return _u
+}
+
+// SetMaxInterval sets the "max_interval" field.
+func (_u *SchedulerPresetUpdateOne) SetMaxInterval(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetMaxInterval()
+	_u.mutation.SetMaxInterval(v)
+	return _u
+}
+
+// SetNillableMaxInterval sets the "max_interval" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableMaxInterval(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetMaxInterval(*v)
+	}
+	return _u
+}
+
+// AddMaxInterval adds value to the "max_interval" field.
+func (_u *SchedulerPresetUpdateOne) AddMaxInterval(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddMaxInterval(v)
+	return _u
+}
+
+// SetEnableFuzz sets the "enable_fuzz" field.
+func (_u *SchedulerPresetUpdateOne) SetEnableFuzz(v bool) *SchedulerPresetUpdateOne {
+	_u.mutation.SetEnableFuzz(v)
+	return _u
+}
+
+// SetNillableEnableFuzz sets the "enable_fuzz" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableEnableFuzz(v *bool) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetEnableFuzz(*v)
+	}
+	return _u
+}
+
+// SetEnableLoadBalance sets the "enable_load_balance" field.
+func (_u *SchedulerPresetUpdateOne) SetEnableLoadBalance(v bool) *SchedulerPresetUpdateOne {
+	_u.mutation.SetEnableLoadBalance(v)
+	return _u
+}
+
+// SetNillableEnableLoadBalance sets the "enable_load_balance" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableEnableLoadBalance(v *bool) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetEnableLoadBalance(*v)
+	}
+	return _u
+}
+
+// SetLearningSteps sets the "learning_steps" field.
+func (_u *SchedulerPresetUpdateOne) SetLearningSteps(v []int) *SchedulerPresetUpdateOne {
+	_u.mutation.SetLearningSteps(v)
+	return _u
+}
+
+// AppendLearningSteps appends value to the "learning_steps" field.
+func (_u *SchedulerPresetUpdateOne) AppendLearningSteps(v []int) *SchedulerPresetUpdateOne {
+	_u.mutation.AppendLearningSteps(v)
+	return _u
+}
+
+// SetRelearningSteps sets the "relearning_steps" field.
+func (_u *SchedulerPresetUpdateOne) SetRelearningSteps(v []int) *SchedulerPresetUpdateOne {
+	_u.mutation.SetRelearningSteps(v)
+	return _u
+}
+
+// AppendRelearningSteps appends value to the "relearning_steps" field.
+func (_u *SchedulerPresetUpdateOne) AppendRelearningSteps(v []int) *SchedulerPresetUpdateOne {
+	_u.mutation.AppendRelearningSteps(v)
+	return _u
+}
+
+// SetGraduatingInterval sets the "graduating_interval" field.
+func (_u *SchedulerPresetUpdateOne) SetGraduatingInterval(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetGraduatingInterval()
+	_u.mutation.SetGraduatingInterval(v)
+	return _u
+}
+
+// SetNillableGraduatingInterval sets the "graduating_interval" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableGraduatingInterval(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetGraduatingInterval(*v)
+	}
+	return _u
+}
+
+// AddGraduatingInterval adds value to the "graduating_interval" field.
+func (_u *SchedulerPresetUpdateOne) AddGraduatingInterval(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddGraduatingInterval(v)
+	return _u
+}
+
+// SetEasyInterval sets the "easy_interval" field.
+func (_u *SchedulerPresetUpdateOne) SetEasyInterval(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetEasyInterval()
+	_u.mutation.SetEasyInterval(v)
+	return _u
+}
+
+// SetNillableEasyInterval sets the "easy_interval" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableEasyInterval(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetEasyInterval(*v)
+	}
+	return _u
+}
+
+// AddEasyInterval adds value to the "easy_interval" field.
+func (_u *SchedulerPresetUpdateOne) AddEasyInterval(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddEasyInterval(v)
+	return _u
+}
+
+// SetLeechThreshold sets the "leech_threshold" field.
+func (_u *SchedulerPresetUpdateOne) SetLeechThreshold(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetLeechThreshold()
+	_u.mutation.SetLeechThreshold(v)
+	return _u
+}
+
+// SetNillableLeechThreshold sets the "leech_threshold" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableLeechThreshold(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetLeechThreshold(*v)
+	}
+	return _u
+}
+
+// AddLeechThreshold adds value to the "leech_threshold" field.
+func (_u *SchedulerPresetUpdateOne) AddLeechThreshold(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddLeechThreshold(v)
+	return _u
+}
+
+// SetLeechSuspend sets the "leech_suspend" field.
+func (_u *SchedulerPresetUpdateOne) SetLeechSuspend(v bool) *SchedulerPresetUpdateOne {
+	_u.mutation.SetLeechSuspend(v)
+	return _u
+}
+
+// SetNillableLeechSuspend sets the "leech_suspend" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableLeechSuspend(v *bool) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetLeechSuspend(*v)
+	}
+	return _u
+}
+
+// SetBuryTranslations sets the "bury_translations" field.
+func (_u *SchedulerPresetUpdateOne) SetBuryTranslations(v bool) *SchedulerPresetUpdateOne {
+	_u.mutation.SetBuryTranslations(v)
+	return _u
+}
+
+// SetNillableBuryTranslations sets the "bury_translations" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableBuryTranslations(v *bool) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetBuryTranslations(*v)
+	}
+	return _u
+}
+
+// SetImplicitCredit sets the "implicit_credit" field.
+func (_u *SchedulerPresetUpdateOne) SetImplicitCredit(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetImplicitCredit()
+	_u.mutation.SetImplicitCredit(v)
+	return _u
+}
+
+// SetNillableImplicitCredit sets the "implicit_credit" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableImplicitCredit(v *float64) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetImplicitCredit(*v)
+	}
+	return _u
+}
+
+// AddImplicitCredit adds value to the "implicit_credit" field.
+func (_u *SchedulerPresetUpdateOne) AddImplicitCredit(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.AddImplicitCredit(v)
+	return _u
+}
+
+// SetImplicitLapsePull sets the "implicit_lapse_pull" field.
+func (_u *SchedulerPresetUpdateOne) SetImplicitLapsePull(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetImplicitLapsePull()
+	_u.mutation.SetImplicitLapsePull(v)
+	return _u
+}
+
+// SetNillableImplicitLapsePull sets the "implicit_lapse_pull" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableImplicitLapsePull(v *float64) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetImplicitLapsePull(*v)
+	}
+	return _u
+}
+
+// AddImplicitLapsePull adds value to the "implicit_lapse_pull" field.
+func (_u *SchedulerPresetUpdateOne) AddImplicitLapsePull(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.AddImplicitLapsePull(v)
+	return _u
+}
+
+// SetPrerequisiteGate sets the "prerequisite_gate" field.
+func (_u *SchedulerPresetUpdateOne) SetPrerequisiteGate(v schedulerpreset.PrerequisiteGate) *SchedulerPresetUpdateOne {
+	_u.mutation.SetPrerequisiteGate(v)
+	return _u
+}
+
+// SetNillablePrerequisiteGate sets the "prerequisite_gate" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillablePrerequisiteGate(v *schedulerpreset.PrerequisiteGate) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetPrerequisiteGate(*v)
+	}
+	return _u
+}
+
+// SetPrerequisiteMinStability sets the "prerequisite_min_stability" field.
+func (_u *SchedulerPresetUpdateOne) SetPrerequisiteMinStability(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetPrerequisiteMinStability()
+	_u.mutation.SetPrerequisiteMinStability(v)
+	return _u
+}
+
+// SetNillablePrerequisiteMinStability sets the "prerequisite_min_stability" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillablePrerequisiteMinStability(v *float64) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetPrerequisiteMinStability(*v)
+	}
+	return _u
+}
+
+// AddPrerequisiteMinStability adds value to the "prerequisite_min_stability" field.
+func (_u *SchedulerPresetUpdateOne) AddPrerequisiteMinStability(v float64) *SchedulerPresetUpdateOne {
+	_u.mutation.AddPrerequisiteMinStability(v)
+	return _u
+}
+
+// SetErrorResolveAfter sets the "error_resolve_after" field.
+func (_u *SchedulerPresetUpdateOne) SetErrorResolveAfter(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetErrorResolveAfter()
+	_u.mutation.SetErrorResolveAfter(v)
+	return _u
+}
+
+// SetNillableErrorResolveAfter sets the "error_resolve_after" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableErrorResolveAfter(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetErrorResolveAfter(*v)
+	}
+	return _u
+}
+
+// AddErrorResolveAfter adds value to the "error_resolve_after" field.
+func (_u *SchedulerPresetUpdateOne) AddErrorResolveAfter(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddErrorResolveAfter(v)
+	return _u
+}
+
+// SetNewPerDay sets the "new_per_day" field.
+func (_u *SchedulerPresetUpdateOne) SetNewPerDay(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetNewPerDay()
+	_u.mutation.SetNewPerDay(v)
+	return _u
+}
+
+// SetNillableNewPerDay sets the "new_per_day" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableNewPerDay(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetNewPerDay(*v)
+	}
+	return _u
+}
+
+// AddNewPerDay adds value to the "new_per_day" field.
+func (_u *SchedulerPresetUpdateOne) AddNewPerDay(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddNewPerDay(v)
+	return _u
+}
+
+// SetReviewsPerDay sets the "reviews_per_day" field.
+func (_u *SchedulerPresetUpdateOne) SetReviewsPerDay(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetReviewsPerDay()
+	_u.mutation.SetReviewsPerDay(v)
+	return _u
+}
+
+// SetNillableReviewsPerDay sets the "reviews_per_day" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableReviewsPerDay(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetReviewsPerDay(*v)
+	}
+	return _u
+}
+
+// AddReviewsPerDay adds value to the "reviews_per_day" field.
+func (_u *SchedulerPresetUpdateOne) AddReviewsPerDay(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddReviewsPerDay(v)
+	return _u
+}
+
+// SetLearnAheadMinutes sets the "learn_ahead_minutes" field.
+func (_u *SchedulerPresetUpdateOne) SetLearnAheadMinutes(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.ResetLearnAheadMinutes()
+	_u.mutation.SetLearnAheadMinutes(v)
+	return _u
+}
+
+// SetNillableLearnAheadMinutes sets the "learn_ahead_minutes" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableLearnAheadMinutes(v *int) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetLearnAheadMinutes(*v)
+	}
+	return _u
+}
+
+// AddLearnAheadMinutes adds value to the "learn_ahead_minutes" field.
+func (_u *SchedulerPresetUpdateOne) AddLearnAheadMinutes(v int) *SchedulerPresetUpdateOne {
+	_u.mutation.AddLearnAheadMinutes(v)
+	return _u
+}
+
+// SetQueueOrder sets the "queue_order" field.
+func (_u *SchedulerPresetUpdateOne) SetQueueOrder(v schedulerpreset.QueueOrder) *SchedulerPresetUpdateOne {
+	_u.mutation.SetQueueOrder(v)
+	return _u
+}
+
+// SetNillableQueueOrder sets the "queue_order" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableQueueOrder(v *schedulerpreset.QueueOrder) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetQueueOrder(*v)
+	}
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *SchedulerPresetUpdateOne) SetIsDefault(v bool) *SchedulerPresetUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *SchedulerPresetUpdateOne) SetNillableIsDefault(v *bool) *SchedulerPresetUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SchedulerPresetUpdateOne) SetUpdatedAt(v time.Time) *SchedulerPresetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
+func (_u *SchedulerPresetUpdateOne) AddNodeIDs(ids ...uuid.UUID) *SchedulerPresetUpdateOne {
+	_u.mutation.AddNodeIDs(ids...)
+	return _u
+}
+
+// AddNodes adds the "nodes" edges to the Node entity.
+func (_u *SchedulerPresetUpdateOne) AddNodes(v ...*Node) *SchedulerPresetUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNodeIDs(ids...)
+}
+
+// Mutation returns the SchedulerPresetMutation object of the builder.
+func (_u *SchedulerPresetUpdateOne) Mutation() *SchedulerPresetMutation {
+	return _u.mutation
+}
+
+// ClearNodes clears all "nodes" edges to the Node entity.
+func (_u *SchedulerPresetUpdateOne) ClearNodes() *SchedulerPresetUpdateOne {
+	_u.mutation.ClearNodes()
+	return _u
+}
+
+// RemoveNodeIDs removes the "nodes" edge to Node entities by IDs.
+func (_u *SchedulerPresetUpdateOne) RemoveNodeIDs(ids ...uuid.UUID) *SchedulerPresetUpdateOne {
+	_u.mutation.RemoveNodeIDs(ids...)
+	return _u
+}
+
+// RemoveNodes removes "nodes" edges to Node entities.
+func (_u *SchedulerPresetUpdateOne) RemoveNodes(v ...*Node) *SchedulerPresetUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNodeIDs(ids...)
+}
+
+// Where appends a list predicates to the SchedulerPresetUpdate builder.
+func (_u *SchedulerPresetUpdateOne) Where(ps ...predicate.SchedulerPreset) *SchedulerPresetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SchedulerPresetUpdateOne) Select(field string, fields ...string) *SchedulerPresetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SchedulerPreset entity.
+func (_u *SchedulerPresetUpdateOne) Save(ctx context.Context) (*SchedulerPreset, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchedulerPresetUpdateOne) SaveX(ctx context.Context) *SchedulerPreset {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SchedulerPresetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchedulerPresetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SchedulerPresetUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := schedulerpreset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SchedulerPresetUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := schedulerpreset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scheduler(); ok {
+		if err := schedulerpreset.SchedulerValidator(v); err != nil {
+			return &ValidationError{Name: "scheduler", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.scheduler": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AlgorithmVersion(); ok {
+		if err := schedulerpreset.AlgorithmVersionValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm_version", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.algorithm_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PrerequisiteGate(); ok {
+		if err := schedulerpreset.PrerequisiteGateValidator(v); err != nil {
+			return &ValidationError{Name: "prerequisite_gate", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.prerequisite_gate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QueueOrder(); ok {
+		if err := schedulerpreset.QueueOrderValidator(v); err != nil {
+			return &ValidationError{Name: "queue_order", err: fmt.Errorf(`ent: validator failed for field "SchedulerPreset.queue_order": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchedulerPresetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchedulerPresetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchedulerPresetUpdateOne) sqlSave(ctx context.Context) (_node *SchedulerPreset, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedulerpreset.Table, schedulerpreset.Columns, sqlgraph.NewFieldSpec(schedulerpreset.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SchedulerPreset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulerpreset.FieldID)
+		for _, f := range fields {
+			if !schedulerpreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedulerpreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(schedulerpreset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scheduler(); ok {
+		_spec.SetField(schedulerpreset.FieldScheduler, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LeitnerIntervals(); ok {
+		_spec.SetField(schedulerpreset.FieldLeitnerIntervals, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLeitnerIntervals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldLeitnerIntervals, value)
+		})
+	}
+	if _u.mutation.LeitnerIntervalsCleared() {
+		_spec.ClearField(schedulerpreset.FieldLeitnerIntervals, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AlgorithmVersion(); ok {
+		_spec.SetField(schedulerpreset.FieldAlgorithmVersion, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Weights(); ok {
+		_spec.SetField(schedulerpreset.FieldWeights, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWeights(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldWeights, value)
+		})
+	}
+	if value, ok := _u.mutation.DesiredRetention(); ok {
+		_spec.SetField(schedulerpreset.FieldDesiredRetention, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDesiredRetention(); ok {
+		_spec.AddField(schedulerpreset.FieldDesiredRetention, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldMaxInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxInterval(); ok {
+		_spec.AddField(schedulerpreset.FieldMaxInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnableFuzz(); ok {
+		_spec.SetField(schedulerpreset.FieldEnableFuzz, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnableLoadBalance(); ok {
+		_spec.SetField(schedulerpreset.FieldEnableLoadBalance, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LearningSteps(); ok {
+		_spec.SetField(schedulerpreset.FieldLearningSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLearningSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldLearningSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.RelearningSteps(); ok {
+		_spec.SetField(schedulerpreset.FieldRelearningSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRelearningSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schedulerpreset.FieldRelearningSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.GraduatingInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldGraduatingInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGraduatingInterval(); ok {
+		_spec.AddField(schedulerpreset.FieldGraduatingInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EasyInterval(); ok {
+		_spec.SetField(schedulerpreset.FieldEasyInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEasyInterval(); ok {
+		_spec.AddField(schedulerpreset.FieldEasyInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeechThreshold(); ok {
+		_spec.SetField(schedulerpreset.FieldLeechThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeechThreshold(); ok {
+		_spec.AddField(schedulerpreset.FieldLeechThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeechSuspend(); ok {
+		_spec.SetField(schedulerpreset.FieldLeechSuspend, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BuryTranslations(); ok {
+		_spec.SetField(schedulerpreset.FieldBuryTranslations, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ImplicitCredit(); ok {
+		_spec.SetField(schedulerpreset.FieldImplicitCredit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImplicitCredit(); ok {
+		_spec.AddField(schedulerpreset.FieldImplicitCredit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ImplicitLapsePull(); ok {
+		_spec.SetField(schedulerpreset.FieldImplicitLapsePull, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImplicitLapsePull(); ok {
+		_spec.AddField(schedulerpreset.FieldImplicitLapsePull, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PrerequisiteGate(); ok {
+		_spec.SetField(schedulerpreset.FieldPrerequisiteGate, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PrerequisiteMinStability(); ok {
+		_spec.SetField(schedulerpreset.FieldPrerequisiteMinStability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrerequisiteMinStability(); ok {
+		_spec.AddField(schedulerpreset.FieldPrerequisiteMinStability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ErrorResolveAfter(); ok {
+		_spec.SetField(schedulerpreset.FieldErrorResolveAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedErrorResolveAfter(); ok {
+		_spec.AddField(schedulerpreset.FieldErrorResolveAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NewPerDay(); ok {
+		_spec.SetField(schedulerpreset.FieldNewPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNewPerDay(); ok {
+		_spec.AddField(schedulerpreset.FieldNewPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReviewsPerDay(); ok {
+		_spec.SetField(schedulerpreset.FieldReviewsPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReviewsPerDay(); ok {
+		_spec.AddField(schedulerpreset.FieldReviewsPerDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LearnAheadMinutes(); ok {
+		_spec.SetField(schedulerpreset.FieldLearnAheadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLearnAheadMinutes(); ok {
+		_spec.AddField(schedulerpreset.FieldLearnAheadMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QueueOrder(); ok {
+		_spec.SetField(schedulerpreset.FieldQueueOrder, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(schedulerpreset.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedulerpreset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedulerpreset.NodesTable,
+			Columns: []string{schedulerpreset.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedulerpreset.NodesTable,
+			Columns: []string{schedulerpreset.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedulerpreset.NodesTable,
+			Columns: []string{schedulerpreset.NodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SchedulerPreset{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerpreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -53,6 +54,11 @@ func (Attempt) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// Free-form explanation of the errors linked to this attempt
+		field.String("error_note").
+			Optional().
+			Comment("What went wrong, in the user's words"),
+
 		// User Input Field
 		field.String("user_answer").
 			Optional().
@@ -62,6 +68,35 @@ func (Attempt) Fields() []ent.Field {
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Error logs, difficulty rating, and other attempt metadata"),
+
+		// Idempotency
+		field.UUID("review_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Client-supplied ID; a repeated submission with the same ID is ignored"),
+
+		// Which algorithm made the scheduling decision
+		field.Enum("scheduler").
+			Values("learning_steps", "fsrs", "sm2", "leitner").
+			Optional().
+			Nillable().
+			Comment("Scheduler that decided the next review; empty for attempts recorded before schedulers were pluggable"),
+
+		// Implicit credit propagated along associations is logged, not graded
+		field.Enum("kind").
+			Values("review", "implicit").
+			Default("review").
+			Comment("review = graded by the user; implicit = credit or lapse propagated from a linked node"),
+
+		// Undo support
+		field.JSON("card_before", &CardSnapshot{}).
+			Optional().
+			Comment("Full card state before this attempt, restored on undo"),
+
+		field.JSON("effects", &ReviewEffects{}).
+			Optional().
+			Comment("Changes the review made beyond its own card, reverted on undo"),
 	}
 }
 
@@ -77,5 +112,9 @@ func (Attempt) Edges() []ent.Edge {
 			Ref("attempts").
 			Field("error_type_id").
 			Unique(),
+
+		// Every error type recorded for this attempt; error_type_id holds the first
+		edge.To("errors", AttemptError.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AttemptError links an attempt to one of the error types made in it.
+type AttemptError struct {
+	ent.Schema
+}
+
+func (AttemptError) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("attempt_error_id"),
+
+		field.UUID("attempt_id", uuid.UUID{}),
+		field.UUID("error_type_id", uuid.UUID{}),
+	}
+}
+
+func (AttemptError) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attempt", Attempt.Type).
+			Ref("errors").
+			Field("attempt_id").
+			Unique().
+			Required(),
+
+		edge.From("error_definition", ErrorDefinition.Type).
+			Ref("attempt_errors").
+			Field("error_type_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Each error type is recorded at most once per attempt
+func (AttemptError) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attempt_id", "error_type_id").
+			Unique(),
+	}
+}
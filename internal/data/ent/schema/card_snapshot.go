@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardSnapshot is a copy of every scheduling field of an FsrsCard.
+// Attempts store the snapshot taken before the review so it can be undone exactly.
+type CardSnapshot struct {
+	State         string     `json:"state"`
+	CurrentStep   int        `json:"current_step"`
+	Stability     float64    `json:"stability"`
+	Difficulty    float64    `json:"difficulty"`
+	ElapsedDays   int        `json:"elapsed_days"`
+	ScheduledDays int        `json:"scheduled_days"`
+	Reps          int        `json:"reps"`
+	Lapses        int        `json:"lapses"`
+	EaseFactor    float64    `json:"ease_factor,omitempty"`
+	LeitnerBox    int        `json:"leitner_box"`
+	Due           time.Time  `json:"due"`
+	LastReview    *time.Time `json:"last_review,omitempty"`
+	IsLeech       *bool      `json:"is_leech,omitempty"`     // Nil in snapshots taken before leeches were undoable
+	IsSuspended   *bool      `json:"is_suspended,omitempty"` // Nil in snapshots taken before leeches were undoable
+}
+
+// ReviewEffects records what a review changed beyond its own card, so undo can revert it.
+type ReviewEffects struct {
+	CreatedResolutions []uuid.UUID          `json:"created_resolutions,omitempty"` // Opened by the review, deleted on undo
+	ChangedResolutions []ResolutionSnapshot `json:"changed_resolutions,omitempty"` // Bumped or closed by the review, restored on undo
+	ImplicitAttempts   []uuid.UUID          `json:"implicit_attempts,omitempty"`   // Credit logged on linked cards, reverted and deleted on undo
+	BuriedCards        []BuriedSnapshot     `json:"buried_cards,omitempty"`        // Translation siblings buried by the review, restored on undo
+}
+
+// BuriedSnapshot is a card's buried_until as it was before a review buried it.
+type BuriedSnapshot struct {
+	ID          uuid.UUID  `json:"id"`
+	BuriedUntil *time.Time `json:"buried_until,omitempty"`
+}
+
+// ResolutionSnapshot is an ErrorResolution as it was before a review changed it.
+type ResolutionSnapshot struct {
+	ID              uuid.UUID  `json:"id"`
+	WeightImpact    float64    `json:"weight_impact"`
+	Occurrences     int        `json:"occurrences"`
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"`
+	IsResolved      bool       `json:"is_resolved"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty"`
+	ResolutionNotes string     `json:"resolution_notes,omitempty"`
+}
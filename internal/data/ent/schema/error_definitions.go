@@ -18,9 +18,27 @@ func (ErrorDefinition) Fields() []ent.Field {
 			Default(uuid.New).
 			StorageKey("error_type_id"),
 
+		field.String("code").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Stable identifier such as ERR_LAPSE; labels may be renamed, codes may not"),
+
 		field.String("label").Unique().NotEmpty(),
+
+		field.String("category").
+			Optional().
+			Comment("Parent category used to group related error types"),
+
+		field.String("description").
+			Optional(),
+
 		field.Float("base_weight").Default(1.0),
 		field.Bool("is_system").Default(false),
+
+		field.Bool("is_archived").
+			Default(false).
+			Comment("Archived types keep their history but can no longer be recorded"),
 	}
 }
 
@@ -28,5 +46,8 @@ func (ErrorDefinition) Edges() []ent.Edge {
 	return []ent.Edge{
 		// Inverse edge to Attempts (An attempt can link to this error)
 		edge.To("attempts", Attempt.Type),
+
+		// Typed links from attempts that recorded this error
+		edge.To("attempt_errors", AttemptError.Type),
 	}
 }
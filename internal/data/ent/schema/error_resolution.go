@@ -36,9 +36,18 @@ func (ErrorResolution) Fields() []ent.Field {
 			Optional().
 			Comment("User explanation of the fix"),
 
+		field.Int("occurrences").
+			Default(1).
+			Comment("Times the error was recorded while open"),
+
 		field.Time("created_at").
 			Default(time.Now),
 
+		field.Time("last_seen_at").
+			Optional().
+			Nillable().
+			Comment("When the error was last recorded; correct answers after it count towards resolving"),
+
 		field.Time("resolved_at").
 			Optional().
 			Nillable(),
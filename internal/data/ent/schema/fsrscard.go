@@ -50,7 +50,7 @@ func (FsrsCard) Fields() []ent.Field {
 		field.Enum("state").
 			Values("new", "learning", "review", "relearning").
 			Default("new").
-			Comment("Current FSRS state."),
+			Comment("Current state: new, learning, review, relearning."),
 
 		// Timestamps
 		field.Time("last_review").
@@ -60,24 +60,43 @@ func (FsrsCard) Fields() []ent.Field {
 
 		field.Time("due").
 			Default(time.Now).
-			Comment("Next scheduled review date."),
+			Comment("When this card should be reviewed next."),
 
 		// Foreign Key
 		field.UUID("node_id", uuid.UUID{}).
 			Unique(),
 
-		// Add these fields:
-		field.String("card_state").
-			Default("new").
-			Comment("Current state: new, learning, review, relearning"),
-
 		field.Int("current_step").
 			Default(0).
 			Comment("Current index in learning/relearning steps"),
 
-		field.Time("next_review").
-			Default(time.Now).
-			Comment("When this card should be reviewed next"),
+		// Alternative schedulers
+		field.Float("ease_factor").
+			Default(2.5).
+			Comment("SM-2 ease factor (EF)"),
+
+		field.Int("leitner_box").
+			Default(0).
+			Comment("Leitner box index, 0 is the most frequent"),
+
+		// Leeches
+		field.Bool("is_leech").
+			Default(false).
+			Comment("Lapsed at least the preset's leech threshold"),
+
+		field.Bool("is_suspended").
+			Default(false).
+			Comment("Excluded from study sessions until unsuspended"),
+
+		field.Time("buried_until").
+			Optional().
+			Nillable().
+			Comment("Excluded from study sessions until this time"),
+
+		// Optimistic concurrency
+		field.Int("version").
+			Default(0).
+			Comment("Bumped by every review; a stale version means someone else graded the card"),
 	}
 }
 
@@ -47,6 +47,11 @@ func (Node) Fields() []ent.Field {
 		field.UUID("parent_id", uuid.UUID{}).
 			Optional().
 			Nillable(), // [cite: 21]
+
+		// Scheduler preset assigned to this subject/topic (inherited by descendants)
+		field.UUID("preset_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -80,5 +85,11 @@ func (Node) Edges() []ent.Edge {
 		// 5. Error Resolutions (CASCADE DELETE)
 		edge.To("error_resolutions", ErrorResolution.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		// 6. Scheduler Preset (SET NULL when the preset is deleted)
+		edge.From("preset", SchedulerPreset.Type).
+			Ref("nodes").
+			Field("preset_id").
+			Unique(),
 	}
 }
@@ -0,0 +1,153 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SchedulerPreset holds a named set of FSRS and learning-step parameters.
+// A preset assigned to a subject or topic applies to every descendant card.
+type SchedulerPreset struct {
+	ent.Schema
+}
+
+// Fields of the SchedulerPreset.
+func (SchedulerPreset) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			StorageKey("preset_id"),
+
+		field.String("name").
+			Unique().
+			NotEmpty(),
+
+		// FSRS Parameters
+		field.Enum("scheduler").
+			Values("fsrs", "sm2", "leitner").
+			Default("fsrs").
+			Comment("Algorithm that schedules cards after learning steps"),
+
+		field.JSON("leitner_intervals", []int{}).
+			Optional().
+			Comment("Days between reviews for each Leitner box"),
+
+		field.Enum("algorithm_version").
+			Values("v4", "v5", "v6").
+			Default("v4").
+			Comment("FSRS formula version; determines the expected weight count"),
+
+		field.JSON("weights", []float64{}).
+			Comment("FSRS weights (W): 17 for v4, 19 for v5, 21 for v6"),
+
+		field.Float("desired_retention").
+			Default(0.9).
+			Comment("Target probability of recall at the due date"),
+
+		field.Int("max_interval").
+			Default(36500).
+			Comment("Upper bound on scheduled interval in days"),
+
+		field.Bool("enable_fuzz").
+			Default(true).
+			Comment("Spread review intervals within the FSRS fuzz ranges"),
+
+		field.Bool("enable_load_balance").
+			Default(false).
+			Comment("Move reviews to the least loaded day in the fuzz window"),
+
+		// Learning Steps
+		field.JSON("learning_steps", []int{}).
+			Comment("Minutes between learning steps for new cards"),
+
+		field.JSON("relearning_steps", []int{}).
+			Comment("Minutes between relearning steps after a lapse"),
+
+		field.Int("graduating_interval").
+			Default(1).
+			Comment("Days until first review after the last learning step"),
+
+		field.Int("easy_interval").
+			Default(4).
+			Comment("Days until first review when a learning card is rated Easy"),
+
+		// Leech handling
+		field.Int("leech_threshold").
+			Default(8).
+			Comment("Lapses after which a card is flagged as a leech"),
+
+		field.Bool("leech_suspend").
+			Default(false).
+			Comment("Suspend cards when they become leeches"),
+
+		field.Bool("bury_translations").
+			Default(false).
+			Comment("Bury translation siblings until tomorrow after one of them is reviewed"),
+
+		// Implicit credit along tests/defines associations
+		field.Float("implicit_credit").
+			Default(0.25).
+			Comment("Fraction of a repetition given to linked theory when a problem is solved; 0 disables"),
+
+		field.Float("implicit_lapse_pull").
+			Default(0.5).
+			Comment("Fraction of the remaining interval removed from linked theory when a problem lapses; 0 disables"),
+
+		// Prerequisite gating along comes_before links
+		field.Enum("prerequisite_gate").
+			Values("off", "stability", "mastery").
+			Default("off").
+			Comment("What a prerequisite must reach before new cards that depend on it are introduced"),
+
+		field.Float("prerequisite_min_stability").
+			Default(3).
+			Comment("Stability in days each prerequisite card needs when gating by stability"),
+
+		// Error resolution
+		field.Int("error_resolve_after").
+			Default(3).
+			Comment("Consecutive correct answers that close an open error; 0 leaves errors open until resolved by hand"),
+
+		// Queue assembly
+		field.Int("new_per_day").
+			Default(20).
+			Comment("New cards introduced per day"),
+
+		field.Int("reviews_per_day").
+			Default(200).
+			Comment("Review cards shown per day"),
+
+		field.Int("learn_ahead_minutes").
+			Default(20).
+			Comment("Learning cards due within this window are shown early"),
+
+		field.Enum("queue_order").
+			Values("mixed", "new_first", "reviews_first").
+			Default("mixed").
+			Comment("How new cards are interleaved with reviews"),
+
+		field.Bool("is_default").
+			Default(false).
+			Comment("Used for nodes with no preset on any ancestor"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SchedulerPreset.
+func (SchedulerPreset) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Subjects/Topics this preset is assigned to
+		edge.To("nodes", Node.Type),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Settings holds the user's app-wide preferences (a single row)
+type Settings struct {
+	ent.Schema
+}
+
+// Fields of the Settings.
+func (Settings) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("day_start_hour").
+			Default(4).
+			Range(0, 23).
+			Comment("Hour at which the next study day starts"),
+
+		field.String("timezone").
+			Default("").
+			Comment("IANA timezone for day boundaries; empty uses the system timezone"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,78 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settings
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the settings type in the database.
+	Label = "settings"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDayStartHour holds the string denoting the day_start_hour field in the database.
+	FieldDayStartHour = "day_start_hour"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the settings in the database.
+	Table = "settings"
+)
+
+// Columns holds all SQL columns for settings fields.
+var Columns = []string{
+	FieldID,
+	FieldDayStartHour,
+	FieldTimezone,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDayStartHour holds the default value on creation for the "day_start_hour" field.
+	DefaultDayStartHour int
+	// DayStartHourValidator is a validator for the "day_start_hour" field. It is called by the builders before save.
+	DayStartHourValidator func(int) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Settings queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDayStartHour orders the results by the day_start_hour field.
+func ByDayStartHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDayStartHour, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settings
+
+import (
+	"profen/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldID, id))
+}
+
+// DayStartHour applies equality check predicate on the "day_start_hour" field. It's identical to DayStartHourEQ.
+func DayStartHour(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldDayStartHour, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldTimezone, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DayStartHourEQ applies the EQ predicate on the "day_start_hour" field.
+func DayStartHourEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldDayStartHour, v))
+}
+
+// DayStartHourNEQ applies the NEQ predicate on the "day_start_hour" field.
+func DayStartHourNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldDayStartHour, v))
+}
+
+// DayStartHourIn applies the In predicate on the "day_start_hour" field.
+func DayStartHourIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldDayStartHour, vs...))
+}
+
+// DayStartHourNotIn applies the NotIn predicate on the "day_start_hour" field.
+func DayStartHourNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldDayStartHour, vs...))
+}
+
+// DayStartHourGT applies the GT predicate on the "day_start_hour" field.
+func DayStartHourGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldDayStartHour, v))
+}
+
+// DayStartHourGTE applies the GTE predicate on the "day_start_hour" field.
+func DayStartHourGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldDayStartHour, v))
+}
+
+// DayStartHourLT applies the LT predicate on the "day_start_hour" field.
+func DayStartHourLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldDayStartHour, v))
+}
+
+// DayStartHourLTE applies the LTE predicate on the "day_start_hour" field.
+func DayStartHourLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldDayStartHour, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Settings) predicate.Settings {
+	return predicate.Settings(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Settings) predicate.Settings {
+	return predicate.Settings(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Settings) predicate.Settings {
+	return predicate.Settings(sql.NotPredicates(p))
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"profen/internal/data/ent/settings"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SettingsCreate is the builder for creating a Settings entity.
+type SettingsCreate struct {
+	config
+	mutation *SettingsMutation
+	hooks    []Hook
+}
+
+// SetDayStartHour sets the "day_start_hour" field.
+func (_c *SettingsCreate) SetDayStartHour(v int) *SettingsCreate {
+	_c.mutation.SetDayStartHour(v)
+	return _c
+}
+
+// SetNillableDayStartHour sets the "day_start_hour" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableDayStartHour(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetDayStartHour(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *SettingsCreate) SetTimezone(v string) *SettingsCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableTimezone(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SettingsCreate) SetUpdatedAt(v time.Time) *SettingsCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableUpdatedAt(v *time.Time) *SettingsCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SettingsMutation object of the builder.
+func (_c *SettingsCreate) Mutation() *SettingsMutation {
+	return _c.mutation
+}
+
+// Save creates the Settings in the database.
+func (_c *SettingsCreate) Save(ctx context.Context) (*Settings, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SettingsCreate) SaveX(ctx context.Context) *Settings {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettingsCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettingsCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SettingsCreate) defaults() {
+	if _, ok := _c.mutation.DayStartHour(); !ok {
+		v := settings.DefaultDayStartHour
+		_c.mutation.SetDayStartHour(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := settings.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := settings.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SettingsCreate) check() error {
+	if _, ok := _c.mutation.DayStartHour(); !ok {
+		return &ValidationError{Name: "day_start_hour", err: errors.New(`ent: missing required field "Settings.day_start_hour"`)}
+	}
+	if v, ok := _c.mutation.DayStartHour(); ok {
+		if err := settings.DayStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "day_start_hour", err: fmt.Errorf(`ent: validator failed for field "Settings.day_start_hour": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "Settings.timezone"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Settings.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SettingsCreate) sqlSave(ctx context.Context) (*Settings, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SettingsCreate) createSpec() (*Settings, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Settings{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(settings.Table, sqlgraph.NewFieldSpec(settings.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DayStartHour(); ok {
+		_spec.SetField(settings.FieldDayStartHour, field.TypeInt, value)
+		_node.DayStartHour = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(settings.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(settings.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SettingsCreateBulk is the builder for creating many Settings entities in bulk.
+type SettingsCreateBulk struct {
+	config
+	err      error
+	builders []*SettingsCreate
+}
+
+// Save creates the Settings entities in the database.
+func (_c *SettingsCreateBulk) Save(ctx context.Context) ([]*Settings, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Settings, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettingsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SettingsCreateBulk) SaveX(ctx context.Context) []*Settings {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettingsCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettingsCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
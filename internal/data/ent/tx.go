@@ -14,6 +14,8 @@ type Tx struct {
 	config
 	// Attempt is the client for interacting with the Attempt builders.
 	Attempt *AttemptClient
+	// AttemptError is the client for interacting with the AttemptError builders.
+	AttemptError *AttemptErrorClient
 	// ErrorDefinition is the client for interacting with the ErrorDefinition builders.
 	ErrorDefinition *ErrorDefinitionClient
 	// ErrorResolution is the client for interacting with the ErrorResolution builders.
@@ -26,6 +28,10 @@ type Tx struct {
 	NodeAssociation *NodeAssociationClient
 	// NodeClosure is the client for interacting with the NodeClosure builders.
 	NodeClosure *NodeClosureClient
+	// SchedulerPreset is the client for interacting with the SchedulerPreset builders.
+	SchedulerPreset *SchedulerPresetClient
+	// Settings is the client for interacting with the Settings builders.
+	Settings *SettingsClient
 
 	// lazily loaded.
 	client     *Client
@@ -158,12 +164,15 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Attempt = NewAttemptClient(tx.config)
+	tx.AttemptError = NewAttemptErrorClient(tx.config)
 	tx.ErrorDefinition = NewErrorDefinitionClient(tx.config)
 	tx.ErrorResolution = NewErrorResolutionClient(tx.config)
 	tx.FsrsCard = NewFsrsCardClient(tx.config)
 	tx.Node = NewNodeClient(tx.config)
 	tx.NodeAssociation = NewNodeAssociationClient(tx.config)
 	tx.NodeClosure = NewNodeClosureClient(tx.config)
+	tx.SchedulerPreset = NewSchedulerPresetClient(tx.config)
+	tx.Settings = NewSettingsClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
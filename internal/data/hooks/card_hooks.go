@@ -4,15 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
+	"profen/internal/data"
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/node"
 )
 
 // FsrsCardInitHook automatically creates an empty FSRS card
-// whenever a new Node is created.
-func FsrsCardInitHook(c *ent.Client) ent.Hook {
+// whenever a new Node is created. The card is due at the clock's now.
+func FsrsCardInitHook(c *ent.Client, clock data.Clock) ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			// 1. Filter: Only OpCreate on Nodes
@@ -42,7 +42,7 @@ func FsrsCardInitHook(c *ent.Client) ent.Hook {
 					SetScheduledDays(0).
 					SetReps(0).
 					SetLapses(0).
-					SetDue(time.Now()). // Due Immediately
+					SetDue(clock.Now()). // Due Immediately
 					Exec(ctx)
 
 				if err != nil {
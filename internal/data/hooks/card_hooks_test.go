@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"profen/internal/data"
 	"profen/internal/data/ent/enttest"
 	"profen/internal/data/ent/fsrscard"
 	"profen/internal/data/ent/node"
@@ -20,7 +21,7 @@ func TestFsrsCardInitHook(t *testing.T) {
 	defer client.Close()
 
 	// Register Hook
-	client.Node.Use(hooks.FsrsCardInitHook(client))
+	client.Node.Use(hooks.FsrsCardInitHook(client, data.SystemClock()))
 
 	ctx := context.Background()
 	client.FsrsCard.Delete().Exec(ctx)
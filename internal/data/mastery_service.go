@@ -26,6 +26,7 @@ func (s *MasteryService) GetNodeMastery(ctx context.Context, nodeID uuid.UUID) (
 			attempt.HasCardWith(
 				fsrscard.HasNodeWith(node.ID(nodeID)),
 			),
+			attempt.KindEQ(attempt.KindReview),
 		).
 		Order(ent.Desc(attempt.FieldCreatedAt)).
 		All(ctx)
@@ -9,6 +9,7 @@ import (
 	"profen/internal/data/ent/node"
 	"profen/internal/data/ent/nodeassociation"
 	"profen/internal/data/ent/nodeclosure"
+	"profen/internal/data/ent/predicate"
 
 	"github.com/google/uuid"
 )
@@ -168,10 +169,11 @@ func (r *NodeRepository) CreateAssociation(ctx context.Context, sourceID, target
 		return fmt.Errorf("cannot associate node with itself")
 	}
 
-	// IMPORTANT: Ensure source_id < target_id to satisfy CHECK constraint
+	// IMPORTANT: Ensure source_id < target_id to satisfy CHECK constraint.
+	// Swapping reverses the direction, so the relation is inverted too.
 	if sourceID.String() > targetID.String() {
-		// Swap if needed
 		sourceID, targetID = targetID, sourceID
+		relType = InverseRelType(relType)
 	}
 
 	return r.client.NodeAssociation.Create().
@@ -181,6 +183,60 @@ func (r *NodeRepository) CreateAssociation(ctx context.Context, sourceID, target
 		Exec(ctx)
 }
 
+// InverseRelType returns the relation read from the other end,
+// e.g. "A tests B" is "B defines A"
+func InverseRelType(relType nodeassociation.RelType) nodeassociation.RelType {
+	switch relType {
+	case nodeassociation.RelTypeComesBefore:
+		return nodeassociation.RelTypeComesAfter
+	case nodeassociation.RelTypeComesAfter:
+		return nodeassociation.RelTypeComesBefore
+	case nodeassociation.RelTypeTests:
+		return nodeassociation.RelTypeDefines
+	case nodeassociation.RelTypeDefines:
+		return nodeassociation.RelTypeTests
+	case nodeassociation.RelTypeTranslationOf:
+		return nodeassociation.RelTypeTranslatedFrom
+	case nodeassociation.RelTypeTranslatedFrom:
+		return nodeassociation.RelTypeTranslationOf
+	case nodeassociation.RelTypeVariantOf:
+		return nodeassociation.RelTypeSourceVariant
+	case nodeassociation.RelTypeSourceVariant:
+		return nodeassociation.RelTypeVariantOf
+	default:
+		return relType // similar_to is symmetric
+	}
+}
+
+// TestedBy matches the nodes a problem tests: "problem tests X" or "X defines
+// problem", whichever way round the association was stored
+func TestedBy(problemID uuid.UUID) predicate.Node {
+	return node.Or(
+		node.HasIncomingAssociationsWith(
+			nodeassociation.SourceID(problemID),
+			nodeassociation.RelTypeEQ(nodeassociation.RelTypeTests),
+		),
+		node.HasOutgoingAssociationsWith(
+			nodeassociation.TargetID(problemID),
+			nodeassociation.RelTypeEQ(nodeassociation.RelTypeDefines),
+		),
+	)
+}
+
+// Testing matches the problems that test a theory, the inverse of TestedBy
+func Testing(theoryID uuid.UUID) predicate.Node {
+	return node.Or(
+		node.HasOutgoingAssociationsWith(
+			nodeassociation.TargetID(theoryID),
+			nodeassociation.RelTypeEQ(nodeassociation.RelTypeTests),
+		),
+		node.HasIncomingAssociationsWith(
+			nodeassociation.SourceID(theoryID),
+			nodeassociation.RelTypeEQ(nodeassociation.RelTypeDefines),
+		),
+	)
+}
+
 // GetNodeAssociations returns all associations for a given node (both as source and target)
 func (r *NodeRepository) GetNodeAssociations(ctx context.Context, nodeID uuid.UUID) ([]*ent.NodeAssociation, error) {
 	return r.client.NodeAssociation.Query().
@@ -55,7 +55,12 @@ func NewClient(cfg Config) (*Client, error) {
 
 	// Register Hooks
 	entClient.Node.Use(hooks.NodeClosureHook(entClient))
-	entClient.Node.Use(hooks.FsrsCardInitHook(entClient))
+	entClient.Node.Use(hooks.FsrsCardInitHook(entClient, data.SystemClock()))
+
+	// Data migrations that must run before auto-migration drops columns
+	if err := reconcileCardColumns(context.Background(), db); err != nil {
+		return nil, fmt.Errorf("failed to reconcile card columns: %w", err)
+	}
 
 	// Auto-Migration
 	if err := entClient.Schema.Create(
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// reconcileCardColumns folds the legacy fsrs_cards.card_state / next_review
+// columns into state / due before auto-migration drops them. The scheduler
+// only ever wrote the legacy columns, so their values win. Undo snapshots
+// stored on attempts are rewritten the same way. Safe to run repeatedly.
+func reconcileCardColumns(ctx context.Context, db *sql.DB) error {
+	hasCardState, err := columnExists(ctx, db, "fsrs_cards", "card_state")
+	if err != nil {
+		return err
+	}
+	hasNextReview, err := columnExists(ctx, db, "fsrs_cards", "next_review")
+	if err != nil {
+		return err
+	}
+	hasSnapshots, err := columnExists(ctx, db, "attempts", "card_before")
+	if err != nil {
+		return err
+	}
+
+	if !hasCardState && !hasNextReview && !hasSnapshots {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if hasCardState {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE fsrs_cards
+			SET state = card_state
+			WHERE card_state IN ('new', 'learning', 'review', 'relearning')
+			  AND state IS DISTINCT FROM card_state`)
+		if err != nil {
+			return fmt.Errorf("reconciling card state: %w", err)
+		}
+	}
+
+	if hasNextReview {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE fsrs_cards
+			SET due = next_review
+			WHERE next_review IS NOT NULL
+			  AND due IS DISTINCT FROM next_review`)
+		if err != nil {
+			return fmt.Errorf("reconciling card due date: %w", err)
+		}
+	}
+
+	if hasSnapshots {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE attempts
+			SET card_before = (card_before - 'card_state' - 'next_review')
+				|| jsonb_build_object(
+					'state', COALESCE(card_before->'card_state', card_before->'state'),
+					'due', COALESCE(card_before->'next_review', card_before->'due'))
+			WHERE card_before ? 'card_state' OR card_before ? 'next_review'`)
+		if err != nil {
+			return fmt.Errorf("reconciling attempt snapshots: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func columnExists(ctx context.Context, db *sql.DB, table, column string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema()
+			  AND table_name = $1
+			  AND column_name = $2
+		)`, table, column).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("checking column %s.%s: %w", table, column, err)
+	}
+	return exists, nil
+}
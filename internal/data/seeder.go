@@ -2,34 +2,87 @@ package data
 
 import (
 	"context"
+	"fmt"
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/errordefinition"
 )
 
-func SeedErrorDefinitions(ctx context.Context, client *ent.Client) error {
-	// Defaults from SDLC
-	defaults := []struct {
-		Label  string
-		Weight float64
-	}{
-		{"Memory Lapse", 1.0},
-		{"Conceptual Gap", 2.5},
-		{"Execution/Syntax", 1.2},
-		{"Recognition Fail", 1.5},
-	}
+// Codes of the canonical system error types
+const (
+	ErrorCodeLapse     = "ERR_LAPSE"
+	ErrorCodeConcept   = "ERR_CONCEPT"
+	ErrorCodeExecution = "ERR_EXECUTION"
+	ErrorCodeLangRec   = "ERR_LANG_REC"
+	ErrorCodeLangProd  = "ERR_LANG_PROD"
+	ErrorCodeFluency   = "ERR_FLUENCY"
+)
+
+// SystemErrorType is one canonical error type from the SDLC table
+type SystemErrorType struct {
+	Code        string
+	Label       string
+	Category    string
+	Description string
+	Weight      float64
+	LegacyLabel string // Label seeded before codes existed, adopted instead of duplicated
+}
+
+// SystemErrorTypes lists the canonical error types
+var SystemErrorTypes = []SystemErrorType{
+	{ErrorCodeLapse, "Memory Lapse", "Memory", "Forgot a known fact", 1.0, ""},
+	{ErrorCodeConcept, "Conceptual Gap", "Understanding", "Fundamental misunderstanding of theory", 2.5, ""},
+	{ErrorCodeExecution, "Execution/Syntax", "Execution", "Logic correct, but typo or syntax error", 1.2, ""},
+	{ErrorCodeLangRec, "Recognition (Decoding)", "Language", "Failed to translate Target → Source", 1.5, "Recognition Fail"},
+	{ErrorCodeLangProd, "Production (Encoding)", "Language", "Failed to translate Source → Target", 2.0, ""},
+	{ErrorCodeFluency, "Speed/Timing", "Fluency", "Correct but exceeded time limit", 1.1, ""},
+}
 
-	for _, d := range defaults {
-		// Upsert logic (check if exists)
-		exists, _ := client.ErrorDefinition.Query().
-			Where(errordefinition.LabelEQ(d.Label)).
+// SeedErrorDefinitions makes sure every canonical error type exists under its
+// code. Rows seeded by label before codes existed are adopted; user-adjusted
+// weights are kept.
+func SeedErrorDefinitions(ctx context.Context, client *ent.Client) error {
+	for _, d := range SystemErrorTypes {
+		exists, err := client.ErrorDefinition.Query().
+			Where(errordefinition.Code(d.Code)).
 			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("checking error type %s: %w", d.Code, err)
+		}
+		if exists {
+			continue
+		}
 
-		if !exists {
-			client.ErrorDefinition.Create().
+		labels := []string{d.Label}
+		if d.LegacyLabel != "" {
+			labels = append(labels, d.LegacyLabel)
+		}
+		legacy, err := client.ErrorDefinition.Query().
+			Where(
+				errordefinition.LabelIn(labels...),
+				errordefinition.CodeIsNil(),
+			).
+			First(ctx)
+		switch {
+		case err == nil:
+			err = legacy.Update().
+				SetCode(d.Code).
 				SetLabel(d.Label).
+				SetCategory(d.Category).
+				SetDescription(d.Description).
+				SetIsSystem(true).
+				Exec(ctx)
+		case ent.IsNotFound(err):
+			err = client.ErrorDefinition.Create().
+				SetCode(d.Code).
+				SetLabel(d.Label).
+				SetCategory(d.Category).
+				SetDescription(d.Description).
 				SetBaseWeight(d.Weight).
 				SetIsSystem(true).
-				Save(ctx)
+				Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("seeding error type %s: %w", d.Code, err)
 		}
 	}
 	return nil
@@ -0,0 +1,48 @@
+package data_test
+
+import (
+	"context"
+	"testing"
+
+	"profen/internal/data"
+	"profen/internal/data/ent/enttest"
+	"profen/internal/data/ent/errordefinition"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedErrorDefinitions_AdoptsLegacyLabels(t *testing.T) {
+	dsn := "host=localhost port=5173 user=postgres password=054625565 dbname=profen_test sslmode=disable"
+	client := enttest.Open(t, "postgres", dsn)
+	defer client.Close()
+
+	ctx := context.Background()
+	client.Attempt.Delete().Exec(ctx)
+	client.ErrorResolution.Delete().Exec(ctx)
+	client.ErrorDefinition.Delete().Exec(ctx)
+
+	// A row seeded by label before codes existed, with a user-adjusted weight
+	legacy := client.ErrorDefinition.Create().
+		SetLabel("Recognition Fail").
+		SetBaseWeight(3).
+		SetIsSystem(true).
+		SaveX(ctx)
+
+	require.NoError(t, data.SeedErrorDefinitions(ctx, client))
+	require.NoError(t, data.SeedErrorDefinitions(ctx, client)) // Idempotent
+
+	assert.Equal(t, len(data.SystemErrorTypes), client.ErrorDefinition.Query().CountX(ctx))
+
+	adopted := client.ErrorDefinition.GetX(ctx, legacy.ID)
+	require.NotNil(t, adopted.Code)
+	assert.Equal(t, data.ErrorCodeLangRec, *adopted.Code)
+	assert.Equal(t, "Recognition (Decoding)", adopted.Label)
+	assert.Equal(t, "Language", adopted.Category)
+	assert.Equal(t, 3.0, adopted.BaseWeight)
+
+	fluency := client.ErrorDefinition.Query().Where(errordefinition.Code(data.ErrorCodeFluency)).OnlyX(ctx)
+	assert.True(t, fluency.IsSystem)
+	assert.Equal(t, 1.1, fluency.BaseWeight)
+}
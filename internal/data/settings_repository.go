@@ -0,0 +1,63 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"profen/internal/data/ent"
+)
+
+// SettingsRepository reads and writes the single app settings row
+type SettingsRepository struct {
+	client *ent.Client
+}
+
+func NewSettingsRepository(client *ent.Client) *SettingsRepository {
+	return &SettingsRepository{client: client}
+}
+
+// GetSettings returns the settings, creating them with defaults on first use
+func (r *SettingsRepository) GetSettings(ctx context.Context) (*ent.Settings, error) {
+	settings, err := r.client.Settings.Query().First(ctx)
+	if ent.IsNotFound(err) {
+		return r.client.Settings.Create().Save(ctx)
+	}
+	return settings, err
+}
+
+// UpdateDayBoundary sets the "next day starts at" hour and the timezone
+func (r *SettingsRepository) UpdateDayBoundary(ctx context.Context, startHour int, timezone string) (*ent.Settings, error) {
+	if startHour < 0 || startHour > 23 {
+		return nil, fmt.Errorf("day start hour must be between 0 and 23, got %d", startHour)
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Errorf("unknown timezone %q: %w", timezone, err)
+	}
+
+	settings, err := r.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return settings.Update().
+		SetDayStartHour(startHour).
+		SetTimezone(timezone).
+		Save(ctx)
+}
+
+// DayBoundary returns the configured study-day boundary
+func (r *SettingsRepository) DayBoundary(ctx context.Context) (DayBoundary, error) {
+	settings, err := r.GetSettings(ctx)
+	if err != nil {
+		return DayBoundary{}, fmt.Errorf("loading settings: %w", err)
+	}
+
+	loc := time.Local
+	if settings.Timezone != "" {
+		loc, err = time.LoadLocation(settings.Timezone)
+		if err != nil {
+			return DayBoundary{}, fmt.Errorf("loading timezone %q: %w", settings.Timezone, err)
+		}
+	}
+	return DayBoundary{StartHour: settings.DayStartHour, Location: loc}, nil
+}
@@ -2,24 +2,27 @@ package data
 
 import (
 	"context"
-	"time"
 
 	"profen/internal/data/ent"
+	"profen/internal/data/ent/attempt"
 	"profen/internal/data/ent/fsrscard"
 )
 
 type StatsRepository struct {
 	client *ent.Client
+	clock  Clock
 }
 
-func NewStatsRepository(client *ent.Client) *StatsRepository {
-	return &StatsRepository{client: client}
+func NewStatsRepository(client *ent.Client, clock Clock) *StatsRepository {
+	return &StatsRepository{client: client, clock: clock}
 }
 
 type DashboardStats struct {
 	TotalNodes    int `json:"total_nodes"`
 	TotalAttempts int `json:"total_attempts"`
 	DueCards      int `json:"due_cards"`
+	Suspended     int `json:"suspended"`
+	Buried        int `json:"buried"`
 }
 
 func (r *StatsRepository) GetDashboardStats(ctx context.Context) (*DashboardStats, error) {
@@ -30,15 +33,38 @@ func (r *StatsRepository) GetDashboardStats(ctx context.Context) (*DashboardStat
 	}
 
 	// Total attempts
-	totalAttempts, err := r.client.Attempt.Query().Count(ctx)
+	totalAttempts, err := r.client.Attempt.Query().
+		Where(attempt.KindEQ(attempt.KindReview)).
+		Count(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Due cards (problems/theories with FSRS cards scheduled for today or earlier)
-	now := time.Now()
+	// Due today (same definition as the study queue)
+	day, err := NewSettingsRepository(r.client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := r.clock.Now()
 	dueCards, err := r.client.FsrsCard.Query().
-		Where(fsrscard.DueLTE(now)).
+		Where(DueCards(now, day)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cards out of rotation
+	suspended, err := r.client.FsrsCard.Query().
+		Where(fsrscard.IsSuspended(true)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buried, err := r.client.FsrsCard.Query().
+		Where(
+			fsrscard.IsSuspended(false),
+			fsrscard.BuriedUntilGT(now),
+		).
 		Count(ctx)
 	if err != nil {
 		return nil, err
@@ -48,5 +74,7 @@ func (r *StatsRepository) GetDashboardStats(ctx context.Context) (*DashboardStat
 		TotalNodes:    totalNodes,
 		TotalAttempts: totalAttempts,
 		DueCards:      dueCards,
+		Suspended:     suspended,
+		Buried:        buried,
 	}, nil
 }
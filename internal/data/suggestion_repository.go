@@ -2,7 +2,6 @@ package data
 
 import (
 	"context"
-	"time"
 
 	"profen/internal/data/ent"
 	"profen/internal/data/ent/errorresolution"
@@ -16,27 +15,26 @@ import (
 
 type SuggestionRepository struct {
 	client *ent.Client
+	clock  Clock
 }
 
-func NewSuggestionRepository(client *ent.Client) *SuggestionRepository {
-	return &SuggestionRepository{client: client}
+func NewSuggestionRepository(client *ent.Client, clock Clock) *SuggestionRepository {
+	return &SuggestionRepository{client: client, clock: clock}
 }
 
 // 1. GetDueCards (Stream 1: Maintenance)
 // Returns cards where scheduled_days <= today OR state = 'learning'
 func (r *SuggestionRepository) GetDueCards(ctx context.Context, limit int) ([]*ent.Node, error) {
-	now := time.Now()
+	day, err := NewSettingsRepository(r.client).DayBoundary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := r.clock.Now()
 
 	return r.client.Node.Query().
 		Where(
-			node.HasFsrsCardWith(
-				fsrscard.Or(
-					// Condition A: Due date is in the past (Overdue)
-					fsrscard.DueLTE(now),
-					// Condition B: Card is in 'Learning' or 'Relearning' (High Priority)
-					fsrscard.StateIn(fsrscard.StateLearning, fsrscard.StateRelearning),
-				),
-			),
+			// Due today, or in 'Learning'/'Relearning' (High Priority)
+			node.HasFsrsCardWith(DueCards(now, day)),
 		).
 		// Eager Load the Card to show status
 		WithFsrsCard().
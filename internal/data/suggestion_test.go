@@ -21,7 +21,7 @@ func TestSuggestionEngine_DiagnosticGaps(t *testing.T) {
 
 	// Register Hooks
 	client.Node.Use(hooks.NodeClosureHook(client))
-	client.Node.Use(hooks.FsrsCardInitHook(client))
+	client.Node.Use(hooks.FsrsCardInitHook(client, data.SystemClock()))
 
 	ctx := context.Background()
 
@@ -40,7 +40,7 @@ func TestSuggestionEngine_DiagnosticGaps(t *testing.T) {
 	defConcept, _ := client.ErrorDefinition.Create().SetLabel("Concept").Save(ctx)
 	defTypo, _ := client.ErrorDefinition.Create().SetLabel("Typo").Save(ctx)
 
-	repo := data.NewSuggestionRepository(client)
+	repo := data.NewSuggestionRepository(client, data.SystemClock())
 
 	// 1. Setup Hierarchy
 	// Topic: Math